@@ -150,6 +150,18 @@ case "$MYSQL_FLAVOR" in
     echo "Found MySQL 5.6+ installation in $VT_MYSQL_ROOT."
     ;;
 
+  "MySQL57")
+    myversion=`$VT_MYSQL_ROOT/bin/mysql --version`
+    [[ "$myversion" =~ (Distrib\ 5\.7|Ver\ 8\.0) ]] || fail "Couldn't find MySQL 5.7 or 8.0 in $VT_MYSQL_ROOT. Set VT_MYSQL_ROOT to override search location."
+    echo "Found MySQL 5.7 or 8.0 installation in $VT_MYSQL_ROOT."
+    ;;
+
+  "MySQL80")
+    myversion=`$VT_MYSQL_ROOT/bin/mysql --version`
+    [[ "$myversion" =~ Ver\ 8\.0 ]] || fail "Couldn't find MySQL 8.0 in $VT_MYSQL_ROOT. Set VT_MYSQL_ROOT to override search location."
+    echo "Found MySQL 8.0 installation in $VT_MYSQL_ROOT."
+    ;;
+
   "MariaDB")
     myversion=`$VT_MYSQL_ROOT/bin/mysql --version`
     [[ "$myversion" =~ MariaDB ]] || fail "Couldn't find MariaDB in $VT_MYSQL_ROOT. Set VT_MYSQL_ROOT to override search location."
@@ -177,7 +189,7 @@ echo "Cflags:" "$($VT_MYSQL_ROOT/bin/mysql_config --cflags) -ggdb -fPIC" >> $VTR
 # we installed the standard MySQL packages from a distro into a sub-directory
 # and the provided mysql_config assumes the <root>/lib directory
 # is already in the library path.
-if [[ "$MYSQL_FLAVOR" == "MariaDB" || "$myversion" =~ ^5\.7\. ]]; then
+if [[ "$MYSQL_FLAVOR" == "MariaDB" || "$myversion" =~ ^(5\.7|8\.0)\. ]]; then
   # Use static linking because the shared library doesn't export
   # some internal functions we use, like cli_safe_read.
   echo "Libs:" "-L$VT_MYSQL_ROOT/lib $($VT_MYSQL_ROOT/bin/mysql_config --libs_r | sed -e 's,-lmysqlclient\(_r\)*,-l:libmysqlclient.a -lstdc++,')" >> $VTROOT/lib/gomysql.pc
@@ -33,6 +33,15 @@ CREATE TABLE IF NOT EXISTS _vt.shard_metadata (
   PRIMARY KEY (name)
   ) ENGINE=InnoDB;
 
+# Users are created explicitly, as MySQL 8.0 doesn't create them
+# on GRANT any more.
+CREATE USER 'vt_dba'@'localhost';
+CREATE USER 'vt_app'@'localhost';
+CREATE USER 'vt_allprivs'@'localhost';
+CREATE USER 'vt_repl'@'%';
+CREATE USER 'vt_filtered'@'localhost';
+CREATE USER 'orc_client_user'@'%' IDENTIFIED BY 'orc_client_user_password';
+
 # Admin user with all privileges.
 GRANT ALL ON *.* TO 'vt_dba'@'localhost';
 GRANT GRANT OPTION ON *.* TO 'vt_dba'@'localhost';
@@ -65,9 +74,9 @@ GRANT SELECT, INSERT, UPDATE, DELETE, CREATE, DROP, RELOAD, PROCESS, FILE,
 
 # User for Orchestrator (https://github.com/outbrain/orchestrator).
 GRANT SUPER, PROCESS, REPLICATION SLAVE, RELOAD
-  ON *.* TO 'orc_client_user'@'%' IDENTIFIED BY 'orc_client_user_password';
+  ON *.* TO 'orc_client_user'@'%';
 GRANT SELECT
-  ON _vt.* TO 'orc_client_user'@'%' IDENTIFIED BY 'orc_client_user_password';
+  ON _vt.* TO 'orc_client_user'@'%';
 
 FLUSH PRIVILEGES;
 
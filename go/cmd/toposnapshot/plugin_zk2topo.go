@@ -0,0 +1,6 @@
+package main
+
+import (
+	// Imports and register the zk2 TopologyServer
+	_ "github.com/youtube/vitess/go/vt/topo/zk2topo"
+)
@@ -0,0 +1,112 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// toposnapshot saves the topology to a file, and restores it from one.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	log "github.com/golang/glog"
+	"github.com/youtube/vitess/go/exit"
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/topo"
+	"github.com/youtube/vitess/go/vt/topo/helpers"
+	"golang.org/x/net/context"
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: %v <topo flags> export [-cells=<cell1>,<cell2>,...] <file>\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "       %v <topo flags> import [-dry_run] [-diff] <file>\n", os.Args[0])
+	flag.PrintDefaults()
+}
+
+func main() {
+	defer exit.RecoverAll()
+	defer logutil.Flush()
+
+	flag.Usage = usage
+	flag.Parse()
+	args := flag.Args()
+	if len(args) == 0 {
+		flag.Usage()
+		exit.Return(1)
+	}
+
+	ts := topo.Open()
+	defer ts.Close()
+	ctx := context.Background()
+
+	var err error
+	switch args[0] {
+	case "export":
+		err = export(ctx, ts, args[1:])
+	case "import":
+		err = restore(ctx, ts, args[1:])
+	default:
+		err = fmt.Errorf("unknown command %q", args[0])
+	}
+	if err != nil {
+		log.Errorf("%v", err)
+		exit.Return(1)
+	}
+}
+
+func export(ctx context.Context, ts topo.Server, args []string) error {
+	subFlags := flag.NewFlagSet("export", flag.ExitOnError)
+	cells := subFlags.String("cells", "", "comma-separated list of the cells to export, all the known cells by default")
+	subFlags.Parse(args)
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("export requires <file>")
+	}
+	var cellList []string
+	if *cells != "" {
+		cellList = strings.Split(*cells, ",")
+	}
+
+	s, err := helpers.TakeSnapshot(ctx, ts.Impl, cellList)
+	if err != nil {
+		return err
+	}
+	f, err := os.Create(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	if err := helpers.WriteSnapshot(f, s); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func restore(ctx context.Context, ts topo.Server, args []string) error {
+	subFlags := flag.NewFlagSet("import", flag.ExitOnError)
+	dryRun := subFlags.Bool("dry_run", false, "only lists the changes the import would make")
+	showDiff := subFlags.Bool("diff", false, "also shows the diff of each change")
+	subFlags.Parse(args)
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("import requires <file>")
+	}
+
+	f, err := os.Open(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	s, err := helpers.ReadSnapshot(f)
+	f.Close()
+	if err != nil {
+		return err
+	}
+	changes, err := helpers.RestoreSnapshot(ctx, ts.Impl, s, *dryRun)
+	for _, c := range changes {
+		fmt.Printf("%v %v\n", c.Action, c.Object)
+		if *showDiff {
+			fmt.Print(c.Diff)
+		}
+	}
+	return err
+}
@@ -370,9 +370,9 @@ func (itc *internalTabletConn) Begin(ctx context.Context, target *querypb.Target
 }
 
 // Commit is part of tabletconn.TabletConn
-func (itc *internalTabletConn) Commit(ctx context.Context, target *querypb.Target, transactionID int64) error {
-	err := itc.tablet.qsc.QueryService().Commit(ctx, target, transactionID)
-	return tabletconn.TabletErrorFromGRPC(vterrors.ToGRPCError(err))
+func (itc *internalTabletConn) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (string, error) {
+	position, err := itc.tablet.qsc.QueryService().Commit(ctx, target, transactionID)
+	return position, tabletconn.TabletErrorFromGRPC(vterrors.ToGRPCError(err))
 }
 
 // Rollback is part of tabletconn.TabletConn
@@ -388,9 +388,9 @@ func (itc *internalTabletConn) Prepare(ctx context.Context, target *querypb.Targ
 }
 
 // CommitPrepared is part of tabletconn.TabletConn
-func (itc *internalTabletConn) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) error {
-	err := itc.tablet.qsc.QueryService().CommitPrepared(ctx, target, dtid)
-	return tabletconn.TabletErrorFromGRPC(vterrors.ToGRPCError(err))
+func (itc *internalTabletConn) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) (string, error) {
+	position, err := itc.tablet.qsc.QueryService().CommitPrepared(ctx, target, dtid)
+	return position, tabletconn.TabletErrorFromGRPC(vterrors.ToGRPCError(err))
 }
 
 // RollbackPrepared is part of tabletconn.TabletConn
@@ -406,9 +406,9 @@ func (itc *internalTabletConn) CreateTransaction(ctx context.Context, target *qu
 }
 
 // StartCommit is part of tabletconn.TabletConn
-func (itc *internalTabletConn) StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) error {
-	err := itc.tablet.qsc.QueryService().StartCommit(ctx, target, transactionID, dtid)
-	return tabletconn.TabletErrorFromGRPC(vterrors.ToGRPCError(err))
+func (itc *internalTabletConn) StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (string, error) {
+	position, err := itc.tablet.qsc.QueryService().StartCommit(ctx, target, transactionID, dtid)
+	return position, tabletconn.TabletErrorFromGRPC(vterrors.ToGRPCError(err))
 }
 
 // SetRollback is part of tabletconn.TabletConn
@@ -706,10 +706,18 @@ func (itmc *internalTabletManagerClient) StopSlaveMinimum(ctx context.Context, t
 	return "", fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) StopSlaveBeforeTime(ctx context.Context, tablet *topodatapb.Tablet, stopTime time.Time) (string, error) {
+	return "", fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) StartSlave(ctx context.Context, tablet *topodatapb.Tablet) error {
 	return fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) InjectEmptyTransactions(ctx context.Context, tablet *topodatapb.Tablet, gtids string) error {
+	return fmt.Errorf("not implemented in vtcombo")
+}
+
 func (itmc *internalTabletManagerClient) TabletExternallyReparented(ctx context.Context, tablet *topodatapb.Tablet, externalID string) error {
 	return fmt.Errorf("not implemented in vtcombo")
 }
@@ -778,11 +786,11 @@ func (itmc *internalTabletManagerClient) PromoteSlave(ctx context.Context, table
 	return "", fmt.Errorf("not implemented in vtcombo")
 }
 
-func (itmc *internalTabletManagerClient) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int) (logutil.EventStream, error) {
+func (itmc *internalTabletManagerClient) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, incremental bool) (logutil.EventStream, error) {
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
-func (itmc *internalTabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
+func (itmc *internalTabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, restoreToTime time.Time, restoreToPos string) (logutil.EventStream, error) {
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
@@ -59,8 +59,8 @@ func (c fallbackClient) ExecuteBatchKeyspaceIds(ctx context.Context, queries []*
 	return c.fallback.ExecuteBatchKeyspaceIds(ctx, queries, tabletType, asTransaction, session, options)
 }
 
-func (c fallbackClient) StreamExecute(ctx context.Context, sql string, bindVariables map[string]interface{}, keyspace string, tabletType topodatapb.TabletType, options *querypb.ExecuteOptions, sendReply func(*sqltypes.Result) error) error {
-	return c.fallback.StreamExecute(ctx, sql, bindVariables, keyspace, tabletType, options, sendReply)
+func (c fallbackClient) StreamExecute(ctx context.Context, sql string, bindVariables map[string]interface{}, keyspace string, tabletType topodatapb.TabletType, session *vtgatepb.Session, options *querypb.ExecuteOptions, sendReply func(*sqltypes.Result) error) error {
+	return c.fallback.StreamExecute(ctx, sql, bindVariables, keyspace, tabletType, session, options, sendReply)
 }
 
 func (c fallbackClient) StreamExecuteShards(ctx context.Context, sql string, bindVariables map[string]interface{}, keyspace string, shards []string, tabletType topodatapb.TabletType, options *querypb.ExecuteOptions, sendReply func(*sqltypes.Result) error) error {
@@ -75,8 +75,8 @@ func (c fallbackClient) StreamExecuteKeyRanges(ctx context.Context, sql string,
 	return c.fallback.StreamExecuteKeyRanges(ctx, sql, bindVariables, keyspace, keyRanges, tabletType, options, sendReply)
 }
 
-func (c fallbackClient) Begin(ctx context.Context, singledb bool) (*vtgatepb.Session, error) {
-	return c.fallback.Begin(ctx, singledb)
+func (c fallbackClient) Begin(ctx context.Context, singledb bool, session *vtgatepb.Session) (*vtgatepb.Session, error) {
+	return c.fallback.Begin(ctx, singledb, session)
 }
 
 func (c fallbackClient) Commit(ctx context.Context, twopc bool, session *vtgatepb.Session) error {
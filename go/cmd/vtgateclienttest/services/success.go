@@ -28,7 +28,7 @@ func newSuccessClient(fallback vtgateservice.VTGateService) *successClient {
 	}
 }
 
-func (c *successClient) Begin(ctx context.Context, singledb bool) (*vtgatepb.Session, error) {
+func (c *successClient) Begin(ctx context.Context, singledb bool, session *vtgatepb.Session) (*vtgatepb.Session, error) {
 	if singledb {
 		return nil, errors.New("single db")
 	}
@@ -69,7 +69,7 @@ func (c *terminalClient) ExecuteBatchKeyspaceIds(ctx context.Context, queries []
 	return nil, errTerminal
 }
 
-func (c *terminalClient) StreamExecute(ctx context.Context, sql string, bindVariables map[string]interface{}, keyspace string, tabletType topodatapb.TabletType, options *querypb.ExecuteOptions, sendReply func(*sqltypes.Result) error) error {
+func (c *terminalClient) StreamExecute(ctx context.Context, sql string, bindVariables map[string]interface{}, keyspace string, tabletType topodatapb.TabletType, session *vtgatepb.Session, options *querypb.ExecuteOptions, sendReply func(*sqltypes.Result) error) error {
 	return errTerminal
 }
 
@@ -85,7 +85,7 @@ func (c *terminalClient) StreamExecuteKeyRanges(ctx context.Context, sql string,
 	return errTerminal
 }
 
-func (c *terminalClient) Begin(ctx context.Context, singledb bool) (*vtgatepb.Session, error) {
+func (c *terminalClient) Begin(ctx context.Context, singledb bool, session *vtgatepb.Session) (*vtgatepb.Session, error) {
 	return nil, errTerminal
 }
 
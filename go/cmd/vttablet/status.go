@@ -4,6 +4,7 @@ import (
 	"html/template"
 
 	"github.com/youtube/vitess/go/vt/health"
+	"github.com/youtube/vitess/go/vt/mysqlctl"
 	"github.com/youtube/vitess/go/vt/servenv"
 	_ "github.com/youtube/vitess/go/vt/status"
 	"github.com/youtube/vitess/go/vt/tabletmanager"
@@ -103,6 +104,15 @@ var (
   <dt><span class="unhealthy">unhealthy</span></dt>
   <dd>will not serve traffic.</dd>
 </dl>
+`
+
+	// backupTemplate is about the backup or restore in progress
+	backupTemplate = `
+{{if .}}
+{{.Operation}} in progress since {{.StartTime.Format "Jan 2, 2006 at 15:04:05 (MST)"}}: {{.}}
+{{else}}
+No backup or restore is running.
+{{end}}
 `
 
 	// binlogTemplate is about the binlog players
@@ -195,6 +205,9 @@ func addStatusParts(qsc tabletserver.Controller) {
 		}
 	})
 	qsc.AddStatusPart()
+	servenv.AddStatusPart("Backup and Restore", backupTemplate, func() interface{} {
+		return mysqlctl.CurrentProgress()
+	})
 	servenv.AddStatusPart("Binlog Player", binlogTemplate, func() interface{} {
 		return agent.BinlogPlayerMap.Status()
 	})
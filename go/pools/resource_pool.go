@@ -95,6 +95,13 @@ func (rp *ResourcePool) Get(ctx context.Context) (resource Resource, err error)
 	return rp.get(ctx, true)
 }
 
+// TryGet will return the next available resource. If none is available,
+// and capacity has not been reached, it will create a new one using the
+// factory. Otherwise, it will return nil with no error.
+func (rp *ResourcePool) TryGet() (resource Resource, err error) {
+	return rp.get(context.Background(), false)
+}
+
 func (rp *ResourcePool) get(ctx context.Context, wait bool) (resource Resource, err error) {
 	// If ctx has already expired, avoid racing with rp's resource channel.
 	select {
@@ -489,3 +489,30 @@ func TestExpired(t *testing.T) {
 		t.Errorf("got %v, want %s", err, want)
 	}
 }
+
+func TestTryGet(t *testing.T) {
+	lastID.Set(0)
+	count.Set(0)
+	p := NewResourcePool(PoolFactory, 1, 1, time.Second)
+	defer p.Close()
+	r, err := p.TryGet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r == nil {
+		t.Fatalf("TryGet returned nil, want a resource")
+	}
+	r2, err := p.TryGet()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r2 != nil {
+		t.Errorf("TryGet on an exhausted pool: %v, want nil", r2)
+	}
+	p.Put(r)
+	r, err = p.TryGet()
+	if err != nil || r == nil {
+		t.Errorf("TryGet after Put: %v, %v, want a resource", r, err)
+	}
+	p.Put(r)
+}
@@ -74,6 +74,9 @@ type Streamer struct {
 	timestamp        int64
 	sendTransaction  sendTransactionFunc
 	usePreviousGTIDs bool
+	// started is called, if set, once the binlogs are read from
+	// the start position.
+	started func()
 
 	conn *mysqlctl.SlaveConnection
 }
@@ -154,6 +157,9 @@ func (bls *Streamer) Stream(ctx context.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	if bls.started != nil {
+		bls.started()
+	}
 	// parseEvents will loop until the events channel is closed, the
 	// service enters the SHUTTING_DOWN state, or an error occurs.
 	stopPos, err = bls.parseEvents(ctx, events)
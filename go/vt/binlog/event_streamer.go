@@ -47,6 +47,13 @@ func NewEventStreamer(dbname string, mysqld mysqlctl.MysqlDaemon, startPos repli
 	return evs
 }
 
+// SetStartedCallback sets a function that Stream calls once it reads
+// the binlogs from the start position. Every change after that
+// position is then sent.
+func (evs *EventStreamer) SetStartedCallback(started func()) {
+	evs.bls.started = started
+}
+
 // Stream starts streaming updates
 func (evs *EventStreamer) Stream(ctx context.Context) error {
 	return evs.bls.Stream(ctx)
@@ -663,6 +663,9 @@ func (tcs *TabletsCacheStatus) StatusAsHTML() template.HTML {
 			extra = " (Down)"
 		} else if ts.Target.TabletType == topodatapb.TabletType_MASTER {
 			extra = fmt.Sprintf(" (MasterTS: %v)", ts.TabletExternallyReparentedTimestamp)
+		} else if IsDelayedReplica(ts) {
+			color = "orange"
+			extra = fmt.Sprintf(" (RepLag: %v, Delayed: %v)", ts.Stats.SecondsBehindMaster, ts.Stats.MasterDelaySeconds)
 		} else {
 			extra = fmt.Sprintf(" (RepLag: %v)", ts.Stats.SecondsBehindMaster)
 		}
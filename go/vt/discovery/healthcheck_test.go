@@ -474,8 +474,8 @@ func (fc *fakeConn) Begin(ctx context.Context, target *querypb.Target) (int64, e
 }
 
 // Commit implements tabletconn.TabletConn.
-func (fc *fakeConn) Commit(ctx context.Context, target *querypb.Target, transactionID int64) error {
-	return fmt.Errorf("not implemented")
+func (fc *fakeConn) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (string, error) {
+	return "", fmt.Errorf("not implemented")
 }
 
 // Rollback implements tabletconn.TabletConn.
@@ -489,8 +489,8 @@ func (fc *fakeConn) Prepare(ctx context.Context, target *querypb.Target, transac
 }
 
 // CommitPrepared implements tabletconn.TabletConn.
-func (fc *fakeConn) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) (err error) {
-	return fmt.Errorf("not implemented")
+func (fc *fakeConn) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) (string, error) {
+	return "", fmt.Errorf("not implemented")
 }
 
 // RollbackPrepared implements tabletconn.TabletConn.
@@ -504,8 +504,8 @@ func (fc *fakeConn) CreateTransaction(ctx context.Context, target *querypb.Targe
 }
 
 // StartCommit implements tabletconn.TabletConn.
-func (fc *fakeConn) StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (err error) {
-	return fmt.Errorf("not implemented")
+func (fc *fakeConn) StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (string, error) {
+	return "", fmt.Errorf("not implemented")
 }
 
 // SetRollback implements tabletconn.TabletConn.
@@ -19,8 +19,15 @@ func IsReplicationLagHigh(tabletStats *TabletStats) bool {
 	return float64(tabletStats.Stats.SecondsBehindMaster) > lowReplicationLag.Seconds()
 }
 
+// IsDelayedReplica returns true if the given TabletStats refers to a
+// delayed replica, which deliberately lags behind the master and
+// should never be used to serve queries.
+func IsDelayedReplica(tabletStats *TabletStats) bool {
+	return tabletStats.Stats != nil && tabletStats.Stats.MasterDelaySeconds > 0
+}
+
 // FilterByReplicationLag filters the list of TabletStats by TabletStats.Stats.SecondsBehindMaster.
-// The algorithm (TabletStats that is non-serving or has error is ignored):
+// The algorithm (TabletStats that is non-serving, has error or is a delayed replica is ignored):
 // - Return the list if there is 0 or 1 tablet.
 // - Return the list if all tablets have <=30s lag.
 // - Filter by replication lag: for each tablet, if the mean value without it is more than 0.7 of the mean value across all tablets, it is valid.
@@ -39,9 +46,9 @@ func FilterByReplicationLag(tabletStatsList []*TabletStats) []*TabletStats {
 
 func filterByLag(tabletStatsList []*TabletStats) []*TabletStats {
 	list := make([]*TabletStats, 0, len(tabletStatsList))
-	// filter non-serving tablets
+	// filter non-serving tablets and delayed replicas
 	for _, ts := range tabletStatsList {
-		if !ts.Serving || ts.LastError != nil || ts.Stats == nil {
+		if !ts.Serving || ts.LastError != nil || ts.Stats == nil || IsDelayedReplica(ts) {
 			continue
 		}
 		list = append(list, ts)
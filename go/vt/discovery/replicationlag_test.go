@@ -192,6 +192,20 @@ func TestFilterByReplicationLag(t *testing.T) {
 	if len(got) != 1 || !reflect.DeepEqual(got[0], ts1) {
 		t.Errorf("FilterByReplicationLag([1m, 3h]) = %+v, want [1m]", got)
 	}
+	// delayed replicas are never returned, even when they are the only ones
+	ts2 = &TabletStats{
+		Tablet:  topo.NewTablet(2, "cell", "host2"),
+		Serving: true,
+		Stats:   &querypb.RealtimeStats{SecondsBehindMaster: 1, MasterDelaySeconds: 60 * 60},
+	}
+	got = FilterByReplicationLag([]*TabletStats{ts1, ts2})
+	if len(got) != 1 || !reflect.DeepEqual(got[0], ts1) {
+		t.Errorf("FilterByReplicationLag([1m, delayed]) = %+v, want [1m]", got)
+	}
+	got = FilterByReplicationLag([]*TabletStats{ts2})
+	if len(got) != 0 {
+		t.Errorf("FilterByReplicationLag([delayed]) = %+v, want []", got)
+	}
 }
 
 func TestTrivialStatsUpdate(t *testing.T) {
@@ -5,6 +5,7 @@ package discovery
 // See also replicationlag.go for a more sophisicated filter used by vtgate.
 
 // RemoveUnhealthyTablets filters all unhealthy tablets out.
+// Delayed replicas are filtered out too, as their data is stale.
 // NOTE: Non-serving tablets are considered healthy.
 func RemoveUnhealthyTablets(tabletStatsList []TabletStats) []TabletStats {
 	result := make([]TabletStats, 0, len(tabletStatsList))
@@ -14,7 +15,7 @@ func RemoveUnhealthyTablets(tabletStatsList []TabletStats) []TabletStats {
 		// source and destination, and the source is not serving (disabled by
 		// TabletControl). When we switch the tablet to 'worker', it will
 		// go back to serving state.
-		if ts.Stats == nil || ts.Stats.HealthError != "" || IsReplicationLagHigh(&ts) {
+		if ts.Stats == nil || ts.Stats.HealthError != "" || IsReplicationLagHigh(&ts) || IsDelayedReplica(&ts) {
 			continue
 		}
 		result = append(result, ts)
@@ -34,6 +34,11 @@ func TestRemoveUnhealthyTablets(t *testing.T) {
 			input: []TabletStats{healthy(replica(1)), unhealthyLag(replica(2))},
 			want:  []TabletStats{healthy(replica(1))},
 		},
+		{
+			desc:  "delayed replica",
+			input: []TabletStats{healthy(replica(1)), delayed(healthy(replica(2)))},
+			want:  []TabletStats{healthy(replica(1))},
+		},
 		{
 			desc:  "no filtering by tablet type",
 			input: []TabletStats{healthy(master(1)), healthy(replica(2)), healthy(rdonly(3))},
@@ -101,6 +106,11 @@ func unhealthyError(ts TabletStats) TabletStats {
 	return ts
 }
 
+func delayed(ts TabletStats) TabletStats {
+	ts.Stats.MasterDelaySeconds = 3600
+	return ts
+}
+
 func notServing(ts TabletStats) TabletStats {
 	ts.Serving = false
 	return ts
@@ -0,0 +1,125 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package failover
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+)
+
+// Decision is the kind of an AuditEntry.
+type Decision string
+
+const (
+	// DecisionSuspected is recorded when the health check first
+	// reports an error for a master.
+	DecisionSuspected Decision = "suspected"
+
+	// DecisionRecovered is recorded when a suspected master is
+	// healthy again.
+	DecisionRecovered Decision = "recovered"
+
+	// DecisionDisabled is recorded when a master is down for longer
+	// than the failure threshold, but failover is not enabled for
+	// its keyspace.
+	DecisionDisabled Decision = "disabled"
+
+	// DecisionNotConfirmed is recorded when the cells didn't reach a
+	// quorum on the master failure.
+	DecisionNotConfirmed Decision = "not_confirmed"
+
+	// DecisionConfirmed is recorded when the cells reached a quorum
+	// on the master failure, right before the emergency reparent.
+	DecisionConfirmed Decision = "confirmed"
+
+	// DecisionReparented is recorded when the emergency reparent
+	// succeeded.
+	DecisionReparented Decision = "reparented"
+
+	// DecisionReparentFailed is recorded when the emergency
+	// reparent failed.
+	DecisionReparentFailed Decision = "reparent_failed"
+
+	// DecisionEnabled is recorded when failover is enabled for a
+	// keyspace.
+	DecisionEnabled Decision = "enabled"
+
+	// DecisionDisabledByUser is recorded when failover is disabled
+	// for a keyspace.
+	DecisionDisabledByUser Decision = "disabled_by_user"
+)
+
+// AuditEntry is one decision of the Detector.
+type AuditEntry struct {
+	Time     time.Time
+	Keyspace string
+	Shard    string
+	// Master is the alias of the master the decision is about. It
+	// is empty for keyspace-wide decisions.
+	Master   string
+	Decision Decision
+	Details  string
+}
+
+// AuditLog records the decisions of a Detector. It keeps the most
+// recent ones in memory for the status page, and writes all of them
+// to the INFO log and, optionally, as JSON lines to a writer.
+type AuditLog struct {
+	size int
+	w    io.Writer
+
+	mu      sync.Mutex
+	entries []AuditEntry
+}
+
+// NewAuditLog returns an AuditLog that keeps the last size entries
+// in memory. If w is not nil, every entry is also written to it.
+func NewAuditLog(size int, w io.Writer) *AuditLog {
+	return &AuditLog{
+		size: size,
+		w:    w,
+	}
+}
+
+// Record adds an entry to the audit log. It sets the entry time if
+// it is not set.
+func (a *AuditLog) Record(e AuditEntry) {
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	log.Infof("master failover: %v/%v master %v: %v: %v", e.Keyspace, e.Shard, e.Master, e.Decision, e.Details)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.entries = append(a.entries, e)
+	if len(a.entries) > a.size {
+		a.entries = a.entries[len(a.entries)-a.size:]
+	}
+	if a.w != nil {
+		data, err := json.Marshal(e)
+		if err != nil {
+			log.Errorf("cannot marshal master failover audit entry %v: %v", e, err)
+			return
+		}
+		if _, err := a.w.Write(append(data, '\n')); err != nil {
+			log.Errorf("cannot write master failover audit entry: %v", err)
+		}
+	}
+}
+
+// Entries returns the entries kept in memory, the most recent first.
+func (a *AuditLog) Entries() []AuditEntry {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	result := make([]AuditEntry, len(a.entries))
+	for i, e := range a.entries {
+		result[len(a.entries)-1-i] = e
+	}
+	return result
+}
@@ -0,0 +1,47 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package failover
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestAuditLog(t *testing.T) {
+	buf := &bytes.Buffer{}
+	a := NewAuditLog(2, buf)
+	for _, d := range []Decision{DecisionSuspected, DecisionConfirmed, DecisionReparented} {
+		a.Record(AuditEntry{
+			Keyspace: "ks",
+			Shard:    "0",
+			Master:   "cell1-0000000100",
+			Decision: d,
+		})
+	}
+
+	// Only the last two are kept in memory, the most recent first.
+	entries := a.Entries()
+	if len(entries) != 2 || entries[0].Decision != DecisionReparented || entries[1].Decision != DecisionConfirmed {
+		t.Errorf("got entries %v, want reparented and confirmed", entries)
+	}
+	if entries[0].Time.IsZero() {
+		t.Errorf("entry time is not set")
+	}
+
+	// All of them are written.
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("got %v written entries, want 3: %v", len(lines), buf.String())
+	}
+	var e AuditEntry
+	if err := json.Unmarshal([]byte(lines[0]), &e); err != nil {
+		t.Fatalf("cannot unmarshal written entry %v: %v", lines[0], err)
+	}
+	if e.Decision != DecisionSuspected || e.Master != "cell1-0000000100" {
+		t.Errorf("got written entry %v, want the suspected one", e)
+	}
+}
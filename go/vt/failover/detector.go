@@ -0,0 +1,361 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package failover contains a master failure detector that runs an
+// emergency reparent when the master of a shard is dead.
+//
+// The Detector is a discovery.HealthCheckStatsListener, fed with the
+// health of the tablets of all cells. When the health check of a
+// master keeps failing for longer than the failure threshold, the
+// Detector asks the replicas of every cell whether they are still
+// connected to the master. Each cell votes: the master is down if
+// none of its replicas replicate from it anymore. If a majority of
+// the cells of the shard vote the master is down, the most advanced
+// REPLICA is promoted with an emergency reparent, under the shard
+// lock. Every decision is recorded in an AuditLog.
+//
+// Failover is only done for the keyspaces it is enabled for.
+package failover
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/discovery"
+	"github.com/youtube/vitess/go/vt/tabletmanager/tmclient"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+	"github.com/youtube/vitess/go/vt/wrangler"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// masterState is the state of a master watched by the Detector.
+type masterState struct {
+	keyspace string
+	shard    string
+	alias    *topodatapb.TabletAlias
+	// failingSince is when the health check of the master started
+	// failing. It is zero while the master is healthy.
+	failingSince time.Time
+	lastError    error
+	// disabledReported is true if the failure was already reported
+	// as not handled because failover is disabled for the keyspace.
+	disabledReported bool
+	// inProgress is true while the failure is being confirmed or
+	// the shard reparented.
+	inProgress bool
+}
+
+// Detector detects dead masters and replaces them.
+type Detector struct {
+	tmc              tmclient.TabletManagerClient
+	audit            *AuditLog
+	failureThreshold time.Duration
+	waitSlaveTimeout time.Duration
+	// reparent runs the emergency reparent, it is
+	// wrangler.AutomaticEmergencyReparentShard, except in tests.
+	reparent func(ctx context.Context, keyspace, shard string, deadMasterAlias *topodatapb.TabletAlias, waitSlaveTimeout time.Duration) (*topodatapb.TabletAlias, error)
+
+	// done is closed by Close to stop the check loop.
+	done chan struct{}
+	wg   sync.WaitGroup
+
+	// mu protects the fields below.
+	mu sync.Mutex
+	// enabled has the keyspaces failover is enabled for.
+	enabled map[string]bool
+	// tablets has the latest stats of all the tablets, by key.
+	tablets map[string]*discovery.TabletStats
+	// masters has the state of the masters, by keyspace/shard.
+	masters map[string]*masterState
+}
+
+// NewDetector returns a Detector for the given keyspaces. A master is
+// considered dead when its health check has been failing for
+// failureThreshold, and a quorum of cells confirms it.
+func NewDetector(wr *wrangler.Wrangler, audit *AuditLog, keyspaces []string, failureThreshold, waitSlaveTimeout time.Duration) *Detector {
+	d := &Detector{
+		tmc:              wr.TabletManagerClient(),
+		audit:            audit,
+		failureThreshold: failureThreshold,
+		waitSlaveTimeout: waitSlaveTimeout,
+		reparent:         wr.AutomaticEmergencyReparentShard,
+		done:             make(chan struct{}),
+		enabled:          make(map[string]bool),
+		tablets:          make(map[string]*discovery.TabletStats),
+		masters:          make(map[string]*masterState),
+	}
+	for _, keyspace := range keyspaces {
+		d.enabled[keyspace] = true
+	}
+	return d
+}
+
+// SetKeyspaceEnabled enables or disables failover for a keyspace.
+func (d *Detector) SetKeyspaceEnabled(keyspace string, enabled bool) {
+	d.mu.Lock()
+	changed := d.enabled[keyspace] != enabled
+	if enabled {
+		d.enabled[keyspace] = true
+	} else {
+		delete(d.enabled, keyspace)
+	}
+	d.mu.Unlock()
+
+	if !changed {
+		return
+	}
+	decision := DecisionEnabled
+	if !enabled {
+		decision = DecisionDisabledByUser
+	}
+	d.audit.Record(AuditEntry{
+		Keyspace: keyspace,
+		Decision: decision,
+		Details:  "failover " + string(decision) + " for the keyspace",
+	})
+}
+
+// KeyspaceEnabled returns true if failover is enabled for a keyspace.
+func (d *Detector) KeyspaceEnabled(keyspace string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.enabled[keyspace]
+}
+
+// StatsUpdate is part of the discovery.HealthCheckStatsListener interface.
+func (d *Detector) StatsUpdate(ts *discovery.TabletStats) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if !ts.Up {
+		delete(d.tablets, ts.Key)
+		return
+	}
+	tabletStats := *ts
+	d.tablets[ts.Key] = &tabletStats
+
+	if ts.Target == nil || ts.Target.TabletType != topodatapb.TabletType_MASTER {
+		return
+	}
+	key := ts.Target.Keyspace + "/" + ts.Target.Shard
+	ms, ok := d.masters[key]
+	if !ok || !topoproto.TabletAliasEqual(ms.alias, ts.Tablet.Alias) {
+		// A new master, or the first time we see this one.
+		ms = &masterState{
+			keyspace: ts.Target.Keyspace,
+			shard:    ts.Target.Shard,
+			alias:    ts.Tablet.Alias,
+		}
+		d.masters[key] = ms
+	}
+
+	switch {
+	case ts.LastError != nil && ms.failingSince.IsZero():
+		ms.failingSince = time.Now()
+		ms.lastError = ts.LastError
+		d.audit.Record(AuditEntry{
+			Keyspace: ms.keyspace,
+			Shard:    ms.shard,
+			Master:   topoproto.TabletAliasString(ms.alias),
+			Decision: DecisionSuspected,
+			Details:  fmt.Sprintf("health check failed: %v", ts.LastError),
+		})
+	case ts.LastError != nil:
+		ms.lastError = ts.LastError
+	case !ms.failingSince.IsZero():
+		ms.failingSince = time.Time{}
+		ms.lastError = nil
+		ms.disabledReported = false
+		d.audit.Record(AuditEntry{
+			Keyspace: ms.keyspace,
+			Shard:    ms.shard,
+			Master:   topoproto.TabletAliasString(ms.alias),
+			Decision: DecisionRecovered,
+			Details:  "health check succeeded",
+		})
+	}
+}
+
+// Start runs the checks every checkInterval, until Close is called.
+func (d *Detector) Start(checkInterval time.Duration) {
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		ticker := time.NewTicker(checkInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-d.done:
+				return
+			case <-ticker.C:
+				d.check(context.Background())
+			}
+		}
+	}()
+}
+
+// Close stops the checks. It waits for a running reparent to finish.
+func (d *Detector) Close() {
+	close(d.done)
+	d.wg.Wait()
+}
+
+// check handles all the masters that have been failing for longer
+// than the failure threshold, and waits until it's done.
+func (d *Detector) check(ctx context.Context) {
+	now := time.Now()
+	var wg sync.WaitGroup
+	d.mu.Lock()
+	for _, ms := range d.masters {
+		if ms.failingSince.IsZero() || ms.inProgress || now.Sub(ms.failingSince) < d.failureThreshold {
+			continue
+		}
+		if !d.enabled[ms.keyspace] {
+			if !ms.disabledReported {
+				ms.disabledReported = true
+				d.audit.Record(AuditEntry{
+					Keyspace: ms.keyspace,
+					Shard:    ms.shard,
+					Master:   topoproto.TabletAliasString(ms.alias),
+					Decision: DecisionDisabled,
+					Details:  fmt.Sprintf("health check failing since %v (%v), but failover is not enabled for the keyspace", ms.failingSince.Format(time.RFC3339), ms.lastError),
+				})
+			}
+			continue
+		}
+		ms.inProgress = true
+		wg.Add(1)
+		go func(ms *masterState, replicas []*discovery.TabletStats) {
+			defer wg.Done()
+			d.handleFailure(ctx, ms, replicas)
+		}(ms, d.replicasLocked(ms))
+	}
+	d.mu.Unlock()
+	wg.Wait()
+}
+
+// replicasLocked returns the healthy REPLICA and RDONLY tablets of
+// the shard of the master. d.mu must be held.
+func (d *Detector) replicasLocked(ms *masterState) []*discovery.TabletStats {
+	var result []*discovery.TabletStats
+	for _, ts := range d.tablets {
+		if ts.Target == nil || ts.Target.Keyspace != ms.keyspace || ts.Target.Shard != ms.shard || ts.LastError != nil {
+			continue
+		}
+		if ts.Target.TabletType != topodatapb.TabletType_REPLICA && ts.Target.TabletType != topodatapb.TabletType_RDONLY {
+			continue
+		}
+		result = append(result, ts)
+	}
+	return result
+}
+
+// handleFailure confirms the failure of a master, and reparents its
+// shard if it is confirmed.
+func (d *Detector) handleFailure(ctx context.Context, ms *masterState, replicas []*discovery.TabletStats) {
+	entry := AuditEntry{
+		Keyspace: ms.keyspace,
+		Shard:    ms.shard,
+		Master:   topoproto.TabletAliasString(ms.alias),
+	}
+	confirmed, details := d.vote(ctx, replicas)
+	if !confirmed {
+		entry.Decision = DecisionNotConfirmed
+		entry.Details = details
+		d.audit.Record(entry)
+		d.mu.Lock()
+		ms.inProgress = false
+		d.mu.Unlock()
+		return
+	}
+	entry.Decision = DecisionConfirmed
+	entry.Details = details
+	d.audit.Record(entry)
+
+	newMasterAlias, err := d.reparent(ctx, ms.keyspace, ms.shard, ms.alias, d.waitSlaveTimeout)
+	entry.Time = time.Time{}
+	if err != nil {
+		entry.Decision = DecisionReparentFailed
+		entry.Details = err.Error()
+	} else {
+		entry.Decision = DecisionReparented
+		entry.Details = fmt.Sprintf("promoted %v", topoproto.TabletAliasString(newMasterAlias))
+	}
+	d.audit.Record(entry)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	ms.inProgress = false
+	if err == nil {
+		// The old master is not the master of the shard anymore.
+		if current, ok := d.masters[ms.keyspace+"/"+ms.shard]; ok && current == ms {
+			delete(d.masters, ms.keyspace+"/"+ms.shard)
+		}
+	}
+}
+
+// vote asks the replicas of each cell if they are still replicating
+// from the master. A cell votes the master is down if at least one of
+// its replicas answered, and none is connected to the master. The
+// failure is confirmed if a majority of the cells votes it is down.
+// It also returns the details of the vote, for the audit log.
+func (d *Detector) vote(ctx context.Context, replicas []*discovery.TabletStats) (bool, string) {
+	type cellVote struct {
+		answered bool
+		up       bool
+	}
+	votes := make(map[string]*cellVote)
+	for _, ts := range replicas {
+		votes[ts.Tablet.Alias.Cell] = &cellVote{}
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, ts := range replicas {
+		wg.Add(1)
+		go func(tablet *topodatapb.Tablet) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, d.waitSlaveTimeout)
+			defer cancel()
+			status, err := d.tmc.SlaveStatus(ctx, tablet)
+			if err != nil {
+				log.Warningf("master failover: cannot get replication status of %v, ignoring it: %v", topoproto.TabletAliasString(tablet.Alias), err)
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			v := votes[tablet.Alias.Cell]
+			v.answered = true
+			if status.SlaveIoRunning {
+				v.up = true
+			}
+		}(ts.Tablet)
+	}
+	wg.Wait()
+
+	var down, up, abstained []string
+	for cell, v := range votes {
+		switch {
+		case !v.answered:
+			abstained = append(abstained, cell)
+		case v.up:
+			up = append(up, cell)
+		default:
+			down = append(down, cell)
+		}
+	}
+	sort.Strings(down)
+	sort.Strings(up)
+	sort.Strings(abstained)
+	confirmed := len(down) > len(votes)/2
+	details := fmt.Sprintf("cells voting master down: [%v], up: [%v], not answering: [%v]", strings.Join(down, " "), strings.Join(up, " "), strings.Join(abstained, " "))
+	return confirmed, details
+}
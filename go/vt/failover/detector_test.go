@@ -0,0 +1,216 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package failover
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/discovery"
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/tabletmanager/tmclient"
+	"github.com/youtube/vitess/go/vt/topo"
+	"github.com/youtube/vitess/go/vt/wrangler"
+
+	querypb "github.com/youtube/vitess/go/vt/proto/query"
+	replicationdatapb "github.com/youtube/vitess/go/vt/proto/replicationdata"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// fakeTMC returns the replication status of the tablets by uid. The
+// tablets without a status return an error.
+type fakeTMC struct {
+	tmclient.TabletManagerClient
+	ioRunning map[uint32]bool
+}
+
+// SlaveStatus is part of the tmclient.TabletManagerClient interface.
+func (f *fakeTMC) SlaveStatus(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.Status, error) {
+	running, ok := f.ioRunning[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tablet %v is unreachable", tablet.Alias.Uid)
+	}
+	return &replicationdatapb.Status{SlaveIoRunning: running}, nil
+}
+
+type reparentCall struct {
+	keyspace, shard string
+	deadMaster      *topodatapb.TabletAlias
+}
+
+// newTestDetector returns a Detector with no failure threshold, and
+// a fake reparent which records its calls.
+func newTestDetector(tmc *fakeTMC, keyspaces ...string) (*Detector, *[]reparentCall) {
+	wr := wrangler.New(logutil.NewConsoleLogger(), topo.Server{}, tmc)
+	d := NewDetector(wr, NewAuditLog(100, nil), keyspaces, 0, time.Second)
+	var calls []reparentCall
+	d.reparent = func(ctx context.Context, keyspace, shard string, deadMasterAlias *topodatapb.TabletAlias, waitSlaveTimeout time.Duration) (*topodatapb.TabletAlias, error) {
+		calls = append(calls, reparentCall{keyspace, shard, deadMasterAlias})
+		return &topodatapb.TabletAlias{Cell: "cell1", Uid: 1}, nil
+	}
+	return d, &calls
+}
+
+func tabletStats(cell string, uid uint32, tabletType topodatapb.TabletType, err error) *discovery.TabletStats {
+	return &discovery.TabletStats{
+		Key: fmt.Sprintf("%v-%v", cell, uid),
+		Tablet: &topodatapb.Tablet{
+			Alias:    &topodatapb.TabletAlias{Cell: cell, Uid: uid},
+			Keyspace: "ks",
+			Shard:    "0",
+			Type:     tabletType,
+		},
+		Target: &querypb.Target{
+			Keyspace:   "ks",
+			Shard:      "0",
+			TabletType: tabletType,
+		},
+		Up:        true,
+		Serving:   true,
+		LastError: err,
+	}
+}
+
+// addShard adds a master in cell1, and two replicas in each of cell1,
+// cell2 and cell3 (uids 1 to 6) to the Detector.
+func addShard(d *Detector, masterErr error) {
+	d.StatsUpdate(tabletStats("cell1", 0, topodatapb.TabletType_MASTER, nil))
+	for i, cell := range []string{"cell1", "cell2", "cell3"} {
+		d.StatsUpdate(tabletStats(cell, uint32(2*i+1), topodatapb.TabletType_REPLICA, nil))
+		d.StatsUpdate(tabletStats(cell, uint32(2*i+2), topodatapb.TabletType_RDONLY, nil))
+	}
+	if masterErr != nil {
+		d.StatsUpdate(tabletStats("cell1", 0, topodatapb.TabletType_MASTER, masterErr))
+	}
+}
+
+func decisions(d *Detector) []Decision {
+	var result []Decision
+	for _, e := range d.audit.Entries() {
+		result = append([]Decision{e.Decision}, result...)
+	}
+	return result
+}
+
+func TestDetectorReparent(t *testing.T) {
+	// Replicas of cell1 and cell2 lost the master, cell3 is
+	// unreachable.
+	tmc := &fakeTMC{ioRunning: map[uint32]bool{1: false, 2: false, 3: false, 4: false}}
+	d, calls := newTestDetector(tmc, "ks")
+	addShard(d, errors.New("connection refused"))
+
+	d.check(context.Background())
+	if len(*calls) != 1 || (*calls)[0].keyspace != "ks" || (*calls)[0].shard != "0" || (*calls)[0].deadMaster.Uid != 0 {
+		t.Fatalf("got reparent calls %v, want one for ks/0", *calls)
+	}
+	if got, want := fmt.Sprint(decisions(d)), "[suspected confirmed reparented]"; got != want {
+		t.Errorf("got decisions %v, want %v", got, want)
+	}
+	if got, want := d.audit.Entries()[1].Details, "cells voting master down: [cell1 cell2], up: [], not answering: [cell3]"; got != want {
+		t.Errorf("got vote details %q, want %q", got, want)
+	}
+
+	// The old master is forgotten, no more reparent.
+	d.check(context.Background())
+	if len(*calls) != 1 {
+		t.Errorf("got reparent calls %v after reparent, want only one", *calls)
+	}
+}
+
+func TestDetectorNotConfirmed(t *testing.T) {
+	// One replica of cell2 is still replicating, cell3 is
+	// unreachable, so there is no quorum.
+	tmc := &fakeTMC{ioRunning: map[uint32]bool{1: false, 2: false, 3: false, 4: true}}
+	d, calls := newTestDetector(tmc, "ks")
+	addShard(d, errors.New("connection refused"))
+
+	d.check(context.Background())
+	if len(*calls) != 0 {
+		t.Fatalf("got reparent calls %v, want none", *calls)
+	}
+	if got, want := fmt.Sprint(decisions(d)), "[suspected not_confirmed]"; got != want {
+		t.Errorf("got decisions %v, want %v", got, want)
+	}
+
+	// The master comes back.
+	d.StatsUpdate(tabletStats("cell1", 0, topodatapb.TabletType_MASTER, nil))
+	d.check(context.Background())
+	if got, want := fmt.Sprint(decisions(d)), "[suspected not_confirmed recovered]"; got != want {
+		t.Errorf("got decisions %v, want %v", got, want)
+	}
+}
+
+func TestDetectorThreshold(t *testing.T) {
+	tmc := &fakeTMC{ioRunning: map[uint32]bool{1: false, 3: false, 5: false}}
+	d, calls := newTestDetector(tmc, "ks")
+	d.failureThreshold = time.Hour
+	addShard(d, errors.New("connection refused"))
+
+	d.check(context.Background())
+	if len(*calls) != 0 {
+		t.Errorf("got reparent calls %v before the failure threshold, want none", *calls)
+	}
+	if got, want := fmt.Sprint(decisions(d)), "[suspected]"; got != want {
+		t.Errorf("got decisions %v, want %v", got, want)
+	}
+}
+
+func TestDetectorKeyspaceEnablement(t *testing.T) {
+	tmc := &fakeTMC{ioRunning: map[uint32]bool{1: false, 3: false, 5: false}}
+	d, calls := newTestDetector(tmc, "other")
+	addShard(d, errors.New("connection refused"))
+
+	// The failure is reported once.
+	d.check(context.Background())
+	d.check(context.Background())
+	if len(*calls) != 0 {
+		t.Fatalf("got reparent calls %v for a disabled keyspace, want none", *calls)
+	}
+	if got, want := fmt.Sprint(decisions(d)), "[suspected disabled]"; got != want {
+		t.Errorf("got decisions %v, want %v", got, want)
+	}
+
+	d.SetKeyspaceEnabled("ks", true)
+	if !d.KeyspaceEnabled("ks") {
+		t.Errorf("KeyspaceEnabled(ks) = false after enabling it")
+	}
+	d.check(context.Background())
+	if len(*calls) != 1 {
+		t.Errorf("got reparent calls %v after enabling the keyspace, want one", *calls)
+	}
+	if got, want := fmt.Sprint(decisions(d)), "[suspected disabled enabled confirmed reparented]"; got != want {
+		t.Errorf("got decisions %v, want %v", got, want)
+	}
+
+	d.SetKeyspaceEnabled("ks", false)
+	if d.KeyspaceEnabled("ks") {
+		t.Errorf("KeyspaceEnabled(ks) = true after disabling it")
+	}
+}
+
+func TestDetectorReparentFailed(t *testing.T) {
+	tmc := &fakeTMC{ioRunning: map[uint32]bool{1: false, 3: false, 5: false}}
+	d, _ := newTestDetector(tmc, "ks")
+	d.reparent = func(ctx context.Context, keyspace, shard string, deadMasterAlias *topodatapb.TabletAlias, waitSlaveTimeout time.Duration) (*topodatapb.TabletAlias, error) {
+		return nil, errors.New("shard master is now cell1-0000000001")
+	}
+	addShard(d, errors.New("connection refused"))
+
+	d.check(context.Background())
+	entries := d.audit.Entries()
+	if entries[0].Decision != DecisionReparentFailed || !strings.Contains(entries[0].Details, "shard master is now") {
+		t.Errorf("got last audit entry %v, want a reparent failure", entries[0])
+	}
+
+	status := d.Status()
+	if len(status.FailingMasters) != 1 || status.FailingMasters[0].Master != "cell1-0000000000" || status.FailingMasters[0].LastError != "connection refused" {
+		t.Errorf("got failing masters %v, want cell1-0000000000", status.FailingMasters)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package failover
+
+import (
+	"sort"
+	"time"
+
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+)
+
+// StatusHTML is the template to display a DetectorStatus on a
+// status page.
+const StatusHTML = `
+<p>Failover enabled for keyspaces: {{range .Keyspaces}}{{.}} {{else}}none{{end}}</p>
+{{if .FailingMasters}}
+<table>
+  <tr><th>Shard</th><th>Master</th><th>Failing Since</th><th>Last Error</th></tr>
+  {{range .FailingMasters}}
+  <tr><td>{{.Keyspace}}/{{.Shard}}</td><td>{{.Master}}</td><td>{{.FailingSince}}</td><td>{{.LastError}}</td></tr>
+  {{end}}
+</table>
+{{else}}
+<p>All masters are healthy.</p>
+{{end}}
+<h2>Audit Log</h2>
+<table>
+  <tr><th>Time</th><th>Shard</th><th>Master</th><th>Decision</th><th>Details</th></tr>
+  {{range .AuditEntries}}
+  <tr><td>{{.Time}}</td><td>{{.Keyspace}}/{{.Shard}}</td><td>{{.Master}}</td><td>{{.Decision}}</td><td>{{.Details}}</td></tr>
+  {{end}}
+</table>
+`
+
+// FailingMasterStatus describes a master whose health check fails.
+type FailingMasterStatus struct {
+	Keyspace     string
+	Shard        string
+	Master       string
+	FailingSince time.Time
+	LastError    string
+}
+
+// DetectorStatus is the status of a Detector, to be displayed with
+// StatusHTML.
+type DetectorStatus struct {
+	Keyspaces      []string
+	FailingMasters []FailingMasterStatus
+	AuditEntries   []AuditEntry
+}
+
+// Status returns the current status of the Detector.
+func (d *Detector) Status() *DetectorStatus {
+	status := &DetectorStatus{
+		AuditEntries: d.audit.Entries(),
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for keyspace := range d.enabled {
+		status.Keyspaces = append(status.Keyspaces, keyspace)
+	}
+	sort.Strings(status.Keyspaces)
+	var keys []string
+	for key, ms := range d.masters {
+		if !ms.failingSince.IsZero() {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		ms := d.masters[key]
+		fms := FailingMasterStatus{
+			Keyspace:     ms.keyspace,
+			Shard:        ms.shard,
+			Master:       topoproto.TabletAliasString(ms.alias),
+			FailingSince: ms.failingSince,
+		}
+		if ms.lastError != nil {
+			fms.LastError = ms.lastError.Error()
+		}
+		status.FailingMasters = append(status.FailingMasters, fms)
+	}
+	return status
+}
@@ -113,7 +113,9 @@ $.fn.sortableByColumn = function() {
 }
 
 $(function() {
-  $('table').sortableByColumn();
+  $('table').each(function() {
+    $(this).sortableByColumn();
+  });
 });
 </script>
 
@@ -17,6 +17,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	log "github.com/golang/glog"
 	"golang.org/x/net/context"
@@ -38,6 +39,13 @@ const (
 	backupInnodbLogGroupHomeDir = "InnoDBLog"
 	backupData                  = "Data"
 
+	// the base for archived binlog files
+	backupBinlog = "BinLog"
+
+	// the base for the output of the online_backup hook, which
+	// is not restored as a file
+	backupOnlineStream = "OnlineStream"
+
 	// the manifest file name
 	backupManifest = "MANIFEST"
 )
@@ -73,6 +81,8 @@ type FileEntry struct {
 	// - backupInnodbDataHomeDir for files that go into Mycnf.InnodbDataHomeDir
 	// - backupInnodbLogGroupHomeDir for files that go into Mycnf.InnodbLogGroupHomeDir
 	// - backupData for files that go into Mycnf.DataDir
+	// - backupBinlog for files that go into the directory of Mycnf.BinLogPath
+	// - backupOnlineStream for the output of the online_backup hook
 	Base string
 
 	// Name is the file name, relative to Base
@@ -81,9 +91,15 @@ type FileEntry struct {
 	// Hash is the hash of the final data (transformed and
 	// compressed if specified) stored in the BackupStorage.
 	Hash string
+
+	// Size is the size of the final data stored in the
+	// BackupStorage. It is 0 for backups taken before it was
+	// recorded, and is then not checked.
+	Size int64
 }
 
-func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
+// fullPath returns the path of the file.
+func (fe *FileEntry) fullPath(cnf *Mycnf) (string, error) {
 	// find the root to use
 	var root string
 	switch fe.Base {
@@ -93,14 +109,22 @@ func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
 		root = cnf.InnodbLogGroupHomeDir
 	case backupData:
 		root = cnf.DataDir
+	case backupBinlog:
+		root = path.Dir(cnf.BinLogPath)
 	default:
-		return nil, fmt.Errorf("unknown base: %v", fe.Base)
+		return "", fmt.Errorf("unknown base: %v", fe.Base)
+	}
+	return path.Join(root, fe.Name), nil
+}
+
+func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
+	name, err := fe.fullPath(cnf)
+	if err != nil {
+		return nil, err
 	}
 
 	// and open the file
-	name := path.Join(root, fe.Name)
 	var fd *os.File
-	var err error
 	if readOnly {
 		fd, err = os.Open(name)
 	} else {
@@ -126,6 +150,11 @@ type BackupManifest struct {
 	// Position is the position at which the backup was taken
 	Position replication.Position
 
+	// Time is the time at which Position was read, or a later
+	// time: no transaction the backup contains was committed
+	// after it. It is zero for backups taken before it was recorded.
+	Time time.Time
+
 	// TransformHook that was used on the files, if any.
 	TransformHook string
 
@@ -134,6 +163,18 @@ type BackupManifest struct {
 	// backups that don't have this flag are assumed to be
 	// compressed.
 	SkipCompress bool
+
+	// BackupMethod is the name of the BackupEngine that took the
+	// backup. It is empty for backups taken before it was recorded,
+	// which were all taken by the builtin engine, and
+	// incrementalBackupMethod for incremental backups.
+	BackupMethod string
+
+	// Parent is the name of the backup an incremental backup was
+	// taken from, and FromPosition its Position. They are empty
+	// for full backups.
+	Parent       string
+	FromPosition replication.Position
 }
 
 // isDbDir returns true if the given directory contains a DB
@@ -207,8 +248,7 @@ func findFilesToBackup(cnf *Mycnf) ([]FileEntry, error) {
 
 // Backup is the main entry point for a backup:
 // - uses the BackupStorage service to store a new backup
-// - shuts down Mysqld during the backup
-// - remember if we were replicating, restore the exact same state
+// - uses the BackupEngine to copy the data of mysqld into it
 func Backup(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, dir, name string, backupConcurrency int, hookExtraEnv map[string]string) error {
 	// Start the backup with the BackupStorage.
 	bs, err := backupstorage.GetBackupStorage()
@@ -222,7 +262,14 @@ func Backup(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, dir,
 	}
 
 	// Take the backup, and either AbortBackup or EndBackup.
-	usable, err := backup(ctx, mysqld, logger, bh, backupConcurrency, hookExtraEnv)
+	be, err := GetBackupEngine()
+	if err != nil {
+		if abortErr := bh.AbortBackup(ctx); abortErr != nil {
+			logger.Errorf("failed to abort backup: %v", abortErr)
+		}
+		return err
+	}
+	usable, err := be.ExecuteBackup(ctx, mysqld, logger, bh, backupConcurrency, hookExtraEnv)
 	var finishErr error
 	if usable {
 		finishErr = bh.EndBackup(ctx)
@@ -244,184 +291,26 @@ func Backup(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, dir,
 	return finishErr
 }
 
-// backup returns a boolean that indicates if the backup is usable,
-// and an overall error.
-func backup(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh backupstorage.BackupHandle, backupConcurrency int, hookExtraEnv map[string]string) (bool, error) {
-	// Save initial state so we can restore.
-	slaveStartRequired := false
-	sourceIsMaster := false
-	readOnly := true
-	var replicationPosition replication.Position
-	semiSyncMaster, semiSyncSlave := mysqld.SemiSyncEnabled()
-
-	// See if we need to restart replication after backup.
-	logger.Infof("getting current replication status")
-	slaveStatus, err := mysqld.SlaveStatus()
-	switch err {
-	case nil:
-		slaveStartRequired = slaveStatus.SlaveRunning()
-	case ErrNotSlave:
-		// keep going if we're the master, might be a degenerate case
-		sourceIsMaster = true
-	default:
-		return false, fmt.Errorf("can't get slave status: %v", err)
-	}
-
-	// get the read-only flag
-	readOnly, err = mysqld.IsReadOnly()
-	if err != nil {
-		return false, fmt.Errorf("can't get read-only status: %v", err)
-	}
-
-	// get the replication position
-	if sourceIsMaster {
-		if !readOnly {
-			logger.Infof("turning master read-only before backup")
-			if err = mysqld.SetReadOnly(true); err != nil {
-				return false, fmt.Errorf("can't set read-only status: %v", err)
-			}
-		}
-		replicationPosition, err = mysqld.MasterPosition()
-		if err != nil {
-			return false, fmt.Errorf("can't get master position: %v", err)
-		}
-	} else {
-		if err = StopSlave(mysqld, hookExtraEnv); err != nil {
-			return false, fmt.Errorf("can't stop slave: %v", err)
-		}
-		var slaveStatus replication.Status
-		slaveStatus, err = mysqld.SlaveStatus()
-		if err != nil {
-			return false, fmt.Errorf("can't get slave status: %v", err)
-		}
-		replicationPosition = slaveStatus.Position
-	}
-	logger.Infof("using replication position: %v", replicationPosition)
-
-	// shutdown mysqld
-	err = mysqld.Shutdown(ctx, true)
-	if err != nil {
-		return false, fmt.Errorf("can't shutdown mysqld: %v", err)
-	}
-
-	// Backup everything, capture the error.
-	backupErr := backupFiles(ctx, mysqld, logger, bh, replicationPosition, backupConcurrency, hookExtraEnv)
-	usable := backupErr == nil
-
-	// Try to restart mysqld
-	err = mysqld.Start(ctx)
-	if err != nil {
-		return usable, fmt.Errorf("can't restart mysqld: %v", err)
-	}
-
-	// Restore original mysqld state that we saved above.
-	if semiSyncMaster || semiSyncSlave {
-		// Only do this if one of them was on, since both being off could mean
-		// the plugin isn't even loaded, and the server variables don't exist.
-		logger.Infof("restoring semi-sync settings from before backup: master=%v, slave=%v",
-			semiSyncMaster, semiSyncSlave)
-		err := mysqld.SetSemiSyncEnabled(semiSyncMaster, semiSyncSlave)
-		if err != nil {
-			return usable, err
-		}
-	}
-	if slaveStartRequired {
-		logger.Infof("restarting mysql replication")
-		if err := StartSlave(mysqld, hookExtraEnv); err != nil {
-			return usable, fmt.Errorf("cannot restart slave: %v", err)
-		}
-
-		// this should be quick, but we might as well just wait
-		if err := WaitForSlaveStart(mysqld, slaveStartDeadline); err != nil {
-			return usable, fmt.Errorf("slave is not restarting: %v", err)
-		}
-	}
-
-	// And set read-only mode
-	logger.Infof("resetting mysqld read-only to %v", readOnly)
-	if err := mysqld.SetReadOnly(readOnly); err != nil {
-		return usable, err
-	}
-
-	return usable, backupErr
-}
-
-// backupFiles finds the list of files to backup, and creates the backup.
-func backupFiles(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh backupstorage.BackupHandle, replicationPosition replication.Position, backupConcurrency int, hookExtraEnv map[string]string) (err error) {
-	// Get the files to backup.
-	fes, err := findFilesToBackup(mysqld.Cnf())
-	if err != nil {
-		return fmt.Errorf("can't find files to backup: %v", err)
-	}
-	logger.Infof("found %v files to backup", len(fes))
-
-	// Backup with the provided concurrency.
-	sema := sync2.NewSemaphore(backupConcurrency, 0)
-	rec := concurrency.AllErrorRecorder{}
-	wg := sync.WaitGroup{}
-	for i := range fes {
-		wg.Add(1)
-		go func(i int) {
-			defer wg.Done()
-
-			// Wait until we are ready to go, skip if we already
-			// encountered an error.
-			sema.Acquire()
-			defer sema.Release()
-			if rec.HasErrors() {
-				return
-			}
-
-			// Backup the individual file.
-			name := fmt.Sprintf("%v", i)
-			rec.RecordError(backupFile(ctx, mysqld, logger, bh, &fes[i], name, hookExtraEnv))
-		}(i)
-	}
-
-	wg.Wait()
-	if rec.HasErrors() {
-		return rec.Error()
-	}
-
-	// open the MANIFEST
-	wc, err := bh.AddFile(ctx, backupManifest)
-	if err != nil {
-		return fmt.Errorf("cannot add %v to backup: %v", backupManifest, err)
-	}
-	defer func() {
-		if closeErr := wc.Close(); err == nil {
-			err = closeErr
-		}
-	}()
-
-	// JSON-encode and write the MANIFEST
-	bm := &BackupManifest{
-		FileEntries:   fes,
-		Position:      replicationPosition,
-		TransformHook: *backupStorageHook,
-		SkipCompress:  !*backupStorageCompress,
-	}
-	data, err := json.MarshalIndent(bm, "", "  ")
-	if err != nil {
-		return fmt.Errorf("cannot JSON encode %v: %v", backupManifest, err)
-	}
-	if _, err := wc.Write([]byte(data)); err != nil {
-		return fmt.Errorf("cannot write %v: %v", backupManifest, err)
-	}
-
-	return nil
-}
-
-// backupFile backs up an individual file.
-func backupFile(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh backupstorage.BackupHandle, fe *FileEntry, name string, hookExtraEnv map[string]string) (err error) {
+// backupFile backs up an individual file. progress, if not nil,
+// tracks and limits the reads of the file.
+func backupFile(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh backupstorage.BackupHandle, fe *FileEntry, name string, hookExtraEnv map[string]string, progress *Progress) error {
 	// Open the source file for reading.
-	var source *os.File
-	source, err = fe.open(mysqld.Cnf(), true)
+	source, err := fe.open(mysqld.Cnf(), true)
 	if err != nil {
 		return err
 	}
 	defer source.Close()
 
+	return backupStream(ctx, logger, bh, fe, name, source, hookExtraEnv, progress)
+}
+
+// backupStream stores the data read from source as the file name of
+// the backup, transformed and compressed if specified. It records
+// the hash and size of the stored data in fe. progress, if not nil,
+// tracks and limits the reads from source.
+func backupStream(ctx context.Context, logger logutil.Logger, bh backupstorage.BackupHandle, fe *FileEntry, name string, source io.Reader, hookExtraEnv map[string]string, progress *Progress) (err error) {
+	source = progress.reader(ctx, source)
+
 	// Open the destination file for writing, and a buffer.
 	wc, err := bh.AddFile(ctx, name)
 	if err != nil {
@@ -499,8 +388,44 @@ func backupFile(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger,
 		return fmt.Errorf("cannot flush dst: %v", err)
 	}
 
-	// Save the hash.
+	// Save the hash and size.
 	fe.Hash = hasher.HashString()
+	fe.Size = hasher.Written()
+	return nil
+}
+
+// check returns an error if the data that went through the hasher
+// is not the data stored for the file.
+func (fe *FileEntry) check(h *hasher) error {
+	if fe.Size != 0 && h.Written() != fe.Size {
+		return fmt.Errorf("size mismatch for %v, got %v expected %v", fe.Name, h.Written(), fe.Size)
+	}
+	if hash := h.HashString(); hash != fe.Hash {
+		return fmt.Errorf("hash mismatch for %v, got %v expected %v", fe.Name, hash, fe.Hash)
+	}
+	return nil
+}
+
+// writeManifest JSON-encodes the manifest of a backup, and writes
+// it into its MANIFEST file.
+func writeManifest(ctx context.Context, bh backupstorage.BackupHandle, manifest interface{}) (err error) {
+	wc, err := bh.AddFile(ctx, backupManifest)
+	if err != nil {
+		return fmt.Errorf("cannot add %v to backup: %v", backupManifest, err)
+	}
+	defer func() {
+		if closeErr := wc.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("cannot JSON encode %v: %v", backupManifest, err)
+	}
+	if _, err := wc.Write([]byte(data)); err != nil {
+		return fmt.Errorf("cannot write %v: %v", backupManifest, err)
+	}
 	return nil
 }
 
@@ -541,44 +466,9 @@ func checkNoDB(ctx context.Context, mysqld MysqlDaemon, dbName string) (bool, er
 	return true, nil
 }
 
-// restoreFiles will copy all the files from the BackupStorage to the
-// right place.
-func restoreFiles(ctx context.Context, cnf *Mycnf, bh backupstorage.BackupHandle, fes []FileEntry, transformHook string, compress bool, restoreConcurrency int, hookExtraEnv map[string]string) error {
-	sema := sync2.NewSemaphore(restoreConcurrency, 0)
-	rec := concurrency.AllErrorRecorder{}
-	wg := sync.WaitGroup{}
-	for i := range fes {
-		wg.Add(1)
-		go func(i int) {
-			defer wg.Done()
-
-			// Wait until we are ready to go, skip if we already
-			// encountered an error.
-			sema.Acquire()
-			defer sema.Release()
-			if rec.HasErrors() {
-				return
-			}
-
-			// And restore the file.
-			name := fmt.Sprintf("%v", i)
-			rec.RecordError(restoreFile(ctx, cnf, bh, &fes[i], transformHook, compress, name, hookExtraEnv))
-		}(i)
-	}
-	wg.Wait()
-	return rec.Error()
-}
-
-// restoreFile restores an individual file.
-func restoreFile(ctx context.Context, cnf *Mycnf, bh backupstorage.BackupHandle, fe *FileEntry, transformHook string, compress bool, name string, hookExtraEnv map[string]string) (err error) {
-	// Open the source file for reading.
-	var source io.ReadCloser
-	source, err = bh.ReadFile(ctx, name)
-	if err != nil {
-		return err
-	}
-	defer source.Close()
-
+// restoreFile restores an individual file. progress, if not nil,
+// tracks and limits the reads from the BackupStorage.
+func restoreFile(ctx context.Context, cnf *Mycnf, bh backupstorage.BackupHandle, fe *FileEntry, transformHook string, compress bool, name string, hookExtraEnv map[string]string, progress *Progress) (err error) {
 	// Open the destination file for writing.
 	dstFile, err := fe.open(cnf, false)
 	if err != nil {
@@ -598,12 +488,33 @@ func restoreFile(ctx context.Context, cnf *Mycnf, bh backupstorage.BackupHandle,
 	// Create a buffering output.
 	dst := bufio.NewWriterSize(dstFile, 2*1024*1024)
 
+	if err := restoreStream(ctx, bh, fe, transformHook, compress, name, hookExtraEnv, dst, progress); err != nil {
+		return err
+	}
+
+	// Flush the buffer.
+	return dst.Flush()
+}
+
+// restoreStream reads the file name of the backup, reverts its
+// transformation and compression if specified, and writes the
+// result to dst. It checks the data it read is the data stored for fe.
+// progress, if not nil, tracks and limits the reads from the
+// BackupStorage.
+func restoreStream(ctx context.Context, bh backupstorage.BackupHandle, fe *FileEntry, transformHook string, compress bool, name string, hookExtraEnv map[string]string, dst io.Writer, progress *Progress) (err error) {
+	// Open the source file for reading.
+	source, err := bh.ReadFile(ctx, name)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
 	// Create hash to write the compressed data to.
 	hasher := newHasher()
 
 	// Create a Tee: we split the input into the hasher
 	// and into the gunziper.
-	reader := io.TeeReader(source, hasher)
+	reader := io.TeeReader(progress.reader(ctx, source), hasher)
 
 	// Create the external read pipe, if any.
 	var wait hook.WaitFunc
@@ -651,14 +562,8 @@ func restoreFile(ctx context.Context, cnf *Mycnf, bh backupstorage.BackupHandle,
 		}
 	}
 
-	// Check the hash.
-	hash := hasher.HashString()
-	if hash != fe.Hash {
-		return fmt.Errorf("hash mismatch for %v, got %v expected %v", fe.Name, hash, fe.Hash)
-	}
-
-	// Flush the buffer.
-	return dst.Flush()
+	// Check the hash and size.
+	return fe.check(hasher)
 }
 
 // removeExistingFiles will delete existing files in the data dir to prevent
@@ -713,6 +618,9 @@ func removeExistingFiles(cnf *Mycnf) error {
 // Restore is the main entry point for backup restore.  If there is no
 // appropriate backup on the BackupStorage, Restore logs an error
 // and returns ErrNoBackup. Any other error is returned.
+// If target is set, the latest backup taken before it is restored,
+// and the archived binlogs are replayed up to it. An incremental
+// backup is restored from the full backup its chain starts with.
 func Restore(
 	ctx context.Context,
 	mysqld MysqlDaemon,
@@ -722,7 +630,8 @@ func Restore(
 	localMetadata map[string]string,
 	logger logutil.Logger,
 	deleteBeforeRestore bool,
-	dbName string) (replication.Position, error) {
+	dbName string,
+	target RecoveryTarget) (replication.Position, error) {
 
 	// find the right backup handle: most recent one, with a MANIFEST
 	logger.Infof("Restore: looking for a suitable backup to restore")
@@ -737,6 +646,9 @@ func Restore(
 		return replication.Position{}, fmt.Errorf("ListBackups failed: %v", err)
 	}
 
+	if len(bhs) == 0 && !target.IsZero() {
+		return replication.Position{}, fmt.Errorf("no backup to restore on BackupStorage for directory %v", dir)
+	}
 	if len(bhs) == 0 {
 		// There are no backups (not even broken/incomplete ones).
 		logger.Errorf("No backup to restore on BackupStorage for directory %v. Starting up empty.", dir)
@@ -748,9 +660,11 @@ func Restore(
 
 	var bh backupstorage.BackupHandle
 	var bm BackupManifest
+	var increments []backupLink
 	var toRestore int
 	for toRestore = len(bhs) - 1; toRestore >= 0; toRestore-- {
 		bh = bhs[toRestore]
+		bm = BackupManifest{}
 		rc, err := bh.ReadFile(ctx, backupManifest)
 		if err != nil {
 			log.Warningf("Possibly incomplete backup %v in directory %v on BackupStorage: can't read MANIFEST: %v)", bh.Name(), dir, err)
@@ -763,16 +677,37 @@ func Restore(
 			log.Warningf("Possibly incomplete backup %v in directory %v on BackupStorage (cannot JSON decode MANIFEST: %v)", bh.Name(), dir, err)
 			continue
 		}
+		if !target.allowsBackup(&bm) {
+			logger.Infof("Restore: skipping backup %v %v, which isn't known to be before the recovery target", bh.Directory(), bh.Name())
+			continue
+		}
+
+		if bm.BackupMethod == incrementalBackupMethod {
+			chain, err := incrementalChain(ctx, bhs, bh, &bm)
+			if err != nil {
+				logger.Warningf("Restore: skipping incremental backup %v %v: %v", bh.Directory(), bh.Name(), err)
+				continue
+			}
+			logger.Infof("Restore: found incremental backup %v %v to restore from backup %v and %v incremental backups", bh.Directory(), bh.Name(), chain[0].bh.Name(), len(chain)-1)
+			bh, bm, increments = chain[0].bh, chain[0].bm, chain[1:]
+		}
 
 		logger.Infof("Restore: found backup %v %v to restore with %v files", bh.Directory(), bh.Name(), len(bm.FileEntries))
 		break
 	}
+	if toRestore < 0 && !target.IsZero() {
+		return replication.Position{}, fmt.Errorf("no usable backup before the recovery target %v", target)
+	}
 	if toRestore < 0 {
 		// There is at least one attempted backup, but none could be read.
 		// This implies there is data we ought to have, so it's not safe to start
 		// up empty.
 		return replication.Position{}, errors.New("backup(s) found but none could be read, unsafe to start up empty, restart to retry restore")
 	}
+	be, err := getRestoreEngine(&bm)
+	if err != nil {
+		return replication.Position{}, err
+	}
 
 	if !deleteBeforeRestore {
 		logger.Infof("Restore: checking no existing data is present")
@@ -810,7 +745,7 @@ func Restore(
 	}
 
 	logger.Infof("Restore: copying all files")
-	if err := restoreFiles(context.Background(), mysqld.Cnf(), bh, bm.FileEntries, bm.TransformHook, !bm.SkipCompress, restoreConcurrency, hookExtraEnv); err != nil {
+	if err := be.ExecuteRestore(context.Background(), mysqld, logger, bh, &bm, restoreConcurrency, hookExtraEnv); err != nil {
 		return replication.Position{}, err
 	}
 
@@ -853,5 +788,96 @@ func Restore(
 		return replication.Position{}, err
 	}
 
-	return bm.Position, nil
+	pos := bm.Position
+	for i := range increments {
+		logger.Infof("Restore: applying incremental backup %v", increments[i].bh.Name())
+		pos, err = applyIncrementalBackup(context.Background(), mysqld, logger, &increments[i], pos, hookExtraEnv)
+		if err != nil {
+			return replication.Position{}, err
+		}
+	}
+
+	if !target.IsZero() {
+		// The replay can take a long time, so it can be canceled.
+		// mysqld then has the transactions that were replayed so
+		// far, and an error is returned.
+		logger.Infof("Restore: replaying archived binlogs up to %v", target)
+		return replayBinlogs(ctx, mysqld, logger, bs, dir, pos, target, hookExtraEnv)
+	}
+
+	return pos, nil
+}
+
+// VerifyBackup reads all the files of a backup from the BackupStorage,
+// and checks they match the size and hash its MANIFEST recorded.
+// The files are neither uncompressed nor restored.
+func VerifyBackup(ctx context.Context, bs backupstorage.BackupStorage, logger logutil.Logger, dir, name string, verifyConcurrency int) error {
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil {
+		return fmt.Errorf("ListBackups failed: %v", err)
+	}
+	var bh backupstorage.BackupHandle
+	for _, b := range bhs {
+		if b.Name() == name {
+			bh = b
+			break
+		}
+	}
+	if bh == nil {
+		return fmt.Errorf("no backup %v in directory %v on BackupStorage", name, dir)
+	}
+
+	rc, err := bh.ReadFile(ctx, backupManifest)
+	if err != nil {
+		return fmt.Errorf("can't read MANIFEST: %v", err)
+	}
+	var bm BackupManifest
+	err = json.NewDecoder(rc).Decode(&bm)
+	rc.Close()
+	if err != nil {
+		return fmt.Errorf("cannot JSON decode MANIFEST: %v", err)
+	}
+	logger.Infof("verifying backup %v %v with %v files", dir, name, len(bm.FileEntries))
+
+	// Unlike restoreFiles, we check all the files even after
+	// an error, to report all the broken ones.
+	sema := sync2.NewSemaphore(verifyConcurrency, 0)
+	rec := concurrency.AllErrorRecorder{}
+	wg := sync.WaitGroup{}
+	for i := range bm.FileEntries {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sema.Acquire()
+			defer sema.Release()
+
+			fe := &bm.FileEntries[i]
+			if err := verifyFile(ctx, bh, fe, fmt.Sprintf("%v", i)); err != nil {
+				logger.Errorf("file %v %v is corrupted: %v", fe.Base, fe.Name, err)
+				rec.RecordError(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+	if rec.HasErrors() {
+		return fmt.Errorf("backup %v %v has %v corrupted files: %v", dir, name, len(rec.Errors), rec.Error())
+	}
+	logger.Infof("backup %v %v is valid", dir, name)
+	return nil
+}
+
+// verifyFile reads an individual file from the BackupStorage, and
+// checks its size and hash.
+func verifyFile(ctx context.Context, bh backupstorage.BackupHandle, fe *FileEntry, name string) error {
+	source, err := bh.ReadFile(ctx, name)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	hasher := newHasher()
+	if _, err := io.Copy(hasher, source); err != nil {
+		return fmt.Errorf("cannot read data: %v", err)
+	}
+	return fe.check(hasher)
 }
@@ -0,0 +1,262 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/sync2"
+	"github.com/youtube/vitess/go/vt/logutil"
+)
+
+// This file handles the rate limiting and the progress reporting of
+// backups and restores.
+
+var (
+	backupMaxBytesPerSecond  = flag.Int64("backup_max_bytes_per_second", 0, "if non-zero, the maximum rate at which a backup reads the files it copies, shared by all the files copied concurrently")
+	restoreMaxBytesPerSecond = flag.Int64("restore_max_bytes_per_second", 0, "if non-zero, the maximum rate at which a restore reads the files of the backup from the BackupStorage, shared by all the files copied concurrently")
+	backupProgressInterval   = flag.Duration("backup_progress_interval", 30*time.Second, "how often the progress of a backup or restore is logged")
+)
+
+// byteRateLimiter spaces out the copies of bytes so they don't go
+// faster than a given rate. It is shared by concurrent copies.
+type byteRateLimiter struct {
+	bytesPerSecond int64
+
+	mu sync.Mutex
+	// next is when the next bytes can be copied.
+	next time.Time
+}
+
+// wait reserves n bytes, and waits until they can be copied.
+// A nil byteRateLimiter doesn't limit anything.
+func (rl *byteRateLimiter) wait(ctx context.Context, n int) error {
+	if rl == nil || n == 0 {
+		return nil
+	}
+	rl.mu.Lock()
+	now := time.Now()
+	if rl.next.Before(now) {
+		rl.next = now
+	}
+	wakeUp := rl.next
+	rl.next = rl.next.Add(time.Duration(int64(n) * int64(time.Second) / rl.bytesPerSecond))
+	rl.mu.Unlock()
+
+	d := wakeUp.Sub(now)
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Progress tracks the bytes and files copied by a backup or a
+// restore, and limits the rate of the copies.
+type Progress struct {
+	operation  string
+	startTime  time.Time
+	totalBytes int64
+	totalFiles int
+	logger     logutil.Logger
+
+	doneBytes sync2.AtomicInt64
+	doneFiles sync2.AtomicInt64
+	limiter   *byteRateLimiter
+	stopChan  chan struct{}
+	wg        sync.WaitGroup
+}
+
+var (
+	// currentProgressMu protects currentProgress.
+	currentProgressMu sync.Mutex
+	// currentProgress is the operation in progress, if any.
+	currentProgress *Progress
+)
+
+// startProgress starts tracking an operation that copies totalFiles
+// files of totalBytes bytes in total, 0 if unknown, at most at
+// maxBytesPerSecond if it is not 0. The progress is logged every
+// -backup_progress_interval, and reported by CurrentProgress, until
+// stop is called.
+func startProgress(logger logutil.Logger, operation string, totalBytes int64, totalFiles int, maxBytesPerSecond int64) *Progress {
+	p := &Progress{
+		operation:  operation,
+		startTime:  time.Now(),
+		totalBytes: totalBytes,
+		totalFiles: totalFiles,
+		logger:     logger,
+		stopChan:   make(chan struct{}),
+	}
+	if maxBytesPerSecond > 0 {
+		p.limiter = &byteRateLimiter{bytesPerSecond: maxBytesPerSecond}
+		logger.Infof("%v: limiting the copies to %v/s", operation, formatBytes(maxBytesPerSecond))
+	}
+
+	currentProgressMu.Lock()
+	currentProgress = p
+	currentProgressMu.Unlock()
+
+	if *backupProgressInterval > 0 {
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			ticker := time.NewTicker(*backupProgressInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					logger.Infof("progress: %v", p.Status())
+				case <-p.stopChan:
+					return
+				}
+			}
+		}()
+	}
+	return p
+}
+
+// stop ends the tracking of the operation, and logs its final status.
+func (p *Progress) stop() {
+	close(p.stopChan)
+	p.wg.Wait()
+	currentProgressMu.Lock()
+	if currentProgress == p {
+		currentProgress = nil
+	}
+	currentProgressMu.Unlock()
+	p.logger.Infof("progress: %v", p.Status())
+}
+
+// reader returns a reader that counts the bytes read from r, and
+// limits their rate. A nil Progress returns r.
+func (p *Progress) reader(ctx context.Context, r io.Reader) io.Reader {
+	if p == nil {
+		return r
+	}
+	return &progressReader{ctx: ctx, r: r, p: p}
+}
+
+// fileDone records that one more file was copied.
+// A nil Progress does nothing.
+func (p *Progress) fileDone() {
+	if p != nil {
+		p.doneFiles.Add(1)
+	}
+}
+
+// Status returns a snapshot of the progress.
+func (p *Progress) Status() *ProgressStatus {
+	ps := &ProgressStatus{
+		Operation:  p.operation,
+		StartTime:  p.startTime,
+		DoneBytes:  p.doneBytes.Get(),
+		TotalBytes: p.totalBytes,
+		DoneFiles:  int(p.doneFiles.Get()),
+		TotalFiles: p.totalFiles,
+	}
+	if elapsed := time.Since(p.startTime).Seconds(); elapsed > 0 {
+		ps.BytesPerSecond = float64(ps.DoneBytes) / elapsed
+	}
+	if ps.BytesPerSecond > 0 && ps.TotalBytes > ps.DoneBytes {
+		ps.ETA = time.Duration(float64(ps.TotalBytes-ps.DoneBytes) / ps.BytesPerSecond * float64(time.Second))
+	}
+	return ps
+}
+
+// CurrentProgress returns the progress of the backup or restore in
+// progress, or nil if there is none.
+func CurrentProgress() *ProgressStatus {
+	currentProgressMu.Lock()
+	defer currentProgressMu.Unlock()
+	if currentProgress == nil {
+		return nil
+	}
+	return currentProgress.Status()
+}
+
+// ProgressStatus is a snapshot of the progress of a backup or restore.
+type ProgressStatus struct {
+	// Operation is what is being done, e.g. "backup" or "restore".
+	Operation string
+
+	// StartTime is when the operation started.
+	StartTime time.Time
+
+	// DoneBytes and TotalBytes are the bytes copied so far, and
+	// in total. TotalBytes is 0 if unknown.
+	DoneBytes  int64
+	TotalBytes int64
+
+	// DoneFiles and TotalFiles are the files copied so far, and
+	// in total.
+	DoneFiles  int
+	TotalFiles int
+
+	// BytesPerSecond is the average rate of the copies so far.
+	BytesPerSecond float64
+
+	// ETA is the estimated time to copy the rest, 0 if unknown.
+	ETA time.Duration
+}
+
+// String returns a printable version of the ProgressStatus.
+func (ps *ProgressStatus) String() string {
+	bytes := formatBytes(ps.DoneBytes)
+	if ps.TotalBytes > 0 {
+		bytes = fmt.Sprintf("%v of %v (%.0f%%)", bytes, formatBytes(ps.TotalBytes), 100*float64(ps.DoneBytes)/float64(ps.TotalBytes))
+	}
+	eta := "unknown"
+	if ps.ETA != 0 {
+		eta = (ps.ETA / time.Second * time.Second).String()
+	} else if ps.TotalBytes > 0 && ps.DoneBytes >= ps.TotalBytes {
+		eta = "0s"
+	}
+	return fmt.Sprintf("%v: %v, %v of %v files, %v/s, ETA %v", ps.Operation, bytes, ps.DoneFiles, ps.TotalFiles, formatBytes(int64(ps.BytesPerSecond)), eta)
+}
+
+// formatBytes returns a printable version of a number of bytes.
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%v B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// progressReader counts the bytes read from an io.Reader, and
+// limits their rate.
+type progressReader struct {
+	ctx context.Context
+	r   io.Reader
+	p   *Progress
+}
+
+// Read is part of the io.Reader interface.
+func (pr *progressReader) Read(b []byte) (int, error) {
+	n, err := pr.r.Read(b)
+	pr.p.doneBytes.Add(int64(n))
+	if waitErr := pr.p.limiter.wait(pr.ctx, n); waitErr != nil && err == nil {
+		err = waitErr
+	}
+	return n, err
+}
@@ -0,0 +1,117 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/logutil"
+)
+
+func TestByteRateLimiter(t *testing.T) {
+	ctx := context.Background()
+	rl := &byteRateLimiter{bytesPerSecond: 10000}
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if err := rl.wait(ctx, 1000); err != nil {
+			t.Fatalf("wait failed: %v", err)
+		}
+	}
+	// The first 1000 bytes go right away, the other 4000 take 0.4s.
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Errorf("copying 5000 bytes at 10000 B/s took %v, expected at least 400ms", elapsed)
+	}
+
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	rl.wait(ctx, 100000)
+	if err := rl.wait(cancelledCtx, 1000); err != context.Canceled {
+		t.Errorf("wait with a cancelled context returned %v", err)
+	}
+
+	// A nil byteRateLimiter doesn't limit anything.
+	var nilRL *byteRateLimiter
+	if err := nilRL.wait(cancelledCtx, 1000000); err != nil {
+		t.Errorf("wait on nil byteRateLimiter returned %v", err)
+	}
+}
+
+func TestFormatBytes(t *testing.T) {
+	for n, want := range map[int64]string{
+		0:                  "0 B",
+		1023:               "1023 B",
+		1024:               "1.0 KiB",
+		1536:               "1.5 KiB",
+		5 * 1024 * 1024:    "5.0 MiB",
+		3 << 40:            "3.0 TiB",
+		1024*1024*1024 - 1: "1024.0 MiB",
+	} {
+		if got := formatBytes(n); got != want {
+			t.Errorf("formatBytes(%v) = %v, want %v", n, got, want)
+		}
+	}
+}
+
+func TestProgressStatusString(t *testing.T) {
+	ps := &ProgressStatus{
+		Operation:      "backup",
+		DoneBytes:      1024 * 1024,
+		TotalBytes:     4 * 1024 * 1024,
+		DoneFiles:      3,
+		TotalFiles:     10,
+		BytesPerSecond: 1024 * 1024,
+		ETA:            3*time.Second + 200*time.Millisecond,
+	}
+	if got, want := ps.String(), "backup: 1.0 MiB of 4.0 MiB (25%), 3 of 10 files, 1.0 MiB/s, ETA 3s"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	ps.TotalBytes = 0
+	ps.ETA = 0
+	if got, want := ps.String(), "backup: 1.0 MiB, 3 of 10 files, 1.0 MiB/s, ETA unknown"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestProgress(t *testing.T) {
+	logger := logutil.NewMemoryLogger()
+	ctx := context.Background()
+	p := startProgress(logger, "restore", 3000, 1, 0)
+	ps := CurrentProgress()
+	if ps == nil || ps.Operation != "restore" || ps.TotalBytes != 3000 || ps.TotalFiles != 1 {
+		t.Fatalf("CurrentProgress() = %v", ps)
+	}
+
+	data, err := ioutil.ReadAll(p.reader(ctx, bytes.NewReader(make([]byte, 3000))))
+	if err != nil || len(data) != 3000 {
+		t.Fatalf("reading through the progress returned %v bytes: %v", len(data), err)
+	}
+	p.fileDone()
+	ps = CurrentProgress()
+	if ps.DoneBytes != 3000 || ps.DoneFiles != 1 {
+		t.Errorf("CurrentProgress() after the copy = %v", ps)
+	}
+
+	p.stop()
+	if ps := CurrentProgress(); ps != nil {
+		t.Errorf("CurrentProgress() after stop = %v", ps)
+	}
+	if got := logger.String(); !strings.Contains(got, "progress: restore: 2.9 KiB of 2.9 KiB (100%), 1 of 1 files") {
+		t.Errorf("unexpected logs: %v", got)
+	}
+
+	// A nil Progress doesn't track anything.
+	var nilProgress *Progress
+	r := bytes.NewReader(nil)
+	if got := nilProgress.reader(ctx, r); got != r {
+		t.Errorf("nil Progress wrapped the reader")
+	}
+	nilProgress.fileDone()
+}
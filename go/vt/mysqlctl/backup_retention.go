@@ -0,0 +1,223 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/mysqlctl/backupstorage"
+)
+
+// This file handles the removal of the backups that a retention
+// policy doesn't keep any more.
+
+// BackupTimestampFormat is the format of the time at the beginning
+// of the backup names.
+const BackupTimestampFormat = "2006-01-02.150405"
+
+// RetentionPolicy describes the backups of a shard to keep.
+// A backup is kept if any of the rules keeps it. The zero value
+// keeps all the backups.
+type RetentionPolicy struct {
+	// KeepCount, if non-zero, is the number of most recent
+	// valid backups to keep.
+	KeepCount int
+
+	// KeepDuration, if non-zero, is the age under which backups
+	// are kept, valid or not.
+	KeepDuration time.Duration
+}
+
+// IsZero returns true if the RetentionPolicy keeps all the backups.
+func (rp RetentionPolicy) IsZero() bool {
+	return rp.KeepCount == 0 && rp.KeepDuration == 0
+}
+
+// String returns a printable version of the RetentionPolicy.
+func (rp RetentionPolicy) String() string {
+	var rules []string
+	if rp.KeepCount != 0 {
+		rules = append(rules, fmt.Sprintf("keep %v backups", rp.KeepCount))
+	}
+	if rp.KeepDuration != 0 {
+		rules = append(rules, fmt.Sprintf("keep backups newer than %v", rp.KeepDuration))
+	}
+	if len(rules) == 0 {
+		return "keep all backups"
+	}
+	return strings.Join(rules, ", ")
+}
+
+// backupInfo is what the retention policy looks at in a backup.
+type backupInfo struct {
+	name string
+	// valid is set if the MANIFEST of the backup could be read.
+	valid bool
+	// time is when the backup was taken, zero if unknown.
+	time time.Time
+	bm   BackupManifest
+}
+
+// backupsToPrune returns the indexes of the backups the policy
+// doesn't keep. backups must be sorted oldest first. Besides what
+// the policy keeps, the most recent valid backup is always kept,
+// and so are the backups that come after it, as they may still be
+// in progress. Backups of unknown time are never removed because
+// of their age.
+func (rp RetentionPolicy) backupsToPrune(backups []backupInfo, now time.Time) []int {
+	if rp.IsZero() {
+		return nil
+	}
+	var toPrune []int
+	validCount := 0
+	for i := len(backups) - 1; i >= 0; i-- {
+		b := backups[i]
+		if b.valid {
+			validCount++
+		}
+		switch {
+		case validCount == 0 || (b.valid && validCount == 1):
+			// The most recent valid backup, or a newer one.
+			continue
+		case b.valid && rp.KeepCount != 0 && validCount <= rp.KeepCount:
+			continue
+		case rp.KeepDuration != 0 && (b.time.IsZero() || now.Sub(b.time) < rp.KeepDuration):
+			continue
+		}
+		toPrune = append(toPrune, i)
+	}
+	return toPrune
+}
+
+// keepParents returns toPrune without the backups that are needed
+// to restore the incremental backups that are kept.
+func keepParents(backups []backupInfo, toPrune []int) []int {
+	pruned := make(map[int]bool, len(toPrune))
+	for _, i := range toPrune {
+		pruned[i] = true
+	}
+	byName := make(map[string]int, len(backups))
+	for i, b := range backups {
+		byName[b.name] = i
+	}
+
+	// Parents are older than their children, so going through
+	// the backups newest first finds all the needed ones.
+	needed := make(map[int]bool)
+	for i := len(backups) - 1; i >= 0; i-- {
+		b := backups[i]
+		if !b.valid || b.bm.Parent == "" || (pruned[i] && !needed[i]) {
+			continue
+		}
+		if p, ok := byName[b.bm.Parent]; ok {
+			needed[p] = true
+		}
+	}
+
+	var result []int
+	for _, i := range toPrune {
+		if !needed[i] {
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
+// PruneBackups removes the backups of the directory that the policy
+// doesn't keep, and the archived binlogs that only the removed
+// backups needed. The backups that kept incremental backups are
+// taken from are kept too. With dryRun, it only logs what it would
+// remove. It returns the names of the removed backups.
+func PruneBackups(ctx context.Context, bs backupstorage.BackupStorage, logger logutil.Logger, dir string, policy RetentionPolicy, dryRun bool) ([]string, error) {
+	if policy.IsZero() {
+		return nil, nil
+	}
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil {
+		return nil, fmt.Errorf("ListBackups failed: %v", err)
+	}
+	backups := make([]backupInfo, len(bhs))
+	for i, bh := range bhs {
+		backups[i] = readBackupInfo(ctx, bh)
+	}
+
+	toPrune := keepParents(backups, policy.backupsToPrune(backups, time.Now()))
+	pruned := make(map[int]bool)
+	var removed []string
+	for _, i := range toPrune {
+		name := backups[i].name
+		if dryRun {
+			logger.Infof("would remove backup %v/%v (policy: %v)", dir, name, policy)
+		} else {
+			logger.Infof("removing backup %v/%v (policy: %v)", dir, name, policy)
+			if err := bs.RemoveBackup(ctx, dir, name); err != nil {
+				return removed, fmt.Errorf("RemoveBackup(%v, %v) failed: %v", dir, name, err)
+			}
+		}
+		pruned[i] = true
+		removed = append(removed, name)
+	}
+
+	// The archived binlogs that are part of the oldest valid
+	// backup left are not needed for point-in-time recovery.
+	for i, b := range backups {
+		if !b.valid || pruned[i] {
+			continue
+		}
+		if err := pruneBinlogArchives(ctx, bs, logger, dir, &b.bm, dryRun); err != nil {
+			return removed, err
+		}
+		break
+	}
+	return removed, nil
+}
+
+// pruneBinlogArchives removes the archived binlogs whose transactions
+// are all part of the backup.
+func pruneBinlogArchives(ctx context.Context, bs backupstorage.BackupStorage, logger logutil.Logger, dir string, bm *BackupManifest, dryRun bool) error {
+	archives, err := listBinlogArchives(ctx, logger, bs, dir)
+	if err != nil {
+		return err
+	}
+	archiveDir := BinlogArchiveDir(dir)
+	for _, a := range archives {
+		if a.bm.Position.IsZero() || !bm.Position.AtLeast(a.bm.Position) {
+			continue
+		}
+		if dryRun {
+			logger.Infof("would remove binlog archive %v/%v", archiveDir, a.bh.Name())
+			continue
+		}
+		logger.Infof("removing binlog archive %v/%v", archiveDir, a.bh.Name())
+		if err := bs.RemoveBackup(ctx, archiveDir, a.bh.Name()); err != nil {
+			return fmt.Errorf("RemoveBackup(%v, %v) failed: %v", archiveDir, a.bh.Name(), err)
+		}
+	}
+	return nil
+}
+
+// readBackupInfo reads the MANIFEST of a backup. The time of the
+// backup comes from its MANIFEST, or else from its name.
+func readBackupInfo(ctx context.Context, bh backupstorage.BackupHandle) backupInfo {
+	b := backupInfo{name: bh.Name()}
+	if rc, err := bh.ReadFile(ctx, backupManifest); err == nil {
+		b.valid = json.NewDecoder(rc).Decode(&b.bm) == nil
+		rc.Close()
+	}
+	if b.valid && !b.bm.Time.IsZero() {
+		b.time = b.bm.Time
+	} else if len(b.name) >= len(BackupTimestampFormat) {
+		if t, err := time.Parse(BackupTimestampFormat, b.name[:len(BackupTimestampFormat)]); err == nil {
+			b.time = t
+		}
+	}
+	return b
+}
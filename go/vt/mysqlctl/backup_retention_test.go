@@ -0,0 +1,197 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/mysqlctl/backupstorage"
+	"github.com/youtube/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+func TestBackupsToPrune(t *testing.T) {
+	now := time.Date(2016, 3, 10, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	// backups are oldest first: one every day, the 3rd one
+	// is invalid, and so is the most recent one (in progress).
+	backups := []backupInfo{
+		{name: "b0", valid: true, time: now.Add(-6 * day)},
+		{name: "b1", valid: true, time: now.Add(-5 * day)},
+		{name: "b2", valid: false, time: now.Add(-4 * day)},
+		{name: "b3", valid: true, time: now.Add(-3 * day)},
+		{name: "b4", valid: true},
+		{name: "b5", valid: true, time: now.Add(-1 * day)},
+		{name: "b6", valid: false, time: now},
+	}
+	table := []struct {
+		policy RetentionPolicy
+		want   []int
+	}{{
+		policy: RetentionPolicy{},
+		want:   nil,
+	}, {
+		policy: RetentionPolicy{KeepCount: 1},
+		want:   []int{4, 3, 2, 1, 0},
+	}, {
+		policy: RetentionPolicy{KeepCount: 3},
+		want:   []int{2, 1, 0},
+	}, {
+		policy: RetentionPolicy{KeepCount: 10},
+		want:   []int{2},
+	}, {
+		// b4 has an unknown time, so it is kept.
+		policy: RetentionPolicy{KeepDuration: 2 * day},
+		want:   []int{3, 2, 1, 0},
+	}, {
+		policy: RetentionPolicy{KeepDuration: 100 * day},
+		want:   nil,
+	}, {
+		policy: RetentionPolicy{KeepCount: 4, KeepDuration: 4*day + time.Hour},
+		want:   []int{0},
+	}}
+	for _, tcase := range table {
+		if got := tcase.policy.backupsToPrune(backups, now); !reflect.DeepEqual(got, tcase.want) {
+			t.Errorf("backupsToPrune(%v) = %v, want %v", tcase.policy, got, tcase.want)
+		}
+	}
+
+	// With no valid backup at all, nothing is removed.
+	invalid := []backupInfo{
+		{name: "b0", time: now.Add(-6 * day)},
+		{name: "b1", time: now.Add(-5 * day)},
+	}
+	if got := (RetentionPolicy{KeepCount: 1}).backupsToPrune(invalid, now); got != nil {
+		t.Errorf("backupsToPrune(no valid backup) = %v, want nil", got)
+	}
+}
+
+func TestKeepParents(t *testing.T) {
+	// b1 is taken from b0, b2 from b1, and b4 from b3.
+	backups := []backupInfo{
+		{name: "b0", valid: true},
+		{name: "b1", valid: true, bm: BackupManifest{Parent: "b0"}},
+		{name: "b2", valid: true, bm: BackupManifest{Parent: "b1"}},
+		{name: "b3", valid: true},
+		{name: "b4", valid: true, bm: BackupManifest{Parent: "b3"}},
+	}
+	table := []struct {
+		toPrune []int
+		want    []int
+	}{{
+		toPrune: []int{3, 2, 1, 0},
+		want:    []int{2, 1, 0},
+	}, {
+		toPrune: []int{1, 0},
+		want:    nil,
+	}, {
+		toPrune: []int{3},
+		want:    nil,
+	}, {
+		toPrune: []int{4, 3},
+		want:    []int{4, 3},
+	}}
+	for _, tcase := range table {
+		if got := keepParents(backups, tcase.toPrune); !reflect.DeepEqual(got, tcase.want) {
+			t.Errorf("keepParents(%v) = %v, want %v", tcase.toPrune, got, tcase.want)
+		}
+	}
+}
+
+// addTestBackup creates a backup, with a MANIFEST at the given
+// position if it's not empty. Both backups and binlog archives
+// read their Position from such a MANIFEST.
+func addTestBackup(t *testing.T, bs backupstorage.BackupStorage, dir, name, position string) {
+	ctx := context.Background()
+	bh, err := bs.StartBackup(ctx, dir, name)
+	if err != nil {
+		t.Fatalf("StartBackup(%v, %v) failed: %v", dir, name, err)
+	}
+	if position != "" {
+		wc, err := bh.AddFile(ctx, backupManifest)
+		if err != nil {
+			t.Fatalf("AddFile failed: %v", err)
+		}
+		if err := json.NewEncoder(wc).Encode(&BinlogManifest{Position: mustDecodePosition(t, position)}); err != nil {
+			t.Fatalf("Encode failed: %v", err)
+		}
+		if err := wc.Close(); err != nil {
+			t.Fatalf("Close failed: %v", err)
+		}
+	}
+	if err := bh.EndBackup(ctx); err != nil {
+		t.Fatalf("EndBackup failed: %v", err)
+	}
+}
+
+func listTestBackups(t *testing.T, bs backupstorage.BackupStorage, dir string) []string {
+	bhs, err := bs.ListBackups(context.Background(), dir)
+	if err != nil {
+		t.Fatalf("ListBackups(%v) failed: %v", dir, err)
+	}
+	var names []string
+	for _, bh := range bhs {
+		names = append(names, bh.Name())
+	}
+	return names
+}
+
+func TestPruneBackups(t *testing.T) {
+	root, err := ioutil.TempDir("", "prunebackupstest")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root)
+	*filebackupstorage.FileBackupStorageRoot = root
+	bs := &filebackupstorage.FileBackupStorage{}
+	logger := logutil.NewMemoryLogger()
+	ctx := context.Background()
+
+	dir := "ks/-80"
+	addTestBackup(t, bs, dir, "2016-03-01.100000.cell1-0000000100", "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-10")
+	addTestBackup(t, bs, dir, "2016-03-02.100000.cell1-0000000100", "")
+	addTestBackup(t, bs, dir, "2016-03-03.100000.cell1-0000000100", "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-20")
+	addTestBackup(t, bs, dir, "2016-03-04.100000.cell1-0000000100", "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-30")
+	archiveDir := BinlogArchiveDir(dir)
+	addTestBackup(t, bs, archiveDir, "cell1-0000000100.vt-0000000100-bin.000001", "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-15")
+	addTestBackup(t, bs, archiveDir, "cell1-0000000100.vt-0000000100-bin.000002", "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-25")
+
+	// A dry run doesn't remove anything.
+	wantRemoved := []string{"2016-03-02.100000.cell1-0000000100", "2016-03-01.100000.cell1-0000000100"}
+	removed, err := PruneBackups(ctx, bs, logger, dir, RetentionPolicy{KeepCount: 2}, true /* dryRun */)
+	if err != nil {
+		t.Fatalf("PruneBackups(dry run) failed: %v", err)
+	}
+	if !reflect.DeepEqual(removed, wantRemoved) {
+		t.Errorf("PruneBackups(dry run) = %v, want %v", removed, wantRemoved)
+	}
+	if got := listTestBackups(t, bs, dir); len(got) != 4 {
+		t.Errorf("PruneBackups(dry run) removed backups: %v", got)
+	}
+
+	removed, err = PruneBackups(ctx, bs, logger, dir, RetentionPolicy{KeepCount: 2}, false /* dryRun */)
+	if err != nil {
+		t.Fatalf("PruneBackups failed: %v", err)
+	}
+	if !reflect.DeepEqual(removed, wantRemoved) {
+		t.Errorf("PruneBackups = %v, want %v", removed, wantRemoved)
+	}
+	want := []string{"2016-03-03.100000.cell1-0000000100", "2016-03-04.100000.cell1-0000000100"}
+	if got := listTestBackups(t, bs, dir); !reflect.DeepEqual(got, want) {
+		t.Errorf("backups after PruneBackups = %v, want %v", got, want)
+	}
+	// The first binlog archive is part of the oldest backup left.
+	want = []string{"cell1-0000000100.vt-0000000100-bin.000002"}
+	if got := listTestBackups(t, bs, archiveDir); !reflect.DeepEqual(got, want) {
+		t.Errorf("binlog archives after PruneBackups = %v, want %v", got, want)
+	}
+}
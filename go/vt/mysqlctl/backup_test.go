@@ -5,12 +5,19 @@
 package mysqlctl
 
 import (
+	"encoding/json"
 	"io/ioutil"
 	"os"
 	"path"
 	"reflect"
 	"sort"
+	"strings"
 	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/mysqlctl/filebackupstorage"
 )
 
 func TestFindFilesToBackup(t *testing.T) {
@@ -91,3 +98,85 @@ type forTest []FileEntry
 func (f forTest) Len() int           { return len(f) }
 func (f forTest) Swap(i, j int)      { f[i], f[j] = f[j], f[i] }
 func (f forTest) Less(i, j int) bool { return f[i].Base+f[i].Name < f[j].Base+f[j].Name }
+
+func TestVerifyBackup(t *testing.T) {
+	root, err := ioutil.TempDir("", "verifybackuptest")
+	if err != nil {
+		t.Fatalf("os.TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root)
+	dataDir := path.Join(root, "data")
+	if err := os.MkdirAll(dataDir, os.ModePerm); err != nil {
+		t.Fatalf("failed to create directory %v: %v", dataDir, err)
+	}
+	contents := strings.Repeat("table contents ", 1000)
+	if err := ioutil.WriteFile(path.Join(dataDir, "table1.ibd"), []byte(contents), os.ModePerm); err != nil {
+		t.Fatalf("failed to write file table1.ibd: %v", err)
+	}
+	mysqld := NewFakeMysqlDaemon(nil)
+	mysqld.Mycnf = &Mycnf{DataDir: dataDir}
+	*backupStorageCompress = false
+	defer func() { *backupStorageCompress = true }()
+
+	// Take a backup of the file.
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "backups")
+	bs := &filebackupstorage.FileBackupStorage{}
+	logger := logutil.NewMemoryLogger()
+	ctx := context.Background()
+	dir := "ks/-80"
+	name := "2016-03-01.100000.cell1-0000000100"
+	bh, err := bs.StartBackup(ctx, dir, name)
+	if err != nil {
+		t.Fatalf("StartBackup failed: %v", err)
+	}
+	fe := FileEntry{Base: backupData, Name: "table1.ibd"}
+	if err := backupFile(ctx, mysqld, logger, bh, &fe, "0", nil, nil); err != nil {
+		t.Fatalf("backupFile failed: %v", err)
+	}
+	if fe.Size == 0 || fe.Hash == "" {
+		t.Errorf("backupFile didn't record the size and hash: %#v", fe)
+	}
+	wc, err := bh.AddFile(ctx, backupManifest)
+	if err != nil {
+		t.Fatalf("AddFile failed: %v", err)
+	}
+	if err := json.NewEncoder(wc).Encode(&BackupManifest{FileEntries: []FileEntry{fe}}); err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+	wc.Close()
+	if err := bh.EndBackup(ctx); err != nil {
+		t.Fatalf("EndBackup failed: %v", err)
+	}
+
+	if err := VerifyBackup(ctx, bs, logger, dir, name, 2); err != nil {
+		t.Errorf("VerifyBackup failed: %v", err)
+	}
+	if err := VerifyBackup(ctx, bs, logger, dir, "unknown", 2); err == nil || !strings.Contains(err.Error(), "no backup unknown") {
+		t.Errorf("VerifyBackup(unknown) returned %v", err)
+	}
+
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil || len(bhs) != 1 {
+		t.Fatalf("ListBackups returned %v %v", bhs, err)
+	}
+	restoreCnf := &Mycnf{DataDir: path.Join(root, "restore")}
+	if err := restoreFile(ctx, restoreCnf, bhs[0], &fe, "", false, "0", nil, nil); err != nil {
+		t.Errorf("restoreFile failed: %v", err)
+	}
+	if data, err := ioutil.ReadFile(path.Join(restoreCnf.DataDir, "table1.ibd")); err != nil || string(data) != contents {
+		t.Errorf("restoreFile didn't restore the file: %v", err)
+	}
+
+	// Truncate the stored file: both VerifyBackup and
+	// restoreFile should notice.
+	stored := path.Join(*filebackupstorage.FileBackupStorageRoot, dir, name, "0")
+	if err := os.Truncate(stored, fe.Size/2); err != nil {
+		t.Fatalf("Truncate failed: %v", err)
+	}
+	if err := VerifyBackup(ctx, bs, logger, dir, name, 2); err == nil || !strings.Contains(err.Error(), "size mismatch for table1.ibd") {
+		t.Errorf("VerifyBackup(truncated) returned %v", err)
+	}
+	if err := restoreFile(ctx, restoreCnf, bhs[0], &fe, "", false, "0", nil, nil); err == nil {
+		t.Errorf("restoreFile(truncated) should have failed")
+	}
+}
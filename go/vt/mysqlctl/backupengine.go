@@ -0,0 +1,72 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"flag"
+	"fmt"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/mysqlctl/backupstorage"
+)
+
+const builtinBackupEngineName = "builtin"
+
+var (
+	// BackupEngineImplementation is the implementation to use
+	// for BackupEngine. Exported for test purposes.
+	BackupEngineImplementation = flag.String("backup_engine_implementation", builtinBackupEngineName, "which implementation to use to take backups: 'builtin' shuts mysqld down and copies its files, 'online' runs the online_backup hook while mysqld is running")
+
+	// BackupEngineMap contains the registered implementations for
+	// BackupEngine.
+	BackupEngineMap = make(map[string]BackupEngine)
+)
+
+// BackupEngine is the interface to the code that copies the data of
+// mysqld into a backup, and back.
+type BackupEngine interface {
+	// ExecuteBackup copies the data of mysqld into bh, and writes its
+	// MANIFEST, with BackupMethod set to the name the engine is
+	// registered under. It returns true if the backup is usable,
+	// even if an error happened afterwards (e.g. mysqld could not
+	// be restarted).
+	ExecuteBackup(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh backupstorage.BackupHandle, backupConcurrency int, hookExtraEnv map[string]string) (bool, error)
+
+	// ExecuteRestore copies the data of a backup it took into the
+	// directories of mysqld. mysqld is shut down, and its
+	// directories are emptied, before it is called.
+	ExecuteRestore(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh backupstorage.BackupHandle, bm *BackupManifest, restoreConcurrency int, hookExtraEnv map[string]string) error
+
+	// ShouldDrainForBackup returns true if the tablet must stop
+	// serving while the engine takes a backup.
+	ShouldDrainForBackup() bool
+}
+
+// GetBackupEngine returns the BackupEngine to take backups with.
+// Should be called after flags have been initialized.
+func GetBackupEngine() (BackupEngine, error) {
+	be, ok := BackupEngineMap[*BackupEngineImplementation]
+	if !ok {
+		return nil, fmt.Errorf("no registered implementation of BackupEngine %v", *BackupEngineImplementation)
+	}
+	return be, nil
+}
+
+// getRestoreEngine returns the BackupEngine that took a backup,
+// which is the only one that can restore it.
+func getRestoreEngine(bm *BackupManifest) (BackupEngine, error) {
+	name := bm.BackupMethod
+	if name == "" {
+		// Backups taken before the method was recorded.
+		name = builtinBackupEngineName
+	}
+	be, ok := BackupEngineMap[name]
+	if !ok {
+		return nil, fmt.Errorf("no registered implementation of BackupEngine %v, which took the backup", name)
+	}
+	return be, nil
+}
@@ -0,0 +1,518 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/mysqlctl/backupstorage"
+	"github.com/youtube/vitess/go/vt/mysqlctl/replication"
+)
+
+// This file handles the archiving of binlog files into the
+// BackupStorage, and their replay on top of a restored backup
+// for point-in-time recovery.
+//
+// Each archived binlog file is stored as its own backup, in a
+// separate directory from the regular backups. It contains the
+// compressed binlog file, and a MANIFEST that describes it.
+
+const (
+	// binlogArchiveFile is the name of the binlog file in an archive.
+	binlogArchiveFile = "0"
+
+	// binlogEventHeaderLen is the length of the v4 binlog event header.
+	binlogEventHeaderLen = 19
+)
+
+// binlogFileMagic is at the beginning of every binlog file.
+var binlogFileMagic = []byte{0xfe, 'b', 'i', 'n'}
+
+// RecoveryTarget is the point a point-in-time recovery brings the
+// data to. The zero value means no point-in-time recovery: the
+// latest backup is restored as is.
+type RecoveryTarget struct {
+	// Time, if set, is the time of the last transaction to recover.
+	Time time.Time
+
+	// Position, if set, is the position of the last transaction to recover.
+	Position replication.Position
+}
+
+// IsZero returns true if the RecoveryTarget is not set.
+func (rt RecoveryTarget) IsZero() bool {
+	return rt.Time.IsZero() && rt.Position.IsZero()
+}
+
+// String returns a printable version of the RecoveryTarget.
+func (rt RecoveryTarget) String() string {
+	switch {
+	case !rt.Time.IsZero() && !rt.Position.IsZero():
+		return fmt.Sprintf("%v and %v", rt.Time, rt.Position)
+	case !rt.Time.IsZero():
+		return rt.Time.String()
+	default:
+		return rt.Position.String()
+	}
+}
+
+// allowsBackup returns true if the backup is known to only contain
+// transactions that are part of the target.
+func (rt RecoveryTarget) allowsBackup(bm *BackupManifest) bool {
+	if !rt.Time.IsZero() && (bm.Time.IsZero() || bm.Time.After(rt.Time)) {
+		return false
+	}
+	if !rt.Position.IsZero() && !rt.Position.AtLeast(bm.Position) {
+		return false
+	}
+	return true
+}
+
+// reachedBy returns true if the transaction with the given GTID
+// and timestamp is past the target.
+func (rt RecoveryTarget) reachedBy(gtid replication.GTID, timestamp uint32) bool {
+	if !rt.Time.IsZero() && int64(timestamp) > rt.Time.Unix() {
+		return true
+	}
+	if !rt.Position.IsZero() && !rt.Position.GTIDSet.ContainsGTID(gtid) {
+		return true
+	}
+	return false
+}
+
+// BinlogManifest describes an archived binlog file.
+type BinlogManifest struct {
+	// FileEntry is the binlog file. Its Base is backupBinlog.
+	FileEntry FileEntry
+
+	// FirstTimestamp and LastTimestamp are the timestamps of the
+	// first and last events of the file, in seconds since the epoch.
+	FirstTimestamp int64
+	LastTimestamp  int64
+
+	// Position is the replication position at the end of the file.
+	Position replication.Position
+
+	// TransformHook that was used on the file, if any.
+	TransformHook string
+
+	// SkipCompress is set if the file was not run through gzip.
+	SkipCompress bool
+}
+
+// BinlogArchiveDir returns the directory of the BackupStorage the
+// binlogs of the shard are archived into, given the directory of
+// its backups.
+func BinlogArchiveDir(dir string) string {
+	return path.Join("binlogs", dir)
+}
+
+// ArchiveBinlogs copies the binlog files that mysqld is done writing
+// to, and that are not archived yet, into the BackupStorage. The
+// archives are named after prefix and the binlog file name.
+// It returns the number of files it archived.
+func ArchiveBinlogs(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, dir, prefix string, hookExtraEnv map[string]string) (int, error) {
+	pos, err := mysqld.MasterPosition()
+	if err != nil {
+		return 0, fmt.Errorf("can't get master position: %v", err)
+	}
+	flavor, err := positionFlavor(pos)
+	if err != nil {
+		return 0, err
+	}
+	files, err := closedBinlogFiles(ctx, mysqld)
+	if err != nil {
+		return 0, err
+	}
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return 0, err
+	}
+	defer bs.Close()
+	archiveDir := BinlogArchiveDir(dir)
+	bhs, err := bs.ListBackups(ctx, archiveDir)
+	if err != nil {
+		return 0, fmt.Errorf("ListBackups failed: %v", err)
+	}
+	archived := make(map[string]bool)
+	for _, bh := range bhs {
+		archived[bh.Name()] = true
+	}
+
+	count := 0
+	for _, file := range files {
+		name := fmt.Sprintf("%v.%v", prefix, file)
+		if archived[name] {
+			continue
+		}
+		logger.Infof("archiving binlog file %v as %v", file, name)
+		bh, err := bs.StartBackup(ctx, archiveDir, name)
+		if err != nil {
+			return count, fmt.Errorf("StartBackup failed: %v", err)
+		}
+		if err := archiveBinlog(ctx, mysqld, logger, flavor, bh, file, hookExtraEnv); err != nil {
+			if abortErr := bh.AbortBackup(ctx); abortErr != nil {
+				logger.Errorf("failed to abort archive of binlog file %v: %v", file, abortErr)
+			}
+			return count, err
+		}
+		if err := bh.EndBackup(ctx); err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+// closedBinlogFiles returns the names of the binlog files of mysqld,
+// except the one it is currently writing to.
+func closedBinlogFiles(ctx context.Context, mysqld MysqlDaemon) ([]string, error) {
+	qr, err := mysqld.FetchSuperQuery(ctx, "SHOW BINARY LOGS")
+	if err != nil {
+		return nil, fmt.Errorf("can't list binlog files: %v", err)
+	}
+	if len(qr.Rows) == 0 {
+		return nil, nil
+	}
+	files := make([]string, 0, len(qr.Rows)-1)
+	for _, row := range qr.Rows[:len(qr.Rows)-1] {
+		files = append(files, row[0].String())
+	}
+	return files, nil
+}
+
+// archiveBinlog copies one binlog file and its MANIFEST into bh.
+func archiveBinlog(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, flavor MysqlFlavor, bh backupstorage.BackupHandle, file string, hookExtraEnv map[string]string) error {
+	bm := &BinlogManifest{
+		FileEntry: FileEntry{
+			Base: backupBinlog,
+			Name: file,
+		},
+		TransformHook: *backupStorageHook,
+		SkipCompress:  !*backupStorageCompress,
+	}
+	if err := backupFile(ctx, mysqld, logger, bh, &bm.FileEntry, binlogArchiveFile, hookExtraEnv, nil); err != nil {
+		return err
+	}
+
+	// Describe the file.
+	source, err := bm.FileEntry.open(mysqld.Cnf(), true)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+	summary, err := summarizeBinlogFile(flavor, source, replication.Position{})
+	if err != nil {
+		return fmt.Errorf("can't read binlog file %v: %v", file, err)
+	}
+	bm.FirstTimestamp = summary.firstTimestamp
+	bm.LastTimestamp = summary.lastTimestamp
+	bm.Position = summary.position
+
+	// JSON-encode and write the MANIFEST.
+	return writeManifest(ctx, bh, bm)
+}
+
+// binlogFileSummary describes the contents of a binlog file.
+type binlogFileSummary struct {
+	// firstTimestamp and lastTimestamp are the timestamps of the
+	// first and last events of the file, in seconds since the epoch.
+	firstTimestamp int64
+	lastTimestamp  int64
+
+	// startPosition is the position at the beginning of the file,
+	// if the flavor records it in the file. It is zero otherwise.
+	startPosition replication.Position
+
+	// position is the position at the end of the file.
+	position replication.Position
+}
+
+// summarizeBinlogFile reads a binlog file and describes it. The
+// position at the end of the file is computed from the position at
+// its beginning if the file records it, or else from pos.
+func summarizeBinlogFile(flavor MysqlFlavor, r io.Reader, pos replication.Position) (binlogFileSummary, error) {
+	summary := binlogFileSummary{position: pos}
+	err := scanBinlogFile(flavor, r, func(offset int64, ev replication.BinlogEvent, f replication.BinlogFormat) error {
+		if ts := int64(ev.Timestamp()); ts != 0 {
+			if summary.firstTimestamp == 0 {
+				summary.firstTimestamp = ts
+			}
+			summary.lastTimestamp = ts
+		}
+		switch {
+		case ev.IsPreviousGTIDs():
+			pos, err := ev.PreviousGTIDs(f)
+			if err != nil {
+				return err
+			}
+			summary.startPosition = pos
+			summary.position = pos
+		case ev.HasGTID(f):
+			gtid, err := ev.GTID(f)
+			if err != nil {
+				return err
+			}
+			summary.position = replication.AppendGTID(summary.position, gtid)
+		}
+		return nil
+	})
+	return summary, err
+}
+
+// binlogArchive is an archived binlog file found in the BackupStorage.
+type binlogArchive struct {
+	bh backupstorage.BackupHandle
+	bm BinlogManifest
+}
+
+// listBinlogArchives returns the readable archives in the BackupStorage,
+// sorted by the time of their first event.
+func listBinlogArchives(ctx context.Context, logger logutil.Logger, bs backupstorage.BackupStorage, dir string) ([]binlogArchive, error) {
+	bhs, err := bs.ListBackups(ctx, BinlogArchiveDir(dir))
+	if err != nil {
+		return nil, fmt.Errorf("ListBackups failed: %v", err)
+	}
+	var archives []binlogArchive
+	for _, bh := range bhs {
+		rc, err := bh.ReadFile(ctx, backupManifest)
+		if err != nil {
+			logger.Warningf("Possibly incomplete binlog archive %v in directory %v on BackupStorage: can't read MANIFEST: %v", bh.Name(), bh.Directory(), err)
+			continue
+		}
+		var bm BinlogManifest
+		err = json.NewDecoder(rc).Decode(&bm)
+		rc.Close()
+		if err != nil {
+			logger.Warningf("Possibly incomplete binlog archive %v in directory %v on BackupStorage: cannot JSON decode MANIFEST: %v", bh.Name(), bh.Directory(), err)
+			continue
+		}
+		archives = append(archives, binlogArchive{bh: bh, bm: bm})
+	}
+	sort.Stable(byFirstTimestamp(archives))
+	return archives, nil
+}
+
+type byFirstTimestamp []binlogArchive
+
+func (a byFirstTimestamp) Len() int           { return len(a) }
+func (a byFirstTimestamp) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a byFirstTimestamp) Less(i, j int) bool { return a[i].bm.FirstTimestamp < a[j].bm.FirstTimestamp }
+
+// replayBinlogs replays the archived binlogs on top of a restored
+// backup at position pos, up to the target. It returns the
+// position it reached.
+func replayBinlogs(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bs backupstorage.BackupStorage, dir string, pos replication.Position, target RecoveryTarget, hookExtraEnv map[string]string) (replication.Position, error) {
+	flavor, err := positionFlavor(pos)
+	if err != nil {
+		return pos, err
+	}
+	archives, err := listBinlogArchives(ctx, logger, bs, dir)
+	if err != nil {
+		return pos, err
+	}
+
+	// The archived files are restored one at a time in a temporary
+	// directory, which stands for the binlog directory.
+	tmpDir, err := ioutil.TempDir("", "binlog_replay")
+	if err != nil {
+		return pos, err
+	}
+	defer os.RemoveAll(tmpDir)
+	cnf := &Mycnf{BinLogPath: path.Join(tmpDir, "binlog")}
+
+	reached := false
+	for _, a := range archives {
+		if pos.AtLeast(a.bm.Position) {
+			// Everything in this file was already applied.
+			continue
+		}
+		if !target.Time.IsZero() && a.bm.FirstTimestamp > target.Time.Unix() {
+			reached = true
+			break
+		}
+
+		if err := restoreFile(ctx, cnf, a.bh, &a.bm.FileEntry, a.bm.TransformHook, !a.bm.SkipCompress, binlogArchiveFile, hookExtraEnv, nil); err != nil {
+			return pos, fmt.Errorf("can't restore binlog archive %v: %v", a.bh.Name(), err)
+		}
+		pos, reached, err = replayBinlogFile(ctx, mysqld, logger, flavor, path.Join(tmpDir, a.bm.FileEntry.Name), "binlog archive "+a.bh.Name(), pos, target)
+		if err != nil {
+			return pos, err
+		}
+		if reached {
+			break
+		}
+	}
+
+	if !target.Position.IsZero() && !pos.AtLeast(target.Position) {
+		return pos, fmt.Errorf("archived binlogs end at %v, before the recovery target %v", pos, target.Position)
+	}
+	if !reached {
+		logger.Warningf("Restore: archived binlogs end at %v, before the recovery target %v", pos, target)
+	}
+	logger.Infof("Restore: recovered up to %v", pos)
+	return pos, nil
+}
+
+// replayBinlogFile replays the transactions of a restored binlog file
+// that are not part of pos, up to the target, and removes the file.
+// desc names the file in the logs and errors. It returns the position
+// it reached, and whether the target was reached in the file.
+func replayBinlogFile(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, flavor MysqlFlavor, file, desc string, pos replication.Position, target RecoveryTarget) (replication.Position, bool, error) {
+	ranges, reached, newPos, err := findReplayRanges(flavor, file, pos, target)
+	if err != nil {
+		return pos, false, fmt.Errorf("can't read %v: %v", desc, err)
+	}
+	if len(ranges) != 0 {
+		logger.Infof("Restore: replaying %v", desc)
+	}
+	for _, r := range ranges {
+		if err := mysqld.ApplyBinlogFile(ctx, file, r.start, r.stop); err != nil {
+			return pos, false, fmt.Errorf("can't replay %v: %v", desc, err)
+		}
+	}
+	pos = newPos
+	if err := os.Remove(file); err != nil {
+		return pos, false, err
+	}
+	return pos, reached, nil
+}
+
+// replayRange is a range of a binlog file to replay, from the event at
+// offset start up to the event at offset stop, which is not replayed.
+// A stop of 0 means the end of the file.
+type replayRange struct {
+	start, stop int64
+}
+
+// findReplayRanges returns the ranges of consecutive transactions of the
+// binlog file that are not part of pos, up to the first one past the
+// target. Every transaction is checked, as archives can overlap, and
+// the transactions of several servers can be interleaved. It also
+// returns whether the target was reached in the file, and pos with the
+// replayed transactions added.
+func findReplayRanges(flavor MysqlFlavor, file string, pos replication.Position, target RecoveryTarget) (ranges []replayRange, reached bool, newPos replication.Position, err error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, false, pos, err
+	}
+	defer f.Close()
+
+	newPos = pos
+	// current is the range being built, if its start is not 0.
+	var current replayRange
+	err = scanBinlogFile(flavor, f, func(offset int64, ev replication.BinlogEvent, format replication.BinlogFormat) error {
+		if reached || !ev.HasGTID(format) {
+			return nil
+		}
+		gtid, err := ev.GTID(format)
+		if err != nil {
+			return err
+		}
+		if !pos.GTIDSet.ContainsGTID(gtid) {
+			if target.reachedBy(gtid, ev.Timestamp()) {
+				reached = true
+			} else {
+				if current.start == 0 {
+					current.start = offset
+				}
+				newPos = replication.AppendGTID(newPos, gtid)
+				return nil
+			}
+		}
+		// This transaction is not replayed, which ends the current range.
+		if current.start != 0 {
+			current.stop = offset
+			ranges = append(ranges, current)
+			current = replayRange{}
+		}
+		return nil
+	})
+	if current.start != 0 {
+		ranges = append(ranges, current)
+	}
+	return ranges, reached, newPos, err
+}
+
+// positionFlavor returns the MysqlFlavor a position comes from.
+func positionFlavor(pos replication.Position) (MysqlFlavor, error) {
+	if pos.IsZero() {
+		return nil, fmt.Errorf("can't tell the MySQL flavor of an empty replication position")
+	}
+	flavor, ok := mysqlFlavors[pos.GTIDSet.Flavor()]
+	if !ok {
+		return nil, fmt.Errorf("unknown MySQL flavor %v", pos.GTIDSet.Flavor())
+	}
+	return flavor, nil
+}
+
+// scanBinlogFile reads the events of a binlog file, and calls visit
+// with the offset of each of them in the file. Events that follow
+// the format description are stripped of their checksum.
+func scanBinlogFile(flavor MysqlFlavor, r io.Reader, visit func(offset int64, ev replication.BinlogEvent, f replication.BinlogFormat) error) error {
+	br := bufio.NewReader(r)
+	magic := make([]byte, len(binlogFileMagic))
+	if _, err := io.ReadFull(br, magic); err != nil {
+		return fmt.Errorf("can't read binlog file header: %v", err)
+	}
+	if !bytes.Equal(magic, binlogFileMagic) {
+		return fmt.Errorf("not a binlog file")
+	}
+
+	offset := int64(len(magic))
+	var format replication.BinlogFormat
+	header := make([]byte, binlogEventHeaderLen)
+	for {
+		if _, err := io.ReadFull(br, header); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return fmt.Errorf("can't read event header at offset %v: %v", offset, err)
+		}
+		length := binary.LittleEndian.Uint32(header[9:13])
+		if length < binlogEventHeaderLen {
+			return fmt.Errorf("invalid event length %v at offset %v", length, offset)
+		}
+		buf := make([]byte, length)
+		copy(buf, header)
+		if _, err := io.ReadFull(br, buf[binlogEventHeaderLen:]); err != nil {
+			return fmt.Errorf("can't read event at offset %v: %v", offset, err)
+		}
+
+		ev := flavor.MakeBinlogEvent(buf)
+		if !ev.IsValid() {
+			return fmt.Errorf("invalid event at offset %v", offset)
+		}
+		var err error
+		if ev.IsFormatDescription() {
+			format, err = ev.Format()
+		} else if !format.IsZero() {
+			ev, _, err = ev.StripChecksum(format)
+		}
+		if err != nil {
+			return fmt.Errorf("can't parse event at offset %v: %v", offset, err)
+		}
+		if !format.IsZero() {
+			if err := visit(offset, ev, format); err != nil {
+				return err
+			}
+		}
+		offset += int64(length)
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/youtube/vitess/go/vt/mysqlctl/replication"
+)
+
+// mysql56GTIDEventTime is the timestamp of mysql56GTIDEvent.
+var mysql56GTIDEventTime = time.Unix(0x55494eff, 0)
+
+// writeTestBinlogFile writes a binlog file with a format description,
+// then the GTID event of transaction 4 followed by its query.
+func writeTestBinlogFile(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "binlog_archive_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	var buf bytes.Buffer
+	buf.Write(binlogFileMagic)
+	for _, ev := range []replication.BinlogEvent{mysql56FormatEvent, mysql56GTIDEvent, mysql56QueryEvent} {
+		buf.Write(ev.(mysql56BinlogEvent).binlogEvent)
+	}
+	file := path.Join(dir, "vt-0000000100-bin.000001")
+	if err := ioutil.WriteFile(file, buf.Bytes(), 0644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	return file, func() { os.RemoveAll(dir) }
+}
+
+func mustDecodePosition(t *testing.T, s string) replication.Position {
+	pos, err := replication.DecodePosition(s)
+	if err != nil {
+		t.Fatalf("DecodePosition(%v) failed: %v", s, err)
+	}
+	return pos
+}
+
+func TestScanBinlogFile(t *testing.T) {
+	file, cleanup := writeTestBinlogFile(t)
+	defer cleanup()
+
+	f, err := os.Open(file)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer f.Close()
+	var offsets []int64
+	var gtids []replication.GTID
+	if err := scanBinlogFile(&mysql56{}, f, func(offset int64, ev replication.BinlogEvent, format replication.BinlogFormat) error {
+		offsets = append(offsets, offset)
+		if ev.IsGTID() {
+			gtid, err := ev.GTID(format)
+			if err != nil {
+				return err
+			}
+			gtids = append(gtids, gtid)
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("scanBinlogFile failed: %v", err)
+	}
+	if want := []int64{4, 120, 168}; !reflect.DeepEqual(offsets, want) {
+		t.Errorf("scanBinlogFile offsets = %v, want %v", offsets, want)
+	}
+	if len(gtids) != 1 || gtids[0].String() != "439192bd-f37c-11e4-bbeb-0242ac11035a:4" {
+		t.Errorf("scanBinlogFile GTIDs = %v, want [439192bd-f37c-11e4-bbeb-0242ac11035a:4]", gtids)
+	}
+
+	if err := scanBinlogFile(&mysql56{}, bytes.NewReader([]byte("not a binlog file")), nil); err == nil {
+		t.Errorf("scanBinlogFile on a non-binlog file should have failed")
+	}
+}
+
+func TestFindReplayRanges(t *testing.T) {
+	file, cleanup := writeTestBinlogFile(t)
+	defer cleanup()
+
+	table := []struct {
+		desc        string
+		pos         string
+		target      RecoveryTarget
+		wantRanges  []replayRange
+		wantReached bool
+		wantPos     string
+	}{{
+		desc:       "position target after the transaction",
+		pos:        "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-3",
+		target:     RecoveryTarget{Position: mustDecodePosition(t, "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-5")},
+		wantRanges: []replayRange{{start: 120}},
+		wantPos:    "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-4",
+	}, {
+		desc:        "position target before the transaction",
+		pos:         "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-3",
+		target:      RecoveryTarget{Position: mustDecodePosition(t, "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-3")},
+		wantReached: true,
+		wantPos:     "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-3",
+	}, {
+		desc:       "time target at the transaction",
+		pos:        "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-3",
+		target:     RecoveryTarget{Time: mysql56GTIDEventTime},
+		wantRanges: []replayRange{{start: 120}},
+		wantPos:    "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-4",
+	}, {
+		desc:        "time target before the transaction",
+		pos:         "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-3",
+		target:      RecoveryTarget{Time: mysql56GTIDEventTime.Add(-time.Second)},
+		wantReached: true,
+		wantPos:     "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-3",
+	}, {
+		desc:    "transaction already applied",
+		pos:     "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-4",
+		target:  RecoveryTarget{Time: mysql56GTIDEventTime},
+		wantPos: "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-4",
+	}}
+	for _, tcase := range table {
+		ranges, reached, newPos, err := findReplayRanges(&mysql56{}, file, mustDecodePosition(t, tcase.pos), tcase.target)
+		if err != nil {
+			t.Errorf("%v: findReplayRanges failed: %v", tcase.desc, err)
+			continue
+		}
+		if !reflect.DeepEqual(ranges, tcase.wantRanges) || reached != tcase.wantReached {
+			t.Errorf("%v: findReplayRanges = (%v, %v), want (%v, %v)", tcase.desc, ranges, reached, tcase.wantRanges, tcase.wantReached)
+		}
+		if got := replication.EncodePosition(newPos); got != tcase.wantPos {
+			t.Errorf("%v: findReplayRanges position = %v, want %v", tcase.desc, got, tcase.wantPos)
+		}
+	}
+}
+
+// mysql56GTIDEventWithGNO returns a copy of mysql56GTIDEvent for
+// another transaction of the same server.
+func mysql56GTIDEventWithGNO(gno byte) []byte {
+	ev := append([]byte(nil), mysql56GTIDEvent.(mysql56BinlogEvent).binlogEvent...)
+	// The GNO follows the header, the flags and the SID.
+	ev[19+1+16] = gno
+	return ev
+}
+
+func TestFindReplayRangesSkipsAppliedTransactions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "binlog_archive_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	// The file has transactions 4, 5 and 6, each a GTID event followed
+	// by a query, at offsets 120, 287 and 454.
+	query := mysql56QueryEvent.(mysql56BinlogEvent).binlogEvent
+	var buf bytes.Buffer
+	buf.Write(binlogFileMagic)
+	buf.Write(mysql56FormatEvent.(mysql56BinlogEvent).binlogEvent)
+	for _, gno := range []byte{4, 5, 6} {
+		buf.Write(mysql56GTIDEventWithGNO(gno))
+		buf.Write(query)
+	}
+	file := path.Join(dir, "vt-0000000100-bin.000001")
+	if err := ioutil.WriteFile(file, buf.Bytes(), 0644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+
+	// Transaction 5 was already applied, from an overlapping archive.
+	ranges, reached, newPos, err := findReplayRanges(&mysql56{}, file, mustDecodePosition(t, "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-3:5"), RecoveryTarget{Position: mustDecodePosition(t, "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-10")})
+	if err != nil {
+		t.Fatalf("findReplayRanges failed: %v", err)
+	}
+	if want := []replayRange{{start: 120, stop: 287}, {start: 454}}; !reflect.DeepEqual(ranges, want) || reached {
+		t.Errorf("findReplayRanges = (%v, %v), want (%v, false)", ranges, reached, want)
+	}
+	if got, want := replication.EncodePosition(newPos), "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-6"; got != want {
+		t.Errorf("findReplayRanges position = %v, want %v", got, want)
+	}
+
+	// The target stops the replay at transaction 6.
+	ranges, reached, _, err = findReplayRanges(&mysql56{}, file, mustDecodePosition(t, "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-3:5"), RecoveryTarget{Position: mustDecodePosition(t, "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-5")})
+	if err != nil {
+		t.Fatalf("findReplayRanges failed: %v", err)
+	}
+	if want := []replayRange{{start: 120, stop: 287}}; !reflect.DeepEqual(ranges, want) || !reached {
+		t.Errorf("findReplayRanges = (%v, %v), want (%v, true)", ranges, reached, want)
+	}
+}
+
+func TestRecoveryTargetAllowsBackup(t *testing.T) {
+	backupTime := time.Unix(1456789012, 0)
+	bm := &BackupManifest{
+		Position: mustDecodePosition(t, "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-10"),
+		Time:     backupTime,
+	}
+	table := []struct {
+		desc   string
+		target RecoveryTarget
+		bm     *BackupManifest
+		want   bool
+	}{{
+		desc:   "time after the backup",
+		target: RecoveryTarget{Time: backupTime.Add(time.Minute)},
+		bm:     bm,
+		want:   true,
+	}, {
+		desc:   "time before the backup",
+		target: RecoveryTarget{Time: backupTime.Add(-time.Minute)},
+		bm:     bm,
+		want:   false,
+	}, {
+		desc:   "time with a backup of unknown time",
+		target: RecoveryTarget{Time: backupTime.Add(time.Minute)},
+		bm:     &BackupManifest{Position: bm.Position},
+		want:   false,
+	}, {
+		desc:   "position after the backup",
+		target: RecoveryTarget{Position: mustDecodePosition(t, "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-12")},
+		bm:     bm,
+		want:   true,
+	}, {
+		desc:   "position of the backup",
+		target: RecoveryTarget{Position: bm.Position},
+		bm:     bm,
+		want:   true,
+	}, {
+		desc:   "position before the backup",
+		target: RecoveryTarget{Position: mustDecodePosition(t, "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-8")},
+		bm:     bm,
+		want:   false,
+	}}
+	for _, tcase := range table {
+		if got := tcase.target.allowsBackup(tcase.bm); got != tcase.want {
+			t.Errorf("%v: allowsBackup = %v, want %v", tcase.desc, got, tcase.want)
+		}
+	}
+}
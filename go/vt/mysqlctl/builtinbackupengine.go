@@ -0,0 +1,246 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/sync2"
+	"github.com/youtube/vitess/go/vt/concurrency"
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/mysqlctl/backupstorage"
+	"github.com/youtube/vitess/go/vt/mysqlctl/replication"
+)
+
+// BuiltinBackupEngine is the BackupEngine that shuts mysqld down,
+// and copies its files one by one into the BackupStorage.
+type BuiltinBackupEngine struct{}
+
+// ExecuteBackup is part of the BackupEngine interface. It shuts
+// mysqld down for the duration of the copy, and restores its
+// replication and read-only state afterwards.
+func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh backupstorage.BackupHandle, backupConcurrency int, hookExtraEnv map[string]string) (bool, error) {
+	// Save initial state so we can restore.
+	slaveStartRequired := false
+	sourceIsMaster := false
+	readOnly := true
+	var replicationPosition replication.Position
+	var backupTime time.Time
+	semiSyncMaster, semiSyncSlave := mysqld.SemiSyncEnabled()
+
+	// See if we need to restart replication after backup.
+	logger.Infof("getting current replication status")
+	slaveStatus, err := mysqld.SlaveStatus()
+	switch err {
+	case nil:
+		slaveStartRequired = slaveStatus.SlaveRunning()
+	case ErrNotSlave:
+		// keep going if we're the master, might be a degenerate case
+		sourceIsMaster = true
+	default:
+		return false, fmt.Errorf("can't get slave status: %v", err)
+	}
+
+	// get the read-only flag
+	readOnly, err = mysqld.IsReadOnly()
+	if err != nil {
+		return false, fmt.Errorf("can't get read-only status: %v", err)
+	}
+
+	// get the replication position
+	if sourceIsMaster {
+		if !readOnly {
+			logger.Infof("turning master read-only before backup")
+			if err = mysqld.SetReadOnly(true); err != nil {
+				return false, fmt.Errorf("can't set read-only status: %v", err)
+			}
+		}
+		replicationPosition, err = mysqld.MasterPosition()
+		if err != nil {
+			return false, fmt.Errorf("can't get master position: %v", err)
+		}
+	} else {
+		if err = StopSlave(mysqld, hookExtraEnv); err != nil {
+			return false, fmt.Errorf("can't stop slave: %v", err)
+		}
+		var slaveStatus replication.Status
+		slaveStatus, err = mysqld.SlaveStatus()
+		if err != nil {
+			return false, fmt.Errorf("can't get slave status: %v", err)
+		}
+		replicationPosition = slaveStatus.Position
+	}
+	backupTime = time.Now()
+	logger.Infof("using replication position: %v", replicationPosition)
+
+	// shutdown mysqld
+	err = mysqld.Shutdown(ctx, true)
+	if err != nil {
+		return false, fmt.Errorf("can't shutdown mysqld: %v", err)
+	}
+
+	// Backup everything, capture the error.
+	backupErr := be.backupFiles(ctx, mysqld, logger, bh, replicationPosition, backupTime, backupConcurrency, hookExtraEnv)
+	usable := backupErr == nil
+
+	// Try to restart mysqld
+	err = mysqld.Start(ctx)
+	if err != nil {
+		return usable, fmt.Errorf("can't restart mysqld: %v", err)
+	}
+
+	// Restore original mysqld state that we saved above.
+	if semiSyncMaster || semiSyncSlave {
+		// Only do this if one of them was on, since both being off could mean
+		// the plugin isn't even loaded, and the server variables don't exist.
+		logger.Infof("restoring semi-sync settings from before backup: master=%v, slave=%v",
+			semiSyncMaster, semiSyncSlave)
+		err := mysqld.SetSemiSyncEnabled(semiSyncMaster, semiSyncSlave)
+		if err != nil {
+			return usable, err
+		}
+	}
+	if slaveStartRequired {
+		logger.Infof("restarting mysql replication")
+		if err := StartSlave(mysqld, hookExtraEnv); err != nil {
+			return usable, fmt.Errorf("cannot restart slave: %v", err)
+		}
+
+		// this should be quick, but we might as well just wait
+		if err := WaitForSlaveStart(mysqld, slaveStartDeadline); err != nil {
+			return usable, fmt.Errorf("slave is not restarting: %v", err)
+		}
+	}
+
+	// And set read-only mode
+	logger.Infof("resetting mysqld read-only to %v", readOnly)
+	if err := mysqld.SetReadOnly(readOnly); err != nil {
+		return usable, err
+	}
+
+	return usable, backupErr
+}
+
+// backupFiles finds the list of files to backup, and creates the backup.
+func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh backupstorage.BackupHandle, replicationPosition replication.Position, backupTime time.Time, backupConcurrency int, hookExtraEnv map[string]string) error {
+	// Get the files to backup.
+	fes, err := findFilesToBackup(mysqld.Cnf())
+	if err != nil {
+		return fmt.Errorf("can't find files to backup: %v", err)
+	}
+	logger.Infof("found %v files to backup", len(fes))
+	var totalBytes int64
+	for i := range fes {
+		name, err := fes[i].fullPath(mysqld.Cnf())
+		if err != nil {
+			return err
+		}
+		fi, err := os.Stat(name)
+		if err != nil {
+			return fmt.Errorf("can't stat file %v: %v", name, err)
+		}
+		totalBytes += fi.Size()
+	}
+	progress := startProgress(logger, "backup", totalBytes, len(fes), *backupMaxBytesPerSecond)
+	defer progress.stop()
+
+	// Backup with the provided concurrency.
+	sema := sync2.NewSemaphore(backupConcurrency, 0)
+	rec := concurrency.AllErrorRecorder{}
+	wg := sync.WaitGroup{}
+	for i := range fes {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			// Wait until we are ready to go, skip if we already
+			// encountered an error.
+			sema.Acquire()
+			defer sema.Release()
+			if rec.HasErrors() {
+				return
+			}
+
+			// Backup the individual file.
+			name := fmt.Sprintf("%v", i)
+			if err := backupFile(ctx, mysqld, logger, bh, &fes[i], name, hookExtraEnv, progress); err != nil {
+				rec.RecordError(err)
+				return
+			}
+			progress.fileDone()
+		}(i)
+	}
+
+	wg.Wait()
+	if rec.HasErrors() {
+		return rec.Error()
+	}
+
+	// JSON-encode and write the MANIFEST
+	return writeManifest(ctx, bh, &BackupManifest{
+		FileEntries:   fes,
+		Position:      replicationPosition,
+		Time:          backupTime,
+		TransformHook: *backupStorageHook,
+		SkipCompress:  !*backupStorageCompress,
+		BackupMethod:  builtinBackupEngineName,
+	})
+}
+
+// ExecuteRestore is part of the BackupEngine interface. It copies
+// all the files from the BackupStorage to the right place.
+func (be *BuiltinBackupEngine) ExecuteRestore(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh backupstorage.BackupHandle, bm *BackupManifest, restoreConcurrency int, hookExtraEnv map[string]string) error {
+	cnf := mysqld.Cnf()
+	fes := bm.FileEntries
+	var totalBytes int64
+	for i := range fes {
+		totalBytes += fes[i].Size
+	}
+	progress := startProgress(logger, "restore", totalBytes, len(fes), *restoreMaxBytesPerSecond)
+	defer progress.stop()
+
+	sema := sync2.NewSemaphore(restoreConcurrency, 0)
+	rec := concurrency.AllErrorRecorder{}
+	wg := sync.WaitGroup{}
+	for i := range fes {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			// Wait until we are ready to go, skip if we already
+			// encountered an error.
+			sema.Acquire()
+			defer sema.Release()
+			if rec.HasErrors() {
+				return
+			}
+
+			// And restore the file.
+			name := fmt.Sprintf("%v", i)
+			if err := restoreFile(ctx, cnf, bh, &fes[i], bm.TransformHook, !bm.SkipCompress, name, hookExtraEnv, progress); err != nil {
+				rec.RecordError(err)
+				return
+			}
+			progress.fileDone()
+		}(i)
+	}
+	wg.Wait()
+	return rec.Error()
+}
+
+// ShouldDrainForBackup is part of the BackupEngine interface.
+// mysqld is shut down during the backup, so the tablet can't serve.
+func (be *BuiltinBackupEngine) ShouldDrainForBackup() bool {
+	return true
+}
+
+func init() {
+	BackupEngineMap[builtinBackupEngineName] = &BuiltinBackupEngine{}
+}
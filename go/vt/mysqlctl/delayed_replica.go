@@ -0,0 +1,157 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/mysqlctl/replication"
+)
+
+// This file handles delayed replicas.
+//
+// A delayed replica applies the transactions of the master
+// -master_delay after they were committed, using MASTER_DELAY.
+// Its relay logs have the transactions it didn't apply yet, so
+// after a mistake on the master, it can be stopped right before
+// the mistake, and used to recover the lost data.
+
+// MasterDelay returns the delay of this tablet if it is a delayed
+// replica, or 0.
+func MasterDelay() time.Duration {
+	return *masterDelay
+}
+
+// StopSlaveBeforeTime stops replication, and starts it again so it
+// stops by itself right before the first transaction committed at or
+// after stopTime. It returns the position replication will stop at.
+// The transactions up to that position must be in the relay logs,
+// and none of the transactions past it must have been applied yet.
+func StopSlaveBeforeTime(ctx context.Context, mysqld MysqlDaemon, hookExtraEnv map[string]string, stopTime time.Time) (replication.Position, error) {
+	if err := StopSlave(mysqld, hookExtraEnv); err != nil {
+		return replication.Position{}, fmt.Errorf("can't stop replication: %v", err)
+	}
+	pos, cmds, err := startSlaveBeforeTimeCommands(mysqld, stopTime)
+	if err == nil {
+		err = mysqld.ExecuteSuperQueryList(ctx, cmds)
+	}
+	if err != nil {
+		// Leave replication as we found it.
+		if startErr := StartSlave(mysqld, hookExtraEnv); startErr != nil {
+			return replication.Position{}, fmt.Errorf("%v (and can't restart replication: %v)", err, startErr)
+		}
+		return replication.Position{}, err
+	}
+	return pos, nil
+}
+
+// startSlaveBeforeTimeCommands returns the position of the last
+// transaction committed before stopTime, from the position of the
+// stopped slave and its relay logs, and the commands to start
+// replication so it stops by itself there.
+func startSlaveBeforeTimeCommands(mysqld MysqlDaemon, stopTime time.Time) (replication.Position, []string, error) {
+	status, err := mysqld.SlaveStatus()
+	if err != nil {
+		return replication.Position{}, nil, fmt.Errorf("can't get slave status: %v", err)
+	}
+	// The flavor of the position is enough to read the relay
+	// logs, but the commands depend on the version of the server.
+	flavor, err := positionFlavor(status.Position)
+	if err != nil {
+		return replication.Position{}, nil, err
+	}
+	files, err := relayLogFiles(mysqld.Cnf())
+	if err != nil {
+		return replication.Position{}, nil, err
+	}
+	pos, err := relayLogPositionBeforeTime(flavor, files, status.Position, stopTime)
+	if err != nil {
+		return replication.Position{}, nil, err
+	}
+	cmds, err := mysqld.StartSlaveUntilAfterCommands(pos)
+	if err != nil {
+		return replication.Position{}, nil, err
+	}
+	return pos, cmds, nil
+}
+
+// relayLogFiles returns the relay log files listed in the relay log
+// index, in order.
+func relayLogFiles(cnf *Mycnf) ([]string, error) {
+	f, err := os.Open(cnf.RelayLogIndexPath)
+	if err != nil {
+		return nil, fmt.Errorf("can't read relay log index: %v", err)
+	}
+	defer f.Close()
+
+	var files []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		file := strings.TrimSpace(scanner.Text())
+		if file == "" {
+			continue
+		}
+		if !path.IsAbs(file) {
+			file = path.Join(cnf.DataDir, file)
+		}
+		files = append(files, file)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("can't read relay log index: %v", err)
+	}
+	return files, nil
+}
+
+// relayLogPositionBeforeTime returns pos, with the transactions of
+// the relay log files committed before stopTime added. It is an
+// error if a transaction of pos was committed at or after stopTime,
+// or if the relay logs don't reach stopTime yet.
+func relayLogPositionBeforeTime(flavor MysqlFlavor, files []string, pos replication.Position, stopTime time.Time) (replication.Position, error) {
+	newPos := pos
+	reached := false
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			return pos, fmt.Errorf("can't open relay log: %v", err)
+		}
+		err = scanBinlogFile(flavor, f, func(offset int64, ev replication.BinlogEvent, format replication.BinlogFormat) error {
+			if reached || !ev.HasGTID(format) {
+				return nil
+			}
+			gtid, err := ev.GTID(format)
+			if err != nil {
+				return err
+			}
+			committedBefore := int64(ev.Timestamp()) < stopTime.Unix()
+			if pos.GTIDSet.ContainsGTID(gtid) {
+				if !committedBefore {
+					return fmt.Errorf("transaction %v committed at %v was already applied", gtid, time.Unix(int64(ev.Timestamp()), 0))
+				}
+				return nil
+			}
+			if !committedBefore {
+				reached = true
+				return nil
+			}
+			newPos = replication.AppendGTID(newPos, gtid)
+			return nil
+		})
+		f.Close()
+		if err != nil {
+			return pos, fmt.Errorf("can't read relay log %v: %v", file, err)
+		}
+		if reached {
+			return newPos, nil
+		}
+	}
+	return pos, fmt.Errorf("the relay logs have no transaction committed at or after %v yet", stopTime)
+}
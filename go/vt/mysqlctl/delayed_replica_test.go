@@ -0,0 +1,140 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"io/ioutil"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/mysqlctl/replication"
+)
+
+func TestRelayLogFiles(t *testing.T) {
+	file, cleanup := writeTestBinlogFile(t)
+	defer cleanup()
+	dir := path.Dir(file)
+	cnf := &Mycnf{
+		DataDir:           dir,
+		RelayLogIndexPath: path.Join(dir, "relay-bin.index"),
+	}
+	if err := ioutil.WriteFile(cnf.RelayLogIndexPath, []byte("./relay-bin.000001\n/other/relay-bin.000002\n\n"), 0644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	files, err := relayLogFiles(cnf)
+	if err != nil {
+		t.Fatalf("relayLogFiles failed: %v", err)
+	}
+	if want := []string{path.Join(dir, "relay-bin.000001"), "/other/relay-bin.000002"}; !reflect.DeepEqual(files, want) {
+		t.Errorf("relayLogFiles = %v, want %v", files, want)
+	}
+}
+
+func TestRelayLogPositionBeforeTime(t *testing.T) {
+	// The relay log has transaction 4, at mysql56GTIDEventTime.
+	file, cleanup := writeTestBinlogFile(t)
+	defer cleanup()
+
+	table := []struct {
+		desc     string
+		pos      string
+		stopTime time.Time
+		wantPos  string
+		wantErr  string
+	}{{
+		desc:     "stop at the transaction",
+		pos:      "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-3",
+		stopTime: mysql56GTIDEventTime,
+		wantPos:  "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-3",
+	}, {
+		desc:     "stop after the transaction",
+		pos:      "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-3",
+		stopTime: mysql56GTIDEventTime.Add(time.Second),
+		wantErr:  "no transaction committed at or after",
+	}, {
+		desc:     "transaction already applied",
+		pos:      "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-4",
+		stopTime: mysql56GTIDEventTime,
+		wantErr:  "was already applied",
+	}}
+	for _, tcase := range table {
+		pos, err := relayLogPositionBeforeTime(&mysql56{}, []string{file}, mustDecodePosition(t, tcase.pos), tcase.stopTime)
+		if tcase.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tcase.wantErr) {
+				t.Errorf("%v: relayLogPositionBeforeTime returned %v, want error containing %q", tcase.desc, err, tcase.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v: relayLogPositionBeforeTime failed: %v", tcase.desc, err)
+			continue
+		}
+		if got := replication.EncodePosition(pos); got != tcase.wantPos {
+			t.Errorf("%v: relayLogPositionBeforeTime = %v, want %v", tcase.desc, got, tcase.wantPos)
+		}
+	}
+
+	// When the stop time is not reached in the first relay log,
+	// the next ones are read too.
+	pos, err := relayLogPositionBeforeTime(&mysql56{}, []string{file, path.Join(path.Dir(file), "missing")}, mustDecodePosition(t, "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-3"), mysql56GTIDEventTime.Add(time.Second))
+	if err == nil || !strings.Contains(err.Error(), "can't open relay log") {
+		t.Errorf("relayLogPositionBeforeTime with a missing relay log returned %v, %v", pos, err)
+	}
+}
+
+func TestStopSlaveBeforeTime(t *testing.T) {
+	file, cleanup := writeTestBinlogFile(t)
+	defer cleanup()
+	dir := path.Dir(file)
+	mysqld := NewFakeMysqlDaemon(nil)
+	mysqld.Replicating = true
+	mysqld.Mycnf = &Mycnf{
+		DataDir:           dir,
+		RelayLogIndexPath: path.Join(dir, "relay-bin.index"),
+	}
+	if err := ioutil.WriteFile(mysqld.Mycnf.RelayLogIndexPath, []byte(file+"\n"), 0644); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	mysqld.CurrentMasterPosition = mustDecodePosition(t, "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-3")
+	mysqld.StartSlaveUntilAfterCommandsResult = []string{"START REPLICA SQL_THREAD UNTIL SQL_AFTER_GTIDS = '%v'"}
+	ctx := context.Background()
+
+	mysqld.ExpectedExecuteSuperQueryList = []string{
+		SQLStopSlave,
+		"START REPLICA SQL_THREAD UNTIL SQL_AFTER_GTIDS = '439192bd-f37c-11e4-bbeb-0242ac11035a:1-3'",
+	}
+	pos, err := StopSlaveBeforeTime(ctx, mysqld, nil, mysql56GTIDEventTime)
+	if err != nil {
+		t.Fatalf("StopSlaveBeforeTime failed: %v", err)
+	}
+	if got, want := replication.EncodePosition(pos), "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-3"; got != want {
+		t.Errorf("StopSlaveBeforeTime = %v, want %v", got, want)
+	}
+	if err := mysqld.CheckSuperQueryList(); err != nil {
+		t.Errorf("StopSlaveBeforeTime queries: %v", err)
+	}
+
+	// When the stop position can't be found, replication is
+	// started again.
+	mysqld.ExpectedExecuteSuperQueryList = []string{
+		SQLStopSlave,
+		SQLStartSlave,
+	}
+	mysqld.ExpectedExecuteSuperQueryCurrent = 0
+	if _, err := StopSlaveBeforeTime(ctx, mysqld, nil, mysql56GTIDEventTime.Add(time.Second)); err == nil {
+		t.Errorf("StopSlaveBeforeTime past the relay logs should have failed")
+	}
+	if err := mysqld.CheckSuperQueryList(); err != nil {
+		t.Errorf("StopSlaveBeforeTime queries: %v", err)
+	}
+	if !mysqld.Replicating {
+		t.Errorf("StopSlaveBeforeTime didn't restart replication after failing")
+	}
+}
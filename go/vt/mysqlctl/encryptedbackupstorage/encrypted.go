@@ -0,0 +1,230 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package encryptedbackupstorage implements the BackupStorage interface
+// on top of another BackupStorage implementation, encrypting the
+// backup files before they are stored, and decrypting them when they
+// are read.
+//
+// The files are encrypted with AES-GCM, in chunks, so they can be
+// streamed. The keys are read from a local key file. Each encrypted
+// file records the ID of its key, so new backups can be encrypted
+// with a new key while the older ones can still be restored.
+package encryptedbackupstorage
+
+import (
+	"bufio"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/mysqlctl/backupstorage"
+)
+
+var (
+	// EncryptedBackupStorageImplementation is the BackupStorage
+	// implementation the encrypted files are stored in.
+	// Exported for test purposes.
+	EncryptedBackupStorageImplementation = flag.String("encrypted_backup_storage_implementation", "", "for the encrypted backup storage, the implementation to store the encrypted backups with")
+
+	// EncryptedBackupStorageKeyFile is the file the keys are read from.
+	// Exported for test purposes.
+	EncryptedBackupStorageKeyFile = flag.String("encrypted_backup_storage_key_file", "", "for the encrypted backup storage, the file with the encryption keys: one '<key id> <hex-encoded AES key>' per line, the last one being used for new backups")
+)
+
+// keySet is the content of the key file.
+type keySet struct {
+	// keys maps the key IDs to the AES keys.
+	keys map[string][]byte
+
+	// current is the ID of the key new files are encrypted with.
+	current string
+}
+
+// readKeyFile reads the key file. Each line has a key ID and a
+// hex-encoded 16, 24 or 32 byte AES key. Empty lines and lines
+// starting with '#' are ignored. The last key is the current one.
+func readKeyFile(name string) (*keySet, error) {
+	if name == "" {
+		return nil, fmt.Errorf("no key file specified for the encrypted backup storage, use -encrypted_backup_storage_key_file")
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open key file: %v", err)
+	}
+	defer f.Close()
+
+	ks := &keySet{keys: make(map[string][]byte)}
+	scanner := bufio.NewScanner(f)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("key file %v, line %v: expected '<key id> <key>'", name, lineNumber)
+		}
+		id := fields[0]
+		if len(id) > maxKeyIDLen {
+			return nil, fmt.Errorf("key file %v, line %v: key id is longer than %v bytes", name, lineNumber, maxKeyIDLen)
+		}
+		if _, ok := ks.keys[id]; ok {
+			return nil, fmt.Errorf("key file %v, line %v: duplicate key id %v", name, lineNumber, id)
+		}
+		key, err := hex.DecodeString(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("key file %v, line %v: cannot decode key: %v", name, lineNumber, err)
+		}
+		switch len(key) {
+		case 16, 24, 32:
+		default:
+			return nil, fmt.Errorf("key file %v, line %v: key is %v bytes, expected 16, 24 or 32", name, lineNumber, len(key))
+		}
+		ks.keys[id] = key
+		ks.current = id
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("cannot read key file: %v", err)
+	}
+	if ks.current == "" {
+		return nil, fmt.Errorf("no key in key file %v", name)
+	}
+	return ks, nil
+}
+
+// EncryptedBackupHandle implements BackupHandle by encrypting the
+// files of the underlying BackupHandle.
+type EncryptedBackupHandle struct {
+	bh   backupstorage.BackupHandle
+	keys *keySet
+}
+
+// Directory is part of the BackupHandle interface
+func (ebh *EncryptedBackupHandle) Directory() string {
+	return ebh.bh.Directory()
+}
+
+// Name is part of the BackupHandle interface
+func (ebh *EncryptedBackupHandle) Name() string {
+	return ebh.bh.Name()
+}
+
+// AddFile is part of the BackupHandle interface
+func (ebh *EncryptedBackupHandle) AddFile(ctx context.Context, filename string) (io.WriteCloser, error) {
+	wc, err := ebh.bh.AddFile(ctx, filename)
+	if err != nil {
+		return nil, err
+	}
+	ew, err := newEncryptingWriter(wc, ebh.keys.current, ebh.keys.keys[ebh.keys.current])
+	if err != nil {
+		wc.Close()
+		return nil, err
+	}
+	return ew, nil
+}
+
+// EndBackup is part of the BackupHandle interface
+func (ebh *EncryptedBackupHandle) EndBackup(ctx context.Context) error {
+	return ebh.bh.EndBackup(ctx)
+}
+
+// AbortBackup is part of the BackupHandle interface
+func (ebh *EncryptedBackupHandle) AbortBackup(ctx context.Context) error {
+	return ebh.bh.AbortBackup(ctx)
+}
+
+// ReadFile is part of the BackupHandle interface
+func (ebh *EncryptedBackupHandle) ReadFile(ctx context.Context, filename string) (io.ReadCloser, error) {
+	rc, err := ebh.bh.ReadFile(ctx, filename)
+	if err != nil {
+		return nil, err
+	}
+	dr, err := newDecryptingReader(rc, ebh.keys.keys)
+	if err != nil {
+		rc.Close()
+		return nil, fmt.Errorf("cannot decrypt file %v: %v", filename, err)
+	}
+	return dr, nil
+}
+
+// EncryptedBackupStorage implements BackupStorage on top of the
+// implementation named by -encrypted_backup_storage_implementation.
+// The key file is read for each backup operation, so keys can be
+// added without restarting.
+type EncryptedBackupStorage struct{}
+
+// underlying returns the BackupStorage the encrypted files are stored in.
+func (ebs *EncryptedBackupStorage) underlying() (backupstorage.BackupStorage, error) {
+	bs, ok := backupstorage.BackupStorageMap[*EncryptedBackupStorageImplementation]
+	if !ok || bs == ebs {
+		return nil, fmt.Errorf("no registered implementation of BackupStorage %q to store encrypted backups in", *EncryptedBackupStorageImplementation)
+	}
+	return bs, nil
+}
+
+// ListBackups is part of the BackupStorage interface
+func (ebs *EncryptedBackupStorage) ListBackups(ctx context.Context, dir string) ([]backupstorage.BackupHandle, error) {
+	bs, err := ebs.underlying()
+	if err != nil {
+		return nil, err
+	}
+	keys, err := readKeyFile(*EncryptedBackupStorageKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]backupstorage.BackupHandle, len(bhs))
+	for i, bh := range bhs {
+		result[i] = &EncryptedBackupHandle{bh: bh, keys: keys}
+	}
+	return result, nil
+}
+
+// StartBackup is part of the BackupStorage interface
+func (ebs *EncryptedBackupStorage) StartBackup(ctx context.Context, dir, name string) (backupstorage.BackupHandle, error) {
+	bs, err := ebs.underlying()
+	if err != nil {
+		return nil, err
+	}
+	keys, err := readKeyFile(*EncryptedBackupStorageKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	bh, err := bs.StartBackup(ctx, dir, name)
+	if err != nil {
+		return nil, err
+	}
+	return &EncryptedBackupHandle{bh: bh, keys: keys}, nil
+}
+
+// RemoveBackup is part of the BackupStorage interface
+func (ebs *EncryptedBackupStorage) RemoveBackup(ctx context.Context, dir, name string) error {
+	bs, err := ebs.underlying()
+	if err != nil {
+		return err
+	}
+	return bs.RemoveBackup(ctx, dir, name)
+}
+
+// Close is part of the BackupStorage interface
+func (ebs *EncryptedBackupStorage) Close() error {
+	bs, err := ebs.underlying()
+	if err != nil {
+		return nil
+	}
+	return bs.Close()
+}
+
+func init() {
+	backupstorage.BackupStorageMap["encrypted"] = &EncryptedBackupStorage{}
+}
@@ -0,0 +1,209 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package encryptedbackupstorage
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/mysqlctl/backupstorage"
+	"github.com/youtube/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+const (
+	testKey1 = "000102030405060708090a0b0c0d0e0f000102030405060708090a0b0c0d0e0f"
+	testKey2 = "f0e0d0c0b0a090807060504030201000"
+)
+
+// setupEncryptedBackupStorage returns an EncryptedBackupStorage on
+// top of a FileBackupStorage in a temporary directory, with the
+// given key file contents, and the function to clean it up.
+func setupEncryptedBackupStorage(t *testing.T, keyFile string) (*EncryptedBackupStorage, string, func()) {
+	root, err := ioutil.TempDir("", "ebstest")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "backups")
+	*EncryptedBackupStorageImplementation = "file"
+	*EncryptedBackupStorageKeyFile = path.Join(root, "keys")
+	writeKeyFile(t, keyFile)
+	return backupstorage.BackupStorageMap["encrypted"].(*EncryptedBackupStorage), root, func() { os.RemoveAll(root) }
+}
+
+func writeKeyFile(t *testing.T, contents string) {
+	if err := ioutil.WriteFile(*EncryptedBackupStorageKeyFile, []byte(contents), 0600); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+}
+
+func writeTestBackup(t *testing.T, bs backupstorage.BackupStorage, name string, data []byte) {
+	ctx := context.Background()
+	bh, err := bs.StartBackup(ctx, "ks/0", name)
+	if err != nil {
+		t.Fatalf("StartBackup failed: %v", err)
+	}
+	wc, err := bh.AddFile(ctx, "0")
+	if err != nil {
+		t.Fatalf("AddFile failed: %v", err)
+	}
+	// Write in odd sizes, to cross the chunk boundaries.
+	for len(data) > 0 {
+		n := 1000
+		if n > len(data) {
+			n = len(data)
+		}
+		if _, err := wc.Write(data[:n]); err != nil {
+			t.Fatalf("Write failed: %v", err)
+		}
+		data = data[n:]
+	}
+	if err := wc.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if err := bh.EndBackup(ctx); err != nil {
+		t.Fatalf("EndBackup failed: %v", err)
+	}
+}
+
+func readTestBackup(bs backupstorage.BackupStorage, name string) ([]byte, error) {
+	ctx := context.Background()
+	bhs, err := bs.ListBackups(ctx, "ks/0")
+	if err != nil {
+		return nil, err
+	}
+	for _, bh := range bhs {
+		if bh.Name() != name {
+			continue
+		}
+		rc, err := bh.ReadFile(ctx, "0")
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return ioutil.ReadAll(rc)
+	}
+	return nil, io.ErrUnexpectedEOF
+}
+
+func TestEncryptedBackupStorage(t *testing.T) {
+	ebs, root, cleanup := setupEncryptedBackupStorage(t, "# test keys\nkey1 "+testKey1+"\n")
+	defer cleanup()
+
+	data := bytes.Repeat([]byte("0123456789abcdef"), chunkSize/8+100)
+	for _, size := range []int{0, 1, chunkSize, 2*chunkSize + 1} {
+		name := fmt.Sprintf("size%v", size)
+		writeTestBackup(t, ebs, name, data[:size])
+		got, err := readTestBackup(ebs, name)
+		if err != nil {
+			t.Errorf("reading %v bytes failed: %v", size, err)
+			continue
+		}
+		if !bytes.Equal(got, data[:size]) {
+			t.Errorf("reading %v bytes returned %v bytes of different data", size, len(got))
+		}
+		if err := ebs.RemoveBackup(context.Background(), "ks/0", name); err != nil {
+			t.Fatalf("RemoveBackup failed: %v", err)
+		}
+	}
+
+	// The stored file is not in plaintext.
+	writeTestBackup(t, ebs, "old", data)
+	stored, err := ioutil.ReadFile(path.Join(root, "backups", "ks/0", "old", "0"))
+	if err != nil {
+		t.Fatalf("ReadFile failed: %v", err)
+	}
+	if bytes.Contains(stored, data[:32]) {
+		t.Errorf("stored file contains plaintext")
+	}
+
+	// After a key rotation, both old and new backups can be read.
+	writeKeyFile(t, "key1 "+testKey1+"\nkey2 "+testKey2+"\n")
+	writeTestBackup(t, ebs, "new", data[:100])
+	if got, err := readTestBackup(ebs, "old"); err != nil || !bytes.Equal(got, data) {
+		t.Errorf("reading old backup after rotation failed: %v", err)
+	}
+	if got, err := readTestBackup(ebs, "new"); err != nil || !bytes.Equal(got, data[:100]) {
+		t.Errorf("reading new backup after rotation failed: %v", err)
+	}
+
+	// Without its key, a backup can't be read.
+	writeKeyFile(t, "key2 "+testKey2+"\n")
+	if _, err := readTestBackup(ebs, "old"); err == nil || !strings.Contains(err.Error(), `key "key1"`) {
+		t.Errorf("reading backup without its key returned: %v", err)
+	}
+}
+
+func TestEncryptedBackupStorageCorruption(t *testing.T) {
+	ebs, root, cleanup := setupEncryptedBackupStorage(t, "key1 "+testKey1+"\n")
+	defer cleanup()
+	data := bytes.Repeat([]byte("x"), 2*chunkSize+10)
+	writeTestBackup(t, ebs, "b", data)
+	file := path.Join(root, "backups", "ks/0", "b", "0")
+	stored, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatalf("ReadFile failed: %v", err)
+	}
+	header := len(formatMagic) + 2 + len("key1") + nonceRandomLen
+	sealedChunk := chunkSize + 16
+
+	table := []struct {
+		desc string
+		data []byte
+	}{{
+		desc: "truncated in a chunk",
+		data: stored[:len(stored)-5],
+	}, {
+		desc: "truncated after a chunk",
+		data: stored[:header+sealedChunk],
+	}, {
+		desc: "truncated after the header",
+		data: stored[:header],
+	}, {
+		desc: "modified",
+		data: append(append(append([]byte{}, stored[:header+10]...), stored[header+10]^1), stored[header+11:]...),
+	}}
+	for _, tcase := range table {
+		if err := ioutil.WriteFile(file, tcase.data, 0600); err != nil {
+			t.Fatalf("WriteFile failed: %v", err)
+		}
+		if _, err := readTestBackup(ebs, "b"); err == nil {
+			t.Errorf("%v: reading the backup should have failed", tcase.desc)
+		}
+	}
+}
+
+func TestReadKeyFile(t *testing.T) {
+	_, _, cleanup := setupEncryptedBackupStorage(t, "")
+	defer cleanup()
+	for _, contents := range []string{
+		"",
+		"key1\n",
+		"key1 nothex\n",
+		"key1 0001\n",
+		"key1 " + testKey1 + "\nkey1 " + testKey2 + "\n",
+	} {
+		writeKeyFile(t, contents)
+		if _, err := readKeyFile(*EncryptedBackupStorageKeyFile); err == nil {
+			t.Errorf("readKeyFile(%q) should have failed", contents)
+		}
+	}
+
+	writeKeyFile(t, "key1 "+testKey1+"\n\nkey2 "+testKey2+"\n")
+	ks, err := readKeyFile(*EncryptedBackupStorageKeyFile)
+	if err != nil {
+		t.Fatalf("readKeyFile failed: %v", err)
+	}
+	if ks.current != "key2" || len(ks.keys) != 2 {
+		t.Errorf("readKeyFile returned current %v and %v keys, want key2 and 2 keys", ks.current, len(ks.keys))
+	}
+}
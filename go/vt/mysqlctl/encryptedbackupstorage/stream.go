@@ -0,0 +1,256 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package encryptedbackupstorage
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// This file contains the format of the encrypted files.
+//
+// An encrypted file starts with a header:
+// - the magic bytes 'V', 'T', 'E', 'B'
+// - the format version, 1
+// - the length of the key ID, then the key ID
+// - a random nonce prefix of nonceRandomLen bytes
+// Then the data comes in chunks of chunkSize bytes, the last one
+// being shorter if needed, each sealed with AES-GCM. The nonce of a
+// chunk is the nonce prefix, followed by the index of the chunk as a
+// 4 byte big-endian integer, and a byte set to 1 for the last chunk
+// only, so chunks can't be reordered or dropped. The header is the
+// additional data of every chunk.
+
+const (
+	formatVersion = 1
+
+	// maxKeyIDLen is the maximum length of a key ID.
+	maxKeyIDLen = 255
+
+	// nonceRandomLen is the length of the random part of the nonces.
+	nonceRandomLen = 7
+
+	// chunkSize is the size of the data of each chunk but the last.
+	chunkSize = 64 * 1024
+)
+
+var formatMagic = []byte{'V', 'T', 'E', 'B'}
+
+// errTruncated is returned when an encrypted file ends early.
+var errTruncated = errors.New("encrypted file is truncated")
+
+// newAEAD returns the AES-GCM cipher for a key.
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// chunkNonce sets the chunk index and last chunk flag of a nonce.
+func chunkNonce(nonce []byte, index uint32, last bool) []byte {
+	binary.BigEndian.PutUint32(nonce[nonceRandomLen:], index)
+	nonce[nonceRandomLen+4] = 0
+	if last {
+		nonce[nonceRandomLen+4] = 1
+	}
+	return nonce
+}
+
+// encryptingWriter encrypts the data written to it into wc.
+type encryptingWriter struct {
+	wc     io.WriteCloser
+	aead   cipher.AEAD
+	header []byte
+	nonce  []byte
+	index  uint32
+
+	// buf has the data of the chunk being written.
+	buf    []byte
+	sealed []byte
+}
+
+func newEncryptingWriter(wc io.WriteCloser, keyID string, key []byte) (*encryptingWriter, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce[:nonceRandomLen]); err != nil {
+		return nil, fmt.Errorf("cannot generate nonce: %v", err)
+	}
+	header := make([]byte, 0, len(formatMagic)+2+len(keyID)+nonceRandomLen)
+	header = append(header, formatMagic...)
+	header = append(header, formatVersion, byte(len(keyID)))
+	header = append(header, keyID...)
+	header = append(header, nonce[:nonceRandomLen]...)
+	if _, err := wc.Write(header); err != nil {
+		return nil, err
+	}
+	return &encryptingWriter{
+		wc:     wc,
+		aead:   aead,
+		header: header,
+		nonce:  nonce,
+		buf:    make([]byte, 0, chunkSize),
+		sealed: make([]byte, 0, chunkSize+aead.Overhead()),
+	}, nil
+}
+
+// Write is part of the io.Writer interface.
+func (ew *encryptingWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		// A full chunk is only sealed once we know it's not
+		// the last one.
+		if len(ew.buf) == chunkSize {
+			if err := ew.sealChunk(false); err != nil {
+				return written, err
+			}
+		}
+		n := copy(ew.buf[len(ew.buf):chunkSize], p)
+		ew.buf = ew.buf[:len(ew.buf)+n]
+		p = p[n:]
+		written += n
+	}
+	return written, nil
+}
+
+func (ew *encryptingWriter) sealChunk(last bool) error {
+	if ew.index == ^uint32(0) {
+		return fmt.Errorf("encrypted file is too large")
+	}
+	ew.sealed = ew.aead.Seal(ew.sealed[:0], chunkNonce(ew.nonce, ew.index, last), ew.buf, ew.header)
+	ew.index++
+	ew.buf = ew.buf[:0]
+	_, err := ew.wc.Write(ew.sealed)
+	return err
+}
+
+// Close seals the last chunk, and closes the underlying writer.
+func (ew *encryptingWriter) Close() error {
+	if err := ew.sealChunk(true); err != nil {
+		ew.wc.Close()
+		return err
+	}
+	return ew.wc.Close()
+}
+
+// decryptingReader decrypts the data read from rc.
+type decryptingReader struct {
+	rc     io.ReadCloser
+	br     *bufio.Reader
+	aead   cipher.AEAD
+	header []byte
+	nonce  []byte
+	index  uint32
+
+	// plain has the decrypted data not read yet.
+	plain  []byte
+	sealed []byte
+	done   bool
+}
+
+// newDecryptingReader reads the header of the encrypted file, and
+// returns a reader for its data.
+func newDecryptingReader(rc io.ReadCloser, keys map[string][]byte) (*decryptingReader, error) {
+	br := bufio.NewReaderSize(rc, chunkSize)
+	header := make([]byte, len(formatMagic)+2)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return nil, fmt.Errorf("cannot read header: %v", err)
+	}
+	if !bytes.Equal(header[:len(formatMagic)], formatMagic) {
+		return nil, fmt.Errorf("not an encrypted file")
+	}
+	if header[len(formatMagic)] != formatVersion {
+		return nil, fmt.Errorf("unknown encrypted file format version %v", header[len(formatMagic)])
+	}
+	rest := make([]byte, int(header[len(formatMagic)+1])+nonceRandomLen)
+	if _, err := io.ReadFull(br, rest); err != nil {
+		return nil, fmt.Errorf("cannot read header: %v", err)
+	}
+	header = append(header, rest...)
+	keyID := string(rest[:len(rest)-nonceRandomLen])
+	key, ok := keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("file is encrypted with key %q, which is not in the key file", keyID)
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	copy(nonce, rest[len(rest)-nonceRandomLen:])
+	return &decryptingReader{
+		rc:     rc,
+		br:     br,
+		aead:   aead,
+		header: header,
+		nonce:  nonce,
+		sealed: make([]byte, chunkSize+aead.Overhead()),
+	}, nil
+}
+
+// Read is part of the io.Reader interface.
+func (dr *decryptingReader) Read(p []byte) (int, error) {
+	for len(dr.plain) == 0 {
+		if dr.done {
+			return 0, io.EOF
+		}
+		if err := dr.openChunk(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, dr.plain)
+	dr.plain = dr.plain[n:]
+	return n, nil
+}
+
+func (dr *decryptingReader) openChunk() error {
+	n, err := io.ReadFull(dr.br, dr.sealed)
+	last := false
+	switch err {
+	case nil:
+		// The chunk is the last one if nothing follows it.
+		if _, err := dr.br.Peek(1); err == io.EOF {
+			last = true
+		} else if err != nil {
+			return err
+		}
+	case io.ErrUnexpectedEOF:
+		last = true
+	case io.EOF:
+		return errTruncated
+	default:
+		return err
+	}
+
+	plain, err := dr.aead.Open(dr.sealed[:0], chunkNonce(dr.nonce, dr.index, last), dr.sealed[:n], dr.header)
+	if err != nil {
+		if last {
+			// Either the data was modified, or the file
+			// was cut after a chunk.
+			return fmt.Errorf("cannot decrypt the last chunk, the encrypted file is truncated or corrupted: %v", err)
+		}
+		return fmt.Errorf("cannot decrypt chunk %v, the encrypted file is corrupted: %v", dr.index, err)
+	}
+	dr.plain = plain
+	dr.index++
+	dr.done = last
+	return nil
+}
+
+// Close is part of the io.Closer interface.
+func (dr *decryptingReader) Close() error {
+	return dr.rc.Close()
+}
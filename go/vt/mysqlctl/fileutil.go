@@ -50,15 +50,27 @@ func init() {
 //	return hex.EncodeToString(h.Sum(nil))
 //}
 
-// our hasher, implemented using cgzip crc32
+// our hasher, implemented using cgzip crc32.
+// It also counts the bytes that go through it.
 type hasher struct {
 	hash.Hash32
+	written int64
 }
 
 func newHasher() *hasher {
-	return &hasher{cgzip.NewCrc32()}
+	return &hasher{Hash32: cgzip.NewCrc32()}
+}
+
+func (h *hasher) Write(p []byte) (int, error) {
+	h.written += int64(len(p))
+	return h.Hash32.Write(p)
 }
 
 func (h *hasher) HashString() string {
 	return hex.EncodeToString(h.Sum(nil))
 }
+
+// Written returns the number of bytes that went through the hasher.
+func (h *hasher) Written() int64 {
+	return h.written
+}
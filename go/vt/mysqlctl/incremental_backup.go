@@ -0,0 +1,212 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/mysqlctl/backupstorage"
+	"github.com/youtube/vitess/go/vt/mysqlctl/replication"
+)
+
+// This file handles incremental backups.
+//
+// An incremental backup contains the binlog files with the
+// transactions committed since a previous backup of the same
+// directory, its parent. The parent is either a full backup, or
+// another incremental backup. Restoring an incremental backup
+// restores the full backup its chain starts with, and replays
+// the binlogs of every incremental backup of the chain in order.
+
+// incrementalBackupMethod is the BackupMethod of the MANIFEST of
+// incremental backups. Restore handles them itself, as they are
+// not taken by a BackupEngine.
+const incrementalBackupMethod = "incremental"
+
+// IncrementalBackup stores the binlog files of mysqld that contain
+// transactions committed since the latest valid backup of the
+// directory. mysqld keeps running, but the binlog files since that
+// backup must not have been purged.
+func IncrementalBackup(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, dir, name string, hookExtraEnv map[string]string) error {
+	pos, err := mysqld.MasterPosition()
+	if err != nil {
+		return fmt.Errorf("can't get master position: %v", err)
+	}
+	flavor, err := positionFlavor(pos)
+	if err != nil {
+		return err
+	}
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return err
+	}
+	defer bs.Close()
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil {
+		return fmt.Errorf("ListBackups failed: %v", err)
+	}
+	var parent backupInfo
+	for i := len(bhs) - 1; i >= 0; i-- {
+		if parent = readBackupInfo(ctx, bhs[i]); parent.valid {
+			break
+		}
+	}
+	if !parent.valid {
+		return fmt.Errorf("no backup in directory %v to take an incremental backup from", dir)
+	}
+	logger.Infof("taking an incremental backup from backup %v at %v", parent.name, parent.bm.Position)
+
+	// Close the current binlog file, so all the transactions
+	// committed so far are in the files we back up.
+	if err := mysqld.ExecuteSuperQueryList(ctx, []string{"FLUSH BINARY LOGS"}); err != nil {
+		return fmt.Errorf("can't flush binary logs: %v", err)
+	}
+	backupTime := time.Now()
+	files, err := closedBinlogFiles(ctx, mysqld)
+	if err != nil {
+		return err
+	}
+
+	bh, err := bs.StartBackup(ctx, dir, name)
+	if err != nil {
+		return fmt.Errorf("StartBackup failed: %v", err)
+	}
+	if err := incrementalBackupFiles(ctx, mysqld, logger, flavor, bh, &parent, files, backupTime, hookExtraEnv); err != nil {
+		if abortErr := bh.AbortBackup(ctx); abortErr != nil {
+			logger.Errorf("failed to abort backup: %v", abortErr)
+		}
+		return err
+	}
+	return bh.EndBackup(ctx)
+}
+
+// incrementalBackupFiles copies the binlog files with transactions
+// that are not part of the parent backup, and the MANIFEST, into bh.
+func incrementalBackupFiles(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, flavor MysqlFlavor, bh backupstorage.BackupHandle, parent *backupInfo, files []string, backupTime time.Time, hookExtraEnv map[string]string) error {
+	pos := parent.bm.Position
+	var fes []FileEntry
+	for _, file := range files {
+		fe := FileEntry{
+			Base: backupBinlog,
+			Name: file,
+		}
+		source, err := fe.open(mysqld.Cnf(), true)
+		if err != nil {
+			return err
+		}
+		summary, err := summarizeBinlogFile(flavor, source, pos)
+		source.Close()
+		if err != nil {
+			return fmt.Errorf("can't read binlog file %v: %v", file, err)
+		}
+		if pos.AtLeast(summary.position) {
+			// Everything in this file is part of the parent.
+			continue
+		}
+		if len(fes) == 0 && !summary.startPosition.IsZero() && !parent.bm.Position.AtLeast(summary.startPosition) {
+			return fmt.Errorf("binlog file %v starts at %v, after backup %v at %v: the binlogs in between were purged", file, summary.startPosition, parent.name, parent.bm.Position)
+		}
+
+		logger.Infof("backing up binlog file %v", file)
+		if err := backupFile(ctx, mysqld, logger, bh, &fe, fmt.Sprintf("%v", len(fes)), hookExtraEnv, nil); err != nil {
+			return fmt.Errorf("cannot backup binlog file %v: %v", file, err)
+		}
+		fes = append(fes, fe)
+		pos = summary.position
+	}
+	if len(fes) == 0 {
+		return fmt.Errorf("no transaction since backup %v at %v", parent.name, parent.bm.Position)
+	}
+	logger.Infof("incremental backup is at replication position: %v", pos)
+
+	return writeManifest(ctx, bh, &BackupManifest{
+		FileEntries:   fes,
+		Position:      pos,
+		Time:          backupTime,
+		TransformHook: *backupStorageHook,
+		SkipCompress:  !*backupStorageCompress,
+		BackupMethod:  incrementalBackupMethod,
+		Parent:        parent.name,
+		FromPosition:  parent.bm.Position,
+	})
+}
+
+// backupLink is one of the backups of an incremental chain.
+type backupLink struct {
+	bh backupstorage.BackupHandle
+	bm BackupManifest
+}
+
+// incrementalChain returns the backups an incremental backup is
+// restored from: the full backup its chain starts with, then the
+// incremental backups in order, up to the backup itself.
+func incrementalChain(ctx context.Context, bhs []backupstorage.BackupHandle, bh backupstorage.BackupHandle, bm *BackupManifest) ([]backupLink, error) {
+	byName := make(map[string]backupstorage.BackupHandle, len(bhs))
+	for _, b := range bhs {
+		byName[b.Name()] = b
+	}
+	chain := []backupLink{{bh: bh, bm: *bm}}
+	for chain[0].bm.BackupMethod == incrementalBackupMethod {
+		if len(chain) > len(bhs) {
+			return nil, fmt.Errorf("incremental backup %v has a cycle of parents", bh.Name())
+		}
+		child := chain[0]
+		parentBh, ok := byName[child.bm.Parent]
+		if !ok {
+			return nil, fmt.Errorf("parent %v of incremental backup %v is missing", child.bm.Parent, child.bh.Name())
+		}
+		parent := readBackupInfo(ctx, parentBh)
+		if !parent.valid {
+			return nil, fmt.Errorf("parent %v of incremental backup %v can't be read", child.bm.Parent, child.bh.Name())
+		}
+		if !parent.bm.Position.Equal(child.bm.FromPosition) {
+			return nil, fmt.Errorf("parent %v of incremental backup %v is at %v, not %v", child.bm.Parent, child.bh.Name(), parent.bm.Position, child.bm.FromPosition)
+		}
+		chain = append([]backupLink{{bh: parentBh, bm: parent.bm}}, chain...)
+	}
+	return chain, nil
+}
+
+// applyIncrementalBackup replays the binlog files of an incremental
+// backup on top of the restored data at position pos. It returns
+// the position it reached.
+func applyIncrementalBackup(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, link *backupLink, pos replication.Position, hookExtraEnv map[string]string) (replication.Position, error) {
+	flavor, err := positionFlavor(pos)
+	if err != nil {
+		return pos, err
+	}
+
+	// The files are restored one at a time in a temporary
+	// directory, which stands for the binlog directory.
+	tmpDir, err := ioutil.TempDir("", "incremental_restore")
+	if err != nil {
+		return pos, err
+	}
+	defer os.RemoveAll(tmpDir)
+	cnf := &Mycnf{BinLogPath: path.Join(tmpDir, "binlog")}
+
+	for i := range link.bm.FileEntries {
+		fe := &link.bm.FileEntries[i]
+		if err := restoreFile(ctx, cnf, link.bh, fe, link.bm.TransformHook, !link.bm.SkipCompress, fmt.Sprintf("%v", i), hookExtraEnv, nil); err != nil {
+			return pos, fmt.Errorf("can't restore binlog file %v of incremental backup %v: %v", fe.Name, link.bh.Name(), err)
+		}
+		desc := fmt.Sprintf("binlog file %v of incremental backup %v", fe.Name, link.bh.Name())
+		if pos, _, err = replayBinlogFile(ctx, mysqld, logger, flavor, path.Join(tmpDir, fe.Name), desc, pos, RecoveryTarget{}); err != nil {
+			return pos, err
+		}
+	}
+	if !pos.AtLeast(link.bm.Position) {
+		return pos, fmt.Errorf("incremental backup %v ends at %v, not %v", link.bh.Name(), pos, link.bm.Position)
+	}
+	return pos, nil
+}
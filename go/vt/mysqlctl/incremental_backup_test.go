@@ -0,0 +1,112 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/sqltypes"
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/mysqlctl/backupstorage"
+	"github.com/youtube/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+func TestIncrementalBackup(t *testing.T) {
+	// The binlog file has transaction 4, and the full backup is at 3.
+	file, cleanup := writeTestBinlogFile(t)
+	defer cleanup()
+	root, err := ioutil.TempDir("", "incrementalbackuptest")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root)
+	*backupstorage.BackupStorageImplementation = "file"
+	*filebackupstorage.FileBackupStorageRoot = root
+	*backupStorageCompress = false
+	defer func() { *backupStorageCompress = true }()
+	bs := &filebackupstorage.FileBackupStorage{}
+	logger := logutil.NewMemoryLogger()
+	ctx := context.Background()
+	dir := "ks/-80"
+	addTestBackup(t, bs, dir, "2016-03-01.100000.cell1-0000000100", "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-3")
+
+	mysqld := NewFakeMysqlDaemon(nil)
+	mysqld.Mycnf = &Mycnf{BinLogPath: path.Join(path.Dir(file), "vt-0000000100-bin")}
+	mysqld.CurrentMasterPosition = mustDecodePosition(t, "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-4")
+	mysqld.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"SHOW BINARY LOGS": {
+			Rows: [][]sqltypes.Value{
+				{sqltypes.MakeString([]byte(path.Base(file)))},
+				{sqltypes.MakeString([]byte("vt-0000000100-bin.000002"))},
+			},
+		},
+	}
+	mysqld.ExpectedExecuteSuperQueryList = []string{"FLUSH BINARY LOGS"}
+	name := "2016-03-02.100000.cell1-0000000100"
+	if err := IncrementalBackup(ctx, mysqld, logger, dir, name, nil); err != nil {
+		t.Fatalf("IncrementalBackup failed: %v", err)
+	}
+	if err := mysqld.CheckSuperQueryList(); err != nil {
+		t.Errorf("IncrementalBackup queries: %v", err)
+	}
+
+	bhs, err := bs.ListBackups(ctx, dir)
+	if err != nil || len(bhs) != 2 {
+		t.Fatalf("ListBackups returned %v %v", bhs, err)
+	}
+	b := readBackupInfo(ctx, bhs[1])
+	if !b.valid || b.bm.BackupMethod != incrementalBackupMethod || b.bm.Parent != "2016-03-01.100000.cell1-0000000100" || len(b.bm.FileEntries) != 1 {
+		t.Fatalf("unexpected MANIFEST: %#v", b.bm)
+	}
+	if got, want := b.bm.FromPosition, mustDecodePosition(t, "MySQL56/439192bd-f37c-11e4-bbeb-0242ac11035a:1-3"); !got.Equal(want) {
+		t.Errorf("FromPosition = %v, want %v", got, want)
+	}
+	if got, want := b.bm.Position, mysqld.CurrentMasterPosition; !got.Equal(want) {
+		t.Errorf("Position = %v, want %v", got, want)
+	}
+
+	// Nothing happened since the incremental backup.
+	mysqld.ExpectedExecuteSuperQueryCurrent = 0
+	if err := IncrementalBackup(ctx, mysqld, logger, dir, "2016-03-03.100000.cell1-0000000100", nil); err == nil || !strings.Contains(err.Error(), "no transaction since backup") {
+		t.Errorf("IncrementalBackup with no new transaction returned %v", err)
+	}
+	if got := listTestBackups(t, bs, dir); len(got) != 2 {
+		t.Errorf("failed IncrementalBackup left a backup: %v", got)
+	}
+
+	// The incremental backup is restored from the full one.
+	chain, err := incrementalChain(ctx, bhs, bhs[1], &b.bm)
+	if err != nil {
+		t.Fatalf("incrementalChain failed: %v", err)
+	}
+	if len(chain) != 2 || chain[0].bh.Name() != "2016-03-01.100000.cell1-0000000100" || chain[1].bh.Name() != name {
+		t.Fatalf("unexpected chain: %v", chain)
+	}
+	pos, err := applyIncrementalBackup(ctx, mysqld, logger, &chain[1], chain[0].bm.Position, nil)
+	if err != nil {
+		t.Fatalf("applyIncrementalBackup failed: %v", err)
+	}
+	if !pos.Equal(mysqld.CurrentMasterPosition) {
+		t.Errorf("applyIncrementalBackup = %v, want %v", pos, mysqld.CurrentMasterPosition)
+	}
+	if want := []string{"vt-0000000100-bin.000001:120:0"}; !reflect.DeepEqual(mysqld.AppliedBinlogFiles, want) {
+		t.Errorf("AppliedBinlogFiles = %v, want %v", mysqld.AppliedBinlogFiles, want)
+	}
+
+	// Without its parent, it can't be restored.
+	if err := bs.RemoveBackup(ctx, dir, "2016-03-01.100000.cell1-0000000100"); err != nil {
+		t.Fatalf("RemoveBackup failed: %v", err)
+	}
+	if _, err := incrementalChain(ctx, bhs[1:], bhs[1], &b.bm); err == nil {
+		t.Errorf("incrementalChain without the parent should have failed")
+	}
+}
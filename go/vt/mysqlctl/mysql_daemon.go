@@ -6,6 +6,7 @@ package mysqlctl
 
 import (
 	"fmt"
+	"path"
 	"reflect"
 	"strings"
 
@@ -51,6 +52,8 @@ type MysqlDaemon interface {
 	SetReadOnly(on bool) error
 	SetSlavePositionCommands(pos replication.Position) ([]string, error)
 	SetMasterCommands(masterHost string, masterPort int) ([]string, error)
+	InjectEmptyTransactionsCommands(gtids replication.GTIDSet) ([]string, error)
+	StartSlaveUntilAfterCommands(pos replication.Position) ([]string, error)
 	WaitForReparentJournal(ctx context.Context, timeCreatedNS int64) error
 
 	// DemoteMaster waits for all current transactions to finish,
@@ -91,6 +94,11 @@ type MysqlDaemon interface {
 	// DisableBinlogPlayback disable playback of binlog events
 	DisableBinlogPlayback() error
 
+	// ApplyBinlogFile replays the events of a binlog file, from
+	// offset start up to offset stop. 0 means the beginning or
+	// the end of the file.
+	ApplyBinlogFile(ctx context.Context, file string, start, stop int64) error
+
 	// Close will close this instance of Mysqld. It will wait for all dba
 	// queries to be finished.
 	Close()
@@ -159,6 +167,21 @@ type FakeMysqlDaemon struct {
 	// SetMasterCommands will return
 	SetMasterCommandsResult []string
 
+	// InjectEmptyTransactionsCommandsInput is matched against the
+	// input of InjectEmptyTransactionsCommands (as "%v"). If it
+	// doesn't match, InjectEmptyTransactionsCommands will return
+	// an error.
+	InjectEmptyTransactionsCommandsInput string
+
+	// InjectEmptyTransactionsCommandsResult is what
+	// InjectEmptyTransactionsCommands will return
+	InjectEmptyTransactionsCommandsResult []string
+
+	// StartSlaveUntilAfterCommandsResult is what
+	// StartSlaveUntilAfterCommands will return, with the position
+	// replaced for %v.
+	StartSlaveUntilAfterCommandsResult []string
+
 	// DemoteMasterPosition is returned by DemoteMaster
 	DemoteMasterPosition replication.Position
 
@@ -206,6 +229,10 @@ type FakeMysqlDaemon struct {
 	// BinlogPlayerEnabled is used by {Enable,Disable}BinlogPlayer
 	BinlogPlayerEnabled bool
 
+	// AppliedBinlogFiles records the calls to ApplyBinlogFile,
+	// as "<file base name>:<start>:<stop>".
+	AppliedBinlogFiles []string
+
 	// SemiSyncMasterEnabled represents the state of rpl_semi_sync_master_enabled.
 	SemiSyncMasterEnabled bool
 	// SemiSyncSlaveEnabled represents the state of rpl_semi_sync_slave_enabled.
@@ -325,6 +352,24 @@ func (fmd *FakeMysqlDaemon) SetMasterCommands(masterHost string, masterPort int)
 	return fmd.SetMasterCommandsResult, nil
 }
 
+// InjectEmptyTransactionsCommands is part of the MysqlDaemon interface
+func (fmd *FakeMysqlDaemon) InjectEmptyTransactionsCommands(gtids replication.GTIDSet) ([]string, error) {
+	input := fmt.Sprintf("%v", gtids)
+	if fmd.InjectEmptyTransactionsCommandsInput != input {
+		return nil, fmt.Errorf("wrong input for InjectEmptyTransactionsCommands: expected %v got %v", fmd.InjectEmptyTransactionsCommandsInput, input)
+	}
+	return fmd.InjectEmptyTransactionsCommandsResult, nil
+}
+
+// StartSlaveUntilAfterCommands is part of the MysqlDaemon interface
+func (fmd *FakeMysqlDaemon) StartSlaveUntilAfterCommands(pos replication.Position) ([]string, error) {
+	cmds := make([]string, len(fmd.StartSlaveUntilAfterCommandsResult))
+	for i, cmd := range fmd.StartSlaveUntilAfterCommandsResult {
+		cmds[i] = fmt.Sprintf(cmd, pos)
+	}
+	return cmds, nil
+}
+
 // WaitForReparentJournal is part of the MysqlDaemon interface
 func (fmd *FakeMysqlDaemon) WaitForReparentJournal(ctx context.Context, timeCreatedNS int64) error {
 	return nil
@@ -418,6 +463,12 @@ func (fmd *FakeMysqlDaemon) DisableBinlogPlayback() error {
 	return nil
 }
 
+// ApplyBinlogFile is part of the MysqlDaemon interface
+func (fmd *FakeMysqlDaemon) ApplyBinlogFile(ctx context.Context, file string, start, stop int64) error {
+	fmd.AppliedBinlogFiles = append(fmd.AppliedBinlogFiles, fmt.Sprintf("%v:%v:%v", path.Base(file), start, stop))
+	return nil
+}
+
 // Close is part of the MysqlDaemon interface
 func (fmd *FakeMysqlDaemon) Close() {
 }
@@ -7,6 +7,8 @@ package mysqlctl
 import (
 	"fmt"
 	"os"
+	"regexp"
+	"strconv"
 
 	"golang.org/x/net/context"
 
@@ -51,6 +53,23 @@ type MysqlFlavor interface {
 	// It should not start or stop replication.
 	SetMasterCommands(params *sqldb.ConnParams, masterHost string, masterPort int, masterConnectRetry int) ([]string, error)
 
+	// SetMasterDelayCommands returns the commands to make the
+	// slave apply the transactions of the master delay seconds
+	// after they were committed. A delay of 0 resets it.
+	// It is guaranteed to be called with replication stopped.
+	SetMasterDelayCommands(delay int) ([]string, error)
+
+	// StartSlaveUntilAfterCommands returns the commands to start
+	// replication so it stops by itself once it has applied all
+	// the transactions of pos.
+	// It is guaranteed to be called with replication stopped.
+	StartSlaveUntilAfterCommands(pos replication.Position) []string
+
+	// InjectEmptyTransactionsCommands returns the commands to
+	// commit an empty transaction for each of the GTIDs, so the
+	// server has them without changing its data.
+	InjectEmptyTransactionsCommands(gtids replication.GTIDSet) ([]string, error)
+
 	// ParseGTID parses a GTID in the canonical format of this
 	// MySQL flavor into a replication.GTID interface value.
 	ParseGTID(string) (replication.GTID, error)
@@ -87,6 +106,41 @@ type MysqlFlavor interface {
 
 var mysqlFlavors = make(map[string]MysqlFlavor)
 
+// serverVersion is the numeric part of a MySQL server version.
+type serverVersion struct {
+	major, minor, patch int
+}
+
+// serverVersionRegexp matches the numeric part at the start of the
+// version strings of MySQL, Percona Server and MariaDB, for instance
+// "5.7.21-20-log" or "10.0.13-MariaDB-1~precise-log".
+var serverVersionRegexp = regexp.MustCompile(`^(\d+)\.(\d+)\.(\d+)`)
+
+// parseServerVersion parses a version string as returned by
+// SELECT VERSION().
+func parseServerVersion(version string) (serverVersion, bool) {
+	m := serverVersionRegexp.FindStringSubmatch(version)
+	if m == nil {
+		return serverVersion{}, false
+	}
+	var v serverVersion
+	v.major, _ = strconv.Atoi(m[1])
+	v.minor, _ = strconv.Atoi(m[2])
+	v.patch, _ = strconv.Atoi(m[3])
+	return v, true
+}
+
+// atLeast returns true if v is the given version or a later one.
+func (v serverVersion) atLeast(major, minor, patch int) bool {
+	if v.major != major {
+		return v.major > major
+	}
+	if v.minor != minor {
+		return v.minor > minor
+	}
+	return v.patch >= patch
+}
+
 // registerFlavorBuiltin adds a flavor to the map only if the name is unused.
 // The flavor implementation passed to this function will only be used if there
 // are no calls to registerFlavorOverride with the same flavor name.
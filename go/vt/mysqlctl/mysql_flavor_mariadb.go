@@ -144,6 +144,26 @@ func (*mariaDB10) SetMasterCommands(params *sqldb.ConnParams, masterHost string,
 	return []string{changeMasterTo}, nil
 }
 
+// SetMasterDelayCommands implements MysqlFlavor.SetMasterDelayCommands().
+func (*mariaDB10) SetMasterDelayCommands(delay int) ([]string, error) {
+	// MASTER_DELAY only appeared in MariaDB 10.2.3.
+	return nil, fmt.Errorf("MariaDB 10.0 doesn't support delayed replicas")
+}
+
+// StartSlaveUntilAfterCommands implements MysqlFlavor.StartSlaveUntilAfterCommands().
+func (*mariaDB10) StartSlaveUntilAfterCommands(pos replication.Position) []string {
+	return []string{
+		fmt.Sprintf("START SLAVE UNTIL master_gtid_pos = '%s'", pos),
+	}
+}
+
+// InjectEmptyTransactionsCommands implements MysqlFlavor.InjectEmptyTransactionsCommands().
+func (*mariaDB10) InjectEmptyTransactionsCommands(gtids replication.GTIDSet) ([]string, error) {
+	// A MariaDB server only keeps the last GTID of each domain,
+	// so it can't have a GTID without the ones before it.
+	return nil, fmt.Errorf("MariaDB doesn't support injecting empty transactions, rebuild the tablet instead")
+}
+
 // ParseGTID implements MysqlFlavor.ParseGTID().
 func (*mariaDB10) ParseGTID(s string) (replication.GTID, error) {
 	return replication.ParseGTID(mariadbFlavorID, s)
@@ -196,6 +196,29 @@ func TestMariadbSetSlavePositionCommands(t *testing.T) {
 	}
 }
 
+func TestMariadbInjectEmptyTransactionsCommands(t *testing.T) {
+	gtid := replication.MariadbGTID{Domain: 1, Server: 41983, Sequence: 12345}
+	if _, err := (&mariaDB10{}).InjectEmptyTransactionsCommands(gtid); err == nil {
+		t.Errorf("(&mariaDB10{}).InjectEmptyTransactionsCommands(%#v) should have failed", gtid)
+	}
+}
+
+func TestMariadbStartSlaveUntilAfterCommands(t *testing.T) {
+	pos := replication.Position{GTIDSet: replication.MariadbGTID{Domain: 1, Server: 41983, Sequence: 12345}}
+	want := []string{
+		"START SLAVE UNTIL master_gtid_pos = '1-41983-12345'",
+	}
+	if got := (&mariaDB10{}).StartSlaveUntilAfterCommands(pos); !reflect.DeepEqual(got, want) {
+		t.Errorf("(&mariaDB10{}).StartSlaveUntilAfterCommands(%#v) = %#v, want %#v", pos, got, want)
+	}
+}
+
+func TestMariadbSetMasterDelayCommands(t *testing.T) {
+	if _, err := (&mariaDB10{}).SetMasterDelayCommands(3600); err == nil {
+		t.Errorf("(&mariaDB10{}).SetMasterDelayCommands(3600) should have failed")
+	}
+}
+
 func TestMariadbSetMasterCommands(t *testing.T) {
 	params := &sqldb.ConnParams{
 		Uname: "username",
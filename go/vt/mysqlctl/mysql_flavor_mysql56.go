@@ -18,7 +18,8 @@ import (
 	"github.com/youtube/vitess/go/vt/mysqlctl/replication"
 )
 
-// mysql56 is the implementation of MysqlFlavor for MySQL 5.6+.
+// mysql56 is the implementation of MysqlFlavor for MySQL 5.6.
+// The flavors of later MySQL versions build on it.
 type mysql56 struct {
 }
 
@@ -26,7 +27,7 @@ const mysql56FlavorID = "MySQL56"
 
 // VersionMatch implements MysqlFlavor.VersionMatch().
 func (*mysql56) VersionMatch(version string) bool {
-	return strings.HasPrefix(version, "5.6") || strings.HasPrefix(version, "5.7")
+	return strings.HasPrefix(version, "5.6")
 }
 
 // MasterPosition implements MysqlFlavor.MasterPosition().
@@ -43,7 +44,13 @@ func (flavor *mysql56) MasterPosition(mysqld *Mysqld) (rp replication.Position,
 
 // SlaveStatus implements MysqlFlavor.SlaveStatus().
 func (flavor *mysql56) SlaveStatus(mysqld *Mysqld) (replication.Status, error) {
-	fields, err := mysqld.fetchSuperQueryMap(context.TODO(), "SHOW SLAVE STATUS")
+	return flavor.slaveStatus(mysqld, "SHOW SLAVE STATUS")
+}
+
+// slaveStatus returns the ReplicationStatus of a slave, using the
+// given SHOW SLAVE STATUS or SHOW REPLICA STATUS query.
+func (flavor *mysql56) slaveStatus(mysqld *Mysqld, query string) (replication.Status, error) {
+	fields, err := mysqld.fetchSuperQueryMap(context.TODO(), query)
 	if err != nil {
 		return replication.Status{}, err
 	}
@@ -63,24 +70,13 @@ func (flavor *mysql56) SlaveStatus(mysqld *Mysqld) (replication.Status, error) {
 
 // WaitMasterPos implements MysqlFlavor.WaitMasterPos().
 func (*mysql56) WaitMasterPos(ctx context.Context, mysqld *Mysqld, targetPos replication.Position) error {
-	var query string
-
 	// A timeout of 0 means wait indefinitely.
-	var timeoutSeconds int
-	if deadline, ok := ctx.Deadline(); ok {
-		timeout := deadline.Sub(time.Now())
-		if timeout <= 0 {
-			return fmt.Errorf("timed out waiting for position %v", targetPos)
-		}
-		// Only whole numbers of seconds are supported.
-		timeoutSeconds = int(timeout.Seconds())
-		if timeoutSeconds == 0 {
-			// We don't want a timeout <1.0s to truncate down to become infinite.
-			timeoutSeconds = 1
-		}
+	timeoutSeconds, err := waitTimeoutSeconds(ctx)
+	if err != nil {
+		return fmt.Errorf("timed out waiting for position %v", targetPos)
 	}
 
-	query = fmt.Sprintf("SELECT WAIT_UNTIL_SQL_THREAD_AFTER_GTIDS('%s', %v)", targetPos, timeoutSeconds)
+	query := fmt.Sprintf("SELECT WAIT_UNTIL_SQL_THREAD_AFTER_GTIDS('%s', %v)", targetPos, timeoutSeconds)
 
 	log.Infof("Waiting for minimum replication position with query: %v", query)
 	qr, err := mysqld.FetchSuperQuery(ctx, query)
@@ -100,6 +96,26 @@ func (*mysql56) WaitMasterPos(ctx context.Context, mysqld *Mysqld, targetPos rep
 	return nil
 }
 
+// waitTimeoutSeconds returns the whole number of seconds left before
+// the deadline of ctx (at least 1), or 0 if it has no deadline.
+func waitTimeoutSeconds(ctx context.Context) (int, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return 0, nil
+	}
+	timeout := deadline.Sub(time.Now())
+	if timeout <= 0 {
+		return 0, context.DeadlineExceeded
+	}
+	// Only whole numbers of seconds are supported.
+	timeoutSeconds := int(timeout.Seconds())
+	if timeoutSeconds == 0 {
+		// We don't want a timeout <1.0s to truncate down to become infinite.
+		timeoutSeconds = 1
+	}
+	return timeoutSeconds, nil
+}
+
 // ResetReplicationCommands implements MysqlFlavor.ResetReplicationCommands().
 func (*mysql56) ResetReplicationCommands() []string {
 	return []string{
@@ -134,6 +150,46 @@ func (*mysql56) SetMasterCommands(params *sqldb.ConnParams, masterHost string, m
 	return []string{changeMasterTo}, nil
 }
 
+// SetMasterDelayCommands implements MysqlFlavor.SetMasterDelayCommands().
+func (*mysql56) SetMasterDelayCommands(delay int) ([]string, error) {
+	return []string{
+		fmt.Sprintf("CHANGE MASTER TO MASTER_DELAY = %d", delay),
+	}, nil
+}
+
+// StartSlaveUntilAfterCommands implements MysqlFlavor.StartSlaveUntilAfterCommands().
+func (*mysql56) StartSlaveUntilAfterCommands(pos replication.Position) []string {
+	// Only the SQL thread is started, the relay logs already have
+	// the transactions to apply.
+	return []string{
+		fmt.Sprintf("START SLAVE SQL_THREAD UNTIL SQL_AFTER_GTIDS = '%s'", pos),
+	}
+}
+
+// maxEmptyTransactions is the most empty transactions
+// InjectEmptyTransactionsCommands commits. Past it, rebuilding the
+// tablet is a better repair.
+const maxEmptyTransactions = 10000
+
+// InjectEmptyTransactionsCommands implements MysqlFlavor.InjectEmptyTransactionsCommands().
+func (*mysql56) InjectEmptyTransactionsCommands(gtids replication.GTIDSet) ([]string, error) {
+	set, ok := gtids.(replication.Mysql56GTIDSet)
+	if !ok {
+		return nil, fmt.Errorf("can't inject GTIDs of flavor %v in a MySQL 5.6 server", gtids.Flavor())
+	}
+	if count := set.Count(); count > maxEmptyTransactions {
+		return nil, fmt.Errorf("can't inject %v empty transactions, the limit is %v, rebuild the tablet instead", count, maxEmptyTransactions)
+	}
+	var cmds []string
+	for _, gtid := range set.GTIDs() {
+		cmds = append(cmds,
+			fmt.Sprintf("SET GTID_NEXT = '%s'", gtid),
+			"BEGIN",
+			"COMMIT")
+	}
+	return append(cmds, "SET GTID_NEXT = 'AUTOMATIC'"), nil
+}
+
 // ParseGTID implements MysqlFlavor.ParseGTID().
 func (*mysql56) ParseGTID(s string) (replication.GTID, error) {
 	return replication.ParseGTID(mysql56FlavorID, s)
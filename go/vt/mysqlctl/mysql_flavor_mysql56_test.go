@@ -6,6 +6,7 @@ package mysqlctl
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/youtube/vitess/go/mysql"
@@ -135,6 +136,7 @@ func TestMysql56VersionMatch(t *testing.T) {
 		"10.0.13-MariaDB-1~precise-log": false,
 		"5.1.63-google-log":             false,
 		"5.6.24-log":                    true,
+		"5.7.17-log":                    false,
 	}
 	for input, want := range table {
 		if got := (&mysql56{}).VersionMatch(input); got != want {
@@ -178,6 +180,54 @@ func TestMysql56SetSlavePositionCommands(t *testing.T) {
 	}
 }
 
+func TestMysql56InjectEmptyTransactionsCommands(t *testing.T) {
+	pos, _ := (&mysql56{}).ParseReplicationPosition("00010203-0405-0607-0809-0a0b0c0d0e0f:1-2")
+	want := []string{
+		"SET GTID_NEXT = '00010203-0405-0607-0809-0a0b0c0d0e0f:1'",
+		"BEGIN",
+		"COMMIT",
+		"SET GTID_NEXT = '00010203-0405-0607-0809-0a0b0c0d0e0f:2'",
+		"BEGIN",
+		"COMMIT",
+		"SET GTID_NEXT = 'AUTOMATIC'",
+	}
+
+	got, err := (&mysql56{}).InjectEmptyTransactionsCommands(pos.GTIDSet)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("(&mysql56{}).InjectEmptyTransactionsCommands(%#v) = %#v, want %#v", pos, got, want)
+	}
+
+	if _, err := (&mysql56{}).InjectEmptyTransactionsCommands(replication.MariadbGTID{Domain: 1, Server: 41983, Sequence: 12345}); err == nil {
+		t.Errorf("InjectEmptyTransactionsCommands with a MariaDB GTID should have failed")
+	}
+
+	pos, _ = (&mysql56{}).ParseReplicationPosition("00010203-0405-0607-0809-0a0b0c0d0e0f:1-1000000000")
+	if _, err := (&mysql56{}).InjectEmptyTransactionsCommands(pos.GTIDSet); err == nil || !strings.Contains(err.Error(), "rebuild the tablet") {
+		t.Errorf("InjectEmptyTransactionsCommands with too many GTIDs returned %v, want a limit error", err)
+	}
+}
+
+func TestMysql56StartSlaveUntilAfterCommands(t *testing.T) {
+	pos, _ := (&mysql56{}).ParseReplicationPosition("00010203-0405-0607-0809-0a0b0c0d0e0f:1-2")
+	want := []string{
+		"START SLAVE SQL_THREAD UNTIL SQL_AFTER_GTIDS = '00010203-0405-0607-0809-0a0b0c0d0e0f:1-2'",
+	}
+	if got := (&mysql56{}).StartSlaveUntilAfterCommands(pos); !reflect.DeepEqual(got, want) {
+		t.Errorf("(&mysql56{}).StartSlaveUntilAfterCommands(%#v) = %#v, want %#v", pos, got, want)
+	}
+}
+
+func TestMysql56SetMasterDelayCommands(t *testing.T) {
+	want := []string{"CHANGE MASTER TO MASTER_DELAY = 3600"}
+	if got, err := (&mysql56{}).SetMasterDelayCommands(3600); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("(&mysql56{}).SetMasterDelayCommands(3600) = (%#v, %v), want %#v", got, err, want)
+	}
+}
+
 func TestMysql56SetMasterCommands(t *testing.T) {
 	params := &sqldb.ConnParams{
 		Uname: "username",
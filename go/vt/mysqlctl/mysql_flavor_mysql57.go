@@ -0,0 +1,80 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"fmt"
+
+	"golang.org/x/net/context"
+
+	log "github.com/golang/glog"
+	"github.com/youtube/vitess/go/vt/mysqlctl/replication"
+)
+
+// mysql57 is the implementation of MysqlFlavor for MySQL 5.7, and for
+// the MySQL 8.0 releases before 8.0.23, which still use the master /
+// slave replication vocabulary. Percona Server is handled like the
+// MySQL version it is based on.
+//
+// It uses the same GTIDs and binlog events as MySQL 5.6, so its
+// replication positions keep the MySQL56 flavor, and can be compared
+// with the ones of MySQL 5.6 tablets.
+type mysql57 struct {
+	mysql56
+}
+
+const mysql57FlavorID = "MySQL57"
+
+// VersionMatch implements MysqlFlavor.VersionMatch().
+func (*mysql57) VersionMatch(version string) bool {
+	v, ok := parseServerVersion(version)
+	if !ok {
+		return false
+	}
+	return (v.major == 5 && v.minor == 7) || (v.major == 8 && v.minor == 0 && !v.atLeast(8, 0, 23))
+}
+
+// WaitMasterPos implements MysqlFlavor.WaitMasterPos().
+//
+// WAIT_UNTIL_SQL_THREAD_AFTER_GTIDS is deprecated in MySQL 5.7, and
+// removed in MySQL 8.0, so we use WAIT_FOR_EXECUTED_GTID_SET.
+func (*mysql57) WaitMasterPos(ctx context.Context, mysqld *Mysqld, targetPos replication.Position) error {
+	query, err := waitForExecutedGTIDSetQuery(ctx, targetPos)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Waiting for minimum replication position with query: %v", query)
+	qr, err := mysqld.FetchSuperQuery(ctx, query)
+	if err != nil {
+		return fmt.Errorf("WAIT_FOR_EXECUTED_GTID_SET() failed: %v", err)
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 1 {
+		return fmt.Errorf("unexpected result format from WAIT_FOR_EXECUTED_GTID_SET(): %#v", qr)
+	}
+	// It returns 0 once the position is reached, and 1 on timeout.
+	if qr.Rows[0][0].String() != "0" {
+		return fmt.Errorf("timed out waiting for position %v", targetPos)
+	}
+	return nil
+}
+
+// waitForExecutedGTIDSetQuery returns the query to wait for targetPos
+// until the deadline of ctx.
+func waitForExecutedGTIDSetQuery(ctx context.Context, targetPos replication.Position) (string, error) {
+	timeoutSeconds, err := waitTimeoutSeconds(ctx)
+	if err != nil {
+		return "", fmt.Errorf("timed out waiting for position %v", targetPos)
+	}
+	if timeoutSeconds == 0 {
+		// Without a timeout, the function waits indefinitely.
+		return fmt.Sprintf("SELECT WAIT_FOR_EXECUTED_GTID_SET('%s')", targetPos), nil
+	}
+	return fmt.Sprintf("SELECT WAIT_FOR_EXECUTED_GTID_SET('%s', %v)", targetPos, timeoutSeconds), nil
+}
+
+func init() {
+	registerFlavorBuiltin(mysql57FlavorID, &mysql57{})
+}
@@ -0,0 +1,63 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+func TestMysql57VersionMatch(t *testing.T) {
+	table := map[string]bool{
+		"10.0.13-MariaDB-1~precise-log": false,
+		"5.6.24-log":                    false,
+		"5.7.17-log":                    true,
+		"5.7.21-20-log":                 true,
+		"8.0.22":                        true,
+		"8.0.23":                        false,
+		"8.0.25-15":                     false,
+	}
+	for input, want := range table {
+		if got := (&mysql57{}).VersionMatch(input); got != want {
+			t.Errorf("(&mysql57{}).VersionMatch(%#v) = %v, want %v", input, got, want)
+		}
+	}
+}
+
+func TestMysql57PositionFlavor(t *testing.T) {
+	// MySQL 5.7 positions are compatible with MySQL 5.6 ones.
+	input := "00010203-0405-0607-0809-0a0b0c0d0e0f:1-2"
+	pos, err := (&mysql57{}).ParseReplicationPosition(input)
+	if err != nil {
+		t.Fatalf("ParseReplicationPosition failed: %v", err)
+	}
+	if got := pos.GTIDSet.Flavor(); got != mysql56FlavorID {
+		t.Errorf("(&mysql57{}).ParseReplicationPosition(%#v) has flavor %v, want %v", input, got, mysql56FlavorID)
+	}
+}
+
+func TestWaitForExecutedGTIDSetQuery(t *testing.T) {
+	pos := mustDecodePosition(t, "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-2")
+
+	got, err := waitForExecutedGTIDSetQuery(context.Background(), pos)
+	if want := "SELECT WAIT_FOR_EXECUTED_GTID_SET('00010203-0405-0607-0809-0a0b0c0d0e0f:1-2')"; err != nil || got != want {
+		t.Errorf("waitForExecutedGTIDSetQuery() = %v, %v, want %v", got, err, want)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second+500*time.Millisecond)
+	defer cancel()
+	got, err = waitForExecutedGTIDSetQuery(ctx, pos)
+	if want := "SELECT WAIT_FOR_EXECUTED_GTID_SET('00010203-0405-0607-0809-0a0b0c0d0e0f:1-2', 10)"; err != nil || got != want {
+		t.Errorf("waitForExecutedGTIDSetQuery() with a timeout = %v, %v, want %v", got, err, want)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), -time.Second)
+	defer cancel()
+	if _, err := waitForExecutedGTIDSetQuery(ctx, pos); err == nil {
+		t.Errorf("waitForExecutedGTIDSetQuery() past the deadline should have failed")
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/youtube/vitess/go/sqldb"
+	"github.com/youtube/vitess/go/vt/mysqlctl/replication"
+)
+
+// mysql80 is the implementation of MysqlFlavor for MySQL 8.0.23 and
+// later 8.0 releases (and the matching Percona Server releases), which
+// have the replica / source replication vocabulary. The old statements
+// are deprecated there, so the flavor uses the new ones.
+type mysql80 struct {
+	mysql57
+}
+
+const mysql80FlavorID = "MySQL80"
+
+// VersionMatch implements MysqlFlavor.VersionMatch().
+func (*mysql80) VersionMatch(version string) bool {
+	v, ok := parseServerVersion(version)
+	if !ok {
+		return false
+	}
+	return v.major == 8 && v.minor == 0 && v.atLeast(8, 0, 23)
+}
+
+// SlaveStatus implements MysqlFlavor.SlaveStatus().
+func (flavor *mysql80) SlaveStatus(mysqld *Mysqld) (replication.Status, error) {
+	return flavor.slaveStatus(mysqld, "SHOW REPLICA STATUS")
+}
+
+// ResetReplicationCommands implements MysqlFlavor.ResetReplicationCommands().
+func (*mysql80) ResetReplicationCommands() []string {
+	return []string{
+		"STOP REPLICA",
+		"RESET REPLICA ALL", // "ALL" makes it forget the source host:port.
+		"RESET MASTER",      // This will also clear gtid_executed and gtid_purged.
+	}
+}
+
+// PromoteSlaveCommands implements MysqlFlavor.PromoteSlaveCommands().
+func (*mysql80) PromoteSlaveCommands() []string {
+	return []string{
+		"RESET REPLICA ALL", // "ALL" makes it forget the source host:port.
+	}
+}
+
+// SetMasterCommands implements MysqlFlavor.SetMasterCommands().
+func (*mysql80) SetMasterCommands(params *sqldb.ConnParams, masterHost string, masterPort int, masterConnectRetry int) ([]string, error) {
+	// Make CHANGE REPLICATION SOURCE TO command, the options are
+	// the same as the ones of CHANGE MASTER TO, renamed.
+	args := changeMasterArgs(params, masterHost, masterPort, masterConnectRetry)
+	args = append(args, "MASTER_AUTO_POSITION = 1")
+	for i, arg := range args {
+		args[i] = "SOURCE_" + strings.TrimPrefix(arg, "MASTER_")
+	}
+	changeSourceTo := "CHANGE REPLICATION SOURCE TO\n  " + strings.Join(args, ",\n  ")
+
+	return []string{changeSourceTo}, nil
+}
+
+// SetMasterDelayCommands implements MysqlFlavor.SetMasterDelayCommands().
+func (*mysql80) SetMasterDelayCommands(delay int) ([]string, error) {
+	return []string{
+		fmt.Sprintf("CHANGE REPLICATION SOURCE TO SOURCE_DELAY = %d", delay),
+	}, nil
+}
+
+// StartSlaveUntilAfterCommands implements MysqlFlavor.StartSlaveUntilAfterCommands().
+func (*mysql80) StartSlaveUntilAfterCommands(pos replication.Position) []string {
+	return []string{
+		fmt.Sprintf("START REPLICA SQL_THREAD UNTIL SQL_AFTER_GTIDS = '%s'", pos),
+	}
+}
+
+func init() {
+	registerFlavorBuiltin(mysql80FlavorID, &mysql80{})
+}
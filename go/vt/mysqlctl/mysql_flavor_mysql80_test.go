@@ -0,0 +1,90 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/youtube/vitess/go/sqldb"
+)
+
+func TestMysql80VersionMatch(t *testing.T) {
+	table := map[string]bool{
+		"10.0.13-MariaDB-1~precise-log": false,
+		"5.7.17-log":                    false,
+		"8.0.22":                        false,
+		"8.0.23":                        true,
+		"8.0.25-15":                     true,
+	}
+	for input, want := range table {
+		if got := (&mysql80{}).VersionMatch(input); got != want {
+			t.Errorf("(&mysql80{}).VersionMatch(%#v) = %v, want %v", input, got, want)
+		}
+	}
+}
+
+func TestMysql80ResetReplicationCommands(t *testing.T) {
+	want := []string{
+		"STOP REPLICA",
+		"RESET REPLICA ALL",
+		"RESET MASTER",
+	}
+	if got := (&mysql80{}).ResetReplicationCommands(); !reflect.DeepEqual(got, want) {
+		t.Errorf("(&mysql80{}).ResetReplicationCommands() = %#v, want %#v", got, want)
+	}
+}
+
+func TestMysql80PromoteSlaveCommands(t *testing.T) {
+	want := []string{"RESET REPLICA ALL"}
+	if got := (&mysql80{}).PromoteSlaveCommands(); !reflect.DeepEqual(got, want) {
+		t.Errorf("(&mysql80{}).PromoteSlaveCommands() = %#v, want %#v", got, want)
+	}
+}
+
+func TestMysql80StartSlaveUntilAfterCommands(t *testing.T) {
+	pos := mustDecodePosition(t, "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-2")
+	want := []string{
+		"START REPLICA SQL_THREAD UNTIL SQL_AFTER_GTIDS = '00010203-0405-0607-0809-0a0b0c0d0e0f:1-2'",
+	}
+	if got := (&mysql80{}).StartSlaveUntilAfterCommands(pos); !reflect.DeepEqual(got, want) {
+		t.Errorf("(&mysql80{}).StartSlaveUntilAfterCommands(%#v) = %#v, want %#v", pos, got, want)
+	}
+}
+
+func TestMysql80SetMasterDelayCommands(t *testing.T) {
+	want := []string{"CHANGE REPLICATION SOURCE TO SOURCE_DELAY = 0"}
+	if got, err := (&mysql80{}).SetMasterDelayCommands(0); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("(&mysql80{}).SetMasterDelayCommands(0) = (%#v, %v), want %#v", got, err, want)
+	}
+}
+
+func TestMysql80SetMasterCommands(t *testing.T) {
+	params := &sqldb.ConnParams{
+		Uname: "username",
+		Pass:  "password",
+	}
+	masterHost := "localhost"
+	masterPort := 123
+	masterConnectRetry := 1234
+	want := []string{
+		`CHANGE REPLICATION SOURCE TO
+  SOURCE_HOST = 'localhost',
+  SOURCE_PORT = 123,
+  SOURCE_USER = 'username',
+  SOURCE_PASSWORD = 'password',
+  SOURCE_CONNECT_RETRY = 1234,
+  SOURCE_AUTO_POSITION = 1`,
+	}
+
+	got, err := (&mysql80{}).SetMasterCommands(params, masterHost, masterPort, masterConnectRetry)
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+		return
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("(&mysql80{}).SetMasterCommands(%#v, %#v, %#v, %#v) = %#v, want %#v", params, masterHost, masterPort, masterConnectRetry, got, want)
+	}
+}
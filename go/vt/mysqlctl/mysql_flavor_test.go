@@ -42,6 +42,15 @@ func (fakeMysqlFlavor) SetSlavePositionCommands(pos replication.Position) ([]str
 func (fakeMysqlFlavor) SetMasterCommands(params *sqldb.ConnParams, masterHost string, masterPort int, masterConnectRetry int) ([]string, error) {
 	return nil, nil
 }
+func (fakeMysqlFlavor) SetMasterDelayCommands(delay int) ([]string, error) {
+	return nil, nil
+}
+func (fakeMysqlFlavor) StartSlaveUntilAfterCommands(pos replication.Position) []string {
+	return nil
+}
+func (fakeMysqlFlavor) InjectEmptyTransactionsCommands(gtids replication.GTIDSet) ([]string, error) {
+	return nil, nil
+}
 func (fakeMysqlFlavor) EnableBinlogPlayback(mysqld *Mysqld) error  { return nil }
 func (fakeMysqlFlavor) DisableBinlogPlayback(mysqld *Mysqld) error { return nil }
 
@@ -89,3 +98,43 @@ func TestRegisterFlavorOverrideSecond(t *testing.T) {
 		t.Errorf("got %#v, want %#v", got, want)
 	}
 }
+
+func TestParseServerVersion(t *testing.T) {
+	// Captured SELECT VERSION() results.
+	table := map[string]serverVersion{
+		"5.6.24-log":                    {5, 6, 24},
+		"5.7.17-log":                    {5, 7, 17},
+		"5.7.21-20-log":                 {5, 7, 21}, // Percona Server
+		"8.0.25-15":                     {8, 0, 25}, // Percona Server
+		"8.0.30":                        {8, 0, 30},
+		"10.0.13-MariaDB-1~precise-log": {10, 0, 13},
+	}
+	for input, want := range table {
+		got, ok := parseServerVersion(input)
+		if !ok || got != want {
+			t.Errorf("parseServerVersion(%#v) = %v, %v, want %v", input, got, ok, want)
+		}
+	}
+	if got, ok := parseServerVersion("not a version"); ok {
+		t.Errorf("parseServerVersion of an invalid version returned %v", got)
+	}
+}
+
+func TestServerVersionAtLeast(t *testing.T) {
+	v := serverVersion{8, 0, 23}
+	for _, tcase := range []struct {
+		major, minor, patch int
+		want                bool
+	}{
+		{5, 7, 30, true},
+		{8, 0, 22, true},
+		{8, 0, 23, true},
+		{8, 0, 24, false},
+		{8, 1, 0, false},
+		{10, 0, 0, false},
+	} {
+		if got := v.atLeast(tcase.major, tcase.minor, tcase.patch); got != tcase.want {
+			t.Errorf("%v.atLeast(%v, %v, %v) = %v, want %v", v, tcase.major, tcase.minor, tcase.patch, got, tcase.want)
+		}
+	}
+}
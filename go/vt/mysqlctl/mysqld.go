@@ -13,6 +13,7 @@ package mysqlctl
 
 import (
 	"bufio"
+	"bytes"
 	"errors"
 	"flag"
 	"fmt"
@@ -48,6 +49,9 @@ var (
 
 	// masterConnectRetry is used in 'SET MASTER' commands
 	masterConnectRetry = flag.Duration("master_connect_retry", 10*time.Second, "how long to wait in between slave -> connection attempts. Only precise to the second.")
+
+	// masterDelay is also used in 'SET MASTER' commands
+	masterDelay = flag.Duration("master_delay", 0, "if set, makes this tablet a delayed replica: MySQL replication applies the transactions of the master this long after they were committed. Only precise to the second.")
 )
 
 // Mysqld is the object that represents a mysqld daemon running on this server.
@@ -183,6 +187,74 @@ func (mysqld *Mysqld) RunMysqlUpgrade() error {
 	return err
 }
 
+// ApplyBinlogFile replays the events of a binlog file through the
+// mysqlbinlog and mysql client binaries, from the event at offset
+// start up to the event at offset stop, which is not replayed.
+// 0 means the beginning or the end of the file. Both binaries are
+// killed if ctx is done before the replay is over.
+func (mysqld *Mysqld) ApplyBinlogFile(ctx context.Context, file string, start, stop int64) error {
+	dir, err := vtenv.VtMysqlRoot()
+	if err != nil {
+		return err
+	}
+	mysqlbinlogPath, err := binaryPath(dir, "mysqlbinlog")
+	if err != nil {
+		return err
+	}
+	mysqlPath, err := binaryPath(dir, "mysql")
+	if err != nil {
+		return err
+	}
+
+	params, err := dbconfigs.WithCredentials(&mysqld.dbcfgs.Dba)
+	if err != nil {
+		return err
+	}
+	cnf, err := mysqld.defaultsExtraFile(&params)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(cnf)
+
+	var args []string
+	if start != 0 {
+		args = append(args, fmt.Sprintf("--start-position=%v", start))
+	}
+	if stop != 0 {
+		args = append(args, fmt.Sprintf("--stop-position=%v", stop))
+	}
+	args = append(args, file)
+	env := []string{os.ExpandEnv("LD_LIBRARY_PATH=$VT_MYSQL_ROOT/lib/mysql")}
+	mysqlbinlogCmd := exec.CommandContext(ctx, mysqlbinlogPath, args...)
+	mysqlbinlogCmd.Env = env
+	var mysqlbinlogErr bytes.Buffer
+	mysqlbinlogCmd.Stderr = &mysqlbinlogErr
+	// --defaults-file=* must be the first arg.
+	mysqlCmd := exec.CommandContext(ctx, mysqlPath, "--defaults-file="+cnf)
+	mysqlCmd.Env = env
+	var mysqlOut bytes.Buffer
+	mysqlCmd.Stdout = &mysqlOut
+	mysqlCmd.Stderr = &mysqlOut
+	mysqlCmd.Stdin, err = mysqlbinlogCmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	log.Infof("replaying binlog file %v from %v to %v", file, start, stop)
+	if err := mysqlCmd.Start(); err != nil {
+		return fmt.Errorf("can't start mysql: %v", err)
+	}
+	if err := mysqlbinlogCmd.Run(); err != nil {
+		mysqlCmd.Process.Kill()
+		mysqlCmd.Wait()
+		return fmt.Errorf("mysqlbinlog failed: %v, stderr: %s", err, mysqlbinlogErr.Bytes())
+	}
+	if err := mysqlCmd.Wait(); err != nil {
+		return fmt.Errorf("mysql failed: %v, output: %s", err, mysqlOut.Bytes())
+	}
+	return nil
+}
+
 // Start will start the mysql daemon, either by running the
 // 'mysqld_start' hook, or by running mysqld_safe in the background.
 // If a mysqlctld address is provided in a flag, Start will run
@@ -528,6 +600,18 @@ func (mysqld *Mysqld) Init(ctx context.Context, initDBSQLFile string) error {
 	return nil
 }
 
+// hasMysqldInitialize returns true if the mysqld binary with the given
+// 'mysqld --version' output initializes data directories itself. It is
+// the case of MySQL and Percona Server 5.7 and later, but not MariaDB.
+func hasMysqldInitialize(versionOutput string) bool {
+	i := strings.Index(versionOutput, "Ver ")
+	if i == -1 || strings.Contains(versionOutput, "MariaDB") {
+		return false
+	}
+	v, ok := parseServerVersion(versionOutput[i+len("Ver "):])
+	return ok && v.atLeast(5, 7, 0)
+}
+
 func (mysqld *Mysqld) installDataDir() error {
 	mysqlRoot, err := vtenv.VtMysqlRoot()
 	if err != nil {
@@ -544,8 +628,9 @@ func (mysqld *Mysqld) installDataDir() error {
 		return err
 	}
 
-	if strings.Contains(version, "Ver 5.7.") {
-		// MySQL 5.7 GA and up have deprecated mysql_install_db.
+	if hasMysqldInitialize(version) {
+		// MySQL 5.7 GA and up have deprecated mysql_install_db
+		// (and 8.0 removed it).
 		// Instead, initialization is built into mysqld.
 		log.Infof("Installing data dir with mysqld --initialize-insecure")
 
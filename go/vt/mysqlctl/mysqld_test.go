@@ -0,0 +1,25 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import "testing"
+
+func TestHasMysqldInitialize(t *testing.T) {
+	// Captured 'mysqld --version' outputs.
+	table := map[string]bool{
+		"/usr/sbin/mysqld  Ver 5.6.35 for Linux on x86_64 (MySQL Community Server (GPL))":                          false,
+		"/usr/sbin/mysqld  Ver 5.7.17 for Linux on x86_64 (MySQL Community Server (GPL))":                          true,
+		"/usr/sbin/mysqld  Ver 5.7.21-20 for debian-linux-gnu on x86_64 (Percona Server (GPL), Release '20')":      true,
+		"/usr/sbin/mysqld  Ver 8.0.25 for Linux on x86_64 (MySQL Community Server - GPL)":                          true,
+		"/usr/sbin/mysqld  Ver 8.0.25-15 for Linux on x86_64 (Percona Server (GPL), Release 15, Revision a558ec2)": true,
+		"/usr/sbin/mysqld  Ver 10.1.22-MariaDB for Linux on x86_64 (MariaDB Server)":                               false,
+		"mysqld: unexpected output": false,
+	}
+	for input, want := range table {
+		if got := hasMysqldInitialize(input); got != want {
+			t.Errorf("hasMysqldInitialize(%#v) = %v, want %v", input, got, want)
+		}
+	}
+}
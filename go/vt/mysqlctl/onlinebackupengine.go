@@ -0,0 +1,193 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/hook"
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/mysqlctl/backupstorage"
+	"github.com/youtube/vitess/go/vt/mysqlctl/replication"
+)
+
+// This file contains the BackupEngine that takes backups while mysqld
+// is running, through an external hot backup tool wrapped in a hook.
+//
+// The hook is called with:
+// - '-operation backup': it writes the backup to its stdout, and the
+//   replication position the backup is consistent with into the file
+//   named by the POSITION_FILE environment variable. The position is
+//   a GTID set in the format of the MySQL flavor, as in the
+//   gtid_executed variable of MySQL 5.6.
+// - '-operation restore': it reads a backup from its stdin, and
+//   restores it into the MySQL directories, which are empty.
+// The MySQL directories, and the my.cnf file, are in the DATA_DIR,
+// INNODB_DATA_HOME_DIR, INNODB_LOG_GROUP_HOME_DIR and MYCNF_FILE
+// environment variables.
+
+const (
+	onlineBackupEngineName = "online"
+
+	// onlineBackupFile is the name of the output of the hook in
+	// the backup.
+	onlineBackupFile = "0"
+)
+
+var onlineBackupHook = flag.String("online_backup_hook", "online_backup", "the hook the online BackupEngine runs to take and restore backups")
+
+// OnlineBackupEngine is the BackupEngine that runs the online_backup
+// hook while mysqld is running, and stores its output as is.
+type OnlineBackupEngine struct{}
+
+// ExecuteBackup is part of the BackupEngine interface.
+func (be *OnlineBackupEngine) ExecuteBackup(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh backupstorage.BackupHandle, backupConcurrency int, hookExtraEnv map[string]string) (bool, error) {
+	// The current position tells us the flavor of the position
+	// the hook reports.
+	pos, err := mysqld.MasterPosition()
+	if err != nil {
+		return false, fmt.Errorf("can't get master position: %v", err)
+	}
+	if pos.IsZero() {
+		return false, fmt.Errorf("can't tell the MySQL flavor of an empty replication position")
+	}
+	flavor := pos.GTIDSet.Flavor()
+
+	positionFile, err := ioutil.TempFile("", "online_backup_position")
+	if err != nil {
+		return false, err
+	}
+	positionFile.Close()
+	defer os.Remove(positionFile.Name())
+
+	h := hook.NewHook(*onlineBackupHook, []string{"-operation", "backup"})
+	h.ExtraEnv = onlineBackupHookEnv(mysqld.Cnf(), hookExtraEnv)
+	h.ExtraEnv["POSITION_FILE"] = positionFile.Name()
+	logger.Infof("running the '%v' hook while mysqld is running", *onlineBackupHook)
+	stdout, wait, _, err := h.ExecuteAsReadPipe(nil)
+	if err != nil {
+		return false, fmt.Errorf("'%v' hook returned error: %v", *onlineBackupHook, err)
+	}
+	fe := FileEntry{
+		Base: backupOnlineStream,
+		Name: *onlineBackupHook,
+	}
+	progress := startProgress(logger, "online backup", 0, 1, *backupMaxBytesPerSecond)
+	streamErr := backupStream(ctx, logger, bh, &fe, onlineBackupFile, stdout, hookExtraEnv, progress)
+	if streamErr == nil {
+		progress.fileDone()
+	}
+	progress.stop()
+	if streamErr != nil {
+		// Let the hook finish, so we can wait for it.
+		io.Copy(ioutil.Discard, stdout)
+	}
+	stderr, err := wait()
+	if stderr != "" {
+		logger.Infof("'%v' hook returned stderr: %v", *onlineBackupHook, stderr)
+	}
+	if streamErr != nil {
+		return false, streamErr
+	}
+	if err != nil {
+		return false, fmt.Errorf("'%v' hook returned error: %v", *onlineBackupHook, err)
+	}
+	// The data of the backup is at the reported position at the
+	// latest when the hook is done.
+	backupTime := time.Now()
+
+	data, err := ioutil.ReadFile(positionFile.Name())
+	if err != nil {
+		return false, fmt.Errorf("can't read the position the '%v' hook reported: %v", *onlineBackupHook, err)
+	}
+	replicationPosition, err := replication.ParsePosition(flavor, strings.TrimSpace(string(data)))
+	if err != nil {
+		return false, fmt.Errorf("can't parse the position the '%v' hook reported: %v", *onlineBackupHook, err)
+	}
+	logger.Infof("backup is at replication position: %v", replicationPosition)
+
+	if err := writeManifest(ctx, bh, &BackupManifest{
+		FileEntries:   []FileEntry{fe},
+		Position:      replicationPosition,
+		Time:          backupTime,
+		TransformHook: *backupStorageHook,
+		SkipCompress:  !*backupStorageCompress,
+		BackupMethod:  onlineBackupEngineName,
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ExecuteRestore is part of the BackupEngine interface.
+func (be *OnlineBackupEngine) ExecuteRestore(ctx context.Context, mysqld MysqlDaemon, logger logutil.Logger, bh backupstorage.BackupHandle, bm *BackupManifest, restoreConcurrency int, hookExtraEnv map[string]string) error {
+	if len(bm.FileEntries) != 1 {
+		return fmt.Errorf("online backup has %v files, expected 1", len(bm.FileEntries))
+	}
+
+	h := hook.NewHook(*onlineBackupHook, []string{"-operation", "restore"})
+	h.ExtraEnv = onlineBackupHookEnv(mysqld.Cnf(), hookExtraEnv)
+	logger.Infof("running the '%v' hook to restore the backup", *onlineBackupHook)
+	var stdout bytes.Buffer
+	stdin, wait, _, err := h.ExecuteAsWritePipe(&stdout)
+	if err != nil {
+		return fmt.Errorf("'%v' hook returned error: %v", *onlineBackupHook, err)
+	}
+	progress := startProgress(logger, "online restore", bm.FileEntries[0].Size, 1, *restoreMaxBytesPerSecond)
+	streamErr := restoreStream(ctx, bh, &bm.FileEntries[0], bm.TransformHook, !bm.SkipCompress, onlineBackupFile, hookExtraEnv, stdin, progress)
+	if streamErr == nil {
+		progress.fileDone()
+	}
+	progress.stop()
+	closeErr := stdin.Close()
+	stderr, err := wait()
+	if stdout.Len() != 0 {
+		logger.Infof("'%v' hook returned stdout: %v", *onlineBackupHook, stdout.String())
+	}
+	if stderr != "" {
+		logger.Infof("'%v' hook returned stderr: %v", *onlineBackupHook, stderr)
+	}
+	switch {
+	case streamErr != nil:
+		return streamErr
+	case closeErr != nil:
+		return fmt.Errorf("cannot close hook pipe: %v", closeErr)
+	case err != nil:
+		return fmt.Errorf("'%v' hook returned error: %v", *onlineBackupHook, err)
+	}
+	return nil
+}
+
+// ShouldDrainForBackup is part of the BackupEngine interface.
+// mysqld keeps running, so the tablet can keep serving.
+func (be *OnlineBackupEngine) ShouldDrainForBackup() bool {
+	return false
+}
+
+// onlineBackupHookEnv returns the environment of the online_backup hook.
+func onlineBackupHookEnv(cnf *Mycnf, hookExtraEnv map[string]string) map[string]string {
+	env := make(map[string]string, len(hookExtraEnv)+5)
+	for k, v := range hookExtraEnv {
+		env[k] = v
+	}
+	env["DATA_DIR"] = cnf.DataDir
+	env["INNODB_DATA_HOME_DIR"] = cnf.InnodbDataHomeDir
+	env["INNODB_LOG_GROUP_HOME_DIR"] = cnf.InnodbLogGroupHomeDir
+	env["MYCNF_FILE"] = cnf.path
+	return env
+}
+
+func init() {
+	BackupEngineMap[onlineBackupEngineName] = &OnlineBackupEngine{}
+}
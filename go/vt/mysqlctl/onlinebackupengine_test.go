@@ -0,0 +1,135 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mysqlctl
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/mysqlctl/filebackupstorage"
+)
+
+// testOnlineBackupHook stands for a hot backup tool: its backup is
+// the contents of the data directory's only file.
+const testOnlineBackupHook = `#!/bin/sh
+case "$2" in
+backup)
+  cat "$DATA_DIR/table1.ibd"
+  echo "0-1-42" > "$POSITION_FILE"
+  ;;
+restore)
+  cat > "$DATA_DIR/table1.ibd"
+  ;;
+esac
+`
+
+func TestGetRestoreEngine(t *testing.T) {
+	for _, tcase := range []struct {
+		method string
+		want   BackupEngine
+	}{
+		{"", BackupEngineMap[builtinBackupEngineName]},
+		{builtinBackupEngineName, BackupEngineMap[builtinBackupEngineName]},
+		{onlineBackupEngineName, BackupEngineMap[onlineBackupEngineName]},
+	} {
+		got, err := getRestoreEngine(&BackupManifest{BackupMethod: tcase.method})
+		if err != nil || got != tcase.want {
+			t.Errorf("getRestoreEngine(%q) = (%v, %v), want %v", tcase.method, got, err, tcase.want)
+		}
+	}
+	if _, err := getRestoreEngine(&BackupManifest{BackupMethod: "unknown"}); err == nil {
+		t.Errorf("getRestoreEngine(unknown) should have failed")
+	}
+}
+
+func TestOnlineBackupEngine(t *testing.T) {
+	root, err := ioutil.TempDir("", "onlinebackuptest")
+	if err != nil {
+		t.Fatalf("os.TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	// Install the hook.
+	oldVtRoot := os.Getenv("VTROOT")
+	defer os.Setenv("VTROOT", oldVtRoot)
+	os.Setenv("VTROOT", root)
+	if err := os.MkdirAll(path.Join(root, "vthook"), os.ModePerm); err != nil {
+		t.Fatalf("failed to create vthook directory: %v", err)
+	}
+	if err := ioutil.WriteFile(path.Join(root, "vthook", "online_backup"), []byte(testOnlineBackupHook), 0755); err != nil {
+		t.Fatalf("failed to write hook: %v", err)
+	}
+
+	dataDir := path.Join(root, "data")
+	if err := os.MkdirAll(dataDir, os.ModePerm); err != nil {
+		t.Fatalf("failed to create directory %v: %v", dataDir, err)
+	}
+	contents := "table contents"
+	if err := ioutil.WriteFile(path.Join(dataDir, "table1.ibd"), []byte(contents), os.ModePerm); err != nil {
+		t.Fatalf("failed to write file table1.ibd: %v", err)
+	}
+	mysqld := NewFakeMysqlDaemon(nil)
+	mysqld.Mycnf = &Mycnf{DataDir: dataDir}
+	mysqld.CurrentMasterPosition = mustDecodePosition(t, "MariaDB/0-1-40")
+	*backupStorageCompress = false
+	defer func() { *backupStorageCompress = true }()
+
+	*filebackupstorage.FileBackupStorageRoot = path.Join(root, "backups")
+	bs := &filebackupstorage.FileBackupStorage{}
+	logger := logutil.NewMemoryLogger()
+	ctx := context.Background()
+	bh, err := bs.StartBackup(ctx, "ks/-80", "2016-03-01.100000.cell1-0000000100")
+	if err != nil {
+		t.Fatalf("StartBackup failed: %v", err)
+	}
+	be := &OnlineBackupEngine{}
+	usable, err := be.ExecuteBackup(ctx, mysqld, logger, bh, 1, nil)
+	if !usable || err != nil {
+		t.Fatalf("ExecuteBackup = (%v, %v), want (true, nil)", usable, err)
+	}
+	if err := bh.EndBackup(ctx); err != nil {
+		t.Fatalf("EndBackup failed: %v", err)
+	}
+
+	bhs, err := bs.ListBackups(ctx, "ks/-80")
+	if err != nil || len(bhs) != 1 {
+		t.Fatalf("ListBackups returned %v %v", bhs, err)
+	}
+	rc, err := bhs[0].ReadFile(ctx, backupManifest)
+	if err != nil {
+		t.Fatalf("ReadFile(MANIFEST) failed: %v", err)
+	}
+	var bm BackupManifest
+	err = json.NewDecoder(rc).Decode(&bm)
+	rc.Close()
+	if err != nil {
+		t.Fatalf("cannot decode MANIFEST: %v", err)
+	}
+	if got, want := bm.Position, mustDecodePosition(t, "MariaDB/0-1-42"); !got.Equal(want) {
+		t.Errorf("backup position = %v, want %v", got, want)
+	}
+	if bm.BackupMethod != onlineBackupEngineName || bm.Time.IsZero() || len(bm.FileEntries) != 1 {
+		t.Errorf("unexpected MANIFEST: %#v", bm)
+	}
+
+	// Restore into an empty data directory.
+	restoreDir := path.Join(root, "restore")
+	if err := os.MkdirAll(restoreDir, os.ModePerm); err != nil {
+		t.Fatalf("failed to create directory %v: %v", restoreDir, err)
+	}
+	mysqld.Mycnf = &Mycnf{DataDir: restoreDir}
+	if err := be.ExecuteRestore(ctx, mysqld, logger, bhs[0], &bm, 1, nil); err != nil {
+		t.Fatalf("ExecuteRestore failed: %v", err)
+	}
+	if data, err := ioutil.ReadFile(path.Join(restoreDir, "table1.ibd")); err != nil || string(data) != contents {
+		t.Errorf("ExecuteRestore restored %q, %v, want %q", data, err, contents)
+	}
+}
@@ -206,10 +206,16 @@ func (mysqld *Mysqld) fetchVariables(ctx context.Context, pattern string) (map[s
 }
 
 const masterPasswordStart = "  MASTER_PASSWORD = '"
+const sourcePasswordStart = "  SOURCE_PASSWORD = '"
 const masterPasswordEnd = "',\n"
 
 func redactMasterPassword(input string) string {
 	i := strings.Index(input, masterPasswordStart)
+	if i == -1 {
+		// MySQL 8.0.23+ CHANGE REPLICATION SOURCE TO, the
+		// prefixes have the same length.
+		i = strings.Index(input, sourcePasswordStart)
+	}
 	if i == -1 {
 		return input
 	}
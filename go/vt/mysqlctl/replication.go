@@ -60,19 +60,38 @@ func changeMasterArgs(params *sqldb.ConnParams, masterHost string, masterPort in
 	return args
 }
 
-// parseSlaveStatus parses the common fields of SHOW SLAVE STATUS.
+// replicaStatusFields maps the fields of SHOW SLAVE STATUS to their
+// names in SHOW REPLICA STATUS, as of MySQL 8.0.22.
+var replicaStatusFields = map[string]string{
+	"Master_Host":           "Source_Host",
+	"Master_Port":           "Source_Port",
+	"Slave_IO_Running":      "Replica_IO_Running",
+	"Slave_SQL_Running":     "Replica_SQL_Running",
+	"Seconds_Behind_Master": "Seconds_Behind_Source",
+}
+
+// parseSlaveStatus parses the common fields of SHOW SLAVE STATUS,
+// or SHOW REPLICA STATUS.
 func parseSlaveStatus(fields map[string]string) replication.Status {
+	field := func(name string) string {
+		if value, ok := fields[name]; ok {
+			return value
+		}
+		return fields[replicaStatusFields[name]]
+	}
 	status := replication.Status{
-		MasterHost:      fields["Master_Host"],
-		SlaveIORunning:  fields["Slave_IO_Running"] == "Yes",
-		SlaveSQLRunning: fields["Slave_SQL_Running"] == "Yes",
+		MasterHost:      field("Master_Host"),
+		SlaveIORunning:  field("Slave_IO_Running") == "Yes",
+		SlaveSQLRunning: field("Slave_SQL_Running") == "Yes",
 	}
-	parseInt, _ := strconv.ParseInt(fields["Master_Port"], 10, 0)
+	parseInt, _ := strconv.ParseInt(field("Master_Port"), 10, 0)
 	status.MasterPort = int(parseInt)
-	parseInt, _ = strconv.ParseInt(fields["Connect_Retry"], 10, 0)
+	parseInt, _ = strconv.ParseInt(field("Connect_Retry"), 10, 0)
 	status.MasterConnectRetry = int(parseInt)
-	parseUint, _ := strconv.ParseUint(fields["Seconds_Behind_Master"], 10, 0)
+	parseUint, _ := strconv.ParseUint(field("Seconds_Behind_Master"), 10, 0)
 	status.SecondsBehindMaster = uint(parseUint)
+	parseUint, _ = strconv.ParseUint(field("SQL_Delay"), 10, 0)
+	status.SQLDelay = uint(parseUint)
 	return status
 }
 
@@ -214,6 +233,27 @@ func (mysqld *Mysqld) SetSlavePositionCommands(pos replication.Position) ([]stri
 	return flavor.SetSlavePositionCommands(pos)
 }
 
+// InjectEmptyTransactionsCommands returns the commands to commit an
+// empty transaction for each of the GTIDs.
+func (mysqld *Mysqld) InjectEmptyTransactionsCommands(gtids replication.GTIDSet) ([]string, error) {
+	flavor, err := mysqld.flavor()
+	if err != nil {
+		return nil, fmt.Errorf("InjectEmptyTransactionsCommands needs flavor: %v", err)
+	}
+	return flavor.InjectEmptyTransactionsCommands(gtids)
+}
+
+// StartSlaveUntilAfterCommands returns the commands to start
+// replication so it stops by itself once it has applied all the
+// transactions of pos.
+func (mysqld *Mysqld) StartSlaveUntilAfterCommands(pos replication.Position) ([]string, error) {
+	flavor, err := mysqld.flavor()
+	if err != nil {
+		return nil, fmt.Errorf("StartSlaveUntilAfterCommands needs flavor: %v", err)
+	}
+	return flavor.StartSlaveUntilAfterCommands(pos), nil
+}
+
 // SetMasterCommands returns the commands to run to make the provided
 // host / port the master.
 func (mysqld *Mysqld) SetMasterCommands(masterHost string, masterPort int) ([]string, error) {
@@ -225,7 +265,41 @@ func (mysqld *Mysqld) SetMasterCommands(masterHost string, masterPort int) ([]st
 	if err != nil {
 		return nil, err
 	}
-	return flavor.SetMasterCommands(&params, masterHost, masterPort, int(masterConnectRetry.Seconds()))
+	cmds, err := flavor.SetMasterCommands(&params, masterHost, masterPort, int(masterConnectRetry.Seconds()))
+	if err != nil {
+		return nil, err
+	}
+	setDelay, err := mysqld.needsMasterDelayCommands()
+	if err != nil {
+		return nil, fmt.Errorf("SetMasterCommands can't get slave status: %v", err)
+	}
+	if !setDelay {
+		return cmds, nil
+	}
+	delayCmds, err := flavor.SetMasterDelayCommands(int(MasterDelay().Seconds()))
+	if err != nil {
+		return nil, err
+	}
+	return append(cmds, delayCmds...), nil
+}
+
+// needsMasterDelayCommands returns true if this tablet is a delayed
+// replica, or if it no longer is one and MySQL still has a delay,
+// that has to be reset. Otherwise, the delay is left alone, as not
+// all flavors support it.
+func (mysqld *Mysqld) needsMasterDelayCommands() (bool, error) {
+	if MasterDelay() > 0 {
+		return true, nil
+	}
+	status, err := mysqld.SlaveStatus()
+	switch err {
+	case nil:
+		return status.SQLDelay > 0, nil
+	case ErrNotSlave:
+		return false, nil
+	default:
+		return false, err
+	}
 }
 
 // ResetReplicationCommands returns the commands to run to reset all
@@ -320,6 +320,68 @@ func (set Mysql56GTIDSet) AddGTID(gtid GTID) GTIDSet {
 	return newSet
 }
 
+// Difference returns the GTIDs of the set that are not in other.
+func (set Mysql56GTIDSet) Difference(other Mysql56GTIDSet) Mysql56GTIDSet {
+	diff := make(Mysql56GTIDSet)
+	for sid, intervals := range set {
+		otherIntervals := other[sid]
+		var diffIntervals []interval
+		for _, iv := range intervals {
+			// Remove each interval of other from iv. Intervals
+			// are sorted, so what remains of iv is before the
+			// next interval of other, or after the last one.
+			for _, oiv := range otherIntervals {
+				if oiv.end < iv.start {
+					continue
+				}
+				if oiv.start > iv.end {
+					break
+				}
+				if oiv.start > iv.start {
+					diffIntervals = append(diffIntervals, interval{start: iv.start, end: oiv.start - 1})
+				}
+				iv.start = oiv.end + 1
+				if iv.start > iv.end {
+					break
+				}
+			}
+			if iv.start <= iv.end {
+				diffIntervals = append(diffIntervals, iv)
+			}
+		}
+		if len(diffIntervals) > 0 {
+			diff[sid] = diffIntervals
+		}
+	}
+	return diff
+}
+
+// Count returns the number of GTIDs in the set.
+func (set Mysql56GTIDSet) Count() int64 {
+	var count int64
+	for _, intervals := range set {
+		for _, iv := range intervals {
+			count += iv.end - iv.start + 1
+		}
+	}
+	return count
+}
+
+// GTIDs returns the GTIDs of the set, ordered by SID and sequence
+// number. Every interval is expanded, so callers should check the
+// Count of the set first.
+func (set Mysql56GTIDSet) GTIDs() []Mysql56GTID {
+	var gtids []Mysql56GTID
+	for _, sid := range set.SIDs() {
+		for _, iv := range set[sid] {
+			for seq := iv.start; seq <= iv.end; seq++ {
+				gtids = append(gtids, Mysql56GTID{Server: sid, Sequence: seq})
+			}
+		}
+	}
+	return gtids
+}
+
 // SIDBlock returns the binary encoding of a MySQL 5.6 GTID set as expected
 // by internal commands that refer to an "SID block".
 //
@@ -405,6 +405,89 @@ func TestMysql56GTIDSetAddGTID(t *testing.T) {
 	}
 }
 
+func TestMysql56GTIDSetDifference(t *testing.T) {
+	sid1 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+	sid2 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 16}
+	sid3 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 17}
+
+	// The set to test against.
+	set := Mysql56GTIDSet{
+		sid1: []interval{{20, 30}, {35, 40}, {42, 45}},
+		sid2: []interval{{1, 5}, {50, 50}, {60, 70}},
+	}
+
+	table := []struct {
+		other, want Mysql56GTIDSet
+	}{{
+		// Nothing removed.
+		other: Mysql56GTIDSet{},
+		want:  set,
+	}, {
+		// Everything removed.
+		other: Mysql56GTIDSet{
+			sid1: []interval{{1, 50}},
+			sid2: []interval{{1, 70}},
+			sid3: []interval{{1, 5}},
+		},
+		want: Mysql56GTIDSet{},
+	}, {
+		// Beginning, middle and end of intervals removed.
+		other: Mysql56GTIDSet{
+			sid1: []interval{{10, 21}, {25, 26}, {40, 43}},
+			sid2: []interval{{1, 5}, {65, 80}},
+		},
+		want: Mysql56GTIDSet{
+			sid1: []interval{{22, 24}, {27, 30}, {35, 39}, {44, 45}},
+			sid2: []interval{{50, 50}, {60, 64}},
+		},
+	}}
+
+	for _, tcase := range table {
+		if got := set.Difference(tcase.other); !got.Equal(tcase.want) {
+			t.Errorf("Difference(%v) = %v, want %v", tcase.other, got, tcase.want)
+		}
+	}
+}
+
+func TestMysql56GTIDSetGTIDs(t *testing.T) {
+	sid1 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+	sid2 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 16}
+
+	set := Mysql56GTIDSet{
+		sid2: []interval{{5, 5}},
+		sid1: []interval{{1, 2}, {7, 8}},
+	}
+	want := []Mysql56GTID{
+		{Server: sid1, Sequence: 1},
+		{Server: sid1, Sequence: 2},
+		{Server: sid1, Sequence: 7},
+		{Server: sid1, Sequence: 8},
+		{Server: sid2, Sequence: 5},
+	}
+	if got := set.GTIDs(); !reflect.DeepEqual(got, want) {
+		t.Errorf("%#v.GTIDs() = %#v, want %#v", set, got, want)
+	}
+	if got := (Mysql56GTIDSet{}).GTIDs(); len(got) != 0 {
+		t.Errorf("Mysql56GTIDSet{}.GTIDs() = %#v, want none", got)
+	}
+}
+
+func TestMysql56GTIDSetCount(t *testing.T) {
+	sid1 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+	sid2 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 16}
+
+	set := Mysql56GTIDSet{
+		sid1: []interval{{1, 2}, {7, 8}},
+		sid2: []interval{{5, 1000000000}},
+	}
+	if got, want := set.Count(), int64(1000000000); got != want {
+		t.Errorf("%#v.Count() = %v, want %v", set, got, want)
+	}
+	if got := (Mysql56GTIDSet{}).Count(); got != 0 {
+		t.Errorf("Mysql56GTIDSet{}.Count() = %v, want 0", got)
+	}
+}
+
 func TestMysql56GTIDSetSIDBlock(t *testing.T) {
 	sid1 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
 	sid2 := SID{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 16}
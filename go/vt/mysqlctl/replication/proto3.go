@@ -20,6 +20,7 @@ func StatusToProto(r Status) *replicationdatapb.Status {
 		MasterHost:          r.MasterHost,
 		MasterPort:          int32(r.MasterPort),
 		MasterConnectRetry:  int32(r.MasterConnectRetry),
+		SqlDelay:            uint32(r.SQLDelay),
 	}
 }
 
@@ -37,5 +38,6 @@ func ProtoToStatus(r *replicationdatapb.Status) Status {
 		MasterHost:          r.MasterHost,
 		MasterPort:          int(r.MasterPort),
 		MasterConnectRetry:  int(r.MasterConnectRetry),
+		SQLDelay:            uint(r.SqlDelay),
 	}
 }
@@ -166,6 +166,8 @@ type Status struct {
 	MasterHost          string
 	MasterPort          int
 	MasterConnectRetry  int
+	// SQLDelay is the MASTER_DELAY of the slave, in seconds.
+	SQLDelay uint
 }
 
 // SlaveRunning returns true iff both the Slave IO and Slave SQL threads are
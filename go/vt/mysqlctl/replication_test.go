@@ -5,7 +5,10 @@
 package mysqlctl
 
 import (
+	"reflect"
 	"testing"
+
+	"github.com/youtube/vitess/go/vt/mysqlctl/replication"
 )
 
 func testRedacted(t *testing.T, source, expected string) {
@@ -43,4 +46,49 @@ func TestRedactMasterPassword(t *testing.T) {
 	testRedacted(t, `CHANGE MASTER TO
   MASTER_PASSWORD = 'AAA`, `CHANGE MASTER TO
   MASTER_PASSWORD = 'AAA`)
+
+	// MySQL 8.0.23+ vocabulary
+	testRedacted(t, `CHANGE REPLICATION SOURCE TO
+  SOURCE_PASSWORD = 'AAA',
+  SOURCE_CONNECT_RETRY = 1
+`,
+		`CHANGE REPLICATION SOURCE TO
+  SOURCE_PASSWORD = '***',
+  SOURCE_CONNECT_RETRY = 1
+`)
+}
+
+func TestParseSlaveStatus(t *testing.T) {
+	// Captured SHOW SLAVE STATUS of MySQL 5.7, and SHOW REPLICA
+	// STATUS of MySQL 8.0.25, trimmed to the fields we use.
+	for _, fields := range []map[string]string{{
+		"Master_Host":           "master-host",
+		"Master_Port":           "3306",
+		"Connect_Retry":         "10",
+		"Slave_IO_Running":      "Yes",
+		"Slave_SQL_Running":     "Yes",
+		"Seconds_Behind_Master": "12",
+		"SQL_Delay":             "3600",
+	}, {
+		"Source_Host":           "master-host",
+		"Source_Port":           "3306",
+		"Connect_Retry":         "10",
+		"Replica_IO_Running":    "Yes",
+		"Replica_SQL_Running":   "Yes",
+		"Seconds_Behind_Source": "12",
+		"SQL_Delay":             "3600",
+	}} {
+		want := replication.Status{
+			MasterHost:          "master-host",
+			MasterPort:          3306,
+			MasterConnectRetry:  10,
+			SlaveIORunning:      true,
+			SlaveSQLRunning:     true,
+			SecondsBehindMaster: 12,
+			SQLDelay:            3600,
+		}
+		if got := parseSlaveStatus(fields); !reflect.DeepEqual(got, want) {
+			t.Errorf("parseSlaveStatus(%v) = %#v, want %#v", fields, got, want)
+		}
+	}
 }
@@ -0,0 +1,406 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package teebackupstorage implements the BackupStorage interface on
+// top of several other BackupStorage implementations. Backups are
+// written to all of them, and are complete if a quorum of them
+// stored the backup. Backups are read from the first implementation
+// that can read them.
+package teebackupstorage
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	log "github.com/golang/glog"
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/concurrency"
+	"github.com/youtube/vitess/go/vt/mysqlctl/backupstorage"
+)
+
+var (
+	// TeeBackupStorageImplementations is the comma separated list
+	// of the BackupStorage implementations to write to, in the
+	// order they are read from. Exported for test purposes.
+	TeeBackupStorageImplementations = flag.String("tee_backup_storage_implementations", "", "for the tee backup storage, the comma separated list of the implementations to store backups with, in the order backups are read from them")
+
+	// TeeBackupStorageQuorum is the number of implementations that
+	// must store a backup for it to be complete. 0 means all of
+	// them. Exported for test purposes.
+	TeeBackupStorageQuorum = flag.Int("tee_backup_storage_quorum", 0, "for the tee backup storage, the number of implementations that must store a backup for it to be complete, 0 for all of them")
+)
+
+// backend is one of the BackupStorage implementations of the tee.
+type backend struct {
+	name string
+	bs   backupstorage.BackupStorage
+}
+
+// backends returns the BackupStorage implementations to use, and
+// the quorum.
+func backends() ([]backend, int, error) {
+	var result []backend
+	for _, name := range strings.Split(*TeeBackupStorageImplementations, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		bs, ok := backupstorage.BackupStorageMap[name]
+		if !ok || name == "tee" {
+			return nil, 0, fmt.Errorf("no registered implementation of BackupStorage %q to tee backups to", name)
+		}
+		result = append(result, backend{name: name, bs: bs})
+	}
+	if len(result) == 0 {
+		return nil, 0, fmt.Errorf("no implementation to tee backups to, use -tee_backup_storage_implementations")
+	}
+	quorum := *TeeBackupStorageQuorum
+	if quorum == 0 {
+		quorum = len(result)
+	}
+	if quorum < 0 || quorum > len(result) {
+		return nil, 0, fmt.Errorf("invalid -tee_backup_storage_quorum %v for %v implementations", quorum, len(result))
+	}
+	return result, quorum, nil
+}
+
+// TeeBackupHandle implements BackupHandle on top of the BackupHandles
+// of the same backup in each backend.
+type TeeBackupHandle struct {
+	dir  string
+	name string
+
+	// backends has all the backends, handles the handle of the
+	// backup in each of them, nil if the backend doesn't have the
+	// backup.
+	backends []backend
+	handles  []backupstorage.BackupHandle
+
+	// quorum is only set for read-write backups.
+	quorum int
+
+	// mu protects failed, which has the errors of the backends
+	// that failed while the backup was being written.
+	mu     sync.Mutex
+	failed map[int]error
+}
+
+// Directory is part of the BackupHandle interface
+func (tbh *TeeBackupHandle) Directory() string {
+	return tbh.dir
+}
+
+// Name is part of the BackupHandle interface
+func (tbh *TeeBackupHandle) Name() string {
+	return tbh.name
+}
+
+// fail records that a backend failed to write the backup. It returns
+// an error if there is no quorum of backends left.
+func (tbh *TeeBackupHandle) fail(i int, err error) error {
+	tbh.mu.Lock()
+	defer tbh.mu.Unlock()
+	if _, ok := tbh.failed[i]; !ok {
+		log.Warningf("backup %v/%v failed on %v, continuing with the other implementations: %v", tbh.dir, tbh.name, tbh.backends[i].name, err)
+		tbh.failed[i] = err
+	}
+	return tbh.checkQuorumLocked()
+}
+
+// checkQuorumLocked returns an error if there is no quorum of
+// backends left. tbh.mu must be held.
+func (tbh *TeeBackupHandle) checkQuorumLocked() error {
+	if live := len(tbh.handles) - len(tbh.failed); live < tbh.quorum {
+		errs := make([]string, 0, len(tbh.failed))
+		for i, err := range tbh.failed {
+			errs = append(errs, fmt.Sprintf("%v: %v", tbh.backends[i].name, err))
+		}
+		sort.Strings(errs)
+		return fmt.Errorf("backup %v/%v is stored by %v implementations, less than the quorum of %v: %v", tbh.dir, tbh.name, live, tbh.quorum, strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// live returns the indexes of the backends that didn't fail.
+func (tbh *TeeBackupHandle) live() []int {
+	tbh.mu.Lock()
+	defer tbh.mu.Unlock()
+	var result []int
+	for i := range tbh.handles {
+		if _, ok := tbh.failed[i]; !ok {
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
+// AddFile is part of the BackupHandle interface
+func (tbh *TeeBackupHandle) AddFile(ctx context.Context, filename string) (io.WriteCloser, error) {
+	if tbh.quorum == 0 {
+		return nil, fmt.Errorf("AddFile cannot be called on read-only backup")
+	}
+	tw := &teeWriter{tbh: tbh}
+	for _, i := range tbh.live() {
+		wc, err := tbh.handles[i].AddFile(ctx, filename)
+		if err != nil {
+			if err := tbh.fail(i, err); err != nil {
+				tw.Close()
+				return nil, err
+			}
+			continue
+		}
+		tw.writers = append(tw.writers, backendWriter{index: i, wc: wc})
+	}
+	return tw, nil
+}
+
+// EndBackup is part of the BackupHandle interface. The backup is
+// ended on the backends that stored it, and aborted on the others.
+// If less than a quorum of backends stored it, it is aborted on all
+// of them.
+func (tbh *TeeBackupHandle) EndBackup(ctx context.Context) error {
+	if tbh.quorum == 0 {
+		return fmt.Errorf("EndBackup cannot be called on read-only backup")
+	}
+	var quorumErr error
+	for _, i := range tbh.live() {
+		if err := tbh.handles[i].EndBackup(ctx); err != nil {
+			quorumErr = tbh.fail(i, err)
+		}
+	}
+	tbh.mu.Lock()
+	if quorumErr == nil {
+		quorumErr = tbh.checkQuorumLocked()
+	}
+	tbh.mu.Unlock()
+
+	if quorumErr != nil {
+		tbh.abortAll(ctx)
+		return quorumErr
+	}
+	for i, bh := range tbh.handles {
+		tbh.mu.Lock()
+		_, failed := tbh.failed[i]
+		tbh.mu.Unlock()
+		if failed && bh != nil {
+			if err := bh.AbortBackup(ctx); err != nil {
+				log.Warningf("failed to abort backup %v/%v on %v: %v", tbh.dir, tbh.name, tbh.backends[i].name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// AbortBackup is part of the BackupHandle interface
+func (tbh *TeeBackupHandle) AbortBackup(ctx context.Context) error {
+	if tbh.quorum == 0 {
+		return fmt.Errorf("AbortBackup cannot be called on read-only backup")
+	}
+	return tbh.abortAll(ctx)
+}
+
+// abortAll aborts the backup on all the backends.
+func (tbh *TeeBackupHandle) abortAll(ctx context.Context) error {
+	rec := concurrency.AllErrorRecorder{}
+	for i, bh := range tbh.handles {
+		if bh == nil {
+			continue
+		}
+		if err := bh.AbortBackup(ctx); err != nil {
+			rec.RecordError(fmt.Errorf("%v: %v", tbh.backends[i].name, err))
+		}
+	}
+	return rec.Error()
+}
+
+// ReadFile is part of the BackupHandle interface. The file is read
+// from the first backend that has the backup and can read it.
+func (tbh *TeeBackupHandle) ReadFile(ctx context.Context, filename string) (io.ReadCloser, error) {
+	if tbh.quorum != 0 {
+		return nil, fmt.Errorf("ReadFile cannot be called on read-write backup")
+	}
+	var errs []string
+	for i, bh := range tbh.handles {
+		if bh == nil {
+			continue
+		}
+		rc, err := bh.ReadFile(ctx, filename)
+		if err == nil {
+			return rc, nil
+		}
+		log.Warningf("cannot read file %v of backup %v/%v from %v, trying the next implementation: %v", filename, tbh.dir, tbh.name, tbh.backends[i].name, err)
+		errs = append(errs, fmt.Sprintf("%v: %v", tbh.backends[i].name, err))
+	}
+	return nil, fmt.Errorf("cannot read file %v of backup %v/%v from any implementation: %v", filename, tbh.dir, tbh.name, strings.Join(errs, ", "))
+}
+
+// backendWriter is the file being written to one backend.
+type backendWriter struct {
+	index int
+	wc    io.WriteCloser
+}
+
+// teeWriter writes a file to several backends. A backend that fails
+// is dropped, as long as a quorum of them is left.
+type teeWriter struct {
+	tbh     *TeeBackupHandle
+	writers []backendWriter
+}
+
+// Write is part of the io.Writer interface.
+func (tw *teeWriter) Write(p []byte) (int, error) {
+	writers := tw.writers[:0]
+	for _, w := range tw.writers {
+		n, err := w.wc.Write(p)
+		if err == nil && n < len(p) {
+			err = io.ErrShortWrite
+		}
+		if err != nil {
+			w.wc.Close()
+			if err := tw.tbh.fail(w.index, err); err != nil {
+				tw.writers = writers
+				return 0, err
+			}
+			continue
+		}
+		writers = append(writers, w)
+	}
+	tw.writers = writers
+	return len(p), nil
+}
+
+// Close is part of the io.Closer interface.
+func (tw *teeWriter) Close() error {
+	var quorumErr error
+	for _, w := range tw.writers {
+		if err := w.wc.Close(); err != nil {
+			quorumErr = tw.tbh.fail(w.index, err)
+		}
+	}
+	tw.writers = nil
+	return quorumErr
+}
+
+// TeeBackupStorage implements BackupStorage on top of the
+// implementations named by -tee_backup_storage_implementations.
+type TeeBackupStorage struct{}
+
+// ListBackups is part of the BackupStorage interface. It merges
+// the backups of all the backends. The backends that fail to list
+// their backups are skipped, unless they all fail.
+func (tbs *TeeBackupStorage) ListBackups(ctx context.Context, dir string) ([]backupstorage.BackupHandle, error) {
+	bes, _, err := backends()
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]*TeeBackupHandle)
+	var errs []string
+	for i, be := range bes {
+		bhs, err := be.bs.ListBackups(ctx, dir)
+		if err != nil {
+			log.Warningf("cannot list backups of %v from %v, skipping it: %v", dir, be.name, err)
+			errs = append(errs, fmt.Sprintf("%v: %v", be.name, err))
+			continue
+		}
+		for _, bh := range bhs {
+			tbh, ok := byName[bh.Name()]
+			if !ok {
+				tbh = &TeeBackupHandle{
+					dir:      dir,
+					name:     bh.Name(),
+					backends: bes,
+					handles:  make([]backupstorage.BackupHandle, len(bes)),
+				}
+				byName[bh.Name()] = tbh
+			}
+			tbh.handles[i] = bh
+		}
+	}
+	if len(errs) == len(bes) {
+		return nil, fmt.Errorf("cannot list backups from any implementation: %v", strings.Join(errs, ", "))
+	}
+
+	names := make([]string, 0, len(byName))
+	for name := range byName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	result := make([]backupstorage.BackupHandle, len(names))
+	for i, name := range names {
+		result[i] = byName[name]
+	}
+	return result, nil
+}
+
+// StartBackup is part of the BackupStorage interface. The backup
+// is started on all the backends, and it is an error if less than
+// a quorum of them can start it.
+func (tbs *TeeBackupStorage) StartBackup(ctx context.Context, dir, name string) (backupstorage.BackupHandle, error) {
+	bes, quorum, err := backends()
+	if err != nil {
+		return nil, err
+	}
+	tbh := &TeeBackupHandle{
+		dir:      dir,
+		name:     name,
+		backends: bes,
+		quorum:   quorum,
+		failed:   make(map[int]error),
+	}
+	tbh.handles = make([]backupstorage.BackupHandle, len(bes))
+	for i, be := range bes {
+		bh, err := be.bs.StartBackup(ctx, dir, name)
+		if err != nil {
+			log.Warningf("cannot start backup %v/%v on %v: %v", dir, name, be.name, err)
+			tbh.failed[i] = err
+			continue
+		}
+		tbh.handles[i] = bh
+	}
+	if err := tbh.checkQuorumLocked(); err != nil {
+		tbh.abortAll(ctx)
+		return nil, err
+	}
+	return tbh, nil
+}
+
+// RemoveBackup is part of the BackupStorage interface. The backup is
+// removed from all the backends.
+func (tbs *TeeBackupStorage) RemoveBackup(ctx context.Context, dir, name string) error {
+	bes, _, err := backends()
+	if err != nil {
+		return err
+	}
+	rec := concurrency.AllErrorRecorder{}
+	for _, be := range bes {
+		if err := be.bs.RemoveBackup(ctx, dir, name); err != nil {
+			rec.RecordError(fmt.Errorf("%v: %v", be.name, err))
+		}
+	}
+	return rec.Error()
+}
+
+// Close is part of the BackupStorage interface
+func (tbs *TeeBackupStorage) Close() error {
+	bes, _, err := backends()
+	if err != nil {
+		return nil
+	}
+	rec := concurrency.AllErrorRecorder{}
+	for _, be := range bes {
+		if err := be.bs.Close(); err != nil {
+			rec.RecordError(fmt.Errorf("%v: %v", be.name, err))
+		}
+	}
+	return rec.Error()
+}
+
+func init() {
+	backupstorage.BackupStorageMap["tee"] = &TeeBackupStorage{}
+}
@@ -0,0 +1,334 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package teebackupstorage
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/mysqlctl/backupstorage"
+)
+
+// memBackupStorage is an in-memory BackupStorage, which can be made
+// to fail.
+type memBackupStorage struct {
+	mu sync.Mutex
+	// backups maps dir/name to the files of complete backups.
+	backups map[string]map[string][]byte
+
+	failList  bool
+	failStart bool
+	failWrite bool
+	failRead  bool
+}
+
+func newMemBackupStorage(name string) *memBackupStorage {
+	mbs := &memBackupStorage{backups: make(map[string]map[string][]byte)}
+	backupstorage.BackupStorageMap[name] = mbs
+	return mbs
+}
+
+func (mbs *memBackupStorage) ListBackups(ctx context.Context, dir string) ([]backupstorage.BackupHandle, error) {
+	mbs.mu.Lock()
+	defer mbs.mu.Unlock()
+	if mbs.failList {
+		return nil, fmt.Errorf("list failure")
+	}
+	var names []string
+	for key := range mbs.backups {
+		if strings.HasPrefix(key, dir+"/") {
+			names = append(names, key[len(dir)+1:])
+		}
+	}
+	sort.Strings(names)
+	result := make([]backupstorage.BackupHandle, len(names))
+	for i, name := range names {
+		result[i] = &memBackupHandle{mbs: mbs, dir: dir, name: name, readOnly: true}
+	}
+	return result, nil
+}
+
+func (mbs *memBackupStorage) StartBackup(ctx context.Context, dir, name string) (backupstorage.BackupHandle, error) {
+	if mbs.failStart {
+		return nil, fmt.Errorf("start failure")
+	}
+	return &memBackupHandle{mbs: mbs, dir: dir, name: name, files: make(map[string][]byte)}, nil
+}
+
+func (mbs *memBackupStorage) RemoveBackup(ctx context.Context, dir, name string) error {
+	mbs.mu.Lock()
+	defer mbs.mu.Unlock()
+	delete(mbs.backups, dir+"/"+name)
+	return nil
+}
+
+func (mbs *memBackupStorage) Close() error {
+	return nil
+}
+
+func (mbs *memBackupStorage) has(dir, name string) bool {
+	mbs.mu.Lock()
+	defer mbs.mu.Unlock()
+	_, ok := mbs.backups[dir+"/"+name]
+	return ok
+}
+
+type memBackupHandle struct {
+	mbs      *memBackupStorage
+	dir      string
+	name     string
+	readOnly bool
+
+	mu      sync.Mutex
+	files   map[string][]byte
+	aborted bool
+}
+
+func (mbh *memBackupHandle) Directory() string {
+	return mbh.dir
+}
+
+func (mbh *memBackupHandle) Name() string {
+	return mbh.name
+}
+
+func (mbh *memBackupHandle) AddFile(ctx context.Context, filename string) (io.WriteCloser, error) {
+	return &memWriter{mbh: mbh, filename: filename}, nil
+}
+
+func (mbh *memBackupHandle) EndBackup(ctx context.Context) error {
+	mbh.mbs.mu.Lock()
+	defer mbh.mbs.mu.Unlock()
+	mbh.mbs.backups[mbh.dir+"/"+mbh.name] = mbh.files
+	return nil
+}
+
+func (mbh *memBackupHandle) AbortBackup(ctx context.Context) error {
+	mbh.aborted = true
+	return nil
+}
+
+func (mbh *memBackupHandle) ReadFile(ctx context.Context, filename string) (io.ReadCloser, error) {
+	mbh.mbs.mu.Lock()
+	defer mbh.mbs.mu.Unlock()
+	if mbh.mbs.failRead {
+		return nil, fmt.Errorf("read failure")
+	}
+	data, ok := mbh.mbs.backups[mbh.dir+"/"+mbh.name][filename]
+	if !ok {
+		return nil, fmt.Errorf("no file %v", filename)
+	}
+	return ioutil.NopCloser(bytes.NewReader(data)), nil
+}
+
+type memWriter struct {
+	mbh      *memBackupHandle
+	filename string
+	buf      bytes.Buffer
+}
+
+func (mw *memWriter) Write(p []byte) (int, error) {
+	if mw.mbh.mbs.failWrite {
+		return 0, fmt.Errorf("write failure")
+	}
+	return mw.buf.Write(p)
+}
+
+func (mw *memWriter) Close() error {
+	mw.mbh.mu.Lock()
+	defer mw.mbh.mu.Unlock()
+	mw.mbh.files[mw.filename] = mw.buf.Bytes()
+	return nil
+}
+
+// setupTee registers the given number of memBackupStorage, and
+// configures the tee to use them with the given quorum.
+func setupTee(count, quorum int) (backupstorage.BackupStorage, []*memBackupStorage) {
+	var names []string
+	var mbss []*memBackupStorage
+	for i := 0; i < count; i++ {
+		name := fmt.Sprintf("mem%v", i)
+		names = append(names, name)
+		mbss = append(mbss, newMemBackupStorage(name))
+	}
+	*TeeBackupStorageImplementations = strings.Join(names, ",")
+	*TeeBackupStorageQuorum = quorum
+	return backupstorage.BackupStorageMap["tee"], mbss
+}
+
+func writeBackup(bs backupstorage.BackupStorage, name string, data []byte) error {
+	ctx := context.Background()
+	bh, err := bs.StartBackup(ctx, "ks/0", name)
+	if err != nil {
+		return err
+	}
+	wc, err := bh.AddFile(ctx, "0")
+	if err != nil {
+		bh.AbortBackup(ctx)
+		return err
+	}
+	if _, err := wc.Write(data); err != nil {
+		wc.Close()
+		bh.AbortBackup(ctx)
+		return err
+	}
+	if err := wc.Close(); err != nil {
+		bh.AbortBackup(ctx)
+		return err
+	}
+	return bh.EndBackup(ctx)
+}
+
+func readBackup(bs backupstorage.BackupStorage, name string) ([]byte, error) {
+	ctx := context.Background()
+	bhs, err := bs.ListBackups(ctx, "ks/0")
+	if err != nil {
+		return nil, err
+	}
+	for _, bh := range bhs {
+		if bh.Name() != name {
+			continue
+		}
+		rc, err := bh.ReadFile(ctx, "0")
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return ioutil.ReadAll(rc)
+	}
+	return nil, fmt.Errorf("no backup %v", name)
+}
+
+func TestTeeBackupStorage(t *testing.T) {
+	tbs, mbss := setupTee(2, 0)
+	data := []byte("some backup data")
+	if err := writeBackup(tbs, "b1", data); err != nil {
+		t.Fatalf("writeBackup failed: %v", err)
+	}
+	for i, mbs := range mbss {
+		if !mbs.has("ks/0", "b1") {
+			t.Errorf("backup is missing from backend %v", i)
+		}
+	}
+
+	// Reads fall back to the next backend.
+	mbss[0].failRead = true
+	if got, err := readBackup(tbs, "b1"); err != nil || !bytes.Equal(got, data) {
+		t.Errorf("readBackup with a failing backend returned %q, %v", got, err)
+	}
+	mbss[1].failRead = true
+	if _, err := readBackup(tbs, "b1"); err == nil {
+		t.Errorf("readBackup with all backends failing should have failed")
+	}
+	mbss[0].failRead = false
+	mbss[1].failRead = false
+
+	// Listing merges the backups of all backends, and skips the
+	// backends that can't list.
+	mbss[1].backups["ks/0/b0"] = map[string][]byte{"0": []byte("only in mem1")}
+	mbss[0].failList = true
+	bhs, err := tbs.ListBackups(context.Background(), "ks/0")
+	if err != nil || len(bhs) != 2 || bhs[0].Name() != "b0" || bhs[1].Name() != "b1" {
+		t.Errorf("ListBackups returned %v, %v", bhs, err)
+	}
+	mbss[1].failList = true
+	if _, err := tbs.ListBackups(context.Background(), "ks/0"); err == nil {
+		t.Errorf("ListBackups with all backends failing should have failed")
+	}
+	mbss[0].failList = false
+	mbss[1].failList = false
+	if got, err := readBackup(tbs, "b0"); err != nil || string(got) != "only in mem1" {
+		t.Errorf("readBackup of a backup of one backend returned %q, %v", got, err)
+	}
+
+	if err := tbs.RemoveBackup(context.Background(), "ks/0", "b1"); err != nil {
+		t.Fatalf("RemoveBackup failed: %v", err)
+	}
+	for i, mbs := range mbss {
+		if mbs.has("ks/0", "b1") {
+			t.Errorf("backup was not removed from backend %v", i)
+		}
+	}
+}
+
+func TestTeeBackupStorageQuorum(t *testing.T) {
+	table := []struct {
+		desc    string
+		quorum  int
+		setup   func(mbss []*memBackupStorage)
+		wantErr bool
+		want    []bool
+	}{{
+		desc:   "write failure within quorum",
+		quorum: 2,
+		setup:  func(mbss []*memBackupStorage) { mbss[1].failWrite = true },
+		want:   []bool{true, false, true},
+	}, {
+		desc:   "start failure within quorum",
+		quorum: 2,
+		setup:  func(mbss []*memBackupStorage) { mbss[0].failStart = true },
+		want:   []bool{false, true, true},
+	}, {
+		desc:    "write failures below quorum",
+		quorum:  2,
+		setup:   func(mbss []*memBackupStorage) { mbss[0].failWrite = true; mbss[2].failWrite = true },
+		wantErr: true,
+		want:    []bool{false, false, false},
+	}, {
+		desc:    "start failure with default quorum",
+		quorum:  0,
+		setup:   func(mbss []*memBackupStorage) { mbss[2].failStart = true },
+		wantErr: true,
+		want:    []bool{false, false, false},
+	}}
+	for _, tcase := range table {
+		tbs, mbss := setupTee(3, tcase.quorum)
+		tcase.setup(mbss)
+		err := writeBackup(tbs, "b", []byte("data"))
+		if (err != nil) != tcase.wantErr {
+			t.Errorf("%v: writeBackup returned %v, wantErr %v", tcase.desc, err, tcase.wantErr)
+		}
+		for i, mbs := range mbss {
+			if got := mbs.has("ks/0", "b"); got != tcase.want[i] {
+				t.Errorf("%v: backend %v has the backup: %v, want %v", tcase.desc, i, got, tcase.want[i])
+			}
+		}
+	}
+}
+
+func TestBackends(t *testing.T) {
+	setupTee(2, 0)
+	for _, tcase := range []struct {
+		implementations string
+		quorum          int
+	}{
+		{"", 0},
+		{"mem0,unknown", 0},
+		{"mem0,tee", 0},
+		{"mem0,mem1", 3},
+		{"mem0,mem1", -1},
+	} {
+		*TeeBackupStorageImplementations = tcase.implementations
+		*TeeBackupStorageQuorum = tcase.quorum
+		if _, _, err := backends(); err == nil {
+			t.Errorf("backends() with %q and quorum %v should have failed", tcase.implementations, tcase.quorum)
+		}
+	}
+
+	*TeeBackupStorageImplementations = " mem1, mem0 "
+	*TeeBackupStorageQuorum = 0
+	bes, quorum, err := backends()
+	if err != nil || len(bes) != 2 || bes[0].name != "mem1" || quorum != 2 {
+		t.Errorf("backends() returned %v, %v, %v", bes, quorum, err)
+	}
+}
@@ -353,15 +353,18 @@ type SplitQueryRequest_Algorithm int32
 const (
 	SplitQueryRequest_EQUAL_SPLITS SplitQueryRequest_Algorithm = 0
 	SplitQueryRequest_FULL_SCAN    SplitQueryRequest_Algorithm = 1
+	SplitQueryRequest_SAMPLING     SplitQueryRequest_Algorithm = 2
 )
 
 var SplitQueryRequest_Algorithm_name = map[int32]string{
 	0: "EQUAL_SPLITS",
 	1: "FULL_SCAN",
+	2: "SAMPLING",
 }
 var SplitQueryRequest_Algorithm_value = map[string]int32{
 	"EQUAL_SPLITS": 0,
 	"FULL_SCAN":    1,
+	"SAMPLING":     2,
 }
 
 func (x SplitQueryRequest_Algorithm) String() string {
@@ -484,6 +487,16 @@ type ExecuteOptions struct {
 	// This is a shortcut so the application doesn't need to care about
 	// comparing EventTokens.
 	CompareEventToken *EventToken `protobuf:"bytes,3,opt,name=compare_event_token,json=compareEventToken" json:"compare_event_token,omitempty"`
+	// If set, a non-master tablet waits until its replication position
+	// covers this one before executing the query. It is a replication
+	// position as returned in ResultExtras or CommitResponse.
+	// If it doesn't catch up in time, the query fails with
+	// QUERY_NOT_SERVED, so it can be retried on another tablet.
+	WaitForPosition string `protobuf:"bytes,4,opt,name=wait_for_position,json=waitForPosition" json:"wait_for_position,omitempty"`
+	// If set, a master tablet returns its replication position in the
+	// ResultExtras of DMLs executed outside of a transaction. In a
+	// transaction, it returns it in the CommitResponse.
+	IncludePosition bool `protobuf:"varint,5,opt,name=include_position,json=includePosition" json:"include_position,omitempty"`
 }
 
 func (m *ExecuteOptions) Reset()                    { *m = ExecuteOptions{} }
@@ -536,6 +549,10 @@ type ResultExtras struct {
 	// If set, it means the data returned with this result is fresher
 	// than the compare_token passed in the ExecuteOptions.
 	Fresher bool `protobuf:"varint,2,opt,name=fresher" json:"fresher,omitempty"`
+	// position is the replication position of the master after the
+	// query, populated if the include_position flag is set in
+	// ExecuteOptions.
+	Position string `protobuf:"bytes,3,opt,name=position" json:"position,omitempty"`
 }
 
 func (m *ResultExtras) Reset()                    { *m = ResultExtras{} }
@@ -962,6 +979,10 @@ func (m *CommitRequest) GetTarget() *Target {
 
 // CommitResponse is the returned value from Commit
 type CommitResponse struct {
+	// position is the replication position of the master after the
+	// commit, populated if the include_position flag was set in the
+	// ExecuteOptions of the transaction.
+	Position string `protobuf:"bytes,1,opt,name=position" json:"position,omitempty"`
 }
 
 func (m *CommitResponse) Reset()                    { *m = CommitResponse{} }
@@ -1092,6 +1113,10 @@ func (m *CommitPreparedRequest) GetTarget() *Target {
 
 // CommitPreparedResponse is the returned value from CommitPrepared
 type CommitPreparedResponse struct {
+	// position is the replication position of the master after the
+	// commit, populated if the include_position flag was set in the
+	// ExecuteOptions of the transaction.
+	Position string `protobuf:"bytes,1,opt,name=position" json:"position,omitempty"`
 }
 
 func (m *CommitPreparedResponse) Reset()                    { *m = CommitPreparedResponse{} }
@@ -1231,6 +1256,10 @@ func (m *StartCommitRequest) GetTarget() *Target {
 
 // StartCommitResponse is the returned value from StartCommit
 type StartCommitResponse struct {
+	// position is the replication position of the master after the
+	// commit, populated if the include_position flag was set in the
+	// ExecuteOptions of the transaction.
+	Position string `protobuf:"bytes,1,opt,name=position" json:"position,omitempty"`
 }
 
 func (m *StartCommitResponse) Reset()                    { *m = StartCommitResponse{} }
@@ -1658,6 +1687,11 @@ type RealtimeStats struct {
 	// qps is the average QPS (queries per second) rate in the last XX seconds
 	// where XX is usually 60 (See query_service_stats.go).
 	Qps float64 `protobuf:"fixed64,6,opt,name=qps" json:"qps,omitempty"`
+	// master_delay_seconds is populated for delayed replicas only. It is
+	// the configured delay of their replication, which is not counted in
+	// seconds_behind_master. Delayed replicas are never used to serve
+	// queries.
+	MasterDelaySeconds uint32 `protobuf:"varint,7,opt,name=master_delay_seconds,json=masterDelaySeconds" json:"master_delay_seconds,omitempty"`
 }
 
 func (m *RealtimeStats) Reset()                    { *m = RealtimeStats{} }
@@ -1847,154 +1881,159 @@ func init() {
 func init() { proto.RegisterFile("query.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 2382 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xec, 0x5a, 0xcb, 0x73, 0x1b, 0xc7,
-	0xd1, 0xd7, 0xe2, 0x45, 0xa0, 0x41, 0x90, 0xc3, 0x01, 0x65, 0xc1, 0x94, 0xfd, 0x59, 0xdf, 0xda,
-	0xb2, 0x15, 0xd9, 0x61, 0x64, 0x8a, 0x61, 0x5c, 0x8e, 0x93, 0x18, 0x04, 0x97, 0x32, 0x22, 0x10,
-	0x84, 0x06, 0x0b, 0x39, 0x4a, 0xb9, 0x6a, 0x6b, 0x08, 0x8c, 0xc8, 0x2d, 0x02, 0xbb, 0xab, 0xdd,
-	0x01, 0x29, 0xdc, 0x94, 0x38, 0x2f, 0xe7, 0xe9, 0x54, 0x1e, 0xce, 0xa3, 0xa2, 0xa4, 0x2a, 0xf7,
-	0x5c, 0x72, 0x4e, 0x55, 0x2a, 0x7f, 0x40, 0x6e, 0xb9, 0x24, 0x87, 0x9c, 0x52, 0xa9, 0xdc, 0x72,
-	0xce, 0x21, 0x95, 0x9a, 0xd9, 0xd9, 0xc5, 0x82, 0x84, 0x2c, 0xd9, 0xc9, 0x85, 0x94, 0x4f, 0x98,
-	0xe9, 0xee, 0x9d, 0xee, 0x5f, 0x77, 0x4f, 0xcf, 0x0b, 0x50, 0xbc, 0x33, 0x64, 0xfe, 0x68, 0xd9,
-	0xf3, 0x5d, 0xee, 0xe2, 0xac, 0xec, 0x2c, 0xcd, 0x71, 0xd7, 0x73, 0x7b, 0x94, 0xd3, 0x90, 0xbc,
-	0x54, 0x3c, 0xe0, 0xbe, 0xd7, 0x0d, 0x3b, 0xfa, 0x1d, 0xc8, 0x99, 0xd4, 0xdf, 0x65, 0x1c, 0x2f,
-	0x41, 0x7e, 0x9f, 0x8d, 0x02, 0x8f, 0x76, 0x59, 0x45, 0xbb, 0xa0, 0x5d, 0x2a, 0x90, 0xb8, 0x8f,
-	0x17, 0x21, 0x1b, 0xec, 0x51, 0xbf, 0x57, 0x49, 0x49, 0x46, 0xd8, 0xc1, 0x9f, 0x84, 0x22, 0xa7,
-	0x3b, 0x7d, 0xc6, 0x2d, 0x3e, 0xf2, 0x58, 0x25, 0x7d, 0x41, 0xbb, 0x34, 0xb7, 0xb2, 0xb8, 0x1c,
-	0xab, 0x33, 0x25, 0xd3, 0x1c, 0x79, 0x8c, 0x00, 0x8f, 0xdb, 0xfa, 0x4b, 0x30, 0x77, 0xd3, 0xbc,
-	0x46, 0x39, 0xab, 0xd1, 0x7e, 0x9f, 0xf9, 0xf5, 0x0d, 0xa1, 0x7a, 0x18, 0x30, 0xdf, 0xa1, 0x83,
-	0x58, 0x75, 0xd4, 0xd7, 0xdf, 0x02, 0x30, 0x0e, 0x98, 0xc3, 0x4d, 0x77, 0x9f, 0x39, 0xf8, 0x29,
-	0x28, 0x70, 0x7b, 0xc0, 0x02, 0x4e, 0x07, 0x9e, 0x14, 0x4d, 0x93, 0x31, 0xe1, 0x01, 0x66, 0x2e,
-	0x41, 0xde, 0x73, 0x03, 0x9b, 0xdb, 0xae, 0x23, 0x6d, 0x2c, 0x90, 0xb8, 0xaf, 0x7f, 0x16, 0xb2,
-	0x37, 0x69, 0x7f, 0xc8, 0xf0, 0x33, 0x90, 0x91, 0x20, 0x34, 0x09, 0xa2, 0xb8, 0x1c, 0xfa, 0x51,
-	0xda, 0x2e, 0x19, 0x62, 0xec, 0x03, 0x21, 0x29, 0xc7, 0x9e, 0x25, 0x61, 0x47, 0xdf, 0x87, 0xd9,
-	0x75, 0xdb, 0xe9, 0xdd, 0xa4, 0xbe, 0x2d, 0x00, 0x7e, 0xc8, 0x61, 0xf0, 0x73, 0x90, 0x93, 0x8d,
-	0xa0, 0x92, 0xbe, 0x90, 0xbe, 0x54, 0x5c, 0x99, 0x55, 0x1f, 0x4a, 0xdb, 0x88, 0xe2, 0xe9, 0x7f,
-	0xd0, 0x00, 0xd6, 0xdd, 0xa1, 0xd3, 0xbb, 0x21, 0x98, 0x18, 0x41, 0x3a, 0xb8, 0xd3, 0x57, 0x0e,
-	0x13, 0x4d, 0x7c, 0x1d, 0xe6, 0x76, 0x6c, 0xa7, 0x67, 0x1d, 0x28, 0x73, 0x82, 0x4a, 0x4a, 0x0e,
-	0xf7, 0x9c, 0x1a, 0x6e, 0xfc, 0xf1, 0x72, 0xd2, 0xea, 0xc0, 0x70, 0xb8, 0x3f, 0x22, 0xa5, 0x9d,
-	0x24, 0x6d, 0xa9, 0x03, 0xf8, 0xb8, 0x90, 0x50, 0xba, 0xcf, 0x46, 0x91, 0xd2, 0x7d, 0x36, 0xc2,
-	0x1f, 0x4b, 0x22, 0x2a, 0xae, 0x94, 0x23, 0x5d, 0x89, 0x6f, 0x15, 0xcc, 0x57, 0x53, 0xaf, 0x68,
-	0xfa, 0x6f, 0x35, 0x98, 0x33, 0xee, 0xb2, 0xee, 0x90, 0xb3, 0x6d, 0x4f, 0xc4, 0x20, 0xc0, 0xcb,
-	0x50, 0x66, 0x77, 0xbb, 0xfd, 0x61, 0x8f, 0x59, 0xb7, 0x6d, 0xd6, 0xef, 0x59, 0x22, 0xf0, 0x81,
-	0xd4, 0x91, 0x27, 0x0b, 0x8a, 0xb5, 0x29, 0x38, 0x4d, 0xc1, 0x10, 0xf2, 0xb6, 0x13, 0xca, 0x33,
-	0x91, 0x1a, 0x16, 0x17, 0xb9, 0x21, 0xf5, 0xe7, 0xc9, 0x82, 0x62, 0x25, 0x92, 0xa6, 0x0a, 0xe5,
-	0xae, 0x3b, 0xf0, 0xa8, 0x3f, 0x29, 0x9f, 0x96, 0xf6, 0x2e, 0x28, 0x7b, 0xc7, 0xf2, 0x64, 0x41,
-	0x49, 0x8f, 0x49, 0xfa, 0x6b, 0x90, 0x95, 0x06, 0x60, 0x0c, 0x99, 0x44, 0x9a, 0xca, 0x76, 0x1c,
-	0xf4, 0xd4, 0x03, 0x82, 0xae, 0x7f, 0x0a, 0xd2, 0xc4, 0x3d, 0xc4, 0x15, 0x98, 0xe9, 0x33, 0x67,
-	0x97, 0xef, 0x09, 0x6c, 0xe9, 0x4b, 0x98, 0x44, 0x5d, 0xfc, 0x44, 0x1c, 0xff, 0x30, 0x2d, 0xa2,
-	0x88, 0xbf, 0x05, 0xb3, 0x84, 0x05, 0xc3, 0x3e, 0x37, 0xee, 0x72, 0x9f, 0x06, 0x78, 0x05, 0x8a,
-	0x49, 0x04, 0xda, 0x83, 0x10, 0x00, 0x1b, 0xa3, 0xaf, 0xc0, 0xcc, 0x6d, 0x9f, 0x05, 0x7b, 0xcc,
-	0x57, 0x1e, 0x8a, 0xba, 0x22, 0x9f, 0x8a, 0x32, 0x1b, 0x42, 0x1d, 0x22, 0x0b, 0xa5, 0xff, 0x43,
-	0xf3, 0xc6, 0x59, 0x28, 0x91, 0x13, 0xc5, 0xc3, 0xcf, 0x42, 0xc9, 0x77, 0x0f, 0x03, 0x8b, 0xde,
-	0xbe, 0xcd, 0xba, 0x9c, 0x85, 0x93, 0x2d, 0x43, 0x66, 0x05, 0xb1, 0xaa, 0x68, 0xf8, 0x3c, 0x14,
-	0x6c, 0x27, 0x60, 0x3e, 0xb7, 0xec, 0x9e, 0x74, 0x74, 0x86, 0xe4, 0x43, 0x42, 0xbd, 0x87, 0xff,
-	0x0f, 0x32, 0x42, 0xb8, 0x92, 0x91, 0x5a, 0x40, 0x69, 0x21, 0xee, 0x21, 0x91, 0x74, 0xfc, 0x22,
-	0xe4, 0x98, 0xc4, 0x5b, 0xc9, 0x4e, 0xa4, 0x54, 0xd2, 0x15, 0x44, 0x89, 0xe8, 0xbf, 0x4a, 0x43,
-	0xb1, 0xcd, 0x7d, 0x46, 0x07, 0x12, 0x3f, 0x7e, 0x0d, 0x20, 0xe0, 0x94, 0xb3, 0x01, 0x73, 0x78,
-	0x04, 0xe4, 0x29, 0x35, 0x40, 0x42, 0x6e, 0xb9, 0x1d, 0x09, 0x91, 0x84, 0xfc, 0x51, 0x07, 0xa7,
-	0x1e, 0xc1, 0xc1, 0x4b, 0xf7, 0x53, 0x50, 0x88, 0x47, 0xc3, 0x55, 0xc8, 0x77, 0x29, 0x67, 0xbb,
-	0xae, 0x3f, 0x52, 0x55, 0xe0, 0xe2, 0xfb, 0x69, 0x5f, 0xae, 0x29, 0x61, 0x12, 0x7f, 0x86, 0x9f,
-	0x86, 0xb0, 0x5c, 0xca, 0x79, 0xa0, 0x6a, 0x59, 0x41, 0x52, 0x44, 0xfe, 0xe3, 0x57, 0x01, 0x7b,
-	0xbe, 0x3d, 0xa0, 0xfe, 0xc8, 0xda, 0x67, 0x23, 0x4b, 0x85, 0x2c, 0x3d, 0x25, 0x64, 0x48, 0xc9,
-	0x5d, 0x67, 0xa3, 0xcd, 0x30, 0x78, 0xaf, 0x4c, 0x7e, 0xab, 0x92, 0xee, 0x78, 0x20, 0x12, 0x5f,
-	0xca, 0x1a, 0x14, 0x44, 0xd5, 0x26, 0x2b, 0xf3, 0x53, 0x34, 0xf5, 0x17, 0x20, 0x1f, 0x19, 0x8f,
-	0x0b, 0x90, 0x35, 0x7c, 0xdf, 0xf5, 0xd1, 0x19, 0x3c, 0x03, 0xe9, 0x8d, 0xad, 0x06, 0xd2, 0x64,
-	0x63, 0xa3, 0x81, 0x52, 0xfa, 0xef, 0x53, 0xf1, 0x94, 0x27, 0xec, 0xce, 0x90, 0x05, 0x1c, 0x7f,
-	0x0e, 0xca, 0x4c, 0xe6, 0x8a, 0x7d, 0xc0, 0xac, 0xae, 0x5c, 0x07, 0x44, 0xa6, 0x84, 0x09, 0x3d,
-	0xbf, 0x1c, 0xae, 0x50, 0xd1, 0xfa, 0x40, 0x16, 0x62, 0x59, 0x45, 0xea, 0x61, 0x03, 0xca, 0xf6,
-	0x60, 0xc0, 0x7a, 0x36, 0xe5, 0xc9, 0x01, 0xc2, 0x80, 0x9d, 0x8d, 0xca, 0xe7, 0xc4, 0x32, 0x43,
-	0x16, 0xe2, 0x2f, 0xe2, 0x61, 0x2e, 0x42, 0x8e, 0xcb, 0xe5, 0x4f, 0x55, 0x83, 0x52, 0x34, 0x79,
-	0x25, 0x91, 0x28, 0x26, 0x7e, 0x01, 0xc2, 0xb5, 0xb4, 0x92, 0x99, 0x48, 0x88, 0x71, 0x3d, 0x25,
-	0x21, 0x1f, 0x5f, 0x84, 0x39, 0xee, 0x53, 0x27, 0xa0, 0x5d, 0x51, 0xda, 0x84, 0x45, 0x59, 0xb9,
-	0x48, 0x95, 0x12, 0xd4, 0x7a, 0x0f, 0x7f, 0x02, 0x66, 0xdc, 0xb0, 0xf8, 0x55, 0x72, 0x13, 0x16,
-	0x4f, 0x56, 0x46, 0x12, 0x49, 0xe9, 0x9f, 0x81, 0xf9, 0xd8, 0x83, 0x81, 0xe7, 0x3a, 0x01, 0xc3,
-	0x97, 0x21, 0xe7, 0xcb, 0x09, 0xa1, 0xbc, 0x86, 0xd5, 0x10, 0x89, 0x19, 0x4d, 0x94, 0x84, 0xde,
-	0x83, 0xf9, 0x90, 0xf2, 0xa6, 0xcd, 0xf7, 0x64, 0xa0, 0xf0, 0x45, 0xc8, 0x32, 0xd1, 0x38, 0xe2,
-	0x73, 0xd2, 0xaa, 0x49, 0x3e, 0x09, 0xb9, 0x09, 0x2d, 0xa9, 0x87, 0x6a, 0xf9, 0x67, 0x0a, 0xca,
-	0xca, 0xca, 0x75, 0xca, 0xbb, 0x7b, 0x27, 0x34, 0xd8, 0x2f, 0xc2, 0x8c, 0xa0, 0xdb, 0xf1, 0xc4,
-	0x98, 0x12, 0xee, 0x48, 0x42, 0x04, 0x9c, 0x06, 0x56, 0x22, 0xba, 0x32, 0xe0, 0x79, 0x52, 0xa2,
-	0x81, 0x39, 0x26, 0x4e, 0xc9, 0x8b, 0xdc, 0x43, 0xf2, 0x62, 0xe6, 0x91, 0xf2, 0x62, 0x03, 0x16,
-	0x27, 0x3d, 0xae, 0x92, 0xe3, 0x25, 0x98, 0x09, 0x83, 0x12, 0x95, 0xc0, 0x69, 0x71, 0x8b, 0x44,
-	0xf4, 0x5f, 0xa6, 0x60, 0x51, 0x55, 0xa7, 0xc7, 0x63, 0x9a, 0x26, 0xfc, 0x9c, 0x7d, 0x24, 0x3f,
-	0xd7, 0xe0, 0xec, 0x11, 0x07, 0x7d, 0x88, 0x59, 0xf8, 0x3b, 0x0d, 0x66, 0xd7, 0xd9, 0xae, 0xed,
-	0x9c, 0x4c, 0xf7, 0xea, 0x6b, 0x50, 0x52, 0xe6, 0x2b, 0xf0, 0xc7, 0xb3, 0x5a, 0x9b, 0x92, 0xd5,
-	0xfa, 0xdf, 0x34, 0x28, 0xd5, 0xdc, 0xc1, 0xc0, 0xe6, 0x27, 0x34, 0xaf, 0x8e, 0xe3, 0xcc, 0x4c,
-	0xc3, 0x89, 0x60, 0x2e, 0x82, 0x19, 0x3a, 0x48, 0xff, 0xbb, 0x06, 0xf3, 0xc4, 0xed, 0xf7, 0x77,
-	0x68, 0x77, 0xff, 0x74, 0x63, 0xc7, 0x80, 0xc6, 0x40, 0x15, 0xfa, 0x7f, 0x69, 0x30, 0xd7, 0xf2,
-	0x99, 0xd8, 0x4a, 0x9f, 0x6a, 0xf0, 0xe2, 0x50, 0xd0, 0xe3, 0x6a, 0xad, 0x2f, 0x10, 0xd9, 0xd6,
-	0x17, 0x60, 0x3e, 0xc6, 0xae, 0xfc, 0xf1, 0x67, 0x0d, 0xce, 0x86, 0x09, 0xa2, 0x38, 0xbd, 0x13,
-	0xea, 0x96, 0x08, 0x6f, 0x26, 0x81, 0xb7, 0x02, 0x4f, 0x1c, 0xc5, 0xa6, 0x60, 0xbf, 0x9d, 0x82,
-	0x73, 0x51, 0x6e, 0x9c, 0x70, 0xe0, 0xff, 0x45, 0x3e, 0x2c, 0x41, 0xe5, 0xb8, 0x13, 0x94, 0x87,
-	0xde, 0x4d, 0x41, 0xa5, 0xe6, 0x33, 0xca, 0x59, 0x62, 0xcf, 0x70, 0x7a, 0x72, 0x03, 0xbf, 0x0c,
-	0xb3, 0x1e, 0xf5, 0xb9, 0xdd, 0xb5, 0x3d, 0x2a, 0x4e, 0x65, 0x59, 0xb9, 0x25, 0x39, 0x32, 0xc0,
-	0x84, 0x88, 0x7e, 0x1e, 0x9e, 0x9c, 0xe2, 0x11, 0xe5, 0xaf, 0x7f, 0x6b, 0x80, 0xdb, 0x9c, 0xfa,
-	0xfc, 0x31, 0x58, 0x55, 0xa6, 0x26, 0xd3, 0x59, 0x28, 0x4f, 0xe0, 0x4f, 0xfa, 0x85, 0xf1, 0xc7,
-	0x62, 0xc5, 0x79, 0xa0, 0x5f, 0x92, 0xf8, 0x95, 0x5f, 0xfe, 0xaa, 0xc1, 0x52, 0xcd, 0x0d, 0xef,
-	0x85, 0x4e, 0xe5, 0x0c, 0xd3, 0x9f, 0x86, 0xf3, 0x53, 0x01, 0x2a, 0x07, 0xfc, 0x45, 0x83, 0x27,
-	0x08, 0xa3, 0xbd, 0xd3, 0x09, 0xfe, 0x06, 0x9c, 0x3b, 0x06, 0x4e, 0xed, 0x50, 0xd7, 0x20, 0x3f,
-	0x60, 0x9c, 0xf6, 0x28, 0xa7, 0x0a, 0xd2, 0x52, 0x34, 0xee, 0x58, 0x7a, 0x4b, 0x49, 0x90, 0x58,
-	0x56, 0xbf, 0x9f, 0x82, 0xb2, 0xdc, 0xeb, 0x7e, 0x74, 0x20, 0x9a, 0x7e, 0x20, 0x7a, 0x57, 0x83,
-	0xc5, 0x49, 0x07, 0xc5, 0x67, 0x82, 0xff, 0xf5, 0xbd, 0xc2, 0x94, 0x82, 0x90, 0x9e, 0xb6, 0x05,
-	0xfd, 0x63, 0x0a, 0x2a, 0x49, 0x93, 0x3e, 0xba, 0x83, 0x98, 0xbc, 0x83, 0xf8, 0xc0, 0x97, 0x4e,
-	0xef, 0x69, 0xf0, 0xe4, 0x14, 0x87, 0x7e, 0xb0, 0x40, 0x27, 0x6e, 0x22, 0x52, 0x0f, 0xbd, 0x89,
-	0x78, 0xd4, 0x50, 0xff, 0x29, 0x0d, 0x0b, 0x6d, 0xaf, 0x6f, 0x73, 0x35, 0xc8, 0xe9, 0x9e, 0x9c,
-	0xff, 0x0f, 0xb3, 0x81, 0x00, 0x6b, 0x75, 0xdd, 0xfe, 0x70, 0xe0, 0xc8, 0xed, 0x53, 0x81, 0x14,
-	0x25, 0xad, 0x26, 0x49, 0xf8, 0x19, 0x28, 0x46, 0x22, 0x43, 0x87, 0xab, 0xcb, 0x25, 0x50, 0x12,
-	0x43, 0x87, 0xe3, 0x55, 0x38, 0xe7, 0x0c, 0x07, 0x96, 0xbc, 0xb9, 0xf7, 0x98, 0x6f, 0xc9, 0x91,
-	0x2d, 0xb1, 0xe5, 0xaa, 0xe4, 0xa5, 0x70, 0xd9, 0x19, 0x0e, 0x88, 0x7b, 0x18, 0xb4, 0x98, 0x2f,
-	0x95, 0xb7, 0xa8, 0xcf, 0xf1, 0xeb, 0x50, 0xa0, 0xfd, 0x5d, 0xd7, 0xb7, 0xf9, 0xde, 0xa0, 0x52,
-	0x90, 0xb7, 0xd9, 0x7a, 0x74, 0x9b, 0x7d, 0xd4, 0xfd, 0xcb, 0xd5, 0x48, 0x92, 0x8c, 0x3f, 0xd2,
-	0x5f, 0x82, 0x42, 0x4c, 0xc7, 0x08, 0x66, 0x8d, 0x1b, 0x9d, 0x6a, 0xc3, 0x6a, 0xb7, 0x1a, 0x75,
-	0xb3, 0x8d, 0xce, 0xe0, 0x12, 0x14, 0x36, 0x3b, 0x8d, 0x86, 0xd5, 0xae, 0x55, 0x9b, 0x48, 0xd3,
-	0x09, 0x80, 0x1c, 0x52, 0x0e, 0x3e, 0x76, 0x90, 0xf6, 0x10, 0x07, 0x9d, 0x87, 0x82, 0xef, 0x1e,
-	0x2a, 0xec, 0x29, 0x09, 0x27, 0xef, 0xbb, 0x87, 0x12, 0xb9, 0x5e, 0x05, 0x9c, 0xb4, 0x55, 0x65,
-	0x6f, 0x62, 0x82, 0x69, 0x13, 0x13, 0x6c, 0xac, 0x3f, 0x9e, 0x60, 0xe1, 0x76, 0xcb, 0x67, 0x74,
-	0xf0, 0x06, 0xa3, 0x7d, 0x1e, 0xd5, 0x14, 0xfd, 0xd7, 0x29, 0x28, 0x11, 0x41, 0xb1, 0x07, 0xac,
-	0xcd, 0x29, 0x0f, 0x44, 0xa4, 0xf6, 0xa4, 0x88, 0x35, 0x9e, 0x1a, 0x05, 0x52, 0x0c, 0x69, 0xe1,
-	0xbd, 0xeb, 0x0a, 0x9c, 0x0d, 0x58, 0xd7, 0x75, 0x7a, 0x81, 0xb5, 0xc3, 0xf6, 0x6c, 0xa7, 0x67,
-	0x0d, 0x68, 0xc0, 0xd5, 0xe3, 0x4c, 0x89, 0x94, 0x15, 0x73, 0x5d, 0xf2, 0xb6, 0x24, 0x0b, 0x5f,
-	0x81, 0xc5, 0x1d, 0xdb, 0xe9, 0xbb, 0xbb, 0x96, 0xd7, 0xa7, 0x23, 0xe6, 0x07, 0x0a, 0xaa, 0x48,
-	0xaf, 0x2c, 0xc1, 0x21, 0xaf, 0x15, 0xb2, 0xc2, 0x70, 0x7f, 0x11, 0x2e, 0x4f, 0xd5, 0x62, 0xdd,
-	0xb6, 0xfb, 0x9c, 0xf9, 0xac, 0x67, 0xf9, 0xcc, 0xeb, 0xdb, 0x5d, 0x2a, 0xcb, 0x45, 0xb8, 0xbf,
-	0x7a, 0x7e, 0x8a, 0xea, 0x4d, 0x25, 0x4e, 0xc6, 0xd2, 0xc2, 0xdb, 0x5d, 0x6f, 0x68, 0x0d, 0x03,
-	0xba, 0xcb, 0x64, 0xa5, 0xd1, 0x48, 0xbe, 0xeb, 0x0d, 0x3b, 0xa2, 0x8f, 0x11, 0xa4, 0xef, 0x78,
-	0x61, 0x81, 0xd1, 0x88, 0x68, 0xea, 0xff, 0xd0, 0xa2, 0xcb, 0xc5, 0xc8, 0x7b, 0x71, 0x01, 0x89,
-	0xa6, 0x89, 0xf6, 0x7e, 0xd3, 0xa4, 0x02, 0x33, 0x01, 0xf3, 0x0f, 0x6c, 0x67, 0x37, 0x7a, 0xbf,
-	0x52, 0x5d, 0xdc, 0x86, 0xe7, 0xd5, 0xfb, 0x33, 0xbb, 0xcb, 0x99, 0xef, 0xd0, 0x7e, 0x7f, 0x64,
-	0x85, 0x67, 0x2b, 0x87, 0xb3, 0x9e, 0x35, 0x7e, 0x29, 0x0e, 0x8b, 0xc8, 0xb3, 0xa1, 0xb4, 0x11,
-	0x0b, 0x93, 0x58, 0xd6, 0x8c, 0xdf, 0x90, 0x3f, 0x0d, 0x73, 0xbe, 0x8a, 0xa9, 0x15, 0x88, 0xa0,
-	0xaa, 0xe9, 0xb9, 0x18, 0x3f, 0x42, 0x25, 0x02, 0x4e, 0x4a, 0x7e, 0xb2, 0x2b, 0x36, 0xe0, 0xe5,
-	0x8e, 0xd7, 0xa3, 0x9c, 0x85, 0x88, 0x4f, 0x68, 0x65, 0x4a, 0xbe, 0x98, 0x67, 0x26, 0x5f, 0xcc,
-	0x27, 0x5f, 0xe0, 0xb3, 0x47, 0x5e, 0xe0, 0xf5, 0xd7, 0x61, 0x71, 0x12, 0xbf, 0x8a, 0xf5, 0x25,
-	0xc8, 0xca, 0x17, 0xb3, 0x23, 0xb7, 0xa4, 0x89, 0x27, 0x31, 0x12, 0x0a, 0xe8, 0xbf, 0xd1, 0xa0,
-	0x3c, 0x65, 0x6f, 0x16, 0x6f, 0xfc, 0xb4, 0xc4, 0xb9, 0xf2, 0xe3, 0x90, 0x95, 0x6f, 0x77, 0xea,
-	0xe5, 0xf5, 0xdc, 0xf1, 0xad, 0x9d, 0x7c, 0x67, 0x23, 0xa1, 0x94, 0x98, 0x9d, 0x32, 0xac, 0x5d,
-	0x79, 0xb0, 0x8c, 0x96, 0x96, 0xa2, 0xa0, 0x85, 0x67, 0xcd, 0xe3, 0x27, 0xd5, 0xcc, 0x43, 0x4f,
-	0xaa, 0x97, 0xf7, 0x21, 0xb3, 0xd9, 0xa7, 0xbb, 0x38, 0x0f, 0x99, 0xe6, 0x76, 0xd3, 0x40, 0x67,
-	0xf0, 0x3c, 0x40, 0xbd, 0x5d, 0x6f, 0x9a, 0xc6, 0x35, 0x52, 0x6d, 0xa0, 0x7b, 0xa9, 0x90, 0xd0,
-	0x69, 0xb6, 0xeb, 0xd7, 0x9a, 0xc6, 0x06, 0xba, 0x97, 0xc1, 0xb3, 0x30, 0x53, 0x6f, 0x6f, 0x36,
-	0xb6, 0xab, 0x26, 0xba, 0x97, 0xc7, 0x25, 0xc8, 0xd7, 0xdb, 0x37, 0x3a, 0xdb, 0xa6, 0x60, 0x22,
-	0x5c, 0x84, 0x5c, 0xbd, 0x6d, 0x1a, 0x5f, 0x30, 0xd1, 0xbd, 0x0b, 0x21, 0x6f, 0xbd, 0xde, 0xac,
-	0x92, 0x5b, 0xe8, 0xde, 0xeb, 0x97, 0xdf, 0x49, 0x43, 0xc6, 0x1c, 0x79, 0x4c, 0x14, 0xce, 0xa6,
-	0x28, 0x9c, 0xe6, 0xad, 0x96, 0x50, 0x59, 0x80, 0x4c, 0xbd, 0x69, 0xbe, 0x82, 0xbe, 0x94, 0xc2,
-	0x00, 0xd9, 0x8e, 0x6c, 0x7f, 0x39, 0x27, 0xda, 0xf5, 0xa6, 0xf9, 0xf2, 0x1a, 0x7a, 0x3b, 0x25,
-	0x86, 0xed, 0x84, 0x9d, 0xaf, 0x44, 0x8c, 0x95, 0x55, 0xf4, 0xd5, 0x98, 0xb1, 0xb2, 0x8a, 0xbe,
-	0x16, 0x31, 0xae, 0xae, 0xa0, 0xaf, 0xc7, 0x8c, 0xab, 0x2b, 0xe8, 0x1b, 0x11, 0x63, 0x6d, 0x15,
-	0xbd, 0x13, 0x33, 0xd6, 0x56, 0xd1, 0x37, 0x73, 0x02, 0x8b, 0x44, 0x72, 0x75, 0x05, 0x7d, 0x2b,
-	0x1f, 0xf7, 0xd6, 0x56, 0xd1, 0xb7, 0xf3, 0x78, 0x0e, 0x0a, 0x66, 0x7d, 0xcb, 0x68, 0x9b, 0xd5,
-	0xad, 0x16, 0xfa, 0x0e, 0x12, 0x66, 0x6e, 0x54, 0x4d, 0x03, 0x7d, 0x57, 0x36, 0x05, 0x0b, 0x7d,
-	0x0f, 0x09, 0x8c, 0x82, 0x2a, 0xbb, 0xef, 0x4a, 0xce, 0x2d, 0xa3, 0x4a, 0xd0, 0xf7, 0x73, 0xb8,
-	0x08, 0x33, 0x1b, 0x46, 0xad, 0xbe, 0x55, 0x6d, 0x20, 0x2c, 0xbf, 0x10, 0x5e, 0xf9, 0xc1, 0x15,
-	0xd1, 0x5c, 0x6f, 0x6c, 0xaf, 0xa3, 0x1f, 0xb6, 0x84, 0xc2, 0x9b, 0x55, 0x52, 0x7b, 0xa3, 0x4a,
-	0xd0, 0x8f, 0xae, 0x08, 0x85, 0x37, 0xab, 0x44, 0xf9, 0xeb, 0xc7, 0x2d, 0x21, 0x28, 0x59, 0xef,
-	0x5d, 0x11, 0x46, 0x2b, 0xfa, 0x4f, 0x5a, 0x38, 0x0f, 0xe9, 0xf5, 0xba, 0x89, 0x7e, 0x2a, 0xb5,
-	0x19, 0xcd, 0xce, 0x16, 0xfa, 0x19, 0x12, 0xc4, 0xb6, 0x61, 0xa2, 0x9f, 0x0b, 0x62, 0xd6, 0xec,
-	0xb4, 0x1a, 0x06, 0x7a, 0x4a, 0x18, 0x77, 0xcd, 0xd8, 0xde, 0x32, 0x4c, 0x72, 0x0b, 0xfd, 0x42,
-	0x8a, 0x7f, 0xbe, 0xbd, 0xdd, 0x44, 0xf7, 0xd1, 0xe5, 0x4d, 0x40, 0x47, 0x33, 0x4d, 0x18, 0xdc,
-	0x69, 0x5e, 0x6f, 0x6e, 0xbf, 0xd9, 0x44, 0x67, 0x44, 0xa7, 0x45, 0x8c, 0x56, 0x95, 0x18, 0x48,
-	0xc3, 0x00, 0xb9, 0xda, 0xf6, 0xd6, 0x56, 0xdd, 0x44, 0x29, 0x3c, 0x0b, 0x79, 0xb2, 0xdd, 0x68,
-	0xac, 0x57, 0x6b, 0xd7, 0x51, 0x7a, 0x7d, 0x09, 0x2a, 0x5d, 0x77, 0xb0, 0x3c, 0x72, 0x87, 0x7c,
-	0xb8, 0xc3, 0x96, 0x0f, 0x6c, 0xce, 0x82, 0x20, 0xfc, 0x7b, 0xce, 0x4e, 0x4e, 0xfe, 0x5c, 0xfd,
-	0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x47, 0x64, 0x07, 0x53, 0xd8, 0x23, 0x00, 0x00,
+	// 2457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xec, 0x5a, 0x5b, 0x73, 0x1b, 0x49,
+	0x15, 0xce, 0xe8, 0x66, 0xe9, 0xc8, 0xb2, 0xdb, 0x2d, 0x27, 0xd1, 0x3a, 0x59, 0x36, 0xcc, 0x6e,
+	0x76, 0xb3, 0xde, 0x60, 0x12, 0xc7, 0x98, 0x54, 0x58, 0x20, 0xb2, 0x2c, 0x67, 0x45, 0x64, 0x59,
+	0x69, 0x8d, 0xb2, 0x84, 0xa2, 0x6a, 0xaa, 0x2d, 0xb5, 0xed, 0x29, 0x4b, 0x33, 0xca, 0x4c, 0xcb,
+	0x8e, 0xde, 0x02, 0xcb, 0x6d, 0x97, 0x5b, 0x28, 0x2e, 0xcb, 0xa5, 0x08, 0xfc, 0x02, 0xfe, 0x01,
+	0x55, 0xd4, 0xfe, 0x00, 0xde, 0x78, 0x81, 0x07, 0x1e, 0x28, 0x8a, 0xe2, 0x8d, 0x67, 0x1e, 0x28,
+	0xaa, 0x7b, 0x7a, 0x46, 0x23, 0x5b, 0x49, 0xbc, 0x0b, 0x2f, 0x76, 0xf6, 0x49, 0xdd, 0xe7, 0x9c,
+	0xe9, 0xd3, 0xe7, 0x3b, 0x97, 0xbe, 0x09, 0xb2, 0xf7, 0xfb, 0xcc, 0x1d, 0x2c, 0xf4, 0x5c, 0x87,
+	0x3b, 0x38, 0x29, 0x3b, 0x73, 0x53, 0xdc, 0xe9, 0x39, 0x6d, 0xca, 0xa9, 0x4f, 0x9e, 0xcb, 0xee,
+	0x71, 0xb7, 0xd7, 0xf2, 0x3b, 0xfa, 0x7d, 0x48, 0x19, 0xd4, 0xdd, 0x66, 0x1c, 0xcf, 0x41, 0x7a,
+	0x97, 0x0d, 0xbc, 0x1e, 0x6d, 0xb1, 0x82, 0x76, 0x41, 0xbb, 0x94, 0x21, 0x61, 0x1f, 0xcf, 0x42,
+	0xd2, 0xdb, 0xa1, 0x6e, 0xbb, 0x10, 0x93, 0x0c, 0xbf, 0x83, 0x3f, 0x03, 0x59, 0x4e, 0x37, 0x3b,
+	0x8c, 0x9b, 0x7c, 0xd0, 0x63, 0x85, 0xf8, 0x05, 0xed, 0xd2, 0xd4, 0xe2, 0xec, 0x42, 0xa8, 0xce,
+	0x90, 0x4c, 0x63, 0xd0, 0x63, 0x04, 0x78, 0xd8, 0xd6, 0x2f, 0xc3, 0xd4, 0x5d, 0xe3, 0x16, 0xe5,
+	0xac, 0x44, 0x3b, 0x1d, 0xe6, 0x56, 0x56, 0x85, 0xea, 0xbe, 0xc7, 0x5c, 0x9b, 0x76, 0x43, 0xd5,
+	0x41, 0x5f, 0xff, 0x2a, 0x40, 0x79, 0x8f, 0xd9, 0xdc, 0x70, 0x76, 0x99, 0x8d, 0xcf, 0x43, 0x86,
+	0x5b, 0x5d, 0xe6, 0x71, 0xda, 0xed, 0x49, 0xd1, 0x38, 0x19, 0x12, 0x9e, 0x30, 0xcd, 0x39, 0x48,
+	0xf7, 0x1c, 0xcf, 0xe2, 0x96, 0x63, 0xcb, 0x39, 0x66, 0x48, 0xd8, 0xd7, 0xbf, 0x00, 0xc9, 0xbb,
+	0xb4, 0xd3, 0x67, 0xf8, 0x25, 0x48, 0x48, 0x23, 0x34, 0x69, 0x44, 0x76, 0xc1, 0xc7, 0x51, 0xce,
+	0x5d, 0x32, 0xc4, 0xd8, 0x7b, 0x42, 0x52, 0x8e, 0x3d, 0x49, 0xfc, 0x8e, 0xbe, 0x0b, 0x93, 0x2b,
+	0x96, 0xdd, 0xbe, 0x4b, 0x5d, 0x4b, 0x18, 0xf8, 0x11, 0x87, 0xc1, 0xaf, 0x40, 0x4a, 0x36, 0xbc,
+	0x42, 0xfc, 0x42, 0xfc, 0x52, 0x76, 0x71, 0x52, 0x7d, 0x28, 0xe7, 0x46, 0x14, 0x4f, 0xff, 0x40,
+	0x03, 0x58, 0x71, 0xfa, 0x76, 0xfb, 0x8e, 0x60, 0x62, 0x04, 0x71, 0xef, 0x7e, 0x47, 0x01, 0x26,
+	0x9a, 0xf8, 0x36, 0x4c, 0x6d, 0x5a, 0x76, 0xdb, 0xdc, 0x53, 0xd3, 0xf1, 0x0a, 0x31, 0x39, 0xdc,
+	0x2b, 0x6a, 0xb8, 0xe1, 0xc7, 0x0b, 0xd1, 0x59, 0x7b, 0x65, 0x9b, 0xbb, 0x03, 0x92, 0xdb, 0x8c,
+	0xd2, 0xe6, 0x9a, 0x80, 0x0f, 0x0b, 0x09, 0xa5, 0xbb, 0x6c, 0x10, 0x28, 0xdd, 0x65, 0x03, 0xfc,
+	0x7a, 0xd4, 0xa2, 0xec, 0x62, 0x3e, 0xd0, 0x15, 0xf9, 0x56, 0x99, 0x79, 0x23, 0x76, 0x5d, 0xd3,
+	0xdf, 0x8b, 0xc1, 0x54, 0xf9, 0x01, 0x6b, 0xf5, 0x39, 0xdb, 0xe8, 0x09, 0x1f, 0x78, 0x78, 0x01,
+	0xf2, 0xec, 0x41, 0xab, 0xd3, 0x6f, 0x33, 0x73, 0xcb, 0x62, 0x9d, 0xb6, 0x29, 0x1c, 0xef, 0x49,
+	0x1d, 0x69, 0x32, 0xa3, 0x58, 0x6b, 0x82, 0x53, 0x13, 0x0c, 0x21, 0x6f, 0xd9, 0xbe, 0x3c, 0x13,
+	0xa1, 0x61, 0x72, 0x11, 0x1b, 0x52, 0x7f, 0x9a, 0xcc, 0x28, 0x56, 0x24, 0x68, 0x8a, 0x90, 0x6f,
+	0x39, 0xdd, 0x1e, 0x75, 0x47, 0xe5, 0xe3, 0x72, 0xbe, 0x33, 0x6a, 0xbe, 0x43, 0x79, 0x32, 0xa3,
+	0xa4, 0x23, 0x43, 0xcc, 0xc3, 0xcc, 0x3e, 0xb5, 0xb8, 0xb9, 0xe5, 0xb8, 0x66, 0x18, 0x4c, 0x09,
+	0x09, 0xc2, 0xb4, 0x60, 0xac, 0x39, 0x6e, 0x5d, 0x91, 0xf1, 0xeb, 0x80, 0x82, 0xe9, 0x85, 0xa2,
+	0x49, 0x39, 0xb7, 0x69, 0x45, 0x0f, 0x44, 0xf5, 0x37, 0x21, 0x29, 0xed, 0xc2, 0x18, 0x12, 0x91,
+	0xe8, 0x97, 0xed, 0x30, 0x96, 0x62, 0x4f, 0x88, 0x25, 0xfd, 0xb3, 0x10, 0x27, 0xce, 0x3e, 0x2e,
+	0xc0, 0x44, 0x87, 0xd9, 0xdb, 0x7c, 0x47, 0x40, 0x16, 0xbf, 0x84, 0x49, 0xd0, 0xc5, 0x67, 0xc2,
+	0xb0, 0xf2, 0xa3, 0x2d, 0x08, 0xa4, 0x07, 0x30, 0x49, 0x98, 0xd7, 0xef, 0xf0, 0xf2, 0x03, 0xee,
+	0x52, 0x0f, 0x2f, 0x42, 0x36, 0x0a, 0x8c, 0xf6, 0x24, 0x60, 0x80, 0x85, 0x6d, 0xa1, 0x75, 0xcb,
+	0x65, 0xde, 0x0e, 0x73, 0x15, 0xf0, 0x41, 0xf7, 0xa9, 0xf9, 0xf6, 0x81, 0x06, 0x59, 0x19, 0x80,
+	0xbe, 0x7e, 0x11, 0xf8, 0xd2, 0xe5, 0xfe, 0xd4, 0x87, 0x81, 0x2f, 0x51, 0x21, 0x8a, 0x87, 0x5f,
+	0x86, 0x9c, 0xeb, 0xec, 0x7b, 0x26, 0xdd, 0xda, 0x62, 0x2d, 0xce, 0xfc, 0xfc, 0x4e, 0x90, 0x49,
+	0x41, 0x2c, 0x2a, 0x1a, 0x3e, 0x07, 0x19, 0xcb, 0xf6, 0x98, 0xcb, 0x4d, 0xab, 0x2d, 0xf5, 0x26,
+	0x48, 0xda, 0x27, 0x54, 0xda, 0xf8, 0x13, 0x90, 0x10, 0xc2, 0x85, 0x84, 0xd4, 0x02, 0x4a, 0x0b,
+	0x71, 0xf6, 0x89, 0xa4, 0xe3, 0x37, 0x20, 0xc5, 0x24, 0x16, 0x85, 0xe4, 0x48, 0x14, 0x47, 0x61,
+	0x22, 0x4a, 0x44, 0xff, 0x6d, 0x1c, 0xb2, 0x0d, 0xee, 0x32, 0xda, 0x95, 0xd8, 0xe0, 0x37, 0x01,
+	0x3c, 0x4e, 0x39, 0xeb, 0x32, 0x9b, 0x07, 0x86, 0x9c, 0x57, 0x03, 0x44, 0xe4, 0x16, 0x1a, 0x81,
+	0x10, 0x89, 0xc8, 0x1f, 0x04, 0x3f, 0x76, 0x04, 0xf0, 0xe7, 0x1e, 0xc7, 0x20, 0x13, 0x8e, 0x86,
+	0x8b, 0x90, 0x6e, 0x51, 0xce, 0xb6, 0x1d, 0x77, 0xa0, 0x0a, 0xcf, 0xc5, 0xa7, 0x69, 0x5f, 0x28,
+	0x29, 0x61, 0x12, 0x7e, 0x86, 0x5f, 0x04, 0xbf, 0x42, 0xcb, 0xd4, 0x53, 0xe5, 0x33, 0x23, 0x29,
+	0x22, 0xe5, 0xf0, 0x0d, 0xc0, 0x3d, 0xd7, 0xea, 0x52, 0x77, 0x60, 0xee, 0xb2, 0x81, 0xa9, 0x5c,
+	0x16, 0x1f, 0xe3, 0x32, 0xa4, 0xe4, 0x6e, 0xb3, 0xc1, 0x9a, 0xef, 0xbc, 0xeb, 0xa3, 0xdf, 0xaa,
+	0x80, 0x3c, 0xec, 0x88, 0xc8, 0x97, 0xb2, 0xec, 0x79, 0x41, 0x81, 0x4b, 0xca, 0xd8, 0x15, 0x4d,
+	0xfd, 0x35, 0x48, 0x07, 0x93, 0xc7, 0x19, 0x48, 0x96, 0x5d, 0xd7, 0x71, 0xd1, 0x29, 0x3c, 0x01,
+	0xf1, 0xd5, 0xf5, 0x2a, 0xd2, 0x64, 0x63, 0xb5, 0x8a, 0x62, 0xfa, 0x1f, 0x86, 0x55, 0x86, 0xb0,
+	0xfb, 0x7d, 0xe6, 0x71, 0xfc, 0x45, 0xc8, 0x33, 0x19, 0x2b, 0xd6, 0x1e, 0x33, 0x5b, 0x72, 0xe9,
+	0x11, 0x91, 0xe2, 0x07, 0xfb, 0xf4, 0x82, 0xbf, 0x28, 0x06, 0x4b, 0x12, 0x99, 0x09, 0x65, 0x15,
+	0xa9, 0x8d, 0xcb, 0x90, 0xb7, 0xba, 0x5d, 0xd6, 0xb6, 0x28, 0x8f, 0x0e, 0xe0, 0x3b, 0xec, 0x74,
+	0x50, 0xb1, 0x47, 0x56, 0x36, 0x32, 0x13, 0x7e, 0x11, 0x0e, 0x73, 0x11, 0x52, 0x5c, 0xae, 0xb8,
+	0xaa, 0x00, 0xe5, 0x82, 0xc4, 0x96, 0x44, 0xa2, 0x98, 0xf8, 0x35, 0xf0, 0x97, 0x6f, 0x59, 0x65,
+	0x86, 0x01, 0x31, 0x2c, 0xe1, 0xc4, 0xe7, 0xe3, 0x8b, 0x30, 0xc5, 0x5d, 0x6a, 0x7b, 0xb4, 0x25,
+	0x32, 0x4c, 0xcc, 0x28, 0x29, 0xd7, 0xc5, 0x5c, 0x84, 0x5a, 0x69, 0xe3, 0x4f, 0xc3, 0x84, 0xe3,
+	0xd7, 0xdb, 0x42, 0x6a, 0x64, 0xc6, 0xa3, 0xc5, 0x98, 0x04, 0x52, 0xfa, 0xe7, 0x61, 0x3a, 0x44,
+	0xd0, 0xeb, 0x39, 0xb6, 0xc7, 0xf0, 0x3c, 0xa4, 0x5c, 0x99, 0x10, 0x0a, 0x35, 0xac, 0x86, 0x88,
+	0x64, 0x34, 0x51, 0x12, 0x7a, 0x1b, 0xa6, 0x7d, 0xca, 0xdb, 0x16, 0xdf, 0x91, 0x8e, 0xc2, 0x17,
+	0x21, 0xc9, 0x44, 0xe3, 0x00, 0xe6, 0xa4, 0x5e, 0x92, 0x7c, 0xe2, 0x73, 0x23, 0x5a, 0x62, 0xcf,
+	0xd4, 0xf2, 0xaf, 0x18, 0xe4, 0xd5, 0x2c, 0x57, 0x28, 0x6f, 0xed, 0x1c, 0x53, 0x67, 0xbf, 0x01,
+	0x13, 0x82, 0x6e, 0x85, 0x89, 0x31, 0xc6, 0xdd, 0x81, 0x84, 0x70, 0x38, 0xf5, 0xcc, 0x88, 0x77,
+	0xd5, 0xea, 0x92, 0xa3, 0x9e, 0x31, 0x24, 0x8e, 0x89, 0x8b, 0xd4, 0x33, 0xe2, 0x62, 0xe2, 0x48,
+	0x71, 0xb1, 0x0a, 0xb3, 0xa3, 0x88, 0xab, 0xe0, 0xb8, 0x0c, 0x13, 0xbe, 0x53, 0x82, 0x12, 0x38,
+	0xce, 0x6f, 0x81, 0x88, 0xfe, 0x9b, 0x18, 0xcc, 0xaa, 0xea, 0xf4, 0x7c, 0xa4, 0x69, 0x04, 0xe7,
+	0xe4, 0x91, 0x70, 0x2e, 0xc1, 0xe9, 0x03, 0x00, 0x7d, 0x84, 0x2c, 0xfc, 0xbd, 0x06, 0x93, 0x2b,
+	0x6c, 0xdb, 0xb2, 0x8f, 0x27, 0xbc, 0xfa, 0x32, 0xe4, 0xd4, 0xf4, 0x95, 0xf1, 0x87, 0xa3, 0x5a,
+	0x1b, 0x13, 0xd5, 0xfa, 0xdf, 0x35, 0xc8, 0x95, 0x9c, 0x6e, 0xd7, 0xe2, 0xc7, 0x34, 0xae, 0x0e,
+	0xdb, 0x99, 0x18, 0x67, 0xe7, 0x65, 0x98, 0x0a, 0xcc, 0x54, 0x00, 0x45, 0x77, 0x5f, 0xda, 0x81,
+	0xdd, 0xd7, 0x3f, 0x34, 0x98, 0x26, 0x4e, 0xa7, 0xb3, 0x49, 0x5b, 0xbb, 0x27, 0x1b, 0x17, 0x0c,
+	0x68, 0x68, 0xa8, 0x8f, 0x8c, 0xfe, 0x6f, 0x0d, 0xa6, 0xea, 0x2e, 0xeb, 0x51, 0x97, 0x9d, 0x68,
+	0xe3, 0xc5, 0x61, 0xa2, 0xcd, 0xd5, 0x3e, 0x20, 0x43, 0x64, 0x5b, 0x9f, 0x81, 0xe9, 0xd0, 0x76,
+	0x85, 0xc7, 0x9f, 0x35, 0x38, 0xed, 0x07, 0x8f, 0xe2, 0xb4, 0x8f, 0x29, 0x2c, 0x81, 0xbd, 0x89,
+	0x88, 0xbd, 0x4b, 0x70, 0xe6, 0xa0, 0x6d, 0x47, 0x48, 0x90, 0x77, 0x62, 0x70, 0x36, 0x88, 0x9b,
+	0x63, 0x0e, 0xca, 0xff, 0x10, 0x2b, 0x73, 0x50, 0x38, 0x0c, 0x82, 0x0a, 0x9a, 0x47, 0x31, 0x28,
+	0x94, 0x5c, 0x46, 0x39, 0x8b, 0xec, 0x35, 0x4e, 0x4e, 0xdc, 0xe0, 0xab, 0x30, 0xd9, 0xa3, 0x2e,
+	0xb7, 0x5a, 0x56, 0x8f, 0x8a, 0xd3, 0x5c, 0xf2, 0x42, 0xfc, 0xf0, 0x00, 0x23, 0x22, 0xfa, 0x39,
+	0x78, 0x61, 0x0c, 0x22, 0x0a, 0xaf, 0xff, 0x68, 0x80, 0x1b, 0x9c, 0xba, 0xfc, 0x39, 0x58, 0x8d,
+	0xc6, 0x06, 0xd3, 0x55, 0xc8, 0x8f, 0xd8, 0x7f, 0x84, 0x2c, 0x94, 0x98, 0x31, 0xfe, 0x5c, 0xac,
+	0x54, 0x63, 0x31, 0x3b, 0x0d, 0xf9, 0x11, 0xfb, 0x55, 0x2c, 0xfd, 0x55, 0x83, 0xb9, 0x92, 0xe3,
+	0x5f, 0x21, 0x9d, 0xc8, 0xec, 0xd3, 0x5f, 0x84, 0x73, 0x63, 0x0d, 0x54, 0x00, 0xfc, 0x45, 0x83,
+	0x33, 0x84, 0xd1, 0xf6, 0xc9, 0x34, 0xfe, 0x0e, 0x9c, 0x3d, 0x64, 0x9c, 0xca, 0x96, 0x65, 0x48,
+	0x77, 0x19, 0xa7, 0x6d, 0xca, 0xa9, 0x32, 0x69, 0x2e, 0x18, 0x77, 0x28, 0xbd, 0xae, 0x24, 0x48,
+	0x28, 0xab, 0x3f, 0x8e, 0x41, 0x5e, 0xee, 0x9f, 0x3f, 0x3e, 0x64, 0x8d, 0x3f, 0x64, 0x3d, 0xd2,
+	0x60, 0x76, 0x14, 0xa0, 0xf0, 0x9c, 0xf1, 0xff, 0xbe, 0xab, 0x18, 0x53, 0x10, 0xe2, 0xe3, 0xb6,
+	0xae, 0x7f, 0x8c, 0x41, 0x21, 0x3a, 0xa5, 0x8f, 0xef, 0x35, 0x46, 0xef, 0x35, 0x3e, 0xf4, 0x45,
+	0xd6, 0xfb, 0x1a, 0xbc, 0x30, 0x06, 0xd0, 0x0f, 0xe7, 0xe8, 0xc8, 0xed, 0x46, 0xec, 0x99, 0xb7,
+	0x1b, 0x47, 0x75, 0xf5, 0xdf, 0xe2, 0x30, 0xd3, 0xe8, 0x75, 0x2c, 0xae, 0x06, 0x39, 0xd9, 0xc9,
+	0xf9, 0x49, 0x98, 0xf4, 0x84, 0xb1, 0x66, 0xcb, 0xe9, 0xf4, 0xbb, 0xb6, 0xdc, 0x5a, 0x65, 0x48,
+	0x56, 0xd2, 0x4a, 0x92, 0x84, 0x5f, 0x82, 0x6c, 0x20, 0xd2, 0xb7, 0xb9, 0xba, 0xb0, 0x02, 0x25,
+	0xd1, 0xb7, 0x39, 0x5e, 0x82, 0xb3, 0x76, 0xbf, 0x6b, 0xca, 0xd7, 0x80, 0x1e, 0x73, 0x4d, 0x39,
+	0xb2, 0x29, 0xb6, 0x63, 0x85, 0xb4, 0x14, 0xce, 0xdb, 0xfd, 0x2e, 0x71, 0xf6, 0xbd, 0x3a, 0x73,
+	0xa5, 0xf2, 0x3a, 0x75, 0x39, 0xbe, 0x09, 0x19, 0xda, 0xd9, 0x76, 0x5c, 0x8b, 0xef, 0x74, 0x0b,
+	0x19, 0x79, 0x43, 0xae, 0x07, 0x37, 0xe4, 0x07, 0xe1, 0x5f, 0x28, 0x06, 0x92, 0x64, 0xf8, 0x91,
+	0x7e, 0x03, 0x32, 0x21, 0x1d, 0x23, 0x98, 0x2c, 0xdf, 0x69, 0x16, 0xab, 0x66, 0xa3, 0x5e, 0xad,
+	0x18, 0x0d, 0x74, 0x0a, 0xe7, 0x20, 0xb3, 0xd6, 0xac, 0x56, 0xcd, 0x46, 0xa9, 0x58, 0x43, 0x1a,
+	0x9e, 0x84, 0x74, 0xa3, 0xb8, 0x5e, 0xaf, 0x56, 0x6a, 0xb7, 0x50, 0x4c, 0x27, 0x00, 0x52, 0x81,
+	0x54, 0x35, 0x84, 0x4b, 0x7b, 0x06, 0x5c, 0xe7, 0x20, 0xe3, 0x3a, 0xfb, 0x0a, 0x89, 0x98, 0x34,
+	0x2e, 0xed, 0x3a, 0xfb, 0x12, 0x07, 0xbd, 0x08, 0x38, 0x3a, 0x73, 0x15, 0xcb, 0x91, 0x74, 0xd3,
+	0x46, 0xd2, 0x6d, 0xa8, 0x3f, 0x4c, 0x37, 0xb9, 0xc9, 0x90, 0xf7, 0x4b, 0x6f, 0x31, 0xda, 0xe1,
+	0x41, 0x85, 0xd1, 0xff, 0x14, 0x83, 0x1c, 0x11, 0x14, 0xab, 0xcb, 0xc4, 0x93, 0x81, 0x27, 0xfc,
+	0xb6, 0x23, 0x45, 0xcc, 0x61, 0xa2, 0x64, 0x48, 0xd6, 0xa7, 0xf9, 0x37, 0xbb, 0x8b, 0x70, 0xda,
+	0x63, 0x2d, 0xc7, 0x6e, 0x7b, 0xe6, 0x26, 0xdb, 0x11, 0x4f, 0x90, 0x5d, 0xea, 0x71, 0xf5, 0x34,
+	0x94, 0x23, 0x79, 0xc5, 0x5c, 0x91, 0xbc, 0x75, 0xc9, 0xc2, 0x57, 0x60, 0x76, 0xd3, 0xb2, 0x3b,
+	0xce, 0xb6, 0xd9, 0xeb, 0xd0, 0x01, 0x73, 0x3d, 0x65, 0xaa, 0x08, 0xb6, 0x24, 0xc1, 0x3e, 0xaf,
+	0xee, 0xb3, 0x7c, 0xe7, 0x7f, 0x05, 0xe6, 0xc7, 0x6a, 0x31, 0xb7, 0xac, 0x0e, 0x67, 0x2e, 0x6b,
+	0x9b, 0x2e, 0xeb, 0x75, 0xac, 0x16, 0x0d, 0x5f, 0xe7, 0xe2, 0xe4, 0xd5, 0x31, 0xaa, 0xd7, 0x94,
+	0x38, 0x19, 0x4a, 0x0b, 0xb4, 0x5b, 0xbd, 0xbe, 0xd9, 0xf7, 0xe8, 0x36, 0x93, 0x75, 0x47, 0x23,
+	0xe9, 0x56, 0xaf, 0xdf, 0x14, 0x7d, 0xf1, 0x10, 0x71, 0xbf, 0xe7, 0x97, 0x1b, 0x8d, 0x88, 0xa6,
+	0x98, 0xbc, 0xd2, 0xdd, 0x66, 0x1d, 0x3a, 0x30, 0x95, 0x16, 0x79, 0x85, 0x9a, 0x23, 0xd8, 0xe7,
+	0xad, 0x0a, 0x56, 0xc3, 0xe7, 0xe8, 0xff, 0xd4, 0x60, 0x76, 0x14, 0xef, 0xb0, 0x00, 0x05, 0x69,
+	0xa6, 0x3d, 0x2d, 0xcd, 0x0a, 0x30, 0xe1, 0x31, 0x77, 0xcf, 0xb2, 0xb7, 0x83, 0xf7, 0x36, 0xd5,
+	0xc5, 0x0d, 0x78, 0x55, 0x3d, 0xc3, 0xb3, 0x07, 0x9c, 0xb9, 0x36, 0xed, 0x74, 0x06, 0xa6, 0x7f,
+	0x6e, 0xb3, 0x39, 0x6b, 0x9b, 0xc3, 0x07, 0x73, 0xbf, 0x08, 0xbd, 0xec, 0x4b, 0x97, 0x43, 0x61,
+	0x12, 0xca, 0x1a, 0x81, 0x28, 0xfe, 0x1c, 0x4c, 0xb9, 0x2a, 0x0a, 0x4c, 0x4f, 0x84, 0x81, 0x4a,
+	0xef, 0xd9, 0xf0, 0x61, 0x2c, 0x12, 0x22, 0x24, 0xe7, 0x46, 0xbb, 0x62, 0x03, 0x9f, 0x6f, 0xf6,
+	0xda, 0x94, 0x33, 0xdf, 0xe2, 0x63, 0x5a, 0xd9, 0xa2, 0x67, 0x94, 0xc4, 0xe8, 0x19, 0x65, 0xf4,
+	0x8f, 0x08, 0xc9, 0x03, 0x7f, 0x44, 0xd0, 0x6f, 0xc2, 0xec, 0xa8, 0xfd, 0xca, 0xd7, 0x97, 0x20,
+	0x29, 0x5f, 0xf1, 0x0e, 0xdc, 0xdc, 0x46, 0x9e, 0xe9, 0x88, 0x2f, 0xa0, 0xff, 0x4e, 0x83, 0xfc,
+	0x98, 0xbd, 0x5d, 0xb8, 0x71, 0xd4, 0x22, 0x67, 0xd6, 0x4f, 0x41, 0x52, 0xb8, 0x28, 0x78, 0x29,
+	0x3e, 0x7b, 0x78, 0x6b, 0x28, 0xdc, 0xc2, 0x88, 0x2f, 0x25, 0xf2, 0x59, 0xba, 0xb5, 0x25, 0x0f,
+	0xad, 0xc1, 0xd2, 0x94, 0x15, 0x34, 0xff, 0x1c, 0x7b, 0xf8, 0x14, 0x9c, 0x78, 0xe6, 0x29, 0x78,
+	0x7e, 0x17, 0x12, 0x6b, 0x1d, 0xba, 0x8d, 0xd3, 0x90, 0xa8, 0x6d, 0xd4, 0xca, 0xe8, 0x14, 0x9e,
+	0x06, 0xa8, 0x34, 0x2a, 0x35, 0xa3, 0x7c, 0x8b, 0x14, 0xab, 0xe8, 0x61, 0xcc, 0x27, 0x34, 0x6b,
+	0x8d, 0xca, 0xad, 0x5a, 0x79, 0x15, 0x3d, 0x4c, 0xe0, 0x49, 0x98, 0xa8, 0x34, 0xd6, 0xaa, 0x1b,
+	0x45, 0x03, 0x3d, 0x4c, 0xe3, 0x1c, 0xa4, 0x2b, 0x8d, 0x3b, 0xcd, 0x0d, 0x43, 0x30, 0x11, 0xce,
+	0x42, 0xaa, 0xd2, 0x30, 0xca, 0x5f, 0x36, 0xd0, 0xc3, 0x0b, 0x3e, 0x6f, 0xa5, 0x52, 0x2b, 0x92,
+	0x7b, 0xe8, 0xe1, 0xcd, 0xf9, 0x77, 0xe3, 0x90, 0x10, 0x0f, 0xe1, 0xa2, 0xf0, 0xd6, 0x44, 0xe1,
+	0x35, 0xee, 0xd5, 0x85, 0xca, 0x0c, 0x24, 0x2a, 0x35, 0xe3, 0x3a, 0xfa, 0x5a, 0x0c, 0x03, 0x24,
+	0x9b, 0xb2, 0xfd, 0xf5, 0x94, 0x68, 0x57, 0x6a, 0xc6, 0xd5, 0x65, 0xf4, 0x4e, 0x4c, 0x0c, 0xdb,
+	0xf4, 0x3b, 0xdf, 0x08, 0x18, 0x8b, 0x4b, 0xe8, 0x9b, 0x21, 0x63, 0x71, 0x09, 0x7d, 0x2b, 0x60,
+	0x5c, 0x5b, 0x44, 0xdf, 0x0e, 0x19, 0xd7, 0x16, 0xd1, 0x77, 0x02, 0xc6, 0xf2, 0x12, 0x7a, 0x37,
+	0x64, 0x2c, 0x2f, 0xa1, 0xf7, 0x52, 0xc2, 0x16, 0x69, 0xc9, 0xb5, 0x45, 0xf4, 0xdd, 0x74, 0xd8,
+	0x5b, 0x5e, 0x42, 0xdf, 0x4b, 0xe3, 0x29, 0xc8, 0x18, 0x95, 0xf5, 0x72, 0xc3, 0x28, 0xae, 0xd7,
+	0xd1, 0xf7, 0x91, 0x98, 0xe6, 0x6a, 0xd1, 0x28, 0xa3, 0x1f, 0xc8, 0xa6, 0x60, 0xa1, 0x1f, 0x22,
+	0x61, 0xa3, 0xa0, 0xca, 0xee, 0x23, 0xc9, 0xb9, 0x57, 0x2e, 0x12, 0xf4, 0xa3, 0x14, 0xce, 0xc2,
+	0xc4, 0x6a, 0xb9, 0x54, 0x59, 0x2f, 0x56, 0x11, 0x96, 0x5f, 0x08, 0x54, 0x7e, 0x7c, 0x45, 0x34,
+	0x57, 0xaa, 0x1b, 0x2b, 0xe8, 0x27, 0x75, 0xa1, 0xf0, 0x6e, 0x91, 0x94, 0xde, 0x2a, 0x12, 0xf4,
+	0xd3, 0x2b, 0x42, 0xe1, 0xdd, 0x22, 0x51, 0x78, 0xfd, 0xac, 0x2e, 0x04, 0x25, 0xeb, 0xfd, 0x2b,
+	0x62, 0xd2, 0x8a, 0xfe, 0xf3, 0x3a, 0x4e, 0x43, 0x7c, 0xa5, 0x62, 0xa0, 0x5f, 0x48, 0x6d, 0xe5,
+	0x5a, 0x73, 0x1d, 0xfd, 0x12, 0x09, 0x62, 0xa3, 0x6c, 0xa0, 0x5f, 0x09, 0x62, 0xd2, 0x68, 0xd6,
+	0xab, 0x65, 0x74, 0x5e, 0x4c, 0xee, 0x56, 0x79, 0x63, 0xbd, 0x6c, 0x90, 0x7b, 0xe8, 0xd7, 0x52,
+	0xfc, 0x4b, 0x8d, 0x8d, 0x1a, 0x7a, 0x8c, 0xe6, 0xd7, 0x00, 0x1d, 0x8c, 0x34, 0x31, 0xe1, 0x66,
+	0xed, 0x76, 0x6d, 0xe3, 0xed, 0x1a, 0x3a, 0x25, 0x3a, 0x75, 0x52, 0xae, 0x17, 0x49, 0x19, 0x69,
+	0x18, 0x20, 0x55, 0xda, 0x58, 0x5f, 0xaf, 0x18, 0x28, 0x26, 0x96, 0x49, 0xb2, 0x51, 0xad, 0xae,
+	0x14, 0x4b, 0xb7, 0x51, 0x7c, 0x65, 0x0e, 0x0a, 0x2d, 0xa7, 0xbb, 0x30, 0x70, 0xfa, 0xbc, 0xbf,
+	0xc9, 0x16, 0xf6, 0x2c, 0xce, 0x3c, 0xcf, 0xff, 0x97, 0xd2, 0x66, 0x4a, 0xfe, 0x5c, 0xfb, 0xef,
+	0x00, 0x47, 0x75, 0x3e, 0x13, 0xdf, 0x24, 0x00, 0x00,
 }
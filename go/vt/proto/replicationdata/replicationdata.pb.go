@@ -38,6 +38,9 @@ type Status struct {
 	MasterHost          string `protobuf:"bytes,5,opt,name=master_host,json=masterHost" json:"master_host,omitempty"`
 	MasterPort          int32  `protobuf:"varint,6,opt,name=master_port,json=masterPort" json:"master_port,omitempty"`
 	MasterConnectRetry  int32  `protobuf:"varint,7,opt,name=master_connect_retry,json=masterConnectRetry" json:"master_connect_retry,omitempty"`
+	// sql_delay is the MASTER_DELAY of the slave, in seconds. It is
+	// only set on delayed replicas.
+	SqlDelay uint32 `protobuf:"varint,8,opt,name=sql_delay,json=sqlDelay" json:"sql_delay,omitempty"`
 }
 
 func (m *Status) Reset()                    { *m = Status{} }
@@ -52,21 +55,21 @@ func init() {
 func init() { proto.RegisterFile("replicationdata.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 241 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x5c, 0xd0, 0xc1, 0x4a, 0x03, 0x31,
-	0x10, 0xc6, 0x71, 0x52, 0xed, 0x5a, 0x47, 0xb4, 0x1a, 0x2d, 0x04, 0x2f, 0x2e, 0x9e, 0x82, 0x07,
-	0x11, 0x7d, 0x03, 0xbd, 0xe8, 0x41, 0x90, 0xf4, 0x01, 0x42, 0xba, 0x1b, 0x6c, 0x60, 0xcd, 0x6c,
-	0x33, 0x53, 0xc1, 0xd7, 0xf1, 0x49, 0xa5, 0x49, 0xbb, 0x48, 0x8f, 0xf9, 0xfe, 0xbf, 0x43, 0x18,
-	0x98, 0x25, 0xdf, 0x77, 0xa1, 0x71, 0x1c, 0x30, 0xb6, 0x8e, 0xdd, 0x7d, 0x9f, 0x90, 0x51, 0x4e,
-	0xf7, 0xe6, 0xdb, 0xdf, 0x11, 0x54, 0x73, 0x76, 0xbc, 0x26, 0x79, 0x0d, 0x93, 0x1e, 0x29, 0x6c,
-	0x92, 0x12, 0xb5, 0xd0, 0xc7, 0x66, 0x78, 0x4b, 0x0d, 0xe7, 0xd4, 0xb9, 0x6f, 0x6f, 0x03, 0xda,
-	0xb4, 0x8e, 0x31, 0xc4, 0x4f, 0x35, 0xaa, 0x85, 0x9e, 0x98, 0xb3, 0xbc, 0xbf, 0xa1, 0x29, 0xab,
-	0xbc, 0x83, 0x8b, 0x22, 0x69, 0xd5, 0x0d, 0xf4, 0x20, 0xd3, 0x69, 0x0e, 0xf3, 0x55, 0xb7, 0xb3,
-	0x8f, 0x30, 0x23, 0xdf, 0x60, 0x6c, 0xc9, 0x2e, 0xfc, 0x32, 0xc4, 0xd6, 0x7e, 0x39, 0x62, 0x9f,
-	0xd4, 0x61, 0x2d, 0xf4, 0xa9, 0xb9, 0xdc, 0xc6, 0xe7, 0xdc, 0xde, 0x73, 0x92, 0x37, 0x70, 0x52,
-	0x90, 0x5d, 0x22, 0xb1, 0x1a, 0xe7, 0x8f, 0x42, 0x99, 0x5e, 0x91, 0xf8, 0x1f, 0xe8, 0x31, 0xb1,
-	0xaa, 0x6a, 0xa1, 0xc7, 0x3b, 0xf0, 0x81, 0x89, 0xe5, 0x03, 0x5c, 0x6d, 0x41, 0x83, 0x31, 0xfa,
-	0x86, 0x6d, 0xf2, 0x9c, 0x7e, 0xd4, 0x51, 0x96, 0xb2, 0xb4, 0x97, 0x92, 0xcc, 0xa6, 0x2c, 0xaa,
-	0x7c, 0xbc, 0xa7, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x13, 0x7f, 0xc4, 0x55, 0x01, 0x00,
-	0x00,
+	// 256 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x5c, 0x90, 0xc1, 0x4a, 0x03, 0x31,
+	0x10, 0x86, 0xd9, 0x6a, 0xd7, 0xed, 0x88, 0x56, 0xa3, 0x85, 0xa0, 0x07, 0x17, 0x4f, 0x8b, 0x07,
+	0x11, 0x7d, 0x03, 0xf5, 0xa0, 0x07, 0x41, 0xb6, 0x0f, 0x10, 0xd2, 0xdd, 0x60, 0x03, 0x31, 0xb3,
+	0x9b, 0x99, 0x0a, 0x7d, 0x3a, 0x5f, 0x4d, 0x36, 0x69, 0x8b, 0xf4, 0x38, 0xdf, 0xf7, 0x1d, 0xfe,
+	0x04, 0x66, 0xc1, 0x74, 0xce, 0x36, 0x9a, 0x2d, 0xfa, 0x56, 0xb3, 0xbe, 0xef, 0x02, 0x32, 0x8a,
+	0xe9, 0x1e, 0xbe, 0xfd, 0x1d, 0x41, 0x3e, 0x67, 0xcd, 0x2b, 0x12, 0x57, 0x50, 0x74, 0x48, 0x76,
+	0x50, 0x32, 0x2b, 0xb3, 0x6a, 0x52, 0xef, 0x6e, 0x51, 0xc1, 0x19, 0x39, 0xfd, 0x63, 0x94, 0x45,
+	0x15, 0x56, 0xde, 0x5b, 0xff, 0x25, 0x47, 0x65, 0x56, 0x15, 0xf5, 0x69, 0xe4, 0xef, 0x58, 0x27,
+	0x2a, 0xee, 0xe0, 0x3c, 0x95, 0xd4, 0xbb, 0x5d, 0x7a, 0x10, 0xd3, 0x69, 0x14, 0xf3, 0xde, 0x6d,
+	0xdb, 0x47, 0x98, 0x91, 0x69, 0xd0, 0xb7, 0xa4, 0x16, 0x66, 0x69, 0x7d, 0xab, 0xbe, 0x35, 0xb1,
+	0x09, 0xf2, 0xb0, 0xcc, 0xaa, 0x93, 0xfa, 0x62, 0x23, 0x9f, 0xa3, 0xfb, 0x88, 0x4a, 0xdc, 0xc0,
+	0x71, 0x8a, 0xd4, 0x12, 0x89, 0xe5, 0x38, 0x0e, 0x85, 0x84, 0xde, 0x90, 0xf8, 0x5f, 0xd0, 0x61,
+	0x60, 0x99, 0x97, 0x59, 0x35, 0xde, 0x06, 0x9f, 0x18, 0x58, 0x3c, 0xc0, 0xe5, 0x26, 0x68, 0xd0,
+	0x7b, 0xd3, 0xb0, 0x0a, 0x86, 0xc3, 0x5a, 0x1e, 0xc5, 0x52, 0x24, 0xf7, 0x92, 0x54, 0x3d, 0x18,
+	0x71, 0x0d, 0x93, 0xe1, 0x35, 0xad, 0x71, 0x7a, 0x2d, 0x8b, 0xb8, 0xad, 0xa0, 0xde, 0xbd, 0x0e,
+	0xf7, 0x22, 0x8f, 0x3f, 0xfb, 0xf4, 0x37, 0x00, 0x23, 0xfa, 0xbd, 0x3f, 0x72, 0x01, 0x00, 0x00,
 }
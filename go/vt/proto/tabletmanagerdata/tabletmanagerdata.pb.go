@@ -58,8 +58,12 @@ It has these top-level messages:
 	StopSlaveResponse
 	StopSlaveMinimumRequest
 	StopSlaveMinimumResponse
+	StopSlaveBeforeTimeRequest
+	StopSlaveBeforeTimeResponse
 	StartSlaveRequest
 	StartSlaveResponse
+	InjectEmptyTransactionsRequest
+	InjectEmptyTransactionsResponse
 	TabletExternallyReparentedRequest
 	TabletExternallyReparentedResponse
 	TabletExternallyElectedRequest
@@ -739,13 +743,32 @@ func (m *StopSlaveMinimumResponse) String() string            { return proto.Com
 func (*StopSlaveMinimumResponse) ProtoMessage()               {}
 func (*StopSlaveMinimumResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
 
+type StopSlaveBeforeTimeRequest struct {
+	// stop_time_ns is a time in nanoseconds since the epoch.
+	StopTimeNs int64 `protobuf:"varint,1,opt,name=stop_time_ns,json=stopTimeNs" json:"stop_time_ns,omitempty"`
+}
+
+func (m *StopSlaveBeforeTimeRequest) Reset()                    { *m = StopSlaveBeforeTimeRequest{} }
+func (m *StopSlaveBeforeTimeRequest) String() string            { return proto.CompactTextString(m) }
+func (*StopSlaveBeforeTimeRequest) ProtoMessage()               {}
+func (*StopSlaveBeforeTimeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+
+type StopSlaveBeforeTimeResponse struct {
+	Position string `protobuf:"bytes,1,opt,name=position" json:"position,omitempty"`
+}
+
+func (m *StopSlaveBeforeTimeResponse) Reset()                    { *m = StopSlaveBeforeTimeResponse{} }
+func (m *StopSlaveBeforeTimeResponse) String() string            { return proto.CompactTextString(m) }
+func (*StopSlaveBeforeTimeResponse) ProtoMessage()               {}
+func (*StopSlaveBeforeTimeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+
 type StartSlaveRequest struct {
 }
 
 func (m *StartSlaveRequest) Reset()                    { *m = StartSlaveRequest{} }
 func (m *StartSlaveRequest) String() string            { return proto.CompactTextString(m) }
 func (*StartSlaveRequest) ProtoMessage()               {}
-func (*StartSlaveRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+func (*StartSlaveRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
 
 type StartSlaveResponse struct {
 }
@@ -753,7 +776,25 @@ type StartSlaveResponse struct {
 func (m *StartSlaveResponse) Reset()                    { *m = StartSlaveResponse{} }
 func (m *StartSlaveResponse) String() string            { return proto.CompactTextString(m) }
 func (*StartSlaveResponse) ProtoMessage()               {}
-func (*StartSlaveResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+func (*StartSlaveResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+
+type InjectEmptyTransactionsRequest struct {
+	// gtids is the encoded replication position of the GTIDs to inject.
+	Gtids string `protobuf:"bytes,1,opt,name=gtids" json:"gtids,omitempty"`
+}
+
+func (m *InjectEmptyTransactionsRequest) Reset()                    { *m = InjectEmptyTransactionsRequest{} }
+func (m *InjectEmptyTransactionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*InjectEmptyTransactionsRequest) ProtoMessage()               {}
+func (*InjectEmptyTransactionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+
+type InjectEmptyTransactionsResponse struct {
+}
+
+func (m *InjectEmptyTransactionsResponse) Reset()                    { *m = InjectEmptyTransactionsResponse{} }
+func (m *InjectEmptyTransactionsResponse) String() string            { return proto.CompactTextString(m) }
+func (*InjectEmptyTransactionsResponse) ProtoMessage()               {}
+func (*InjectEmptyTransactionsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
 
 type TabletExternallyReparentedRequest struct {
 	// external_id is an string value that may be provided by an external
@@ -766,7 +807,7 @@ func (m *TabletExternallyReparentedRequest) Reset()         { *m = TabletExterna
 func (m *TabletExternallyReparentedRequest) String() string { return proto.CompactTextString(m) }
 func (*TabletExternallyReparentedRequest) ProtoMessage()    {}
 func (*TabletExternallyReparentedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{51}
+	return fileDescriptor0, []int{55}
 }
 
 type TabletExternallyReparentedResponse struct {
@@ -776,7 +817,7 @@ func (m *TabletExternallyReparentedResponse) Reset()         { *m = TabletExtern
 func (m *TabletExternallyReparentedResponse) String() string { return proto.CompactTextString(m) }
 func (*TabletExternallyReparentedResponse) ProtoMessage()    {}
 func (*TabletExternallyReparentedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{52}
+	return fileDescriptor0, []int{56}
 }
 
 type TabletExternallyElectedRequest struct {
@@ -785,7 +826,7 @@ type TabletExternallyElectedRequest struct {
 func (m *TabletExternallyElectedRequest) Reset()                    { *m = TabletExternallyElectedRequest{} }
 func (m *TabletExternallyElectedRequest) String() string            { return proto.CompactTextString(m) }
 func (*TabletExternallyElectedRequest) ProtoMessage()               {}
-func (*TabletExternallyElectedRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+func (*TabletExternallyElectedRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
 
 type TabletExternallyElectedResponse struct {
 }
@@ -794,7 +835,7 @@ func (m *TabletExternallyElectedResponse) Reset()         { *m = TabletExternall
 func (m *TabletExternallyElectedResponse) String() string { return proto.CompactTextString(m) }
 func (*TabletExternallyElectedResponse) ProtoMessage()    {}
 func (*TabletExternallyElectedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{54}
+	return fileDescriptor0, []int{58}
 }
 
 type GetSlavesRequest struct {
@@ -803,7 +844,7 @@ type GetSlavesRequest struct {
 func (m *GetSlavesRequest) Reset()                    { *m = GetSlavesRequest{} }
 func (m *GetSlavesRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetSlavesRequest) ProtoMessage()               {}
-func (*GetSlavesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+func (*GetSlavesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
 
 type GetSlavesResponse struct {
 	Addrs []string `protobuf:"bytes,1,rep,name=addrs" json:"addrs,omitempty"`
@@ -812,7 +853,7 @@ type GetSlavesResponse struct {
 func (m *GetSlavesResponse) Reset()                    { *m = GetSlavesResponse{} }
 func (m *GetSlavesResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetSlavesResponse) ProtoMessage()               {}
-func (*GetSlavesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+func (*GetSlavesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
 
 type WaitBlpPositionRequest struct {
 	BlpPosition *BlpPosition `protobuf:"bytes,1,opt,name=blp_position,json=blpPosition" json:"blp_position,omitempty"`
@@ -822,7 +863,7 @@ type WaitBlpPositionRequest struct {
 func (m *WaitBlpPositionRequest) Reset()                    { *m = WaitBlpPositionRequest{} }
 func (m *WaitBlpPositionRequest) String() string            { return proto.CompactTextString(m) }
 func (*WaitBlpPositionRequest) ProtoMessage()               {}
-func (*WaitBlpPositionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+func (*WaitBlpPositionRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
 
 func (m *WaitBlpPositionRequest) GetBlpPosition() *BlpPosition {
 	if m != nil {
@@ -837,7 +878,7 @@ type WaitBlpPositionResponse struct {
 func (m *WaitBlpPositionResponse) Reset()                    { *m = WaitBlpPositionResponse{} }
 func (m *WaitBlpPositionResponse) String() string            { return proto.CompactTextString(m) }
 func (*WaitBlpPositionResponse) ProtoMessage()               {}
-func (*WaitBlpPositionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+func (*WaitBlpPositionResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
 
 type StopBlpRequest struct {
 }
@@ -845,7 +886,7 @@ type StopBlpRequest struct {
 func (m *StopBlpRequest) Reset()                    { *m = StopBlpRequest{} }
 func (m *StopBlpRequest) String() string            { return proto.CompactTextString(m) }
 func (*StopBlpRequest) ProtoMessage()               {}
-func (*StopBlpRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+func (*StopBlpRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
 
 type StopBlpResponse struct {
 	BlpPositions []*BlpPosition `protobuf:"bytes,1,rep,name=blp_positions,json=blpPositions" json:"blp_positions,omitempty"`
@@ -854,7 +895,7 @@ type StopBlpResponse struct {
 func (m *StopBlpResponse) Reset()                    { *m = StopBlpResponse{} }
 func (m *StopBlpResponse) String() string            { return proto.CompactTextString(m) }
 func (*StopBlpResponse) ProtoMessage()               {}
-func (*StopBlpResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+func (*StopBlpResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
 
 func (m *StopBlpResponse) GetBlpPositions() []*BlpPosition {
 	if m != nil {
@@ -869,7 +910,7 @@ type StartBlpRequest struct {
 func (m *StartBlpRequest) Reset()                    { *m = StartBlpRequest{} }
 func (m *StartBlpRequest) String() string            { return proto.CompactTextString(m) }
 func (*StartBlpRequest) ProtoMessage()               {}
-func (*StartBlpRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+func (*StartBlpRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
 
 type StartBlpResponse struct {
 }
@@ -877,7 +918,7 @@ type StartBlpResponse struct {
 func (m *StartBlpResponse) Reset()                    { *m = StartBlpResponse{} }
 func (m *StartBlpResponse) String() string            { return proto.CompactTextString(m) }
 func (*StartBlpResponse) ProtoMessage()               {}
-func (*StartBlpResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+func (*StartBlpResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
 
 type RunBlpUntilRequest struct {
 	BlpPositions []*BlpPosition `protobuf:"bytes,1,rep,name=blp_positions,json=blpPositions" json:"blp_positions,omitempty"`
@@ -887,7 +928,7 @@ type RunBlpUntilRequest struct {
 func (m *RunBlpUntilRequest) Reset()                    { *m = RunBlpUntilRequest{} }
 func (m *RunBlpUntilRequest) String() string            { return proto.CompactTextString(m) }
 func (*RunBlpUntilRequest) ProtoMessage()               {}
-func (*RunBlpUntilRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+func (*RunBlpUntilRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
 
 func (m *RunBlpUntilRequest) GetBlpPositions() []*BlpPosition {
 	if m != nil {
@@ -903,7 +944,7 @@ type RunBlpUntilResponse struct {
 func (m *RunBlpUntilResponse) Reset()                    { *m = RunBlpUntilResponse{} }
 func (m *RunBlpUntilResponse) String() string            { return proto.CompactTextString(m) }
 func (*RunBlpUntilResponse) ProtoMessage()               {}
-func (*RunBlpUntilResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+func (*RunBlpUntilResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
 
 type ResetReplicationRequest struct {
 }
@@ -911,7 +952,7 @@ type ResetReplicationRequest struct {
 func (m *ResetReplicationRequest) Reset()                    { *m = ResetReplicationRequest{} }
 func (m *ResetReplicationRequest) String() string            { return proto.CompactTextString(m) }
 func (*ResetReplicationRequest) ProtoMessage()               {}
-func (*ResetReplicationRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
+func (*ResetReplicationRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
 
 type ResetReplicationResponse struct {
 }
@@ -919,7 +960,7 @@ type ResetReplicationResponse struct {
 func (m *ResetReplicationResponse) Reset()                    { *m = ResetReplicationResponse{} }
 func (m *ResetReplicationResponse) String() string            { return proto.CompactTextString(m) }
 func (*ResetReplicationResponse) ProtoMessage()               {}
-func (*ResetReplicationResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
+func (*ResetReplicationResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
 
 type InitMasterRequest struct {
 }
@@ -927,7 +968,7 @@ type InitMasterRequest struct {
 func (m *InitMasterRequest) Reset()                    { *m = InitMasterRequest{} }
 func (m *InitMasterRequest) String() string            { return proto.CompactTextString(m) }
 func (*InitMasterRequest) ProtoMessage()               {}
-func (*InitMasterRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+func (*InitMasterRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
 
 type InitMasterResponse struct {
 	Position string `protobuf:"bytes,1,opt,name=position" json:"position,omitempty"`
@@ -936,7 +977,7 @@ type InitMasterResponse struct {
 func (m *InitMasterResponse) Reset()                    { *m = InitMasterResponse{} }
 func (m *InitMasterResponse) String() string            { return proto.CompactTextString(m) }
 func (*InitMasterResponse) ProtoMessage()               {}
-func (*InitMasterResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
+func (*InitMasterResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
 
 type PopulateReparentJournalRequest struct {
 	TimeCreatedNs       int64                 `protobuf:"varint,1,opt,name=time_created_ns,json=timeCreatedNs" json:"time_created_ns,omitempty"`
@@ -948,7 +989,7 @@ type PopulateReparentJournalRequest struct {
 func (m *PopulateReparentJournalRequest) Reset()                    { *m = PopulateReparentJournalRequest{} }
 func (m *PopulateReparentJournalRequest) String() string            { return proto.CompactTextString(m) }
 func (*PopulateReparentJournalRequest) ProtoMessage()               {}
-func (*PopulateReparentJournalRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+func (*PopulateReparentJournalRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
 
 func (m *PopulateReparentJournalRequest) GetMasterAlias() *topodata.TabletAlias {
 	if m != nil {
@@ -964,7 +1005,7 @@ func (m *PopulateReparentJournalResponse) Reset()         { *m = PopulateReparen
 func (m *PopulateReparentJournalResponse) String() string { return proto.CompactTextString(m) }
 func (*PopulateReparentJournalResponse) ProtoMessage()    {}
 func (*PopulateReparentJournalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{70}
+	return fileDescriptor0, []int{74}
 }
 
 type InitSlaveRequest struct {
@@ -976,7 +1017,7 @@ type InitSlaveRequest struct {
 func (m *InitSlaveRequest) Reset()                    { *m = InitSlaveRequest{} }
 func (m *InitSlaveRequest) String() string            { return proto.CompactTextString(m) }
 func (*InitSlaveRequest) ProtoMessage()               {}
-func (*InitSlaveRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
+func (*InitSlaveRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
 
 func (m *InitSlaveRequest) GetParent() *topodata.TabletAlias {
 	if m != nil {
@@ -991,7 +1032,7 @@ type InitSlaveResponse struct {
 func (m *InitSlaveResponse) Reset()                    { *m = InitSlaveResponse{} }
 func (m *InitSlaveResponse) String() string            { return proto.CompactTextString(m) }
 func (*InitSlaveResponse) ProtoMessage()               {}
-func (*InitSlaveResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
+func (*InitSlaveResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
 
 type DemoteMasterRequest struct {
 }
@@ -999,7 +1040,7 @@ type DemoteMasterRequest struct {
 func (m *DemoteMasterRequest) Reset()                    { *m = DemoteMasterRequest{} }
 func (m *DemoteMasterRequest) String() string            { return proto.CompactTextString(m) }
 func (*DemoteMasterRequest) ProtoMessage()               {}
-func (*DemoteMasterRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
+func (*DemoteMasterRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
 
 type DemoteMasterResponse struct {
 	Position string `protobuf:"bytes,1,opt,name=position" json:"position,omitempty"`
@@ -1008,7 +1049,7 @@ type DemoteMasterResponse struct {
 func (m *DemoteMasterResponse) Reset()                    { *m = DemoteMasterResponse{} }
 func (m *DemoteMasterResponse) String() string            { return proto.CompactTextString(m) }
 func (*DemoteMasterResponse) ProtoMessage()               {}
-func (*DemoteMasterResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
+func (*DemoteMasterResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
 
 type PromoteSlaveWhenCaughtUpRequest struct {
 	Position string `protobuf:"bytes,1,opt,name=position" json:"position,omitempty"`
@@ -1018,7 +1059,7 @@ func (m *PromoteSlaveWhenCaughtUpRequest) Reset()         { *m = PromoteSlaveWhe
 func (m *PromoteSlaveWhenCaughtUpRequest) String() string { return proto.CompactTextString(m) }
 func (*PromoteSlaveWhenCaughtUpRequest) ProtoMessage()    {}
 func (*PromoteSlaveWhenCaughtUpRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{75}
+	return fileDescriptor0, []int{79}
 }
 
 type PromoteSlaveWhenCaughtUpResponse struct {
@@ -1029,7 +1070,7 @@ func (m *PromoteSlaveWhenCaughtUpResponse) Reset()         { *m = PromoteSlaveWh
 func (m *PromoteSlaveWhenCaughtUpResponse) String() string { return proto.CompactTextString(m) }
 func (*PromoteSlaveWhenCaughtUpResponse) ProtoMessage()    {}
 func (*PromoteSlaveWhenCaughtUpResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{76}
+	return fileDescriptor0, []int{80}
 }
 
 type SlaveWasPromotedRequest struct {
@@ -1038,7 +1079,7 @@ type SlaveWasPromotedRequest struct {
 func (m *SlaveWasPromotedRequest) Reset()                    { *m = SlaveWasPromotedRequest{} }
 func (m *SlaveWasPromotedRequest) String() string            { return proto.CompactTextString(m) }
 func (*SlaveWasPromotedRequest) ProtoMessage()               {}
-func (*SlaveWasPromotedRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
+func (*SlaveWasPromotedRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
 
 type SlaveWasPromotedResponse struct {
 }
@@ -1046,7 +1087,7 @@ type SlaveWasPromotedResponse struct {
 func (m *SlaveWasPromotedResponse) Reset()                    { *m = SlaveWasPromotedResponse{} }
 func (m *SlaveWasPromotedResponse) String() string            { return proto.CompactTextString(m) }
 func (*SlaveWasPromotedResponse) ProtoMessage()               {}
-func (*SlaveWasPromotedResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
+func (*SlaveWasPromotedResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
 
 type SetMasterRequest struct {
 	Parent          *topodata.TabletAlias `protobuf:"bytes,1,opt,name=parent" json:"parent,omitempty"`
@@ -1057,7 +1098,7 @@ type SetMasterRequest struct {
 func (m *SetMasterRequest) Reset()                    { *m = SetMasterRequest{} }
 func (m *SetMasterRequest) String() string            { return proto.CompactTextString(m) }
 func (*SetMasterRequest) ProtoMessage()               {}
-func (*SetMasterRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
+func (*SetMasterRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
 
 func (m *SetMasterRequest) GetParent() *topodata.TabletAlias {
 	if m != nil {
@@ -1072,7 +1113,7 @@ type SetMasterResponse struct {
 func (m *SetMasterResponse) Reset()                    { *m = SetMasterResponse{} }
 func (m *SetMasterResponse) String() string            { return proto.CompactTextString(m) }
 func (*SetMasterResponse) ProtoMessage()               {}
-func (*SetMasterResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
+func (*SetMasterResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
 
 type SlaveWasRestartedRequest struct {
 	// the parent alias the tablet should have
@@ -1082,7 +1123,7 @@ type SlaveWasRestartedRequest struct {
 func (m *SlaveWasRestartedRequest) Reset()                    { *m = SlaveWasRestartedRequest{} }
 func (m *SlaveWasRestartedRequest) String() string            { return proto.CompactTextString(m) }
 func (*SlaveWasRestartedRequest) ProtoMessage()               {}
-func (*SlaveWasRestartedRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
+func (*SlaveWasRestartedRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
 
 func (m *SlaveWasRestartedRequest) GetParent() *topodata.TabletAlias {
 	if m != nil {
@@ -1097,7 +1138,7 @@ type SlaveWasRestartedResponse struct {
 func (m *SlaveWasRestartedResponse) Reset()                    { *m = SlaveWasRestartedResponse{} }
 func (m *SlaveWasRestartedResponse) String() string            { return proto.CompactTextString(m) }
 func (*SlaveWasRestartedResponse) ProtoMessage()               {}
-func (*SlaveWasRestartedResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
+func (*SlaveWasRestartedResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
 
 type StopReplicationAndGetStatusRequest struct {
 }
@@ -1106,7 +1147,7 @@ func (m *StopReplicationAndGetStatusRequest) Reset()         { *m = StopReplicat
 func (m *StopReplicationAndGetStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*StopReplicationAndGetStatusRequest) ProtoMessage()    {}
 func (*StopReplicationAndGetStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{83}
+	return fileDescriptor0, []int{87}
 }
 
 type StopReplicationAndGetStatusResponse struct {
@@ -1117,7 +1158,7 @@ func (m *StopReplicationAndGetStatusResponse) Reset()         { *m = StopReplica
 func (m *StopReplicationAndGetStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*StopReplicationAndGetStatusResponse) ProtoMessage()    {}
 func (*StopReplicationAndGetStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{84}
+	return fileDescriptor0, []int{88}
 }
 
 func (m *StopReplicationAndGetStatusResponse) GetStatus() *replicationdata.Status {
@@ -1133,7 +1174,7 @@ type PromoteSlaveRequest struct {
 func (m *PromoteSlaveRequest) Reset()                    { *m = PromoteSlaveRequest{} }
 func (m *PromoteSlaveRequest) String() string            { return proto.CompactTextString(m) }
 func (*PromoteSlaveRequest) ProtoMessage()               {}
-func (*PromoteSlaveRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
+func (*PromoteSlaveRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
 
 type PromoteSlaveResponse struct {
 	Position string `protobuf:"bytes,1,opt,name=position" json:"position,omitempty"`
@@ -1142,16 +1183,18 @@ type PromoteSlaveResponse struct {
 func (m *PromoteSlaveResponse) Reset()                    { *m = PromoteSlaveResponse{} }
 func (m *PromoteSlaveResponse) String() string            { return proto.CompactTextString(m) }
 func (*PromoteSlaveResponse) ProtoMessage()               {}
-func (*PromoteSlaveResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
+func (*PromoteSlaveResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
 
 type BackupRequest struct {
 	Concurrency int64 `protobuf:"varint,1,opt,name=concurrency" json:"concurrency,omitempty"`
+	// If set, only the binlogs since the latest backup are backed up.
+	Incremental bool `protobuf:"varint,2,opt,name=incremental" json:"incremental,omitempty"`
 }
 
 func (m *BackupRequest) Reset()                    { *m = BackupRequest{} }
 func (m *BackupRequest) String() string            { return proto.CompactTextString(m) }
 func (*BackupRequest) ProtoMessage()               {}
-func (*BackupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
+func (*BackupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{91} }
 
 type BackupResponse struct {
 	Event *logutil.Event `protobuf:"bytes,1,opt,name=event" json:"event,omitempty"`
@@ -1160,7 +1203,7 @@ type BackupResponse struct {
 func (m *BackupResponse) Reset()                    { *m = BackupResponse{} }
 func (m *BackupResponse) String() string            { return proto.CompactTextString(m) }
 func (*BackupResponse) ProtoMessage()               {}
-func (*BackupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
+func (*BackupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{92} }
 
 func (m *BackupResponse) GetEvent() *logutil.Event {
 	if m != nil {
@@ -1170,12 +1213,19 @@ func (m *BackupResponse) GetEvent() *logutil.Event {
 }
 
 type RestoreFromBackupRequest struct {
+	// If set, restore_to_time_ns and restore_to_pos ask for a
+	// point-in-time recovery: the latest backup taken before that point
+	// is restored, then the archived binlogs are replayed up to it.
+	// restore_to_time_ns is a time in nanoseconds since the epoch.
+	RestoreToTimeNs int64 `protobuf:"varint,1,opt,name=restore_to_time_ns,json=restoreToTimeNs" json:"restore_to_time_ns,omitempty"`
+	// restore_to_pos is an encoded replication position.
+	RestoreToPos string `protobuf:"bytes,2,opt,name=restore_to_pos,json=restoreToPos" json:"restore_to_pos,omitempty"`
 }
 
 func (m *RestoreFromBackupRequest) Reset()                    { *m = RestoreFromBackupRequest{} }
 func (m *RestoreFromBackupRequest) String() string            { return proto.CompactTextString(m) }
 func (*RestoreFromBackupRequest) ProtoMessage()               {}
-func (*RestoreFromBackupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
+func (*RestoreFromBackupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{93} }
 
 type RestoreFromBackupResponse struct {
 	Event *logutil.Event `protobuf:"bytes,1,opt,name=event" json:"event,omitempty"`
@@ -1184,7 +1234,7 @@ type RestoreFromBackupResponse struct {
 func (m *RestoreFromBackupResponse) Reset()                    { *m = RestoreFromBackupResponse{} }
 func (m *RestoreFromBackupResponse) String() string            { return proto.CompactTextString(m) }
 func (*RestoreFromBackupResponse) ProtoMessage()               {}
-func (*RestoreFromBackupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
+func (*RestoreFromBackupResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{94} }
 
 func (m *RestoreFromBackupResponse) GetEvent() *logutil.Event {
 	if m != nil {
@@ -1243,8 +1293,12 @@ func init() {
 	proto.RegisterType((*StopSlaveResponse)(nil), "tabletmanagerdata.StopSlaveResponse")
 	proto.RegisterType((*StopSlaveMinimumRequest)(nil), "tabletmanagerdata.StopSlaveMinimumRequest")
 	proto.RegisterType((*StopSlaveMinimumResponse)(nil), "tabletmanagerdata.StopSlaveMinimumResponse")
+	proto.RegisterType((*StopSlaveBeforeTimeRequest)(nil), "tabletmanagerdata.StopSlaveBeforeTimeRequest")
+	proto.RegisterType((*StopSlaveBeforeTimeResponse)(nil), "tabletmanagerdata.StopSlaveBeforeTimeResponse")
 	proto.RegisterType((*StartSlaveRequest)(nil), "tabletmanagerdata.StartSlaveRequest")
 	proto.RegisterType((*StartSlaveResponse)(nil), "tabletmanagerdata.StartSlaveResponse")
+	proto.RegisterType((*InjectEmptyTransactionsRequest)(nil), "tabletmanagerdata.InjectEmptyTransactionsRequest")
+	proto.RegisterType((*InjectEmptyTransactionsResponse)(nil), "tabletmanagerdata.InjectEmptyTransactionsResponse")
 	proto.RegisterType((*TabletExternallyReparentedRequest)(nil), "tabletmanagerdata.TabletExternallyReparentedRequest")
 	proto.RegisterType((*TabletExternallyReparentedResponse)(nil), "tabletmanagerdata.TabletExternallyReparentedResponse")
 	proto.RegisterType((*TabletExternallyElectedRequest)(nil), "tabletmanagerdata.TabletExternallyElectedRequest")
@@ -1290,134 +1344,140 @@ func init() {
 func init() { proto.RegisterFile("tabletmanagerdata.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 2049 bytes of a gzipped FileDescriptorProto
+	// 2157 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xcc, 0x59, 0x5b, 0x6f, 0x1b, 0xc7,
-	0xf5, 0x07, 0x45, 0x49, 0x96, 0x0e, 0x2f, 0x22, 0x97, 0xba, 0x50, 0x0a, 0xfe, 0xba, 0xac, 0x9d,
-	0x7f, 0x54, 0x17, 0x55, 0x6a, 0x25, 0x0d, 0x82, 0x04, 0x29, 0xaa, 0xab, 0xed, 0xc4, 0x89, 0x95,
-	0x95, 0x2f, 0x45, 0x5f, 0x16, 0x43, 0xee, 0x11, 0xb9, 0xd0, 0x72, 0x77, 0x3d, 0x33, 0x2b, 0x89,
-	0x40, 0xd1, 0x8f, 0xd0, 0xb7, 0xbe, 0xf5, 0xad, 0x40, 0xfb, 0xde, 0x0f, 0x93, 0xa2, 0x9f, 0xa4,
-	0x0f, 0x7d, 0x29, 0xe6, 0x46, 0xce, 0x92, 0x94, 0x4d, 0x19, 0x2e, 0xd0, 0x17, 0x81, 0xe7, 0x37,
-	0xe7, 0x3e, 0x67, 0xce, 0x39, 0x0b, 0xc1, 0x1a, 0x27, 0xad, 0x08, 0x79, 0x8f, 0xc4, 0xa4, 0x83,
-	0x34, 0x20, 0x9c, 0xec, 0xa5, 0x34, 0xe1, 0x89, 0x53, 0x1f, 0x3b, 0xd8, 0x28, 0xbd, 0xc9, 0x90,
-	0xf6, 0xd5, 0xf9, 0x46, 0x95, 0x27, 0x69, 0x32, 0xe4, 0xdf, 0x58, 0xa1, 0x98, 0x46, 0x61, 0x9b,
-	0xf0, 0x30, 0x89, 0x2d, 0xb8, 0x12, 0x25, 0x9d, 0x8c, 0x87, 0x91, 0x22, 0xdd, 0x7f, 0x16, 0x60,
-	0xe9, 0x85, 0x50, 0x7c, 0x8c, 0x17, 0x61, 0x1c, 0x0a, 0x66, 0xc7, 0x81, 0xd9, 0x98, 0xf4, 0xb0,
-	0x59, 0xd8, 0x2e, 0xec, 0x2e, 0x7a, 0xf2, 0xb7, 0xb3, 0x0a, 0xf3, 0xac, 0xdd, 0xc5, 0x1e, 0x69,
-	0xce, 0x48, 0x54, 0x53, 0x4e, 0x13, 0xee, 0xb5, 0x93, 0x28, 0xeb, 0xc5, 0xac, 0x59, 0xdc, 0x2e,
-	0xee, 0x2e, 0x7a, 0x86, 0x74, 0xf6, 0xa0, 0x91, 0xd2, 0xb0, 0x47, 0x68, 0xdf, 0xbf, 0xc4, 0xbe,
-	0x6f, 0xb8, 0x66, 0x25, 0x57, 0x5d, 0x1f, 0x7d, 0x87, 0xfd, 0x23, 0xcd, 0xef, 0xc0, 0x2c, 0xef,
-	0xa7, 0xd8, 0x9c, 0x53, 0x56, 0xc5, 0x6f, 0x67, 0x0b, 0x4a, 0xc2, 0x75, 0x3f, 0xc2, 0xb8, 0xc3,
-	0xbb, 0xcd, 0xf9, 0xed, 0xc2, 0xee, 0xac, 0x07, 0x02, 0x7a, 0x26, 0x11, 0xe7, 0x23, 0x58, 0xa4,
-	0xc9, 0xb5, 0xdf, 0x4e, 0xb2, 0x98, 0x37, 0xef, 0xc9, 0xe3, 0x05, 0x9a, 0x5c, 0x1f, 0x09, 0xda,
-	0xfd, 0x6b, 0x01, 0x6a, 0xe7, 0xd2, 0x4d, 0x2b, 0xb8, 0x4f, 0x60, 0x49, 0xc8, 0xb7, 0x08, 0x43,
-	0x5f, 0x47, 0xa4, 0xe2, 0xac, 0x1a, 0x58, 0x89, 0x38, 0xcf, 0x41, 0x65, 0xdc, 0x0f, 0x06, 0xc2,
-	0xac, 0x39, 0xb3, 0x5d, 0xdc, 0x2d, 0xed, 0xbb, 0x7b, 0xe3, 0x97, 0x34, 0x92, 0x44, 0xaf, 0xc6,
-	0xf3, 0x00, 0x13, 0xa9, 0xba, 0x42, 0xca, 0xc2, 0x24, 0x6e, 0x16, 0xa5, 0x45, 0x43, 0x0a, 0x47,
-	0x1d, 0x65, 0xf5, 0xa8, 0x4b, 0xe2, 0x0e, 0x7a, 0xc8, 0xb2, 0x88, 0x3b, 0x4f, 0xa0, 0xd2, 0xc2,
-	0x8b, 0x84, 0xe6, 0x1c, 0x2d, 0xed, 0xdf, 0x9f, 0x60, 0x7d, 0x34, 0x4c, 0xaf, 0xac, 0x24, 0x75,
-	0x2c, 0xa7, 0x50, 0x26, 0x17, 0x1c, 0xa9, 0x6f, 0xdd, 0xe1, 0x94, 0x8a, 0x4a, 0x52, 0x50, 0xc1,
-	0xee, 0xbf, 0x0a, 0x50, 0x7d, 0xc9, 0x90, 0x9e, 0x21, 0xed, 0x85, 0x8c, 0xe9, 0x62, 0xe9, 0x26,
-	0x8c, 0x9b, 0x62, 0x11, 0xbf, 0x05, 0x96, 0x31, 0xa4, 0xba, 0x54, 0xe4, 0x6f, 0xe7, 0xe7, 0x50,
-	0x4f, 0x09, 0x63, 0xd7, 0x09, 0x0d, 0xfc, 0x76, 0x17, 0xdb, 0x97, 0x2c, 0xeb, 0xc9, 0x3c, 0xcc,
-	0x7a, 0x35, 0x73, 0x70, 0xa4, 0x71, 0xe7, 0x47, 0x80, 0x94, 0x86, 0x57, 0x61, 0x84, 0x1d, 0x54,
-	0x25, 0x53, 0xda, 0x7f, 0x34, 0xc1, 0xdb, 0xbc, 0x2f, 0x7b, 0x67, 0x03, 0x99, 0x93, 0x98, 0xd3,
-	0xbe, 0x67, 0x29, 0xd9, 0xf8, 0x06, 0x96, 0x46, 0x8e, 0x9d, 0x1a, 0x14, 0x2f, 0xb1, 0xaf, 0x3d,
-	0x17, 0x3f, 0x9d, 0x65, 0x98, 0xbb, 0x22, 0x51, 0x86, 0xda, 0x73, 0x45, 0x7c, 0x35, 0xf3, 0x65,
-	0xc1, 0xfd, 0xa9, 0x00, 0xe5, 0xe3, 0xd6, 0x3b, 0xe2, 0xae, 0xc2, 0x4c, 0xd0, 0xd2, 0xb2, 0x33,
-	0x41, 0x6b, 0x90, 0x87, 0xa2, 0x95, 0x87, 0xe7, 0x13, 0x42, 0xfb, 0x74, 0x42, 0x68, 0xb6, 0xb1,
-	0xff, 0x66, 0x60, 0x7f, 0x29, 0x40, 0x69, 0x68, 0x89, 0x39, 0xcf, 0xa0, 0x26, 0xfc, 0xf4, 0xd3,
-	0x21, 0xd6, 0x2c, 0x48, 0x2f, 0x77, 0xde, 0x79, 0x01, 0xde, 0x52, 0x96, 0xa3, 0x99, 0x73, 0x0a,
-	0xd5, 0xa0, 0x95, 0xd3, 0xa5, 0x5e, 0xd0, 0xd6, 0x3b, 0x22, 0xf6, 0x2a, 0x81, 0x45, 0x31, 0xf7,
-	0x6b, 0x28, 0x1d, 0x46, 0xe9, 0x59, 0xc2, 0xd4, 0x23, 0xae, 0x41, 0x31, 0x0b, 0x03, 0x19, 0x60,
-	0xc5, 0x13, 0x3f, 0x9d, 0x0d, 0x58, 0x48, 0xf5, 0xa9, 0x8e, 0x71, 0x40, 0xbb, 0x9f, 0x40, 0xe9,
-	0x2c, 0x8c, 0x3b, 0x1e, 0xbe, 0xc9, 0x90, 0x71, 0xf1, 0x0e, 0x53, 0xd2, 0x8f, 0x12, 0x12, 0xe8,
-	0x0c, 0x19, 0xd2, 0xdd, 0x85, 0xb2, 0x62, 0x64, 0x69, 0x12, 0x33, 0x7c, 0x0b, 0xe7, 0x43, 0x28,
-	0x9f, 0x47, 0x88, 0xa9, 0xd1, 0xb9, 0x01, 0x0b, 0x41, 0x46, 0x65, 0xaf, 0x95, 0xac, 0x45, 0x6f,
-	0x40, 0xbb, 0x4b, 0x50, 0xd1, 0xbc, 0x4a, 0xad, 0xfb, 0x8f, 0x02, 0x38, 0x27, 0x37, 0xd8, 0xce,
-	0x38, 0x3e, 0x49, 0x92, 0x4b, 0xa3, 0x63, 0x52, 0xdb, 0xdd, 0x04, 0x48, 0x09, 0x25, 0x3d, 0xe4,
-	0x48, 0x55, 0xee, 0x16, 0x3d, 0x0b, 0x71, 0xce, 0x60, 0x11, 0x6f, 0x38, 0x25, 0x3e, 0xc6, 0x57,
-	0xb2, 0x01, 0x97, 0xf6, 0x3f, 0x9b, 0x90, 0xda, 0x71, 0x6b, 0x7b, 0x27, 0x42, 0xec, 0x24, 0xbe,
-	0x52, 0x05, 0xb5, 0x80, 0x9a, 0xdc, 0xf8, 0x1a, 0x2a, 0xb9, 0xa3, 0x3b, 0x15, 0xd3, 0x05, 0x34,
-	0x72, 0xa6, 0x74, 0x1e, 0xb7, 0xa0, 0x84, 0x37, 0x21, 0xf7, 0x19, 0x27, 0x3c, 0x63, 0x3a, 0x41,
-	0x20, 0xa0, 0x73, 0x89, 0xc8, 0xe9, 0xc2, 0x83, 0x24, 0xe3, 0x83, 0xe9, 0x22, 0x29, 0x8d, 0x23,
-	0x35, 0x4f, 0x48, 0x53, 0xee, 0x15, 0xd4, 0x1e, 0x23, 0x57, 0x4d, 0xc9, 0xa4, 0x6f, 0x15, 0xe6,
-	0x65, 0xe0, 0xaa, 0x5c, 0x17, 0x3d, 0x4d, 0x39, 0xf7, 0xa1, 0x12, 0xc6, 0xed, 0x28, 0x0b, 0xd0,
-	0xbf, 0x0a, 0xf1, 0x9a, 0x49, 0x13, 0x0b, 0x5e, 0x59, 0x83, 0xaf, 0x04, 0xe6, 0x7c, 0x0c, 0x55,
-	0xbc, 0x51, 0x4c, 0x5a, 0x89, 0x9a, 0x66, 0x15, 0x8d, 0xca, 0xee, 0xce, 0x5c, 0x84, 0xba, 0x65,
-	0x57, 0x47, 0x77, 0x06, 0x75, 0xd5, 0x56, 0xad, 0x49, 0x71, 0x97, 0x56, 0x5d, 0x63, 0x23, 0x88,
-	0xbb, 0x06, 0x2b, 0x8f, 0x91, 0x5b, 0xf5, 0xaf, 0x63, 0x74, 0x7f, 0x07, 0xab, 0xa3, 0x07, 0xda,
-	0x89, 0xdf, 0x40, 0x29, 0xff, 0x62, 0x85, 0xf9, 0xcd, 0x09, 0xe6, 0x6d, 0x61, 0x5b, 0xc4, 0x5d,
-	0x06, 0xe7, 0x1c, 0xb9, 0x87, 0x24, 0x78, 0x1e, 0x47, 0x7d, 0x63, 0x71, 0x05, 0x1a, 0x39, 0x54,
-	0x97, 0xf0, 0x10, 0x7e, 0x4d, 0x43, 0x8e, 0x86, 0x7b, 0x15, 0x96, 0xf3, 0xb0, 0x66, 0xff, 0x16,
-	0xea, 0x6a, 0xb2, 0xbd, 0xe8, 0xa7, 0x86, 0xd9, 0xf9, 0x15, 0x94, 0x94, 0x7b, 0xbe, 0x9c, 0xfb,
-	0xc2, 0xe5, 0xea, 0xfe, 0xf2, 0xde, 0x60, 0x8d, 0x91, 0x39, 0xe7, 0x52, 0x02, 0xf8, 0xe0, 0xb7,
-	0xf0, 0xd3, 0xd6, 0x35, 0x74, 0xc8, 0xc3, 0x0b, 0x8a, 0xac, 0x2b, 0x4a, 0xca, 0x76, 0x28, 0x0f,
-	0x6b, 0xf6, 0x35, 0x58, 0xf1, 0xb2, 0xf8, 0x09, 0x92, 0x88, 0x77, 0xe5, 0xd4, 0x31, 0x02, 0x4d,
-	0x58, 0x1d, 0x3d, 0xd0, 0x22, 0x9f, 0x43, 0xf3, 0x69, 0x27, 0x4e, 0x28, 0xaa, 0xc3, 0x13, 0x4a,
-	0x13, 0x9a, 0x6b, 0x29, 0x9c, 0x23, 0x8d, 0x87, 0x8d, 0x42, 0x92, 0xee, 0x47, 0xb0, 0x3e, 0x41,
-	0x4a, 0xab, 0xfc, 0x4a, 0x38, 0x2d, 0xfa, 0x49, 0xbe, 0x92, 0xef, 0x43, 0xe5, 0x9a, 0x84, 0xdc,
-	0x1f, 0x34, 0x34, 0xa5, 0xb3, 0x2c, 0x40, 0xd3, 0x02, 0x55, 0x64, 0xb6, 0xac, 0xd6, 0xb9, 0x0f,
-	0xab, 0x67, 0x14, 0x2f, 0xa2, 0xb0, 0xd3, 0x1d, 0x79, 0x20, 0x62, 0x55, 0x93, 0x89, 0x33, 0x2f,
-	0xc4, 0x90, 0x6e, 0x07, 0xd6, 0xc6, 0x64, 0x74, 0x5d, 0x3d, 0x83, 0xaa, 0xe2, 0xf2, 0xa9, 0x5c,
-	0x4a, 0xcc, 0x30, 0xf8, 0xf8, 0xd6, 0xca, 0xb6, 0x57, 0x18, 0xaf, 0xd2, 0xb6, 0x28, 0xe6, 0xfe,
-	0xbb, 0x00, 0xce, 0x41, 0x9a, 0x46, 0xfd, 0xbc, 0x67, 0x35, 0x28, 0xb2, 0x37, 0x91, 0x69, 0x31,
-	0xec, 0x4d, 0x24, 0x5a, 0xcc, 0x45, 0x42, 0xdb, 0xa8, 0x1f, 0xab, 0x22, 0xc4, 0x0e, 0x41, 0xa2,
-	0x28, 0xb9, 0xf6, 0xad, 0xd5, 0x56, 0x76, 0x86, 0x05, 0xaf, 0x26, 0x0f, 0xbc, 0x21, 0x3e, 0xbe,
-	0x3d, 0xcd, 0x7e, 0xa8, 0xed, 0x69, 0xee, 0x3d, 0xb7, 0xa7, 0xbf, 0x15, 0xa0, 0x91, 0x8b, 0x5e,
-	0xe7, 0xf8, 0x7f, 0x6f, 0xcf, 0xfb, 0x7b, 0x01, 0x9a, 0xba, 0x91, 0x9f, 0x22, 0x6f, 0x77, 0x0f,
-	0xd8, 0x71, 0x6b, 0x70, 0x5b, 0xcb, 0x30, 0x27, 0xbf, 0x3b, 0xa4, 0x9b, 0x65, 0x4f, 0x11, 0xce,
-	0x1a, 0xdc, 0x0b, 0x5a, 0xbe, 0x1c, 0x60, 0xba, 0x87, 0x07, 0xad, 0x1f, 0xc4, 0x08, 0x5b, 0x87,
-	0x85, 0x1e, 0xb9, 0xf1, 0x69, 0x72, 0xcd, 0xf4, 0xbe, 0x77, 0xaf, 0x47, 0x6e, 0xbc, 0xe4, 0x9a,
-	0xc9, 0x5d, 0x3c, 0x64, 0x72, 0xc9, 0x6e, 0x85, 0x71, 0x94, 0x74, 0x98, 0xbc, 0xa4, 0x05, 0xaf,
-	0xaa, 0xe1, 0x43, 0x85, 0x8a, 0x17, 0x41, 0x65, 0xb1, 0xdb, 0x57, 0xb0, 0xe0, 0x95, 0xa9, 0xf5,
-	0x02, 0xdc, 0xc7, 0xb0, 0x3e, 0xc1, 0x67, 0x9d, 0xe3, 0x87, 0x30, 0xaf, 0x0a, 0x58, 0x27, 0xd7,
-	0xd9, 0x53, 0xdf, 0x4e, 0x3f, 0x8a, 0xbf, 0xba, 0x58, 0x35, 0x87, 0xfb, 0xc7, 0x02, 0xfc, 0x5f,
-	0x5e, 0xd3, 0x41, 0x14, 0x89, 0x1d, 0x8b, 0x7d, 0xf8, 0x14, 0x8c, 0x45, 0x36, 0x3b, 0x21, 0xb2,
-	0x67, 0xb0, 0x79, 0x9b, 0x3f, 0xef, 0x11, 0xde, 0x77, 0xa3, 0x77, 0x7b, 0x90, 0xa6, 0x6f, 0x0f,
-	0xcc, 0xf6, 0x7f, 0x26, 0xe7, 0xff, 0x78, 0xd2, 0xa5, 0xb2, 0xf7, 0xf0, 0x4a, 0x8c, 0x9f, 0x88,
-	0x5c, 0xa1, 0xda, 0x08, 0x4c, 0x3b, 0x3e, 0x85, 0x46, 0x0e, 0xd5, 0x8a, 0x3f, 0x15, 0x7b, 0xc1,
-	0x60, 0x97, 0x28, 0xed, 0xaf, 0xed, 0x8d, 0x7e, 0xec, 0x6a, 0x01, 0xcd, 0x26, 0xfa, 0xfd, 0xf7,
-	0x84, 0x71, 0xa4, 0xa6, 0x7f, 0x1a, 0x03, 0x9f, 0xc3, 0xea, 0xe8, 0x81, 0xb6, 0x61, 0x6f, 0x94,
-	0x85, 0x91, 0x8d, 0xd2, 0x81, 0xda, 0x39, 0x4f, 0x52, 0xe9, 0x9a, 0xd1, 0xd4, 0x80, 0xba, 0x85,
-	0xe9, 0x6e, 0xfc, 0x5b, 0x58, 0x1b, 0x80, 0xdf, 0x87, 0x71, 0xd8, 0xcb, 0x7a, 0xd6, 0xca, 0x78,
-	0x9b, 0x7e, 0x67, 0x07, 0x64, 0xb3, 0xf7, 0x79, 0xd8, 0x43, 0xb3, 0x15, 0x15, 0xbd, 0x92, 0xc0,
-	0x5e, 0x28, 0xc8, 0xfd, 0x02, 0x9a, 0xe3, 0x9a, 0xa7, 0x70, 0x5d, 0xba, 0x49, 0x28, 0xcf, 0xf9,
-	0x2e, 0x92, 0x6f, 0x81, 0xda, 0xf9, 0x63, 0xd8, 0x51, 0x33, 0xf8, 0xe4, 0x46, 0xcc, 0x32, 0x12,
-	0x89, 0x05, 0x20, 0x25, 0x14, 0x63, 0x8e, 0x81, 0x09, 0x43, 0xee, 0x76, 0xea, 0xd8, 0x0f, 0xcd,
-	0x9e, 0x0c, 0x06, 0x7a, 0x1a, 0xb8, 0x0f, 0xc0, 0x7d, 0x9b, 0x16, 0x6d, 0x6b, 0x1b, 0x36, 0x47,
-	0xb9, 0x4e, 0x22, 0x6c, 0x0f, 0x0d, 0xb9, 0x3b, 0xb0, 0x75, 0x2b, 0x87, 0x56, 0xe2, 0xa8, 0xb5,
-	0x50, 0x04, 0x31, 0xa8, 0xa0, 0x9f, 0xa9, 0x95, 0x4d, 0x63, 0x3a, 0x41, 0xcb, 0x30, 0x47, 0x82,
-	0x80, 0x9a, 0x41, 0xa8, 0x08, 0xf7, 0x0f, 0xb0, 0xfa, 0x9a, 0x84, 0xdc, 0xfa, 0xd0, 0x30, 0x41,
-	0x1e, 0x40, 0xb9, 0x15, 0xa5, 0xf9, 0x81, 0x3c, 0x79, 0xbd, 0xb2, 0x85, 0x4b, 0x2d, 0xeb, 0x93,
-	0x65, 0x8a, 0x2b, 0x5d, 0x87, 0xb5, 0x31, 0xfb, 0x3a, 0xb2, 0x1a, 0x54, 0xc5, 0x6d, 0x1f, 0x46,
-	0xe6, 0xa5, 0xba, 0xaf, 0x60, 0x69, 0x80, 0xe8, 0xa8, 0x8e, 0xa0, 0x62, 0x7b, 0x69, 0x46, 0xf5,
-	0xbb, 0xdc, 0x2c, 0x5b, 0x6e, 0x32, 0xb7, 0x2e, 0xf4, 0x12, 0xca, 0x2d, 0x53, 0xb2, 0xda, 0x0d,
-	0xa4, 0x1d, 0xfa, 0x3d, 0x38, 0x5e, 0x16, 0x1f, 0x46, 0xe9, 0xcb, 0x98, 0x87, 0x91, 0xc9, 0xd3,
-	0x87, 0xf0, 0x60, 0x9a, 0x4c, 0x3d, 0x82, 0x46, 0xce, 0xfa, 0x14, 0x75, 0xbf, 0x0e, 0x6b, 0x1e,
-	0x32, 0xb1, 0x9c, 0x0e, 0x1a, 0x85, 0x89, 0x6f, 0x03, 0x9a, 0xe3, 0x47, 0x3a, 0xce, 0x06, 0xd4,
-	0x9f, 0xc6, 0x21, 0x57, 0x3d, 0xc2, 0x08, 0xfc, 0x12, 0x1c, 0x1b, 0x9c, 0xc2, 0xfa, 0x4f, 0x05,
-	0xd8, 0x3c, 0x4b, 0xd2, 0x2c, 0x92, 0x4b, 0xa8, 0xaa, 0xfe, 0x6f, 0x93, 0x4c, 0x94, 0xb1, 0xc9,
-	0xdd, 0xff, 0xc3, 0x92, 0x88, 0xd8, 0x6f, 0x53, 0x24, 0x1c, 0x03, 0x3f, 0x36, 0x1f, 0x4a, 0x15,
-	0x01, 0x1f, 0x29, 0xf4, 0x07, 0x26, 0x1e, 0x1c, 0x69, 0x0b, 0xa5, 0xf6, 0xa4, 0x01, 0x05, 0xc9,
-	0x69, 0xf3, 0x25, 0x94, 0x7b, 0xd2, 0x33, 0x9f, 0x44, 0x21, 0x51, 0x13, 0xa7, 0xb4, 0xbf, 0x32,
-	0xba, 0x58, 0x1f, 0x88, 0x43, 0xaf, 0xa4, 0x58, 0x25, 0xe1, 0x3c, 0x82, 0x65, 0xab, 0x8f, 0x0e,
-	0xcb, 0x7d, 0x56, 0xda, 0x68, 0x58, 0x67, 0x83, 0x35, 0x74, 0x07, 0xb6, 0x6e, 0x8d, 0x4b, 0xa7,
-	0xf0, 0xcf, 0x05, 0xa8, 0x89, 0x74, 0xd9, 0x1d, 0xc7, 0xf9, 0x05, 0xcc, 0x2b, 0x6e, 0xfd, 0x96,
-	0x6e, 0x71, 0x4f, 0x33, 0xdd, 0xea, 0xd9, 0xcc, 0xad, 0x9e, 0x4d, 0xca, 0x67, 0x71, 0x42, 0x3e,
-	0xcd, 0x0d, 0xe7, 0x5b, 0xdf, 0x0a, 0x34, 0x8e, 0xb1, 0x97, 0x70, 0xcc, 0x5f, 0xfc, 0x3e, 0x2c,
-	0xe7, 0xe1, 0x29, 0xae, 0xfe, 0x1b, 0xd8, 0x3a, 0xa3, 0x89, 0x10, 0x92, 0x26, 0x5e, 0x77, 0x31,
-	0x3e, 0x22, 0x59, 0xa7, 0xcb, 0x5f, 0xa6, 0x53, 0x8c, 0x02, 0xf7, 0xd7, 0xb0, 0x7d, 0xbb, 0xf8,
-	0x74, 0x75, 0xaf, 0x04, 0x09, 0xd3, 0x7a, 0x02, 0xab, 0xee, 0xc7, 0x8f, 0x74, 0x02, 0xfe, 0x54,
-	0x80, 0xda, 0x39, 0xe6, 0xeb, 0xfe, 0xae, 0x97, 0x36, 0xe1, 0x06, 0x66, 0x26, 0x55, 0xf4, 0x43,
-	0xa8, 0xcb, 0xfd, 0xde, 0x67, 0xa2, 0xcb, 0xf8, 0x4c, 0xf8, 0xa4, 0xd7, 0xfa, 0x25, 0x79, 0x30,
-	0x9c, 0x4d, 0x72, 0x7c, 0xe1, 0xc8, 0xcb, 0x73, 0x9f, 0x0e, 0x03, 0xf1, 0x50, 0x2a, 0x19, 0xce,
-	0xa7, 0xbb, 0xf9, 0x2c, 0xbe, 0xd7, 0x26, 0xa8, 0xd2, 0x76, 0x1e, 0x80, 0x2b, 0x7a, 0xae, 0xd5,
-	0x27, 0x0e, 0xe2, 0x40, 0x4c, 0x97, 0xdc, 0xce, 0xf2, 0x0a, 0xee, 0xbf, 0x95, 0xeb, 0x7d, 0x77,
-	0x98, 0x15, 0x68, 0xd8, 0x95, 0x60, 0xd5, 0x64, 0x1e, 0x9e, 0xa2, 0x28, 0x1e, 0x41, 0xe5, 0x90,
-	0xb4, 0x2f, 0xb3, 0x41, 0x05, 0x6e, 0x43, 0xa9, 0x9d, 0xc4, 0xed, 0x8c, 0x52, 0x8c, 0xdb, 0x7d,
-	0xdd, 0x78, 0x6c, 0xc8, 0xfd, 0x02, 0xaa, 0x46, 0x44, 0x1b, 0x78, 0x00, 0x73, 0x78, 0x35, 0x4c,
-	0x6c, 0x75, 0xcf, 0xfc, 0x67, 0xe1, 0x44, 0xa0, 0x9e, 0x3a, 0xd4, 0xcd, 0x95, 0x27, 0x14, 0x4f,
-	0x69, 0xd2, 0xcb, 0x59, 0x75, 0x0f, 0x60, 0x7d, 0xc2, 0xd9, 0x5d, 0xd4, 0xb7, 0xe6, 0xe5, 0xbf,
-	0x31, 0x3e, 0xfb, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x67, 0xfd, 0x4a, 0x37, 0x19, 0x00,
-	0x00,
+	0x15, 0x06, 0x45, 0x49, 0x96, 0x0e, 0x2f, 0x22, 0x97, 0xba, 0x50, 0x32, 0x2a, 0xc9, 0x6b, 0xa7,
+	0x71, 0x1d, 0x54, 0xa9, 0x95, 0xd4, 0x48, 0x13, 0x24, 0xa8, 0x6e, 0xbe, 0x24, 0x8e, 0xcd, 0xac,
+	0x64, 0xbb, 0xe8, 0xcb, 0x62, 0xb8, 0x3b, 0x22, 0xb7, 0x5a, 0xee, 0xac, 0x67, 0x66, 0x25, 0x11,
+	0x28, 0xfa, 0x13, 0xfa, 0xd6, 0xb7, 0xbe, 0x15, 0x68, 0xdf, 0xfb, 0x63, 0x52, 0xf4, 0x97, 0xf4,
+	0xa1, 0x2f, 0xc5, 0xdc, 0xc8, 0x59, 0x92, 0x92, 0x69, 0xc1, 0x28, 0xf2, 0x62, 0xf0, 0x7c, 0xe7,
+	0x7e, 0xe6, 0xcc, 0x99, 0xb3, 0x16, 0xac, 0x71, 0xd4, 0x8e, 0x31, 0xef, 0xa1, 0x04, 0x75, 0x30,
+	0x0d, 0x11, 0x47, 0x3b, 0x29, 0x25, 0x9c, 0x38, 0xf5, 0x31, 0xc6, 0x46, 0xe9, 0x6d, 0x86, 0x69,
+	0x5f, 0xf1, 0x37, 0xaa, 0x9c, 0xa4, 0x64, 0x28, 0xbf, 0xb1, 0x42, 0x71, 0x1a, 0x47, 0x01, 0xe2,
+	0x11, 0x49, 0x2c, 0xb8, 0x12, 0x93, 0x4e, 0xc6, 0xa3, 0x58, 0x91, 0xee, 0xbf, 0x0b, 0xb0, 0x74,
+	0x22, 0x0c, 0x1f, 0xe2, 0xd3, 0x28, 0x89, 0x84, 0xb0, 0xe3, 0xc0, 0x6c, 0x82, 0x7a, 0xb8, 0x59,
+	0xd8, 0x2e, 0xdc, 0x5f, 0xf4, 0xe4, 0x6f, 0x67, 0x15, 0xe6, 0x59, 0xd0, 0xc5, 0x3d, 0xd4, 0x9c,
+	0x91, 0xa8, 0xa6, 0x9c, 0x26, 0xdc, 0x0a, 0x48, 0x9c, 0xf5, 0x12, 0xd6, 0x2c, 0x6e, 0x17, 0xef,
+	0x2f, 0x7a, 0x86, 0x74, 0x76, 0xa0, 0x91, 0xd2, 0xa8, 0x87, 0x68, 0xdf, 0x3f, 0xc3, 0x7d, 0xdf,
+	0x48, 0xcd, 0x4a, 0xa9, 0xba, 0x66, 0x7d, 0x87, 0xfb, 0x07, 0x5a, 0xde, 0x81, 0x59, 0xde, 0x4f,
+	0x71, 0x73, 0x4e, 0x79, 0x15, 0xbf, 0x9d, 0x2d, 0x28, 0x89, 0xd0, 0xfd, 0x18, 0x27, 0x1d, 0xde,
+	0x6d, 0xce, 0x6f, 0x17, 0xee, 0xcf, 0x7a, 0x20, 0xa0, 0xe7, 0x12, 0x71, 0x6e, 0xc3, 0x22, 0x25,
+	0x17, 0x7e, 0x40, 0xb2, 0x84, 0x37, 0x6f, 0x49, 0xf6, 0x02, 0x25, 0x17, 0x07, 0x82, 0x76, 0xff,
+	0x5e, 0x80, 0xda, 0xb1, 0x0c, 0xd3, 0x4a, 0xee, 0x63, 0x58, 0x12, 0xfa, 0x6d, 0xc4, 0xb0, 0xaf,
+	0x33, 0x52, 0x79, 0x56, 0x0d, 0xac, 0x54, 0x9c, 0x97, 0xa0, 0x2a, 0xee, 0x87, 0x03, 0x65, 0xd6,
+	0x9c, 0xd9, 0x2e, 0xde, 0x2f, 0xed, 0xba, 0x3b, 0xe3, 0x87, 0x34, 0x52, 0x44, 0xaf, 0xc6, 0xf3,
+	0x00, 0x13, 0xa5, 0x3a, 0xc7, 0x94, 0x45, 0x24, 0x69, 0x16, 0xa5, 0x47, 0x43, 0x8a, 0x40, 0x1d,
+	0xe5, 0xf5, 0xa0, 0x8b, 0x92, 0x0e, 0xf6, 0x30, 0xcb, 0x62, 0xee, 0x3c, 0x85, 0x4a, 0x1b, 0x9f,
+	0x12, 0x9a, 0x0b, 0xb4, 0xb4, 0x7b, 0x77, 0x82, 0xf7, 0xd1, 0x34, 0xbd, 0xb2, 0xd2, 0xd4, 0xb9,
+	0x3c, 0x86, 0x32, 0x3a, 0xe5, 0x98, 0xfa, 0xd6, 0x19, 0x4e, 0x69, 0xa8, 0x24, 0x15, 0x15, 0xec,
+	0xfe, 0xa7, 0x00, 0xd5, 0x57, 0x0c, 0xd3, 0x16, 0xa6, 0xbd, 0x88, 0x31, 0xdd, 0x2c, 0x5d, 0xc2,
+	0xb8, 0x69, 0x16, 0xf1, 0x5b, 0x60, 0x19, 0xc3, 0x54, 0xb7, 0x8a, 0xfc, 0xed, 0x7c, 0x02, 0xf5,
+	0x14, 0x31, 0x76, 0x41, 0x68, 0xe8, 0x07, 0x5d, 0x1c, 0x9c, 0xb1, 0xac, 0x27, 0xeb, 0x30, 0xeb,
+	0xd5, 0x0c, 0xe3, 0x40, 0xe3, 0xce, 0x0f, 0x00, 0x29, 0x8d, 0xce, 0xa3, 0x18, 0x77, 0xb0, 0x6a,
+	0x99, 0xd2, 0xee, 0xc3, 0x09, 0xd1, 0xe6, 0x63, 0xd9, 0x69, 0x0d, 0x74, 0x8e, 0x12, 0x4e, 0xfb,
+	0x9e, 0x65, 0x64, 0xe3, 0x6b, 0x58, 0x1a, 0x61, 0x3b, 0x35, 0x28, 0x9e, 0xe1, 0xbe, 0x8e, 0x5c,
+	0xfc, 0x74, 0x96, 0x61, 0xee, 0x1c, 0xc5, 0x19, 0xd6, 0x91, 0x2b, 0xe2, 0xcb, 0x99, 0x2f, 0x0a,
+	0xee, 0x8f, 0x05, 0x28, 0x1f, 0xb6, 0xdf, 0x91, 0x77, 0x15, 0x66, 0xc2, 0xb6, 0xd6, 0x9d, 0x09,
+	0xdb, 0x83, 0x3a, 0x14, 0xad, 0x3a, 0xbc, 0x9c, 0x90, 0xda, 0xa7, 0x13, 0x52, 0x3b, 0x6c, 0xff,
+	0x7f, 0x12, 0xfb, 0x5b, 0x01, 0x4a, 0x43, 0x4f, 0xcc, 0x79, 0x0e, 0x35, 0x11, 0xa7, 0x9f, 0x0e,
+	0xb1, 0x66, 0x41, 0x46, 0x79, 0xe7, 0x9d, 0x07, 0xe0, 0x2d, 0x65, 0x39, 0x9a, 0x39, 0x8f, 0xa1,
+	0x1a, 0xb6, 0x73, 0xb6, 0xd4, 0x0d, 0xda, 0x7a, 0x47, 0xc6, 0x5e, 0x25, 0xb4, 0x28, 0xe6, 0x7e,
+	0x05, 0xa5, 0xfd, 0x38, 0x6d, 0x11, 0xa6, 0x2e, 0x71, 0x0d, 0x8a, 0x59, 0x14, 0xca, 0x04, 0x2b,
+	0x9e, 0xf8, 0xe9, 0x6c, 0xc0, 0x42, 0xaa, 0xb9, 0x3a, 0xc7, 0x01, 0xed, 0x7e, 0x0c, 0xa5, 0x56,
+	0x94, 0x74, 0x3c, 0xfc, 0x36, 0xc3, 0x8c, 0x8b, 0x7b, 0x98, 0xa2, 0x7e, 0x4c, 0x50, 0xa8, 0x2b,
+	0x64, 0x48, 0xf7, 0x3e, 0x94, 0x95, 0x20, 0x4b, 0x49, 0xc2, 0xf0, 0x35, 0x92, 0x0f, 0xa0, 0x7c,
+	0x1c, 0x63, 0x9c, 0x1a, 0x9b, 0x1b, 0xb0, 0x10, 0x66, 0x54, 0xce, 0x5a, 0x29, 0x5a, 0xf4, 0x06,
+	0xb4, 0xbb, 0x04, 0x15, 0x2d, 0xab, 0xcc, 0xba, 0xff, 0x2a, 0x80, 0x73, 0x74, 0x89, 0x83, 0x8c,
+	0xe3, 0xa7, 0x84, 0x9c, 0x19, 0x1b, 0x93, 0xc6, 0xee, 0x26, 0x40, 0x8a, 0x28, 0xea, 0x61, 0x8e,
+	0xa9, 0xaa, 0xdd, 0xa2, 0x67, 0x21, 0x4e, 0x0b, 0x16, 0xf1, 0x25, 0xa7, 0xc8, 0xc7, 0xc9, 0xb9,
+	0x1c, 0xc0, 0xa5, 0xdd, 0xcf, 0x26, 0x94, 0x76, 0xdc, 0xdb, 0xce, 0x91, 0x50, 0x3b, 0x4a, 0xce,
+	0x55, 0x43, 0x2d, 0x60, 0x4d, 0x6e, 0x7c, 0x05, 0x95, 0x1c, 0xeb, 0xbd, 0x9a, 0xe9, 0x14, 0x1a,
+	0x39, 0x57, 0xba, 0x8e, 0x5b, 0x50, 0xc2, 0x97, 0x11, 0xf7, 0x19, 0x47, 0x3c, 0x63, 0xba, 0x40,
+	0x20, 0xa0, 0x63, 0x89, 0xc8, 0xd7, 0x85, 0x87, 0x24, 0xe3, 0x83, 0xd7, 0x45, 0x52, 0x1a, 0xc7,
+	0xd4, 0x5c, 0x21, 0x4d, 0xb9, 0xe7, 0x50, 0x7b, 0x82, 0xb9, 0x1a, 0x4a, 0xa6, 0x7c, 0xab, 0x30,
+	0x2f, 0x13, 0x57, 0xed, 0xba, 0xe8, 0x69, 0xca, 0xb9, 0x0b, 0x95, 0x28, 0x09, 0xe2, 0x2c, 0xc4,
+	0xfe, 0x79, 0x84, 0x2f, 0x98, 0x74, 0xb1, 0xe0, 0x95, 0x35, 0xf8, 0x5a, 0x60, 0xce, 0x47, 0x50,
+	0xc5, 0x97, 0x4a, 0x48, 0x1b, 0x51, 0xaf, 0x59, 0x45, 0xa3, 0x72, 0xba, 0x33, 0x17, 0x43, 0xdd,
+	0xf2, 0xab, 0xb3, 0x6b, 0x41, 0x5d, 0x8d, 0x55, 0xeb, 0xa5, 0x78, 0x9f, 0x51, 0x5d, 0x63, 0x23,
+	0x88, 0xbb, 0x06, 0x2b, 0x4f, 0x30, 0xb7, 0xfa, 0x5f, 0xe7, 0xe8, 0xfe, 0x1e, 0x56, 0x47, 0x19,
+	0x3a, 0x88, 0xdf, 0x42, 0x29, 0x7f, 0x63, 0x85, 0xfb, 0xcd, 0x09, 0xee, 0x6d, 0x65, 0x5b, 0xc5,
+	0x5d, 0x06, 0xe7, 0x18, 0x73, 0x0f, 0xa3, 0xf0, 0x65, 0x12, 0xf7, 0x8d, 0xc7, 0x15, 0x68, 0xe4,
+	0x50, 0xdd, 0xc2, 0x43, 0xf8, 0x0d, 0x8d, 0x38, 0x36, 0xd2, 0xab, 0xb0, 0x9c, 0x87, 0xb5, 0xf8,
+	0xb7, 0x50, 0x57, 0x2f, 0xdb, 0x49, 0x3f, 0x35, 0xc2, 0xce, 0xaf, 0xa1, 0xa4, 0xc2, 0xf3, 0xe5,
+	0xbb, 0x2f, 0x42, 0xae, 0xee, 0x2e, 0xef, 0x0c, 0xd6, 0x18, 0x59, 0x73, 0x2e, 0x35, 0x80, 0x0f,
+	0x7e, 0x8b, 0x38, 0x6d, 0x5b, 0xc3, 0x80, 0x3c, 0x7c, 0x4a, 0x31, 0xeb, 0x8a, 0x96, 0xb2, 0x03,
+	0xca, 0xc3, 0x5a, 0x7c, 0x0d, 0x56, 0xbc, 0x2c, 0x79, 0x8a, 0x51, 0xcc, 0xbb, 0xf2, 0xd5, 0x31,
+	0x0a, 0x4d, 0x58, 0x1d, 0x65, 0x68, 0x95, 0xcf, 0xa1, 0xf9, 0xac, 0x93, 0x10, 0x8a, 0x15, 0xf3,
+	0x88, 0x52, 0x42, 0x73, 0x23, 0x85, 0x73, 0x4c, 0x93, 0xe1, 0xa0, 0x90, 0xa4, 0x7b, 0x1b, 0xd6,
+	0x27, 0x68, 0x69, 0x93, 0x5f, 0x8a, 0xa0, 0xc5, 0x3c, 0xc9, 0x77, 0xf2, 0x5d, 0xa8, 0x5c, 0xa0,
+	0x88, 0xfb, 0x83, 0x81, 0xa6, 0x6c, 0x96, 0x05, 0x68, 0x46, 0xa0, 0xca, 0xcc, 0xd6, 0xd5, 0x36,
+	0x77, 0x61, 0xb5, 0x45, 0xf1, 0x69, 0x1c, 0x75, 0xba, 0x23, 0x17, 0x44, 0xac, 0x6a, 0xb2, 0x70,
+	0xe6, 0x86, 0x18, 0xd2, 0xed, 0xc0, 0xda, 0x98, 0x8e, 0xee, 0xab, 0xe7, 0x50, 0x55, 0x52, 0x3e,
+	0x95, 0x4b, 0x89, 0x79, 0x0c, 0x3e, 0xba, 0xb2, 0xb3, 0xed, 0x15, 0xc6, 0xab, 0x04, 0x16, 0xc5,
+	0xdc, 0xff, 0x16, 0xc0, 0xd9, 0x4b, 0xd3, 0xb8, 0x9f, 0x8f, 0xac, 0x06, 0x45, 0xf6, 0x36, 0x36,
+	0x23, 0x86, 0xbd, 0x8d, 0xc5, 0x88, 0x39, 0x25, 0x34, 0xc0, 0xfa, 0xb2, 0x2a, 0x42, 0xec, 0x10,
+	0x28, 0x8e, 0xc9, 0x85, 0x6f, 0xad, 0xb6, 0x72, 0x32, 0x2c, 0x78, 0x35, 0xc9, 0xf0, 0x86, 0xf8,
+	0xf8, 0xf6, 0x34, 0xfb, 0xa1, 0xb6, 0xa7, 0xb9, 0x1b, 0x6e, 0x4f, 0xff, 0x28, 0x40, 0x23, 0x97,
+	0xbd, 0xae, 0xf1, 0x4f, 0x6f, 0xcf, 0xfb, 0x67, 0x01, 0x9a, 0x7a, 0x90, 0x3f, 0xc6, 0x3c, 0xe8,
+	0xee, 0xb1, 0xc3, 0xf6, 0xe0, 0xb4, 0x96, 0x61, 0x4e, 0x7e, 0x77, 0xc8, 0x30, 0xcb, 0x9e, 0x22,
+	0x9c, 0x35, 0xb8, 0x15, 0xb6, 0x7d, 0xf9, 0x80, 0xe9, 0x19, 0x1e, 0xb6, 0x5f, 0x88, 0x27, 0x6c,
+	0x1d, 0x16, 0x7a, 0xe8, 0xd2, 0xa7, 0xe4, 0x82, 0xe9, 0x7d, 0xef, 0x56, 0x0f, 0x5d, 0x7a, 0xe4,
+	0x82, 0xc9, 0x5d, 0x3c, 0x62, 0x72, 0xc9, 0x6e, 0x47, 0x49, 0x4c, 0x3a, 0x4c, 0x1e, 0xd2, 0x82,
+	0x57, 0xd5, 0xf0, 0xbe, 0x42, 0xc5, 0x8d, 0xa0, 0xb2, 0xd9, 0xed, 0x23, 0x58, 0xf0, 0xca, 0xd4,
+	0xba, 0x01, 0xee, 0x13, 0x58, 0x9f, 0x10, 0xb3, 0xae, 0xf1, 0x03, 0x98, 0x57, 0x0d, 0xac, 0x8b,
+	0xeb, 0xec, 0xa8, 0x6f, 0xa7, 0x1f, 0xc4, 0xbf, 0xba, 0x59, 0xb5, 0x84, 0xfb, 0xe7, 0x02, 0xfc,
+	0x2c, 0x6f, 0x69, 0x2f, 0x8e, 0xc5, 0x8e, 0xc5, 0x3e, 0x7c, 0x09, 0xc6, 0x32, 0x9b, 0x9d, 0x90,
+	0xd9, 0x73, 0xd8, 0xbc, 0x2a, 0x9e, 0x1b, 0xa4, 0xf7, 0xdd, 0xe8, 0xd9, 0xee, 0xa5, 0xe9, 0xf5,
+	0x89, 0xd9, 0xf1, 0xcf, 0xe4, 0xe2, 0x1f, 0x2f, 0xba, 0x34, 0x76, 0x83, 0xa8, 0xc4, 0xf3, 0x13,
+	0xa3, 0x73, 0xac, 0x36, 0x02, 0x33, 0x8e, 0x1f, 0x43, 0x23, 0x87, 0x6a, 0xc3, 0x9f, 0x8a, 0xbd,
+	0x60, 0xb0, 0x4b, 0x94, 0x76, 0xd7, 0x76, 0x46, 0x3f, 0x76, 0xb5, 0x82, 0x16, 0x13, 0xf3, 0xfe,
+	0x7b, 0xc4, 0x38, 0xa6, 0x66, 0x7e, 0x1a, 0x07, 0x9f, 0xc3, 0xea, 0x28, 0x43, 0xfb, 0xb0, 0x37,
+	0xca, 0xc2, 0xc8, 0x46, 0xe9, 0x40, 0xed, 0x98, 0x93, 0x54, 0x86, 0x66, 0x2c, 0x35, 0xa0, 0x6e,
+	0x61, 0x7a, 0x1a, 0xff, 0x0e, 0xd6, 0x06, 0xe0, 0xf7, 0x51, 0x12, 0xf5, 0xb2, 0x9e, 0xb5, 0x32,
+	0x5e, 0x65, 0xdf, 0xb9, 0x03, 0x72, 0xd8, 0xfb, 0x3c, 0xea, 0x61, 0xb3, 0x15, 0x15, 0xbd, 0x92,
+	0xc0, 0x4e, 0x14, 0xe4, 0x3e, 0x82, 0xe6, 0xb8, 0xe5, 0x29, 0x42, 0xff, 0x06, 0x36, 0x06, 0x7a,
+	0xfb, 0x72, 0x76, 0x08, 0x8b, 0x26, 0xa8, 0x6d, 0x28, 0x33, 0x4e, 0x52, 0xe9, 0xd8, 0x4f, 0x06,
+	0xab, 0x9a, 0xc0, 0x84, 0xd8, 0x0b, 0xe6, 0xfe, 0x06, 0x6e, 0x4f, 0xd4, 0x9f, 0xc2, 0xb5, 0xac,
+	0x10, 0xa2, 0x3c, 0x57, 0x36, 0x71, 0xee, 0x16, 0xa8, 0xeb, 0xf6, 0x08, 0x36, 0x9f, 0x25, 0x7f,
+	0xc0, 0x01, 0x3f, 0xea, 0xa5, 0xbc, 0x7f, 0x42, 0x51, 0xc2, 0x50, 0xc0, 0xad, 0x55, 0x48, 0x74,
+	0x6a, 0x87, 0x47, 0x21, 0xd3, 0x5e, 0x14, 0xe1, 0xde, 0x81, 0xad, 0x2b, 0xf5, 0xb4, 0xe9, 0x43,
+	0xb8, 0xa3, 0x36, 0x8b, 0xa3, 0x4b, 0xf1, 0x42, 0xa3, 0x58, 0xac, 0x35, 0x29, 0xa2, 0x38, 0xe1,
+	0x38, 0x34, 0xd6, 0xe5, 0xc6, 0xaa, 0xd8, 0x7e, 0x64, 0xb6, 0x7f, 0x30, 0xd0, 0xb3, 0xd0, 0xbd,
+	0x07, 0xee, 0x75, 0x56, 0xb4, 0xaf, 0x6d, 0xd8, 0x1c, 0x95, 0x3a, 0x8a, 0x71, 0x30, 0x74, 0x24,
+	0x02, 0xbe, 0x52, 0x42, 0x1b, 0x71, 0xd4, 0xb2, 0x2b, 0xea, 0x33, 0xb8, 0x17, 0xbf, 0x80, 0xba,
+	0x85, 0xe9, 0xda, 0x2f, 0xc3, 0x1c, 0x0a, 0x43, 0x6a, 0x9e, 0x77, 0x45, 0xb8, 0x7f, 0x82, 0xd5,
+	0x37, 0x28, 0xe2, 0xd6, 0xe7, 0x93, 0x49, 0x72, 0x0f, 0xca, 0xed, 0x38, 0xcd, 0xaf, 0x19, 0x93,
+	0x97, 0x46, 0x5b, 0xb9, 0xd4, 0x1e, 0x12, 0xd3, 0x34, 0xea, 0x3a, 0xac, 0x8d, 0xf9, 0xd7, 0x99,
+	0xd5, 0xa0, 0x2a, 0x7a, 0x69, 0x3f, 0x36, 0xf3, 0xc7, 0x7d, 0x0d, 0x4b, 0x03, 0x44, 0x67, 0x75,
+	0x00, 0x15, 0x3b, 0x4a, 0xb3, 0x80, 0xbc, 0x2b, 0xcc, 0xb2, 0x15, 0x26, 0x73, 0xeb, 0xc2, 0x2e,
+	0xa2, 0xdc, 0x72, 0x25, 0xef, 0xb0, 0x81, 0x74, 0x40, 0x7f, 0x04, 0xc7, 0xcb, 0x92, 0xfd, 0x38,
+	0x7d, 0x95, 0xf0, 0x28, 0x36, 0x75, 0xfa, 0x10, 0x11, 0x4c, 0x53, 0xa9, 0x87, 0xd0, 0xc8, 0x79,
+	0x9f, 0xe2, 0x4a, 0xad, 0xc3, 0x9a, 0x87, 0x19, 0xe6, 0xd6, 0xe2, 0x63, 0xf2, 0xdb, 0x80, 0xe6,
+	0x38, 0x4b, 0xe7, 0xd9, 0x80, 0xfa, 0xb3, 0x24, 0xe2, 0x6a, 0xf2, 0x19, 0x85, 0x5f, 0x81, 0x63,
+	0x83, 0x53, 0x78, 0xff, 0xb1, 0x00, 0x9b, 0x2d, 0x92, 0x66, 0xb1, 0x5c, 0xad, 0x55, 0xf7, 0x7f,
+	0x4b, 0x32, 0xd1, 0xc6, 0xa6, 0x76, 0x3f, 0x87, 0x25, 0x39, 0x4b, 0x02, 0x8a, 0x11, 0xc7, 0xe1,
+	0x70, 0xa6, 0x54, 0x04, 0x7c, 0xa0, 0xd0, 0x17, 0x4c, 0x5c, 0x38, 0x75, 0x51, 0xed, 0xf7, 0x13,
+	0x14, 0x24, 0xdf, 0xd0, 0x2f, 0xa0, 0xdc, 0x93, 0x91, 0xf9, 0x28, 0x8e, 0x90, 0x7a, 0x47, 0x4b,
+	0xbb, 0x2b, 0xa3, 0x9f, 0x0b, 0x7b, 0x82, 0xe9, 0x95, 0x94, 0xa8, 0x24, 0x9c, 0x87, 0xb0, 0x6c,
+	0xbd, 0x0e, 0xc3, 0x76, 0x9f, 0x95, 0x3e, 0x1a, 0x16, 0x6f, 0xb0, 0x5c, 0xdf, 0x81, 0xad, 0x2b,
+	0xf3, 0xd2, 0x25, 0xfc, 0x6b, 0x01, 0x6a, 0xa2, 0x5c, 0xf6, 0x30, 0x73, 0x7e, 0x09, 0xf3, 0x4a,
+	0xba, 0x59, 0xb8, 0x2e, 0x3c, 0x2d, 0x74, 0x65, 0x64, 0x33, 0x57, 0x46, 0x36, 0xa9, 0x9e, 0xc5,
+	0x09, 0xf5, 0x34, 0x27, 0x9c, 0x9f, 0xaa, 0x2b, 0xd0, 0x38, 0xc4, 0x3d, 0xc2, 0x71, 0xfe, 0xe0,
+	0x77, 0x61, 0x39, 0x0f, 0x4f, 0x71, 0xf4, 0x5f, 0xc3, 0x56, 0x8b, 0x12, 0xa1, 0x24, 0x5d, 0xbc,
+	0xe9, 0xe2, 0xe4, 0x00, 0x65, 0x9d, 0x2e, 0x7f, 0x95, 0x4e, 0xf1, 0xc0, 0xb9, 0xdf, 0xc0, 0xf6,
+	0xd5, 0xea, 0xd3, 0xf5, 0xbd, 0x52, 0x44, 0x4c, 0xdb, 0x09, 0xad, 0xbe, 0x1f, 0x67, 0xe9, 0x02,
+	0xfc, 0x45, 0xfc, 0x8f, 0x30, 0xce, 0xf7, 0xfd, 0xfb, 0x1e, 0xda, 0x84, 0x13, 0x98, 0x99, 0xd4,
+	0xd1, 0x0f, 0xa0, 0x2e, 0xbf, 0x5a, 0xc4, 0xff, 0x7a, 0x50, 0xee, 0x33, 0x11, 0x93, 0xfe, 0x58,
+	0x59, 0x92, 0x8c, 0xe1, 0xb3, 0x27, 0x5f, 0x46, 0x3c, 0x72, 0xf3, 0xdc, 0x67, 0xc3, 0x44, 0x3c,
+	0x2c, 0x8d, 0xe0, 0xf0, 0x66, 0x31, 0x8b, 0xaf, 0xd0, 0x09, 0xa6, 0xb4, 0x9f, 0x7b, 0xe0, 0x8a,
+	0x99, 0x6b, 0xcd, 0x89, 0xbd, 0x24, 0x14, 0xaf, 0x4b, 0x6e, 0x13, 0x7b, 0x0d, 0x77, 0xaf, 0x95,
+	0xba, 0xe9, 0x66, 0xb6, 0x02, 0x0d, 0xbb, 0x13, 0xac, 0x9e, 0xcc, 0xc3, 0x53, 0x34, 0xc5, 0x31,
+	0x54, 0xf6, 0x51, 0x70, 0x96, 0xa5, 0xc3, 0x6d, 0xa6, 0x14, 0x90, 0x24, 0xc8, 0x28, 0xc5, 0x49,
+	0xd0, 0xd7, 0x83, 0xc7, 0x86, 0x84, 0x44, 0x94, 0x04, 0x14, 0xf7, 0x70, 0xc2, 0x51, 0xac, 0xbf,
+	0x36, 0x6d, 0xc8, 0x7d, 0x04, 0x55, 0x63, 0x54, 0x87, 0x70, 0x0f, 0xe6, 0xf0, 0xf9, 0xb0, 0xf4,
+	0xd5, 0x1d, 0xf3, 0x17, 0x95, 0x23, 0x81, 0x7a, 0x8a, 0xe9, 0xf6, 0xe4, 0xf8, 0xe5, 0x84, 0xe2,
+	0xc7, 0x94, 0xf4, 0xf2, 0x71, 0x7d, 0x02, 0x0e, 0x55, 0x3c, 0x9f, 0x93, 0x91, 0x5d, 0x6b, 0x49,
+	0x73, 0x4e, 0x88, 0x5a, 0xb8, 0x9c, 0x7b, 0x50, 0xb5, 0x84, 0x53, 0xc2, 0xf4, 0x78, 0x28, 0x0f,
+	0x04, 0x5b, 0x84, 0xb9, 0x7b, 0xb0, 0x3e, 0xc1, 0xdd, 0xfb, 0x44, 0xdc, 0x9e, 0x97, 0x7f, 0x11,
+	0xfa, 0xec, 0x7f, 0x03, 0x00, 0xcd, 0xd1, 0x56, 0x3c, 0x82, 0x1a, 0x00, 0x00,
 }
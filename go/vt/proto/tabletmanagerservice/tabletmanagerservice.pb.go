@@ -76,8 +76,15 @@ type TabletManagerClient interface {
 	// StopSlaveMinimum stops the mysql replication after it reaches
 	// the provided minimum point
 	StopSlaveMinimum(ctx context.Context, in *tabletmanagerdata.StopSlaveMinimumRequest, opts ...grpc.CallOption) (*tabletmanagerdata.StopSlaveMinimumResponse, error)
+	// StopSlaveBeforeTime stops the mysql replication of a delayed
+	// replica right before the first transaction committed at or
+	// after the provided time
+	StopSlaveBeforeTime(ctx context.Context, in *tabletmanagerdata.StopSlaveBeforeTimeRequest, opts ...grpc.CallOption) (*tabletmanagerdata.StopSlaveBeforeTimeResponse, error)
 	// StartSlave starts the mysql replication
 	StartSlave(ctx context.Context, in *tabletmanagerdata.StartSlaveRequest, opts ...grpc.CallOption) (*tabletmanagerdata.StartSlaveResponse, error)
+	// InjectEmptyTransactions commits an empty transaction for each
+	// of the provided GTIDs
+	InjectEmptyTransactions(ctx context.Context, in *tabletmanagerdata.InjectEmptyTransactionsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.InjectEmptyTransactionsResponse, error)
 	// TabletExternallyReparented tells a tablet that its underlying MySQL is
 	// currently the master. It is only used in environments (tabletmanagerdata.such as Vitess+MoB)
 	// in which MySQL is reparented by some agent external to Vitess, and then
@@ -343,6 +350,15 @@ func (c *tabletManagerClient) StopSlaveMinimum(ctx context.Context, in *tabletma
 	return out, nil
 }
 
+func (c *tabletManagerClient) StopSlaveBeforeTime(ctx context.Context, in *tabletmanagerdata.StopSlaveBeforeTimeRequest, opts ...grpc.CallOption) (*tabletmanagerdata.StopSlaveBeforeTimeResponse, error) {
+	out := new(tabletmanagerdata.StopSlaveBeforeTimeResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/StopSlaveBeforeTime", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tabletManagerClient) StartSlave(ctx context.Context, in *tabletmanagerdata.StartSlaveRequest, opts ...grpc.CallOption) (*tabletmanagerdata.StartSlaveResponse, error) {
 	out := new(tabletmanagerdata.StartSlaveResponse)
 	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/StartSlave", in, out, c.cc, opts...)
@@ -352,6 +368,15 @@ func (c *tabletManagerClient) StartSlave(ctx context.Context, in *tabletmanagerd
 	return out, nil
 }
 
+func (c *tabletManagerClient) InjectEmptyTransactions(ctx context.Context, in *tabletmanagerdata.InjectEmptyTransactionsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.InjectEmptyTransactionsResponse, error) {
+	out := new(tabletmanagerdata.InjectEmptyTransactionsResponse)
+	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/InjectEmptyTransactions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tabletManagerClient) TabletExternallyReparented(ctx context.Context, in *tabletmanagerdata.TabletExternallyReparentedRequest, opts ...grpc.CallOption) (*tabletmanagerdata.TabletExternallyReparentedResponse, error) {
 	out := new(tabletmanagerdata.TabletExternallyReparentedResponse)
 	err := grpc.Invoke(ctx, "/tabletmanagerservice.TabletManager/TabletExternallyReparented", in, out, c.cc, opts...)
@@ -613,8 +638,15 @@ type TabletManagerServer interface {
 	// StopSlaveMinimum stops the mysql replication after it reaches
 	// the provided minimum point
 	StopSlaveMinimum(context.Context, *tabletmanagerdata.StopSlaveMinimumRequest) (*tabletmanagerdata.StopSlaveMinimumResponse, error)
+	// StopSlaveBeforeTime stops the mysql replication of a delayed
+	// replica right before the first transaction committed at or
+	// after the provided time
+	StopSlaveBeforeTime(context.Context, *tabletmanagerdata.StopSlaveBeforeTimeRequest) (*tabletmanagerdata.StopSlaveBeforeTimeResponse, error)
 	// StartSlave starts the mysql replication
 	StartSlave(context.Context, *tabletmanagerdata.StartSlaveRequest) (*tabletmanagerdata.StartSlaveResponse, error)
+	// InjectEmptyTransactions commits an empty transaction for each
+	// of the provided GTIDs
+	InjectEmptyTransactions(context.Context, *tabletmanagerdata.InjectEmptyTransactionsRequest) (*tabletmanagerdata.InjectEmptyTransactionsResponse, error)
 	// TabletExternallyReparented tells a tablet that its underlying MySQL is
 	// currently the master. It is only used in environments (tabletmanagerdata.such as Vitess+MoB)
 	// in which MySQL is reparented by some agent external to Vitess, and then
@@ -1065,6 +1097,24 @@ func _TabletManager_StopSlaveMinimum_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabletManager_StopSlaveBeforeTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.StopSlaveBeforeTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).StopSlaveBeforeTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/StopSlaveBeforeTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).StopSlaveBeforeTime(ctx, req.(*tabletmanagerdata.StopSlaveBeforeTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TabletManager_StartSlave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(tabletmanagerdata.StartSlaveRequest)
 	if err := dec(in); err != nil {
@@ -1083,6 +1133,24 @@ func _TabletManager_StartSlave_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabletManager_InjectEmptyTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.InjectEmptyTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).InjectEmptyTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/InjectEmptyTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).InjectEmptyTransactions(ctx, req.(*tabletmanagerdata.InjectEmptyTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TabletManager_TabletExternallyReparented_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(tabletmanagerdata.TabletExternallyReparentedRequest)
 	if err := dec(in); err != nil {
@@ -1537,10 +1605,18 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StopSlaveMinimum",
 			Handler:    _TabletManager_StopSlaveMinimum_Handler,
 		},
+		{
+			MethodName: "StopSlaveBeforeTime",
+			Handler:    _TabletManager_StopSlaveBeforeTime_Handler,
+		},
 		{
 			MethodName: "StartSlave",
 			Handler:    _TabletManager_StartSlave_Handler,
 		},
+		{
+			MethodName: "InjectEmptyTransactions",
+			Handler:    _TabletManager_InjectEmptyTransactions_Handler,
+		},
 		{
 			MethodName: "TabletExternallyReparented",
 			Handler:    _TabletManager_TabletExternallyReparented_Handler,
@@ -1632,66 +1708,69 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("tabletmanagerservice.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 969 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x94, 0x98, 0x6d, 0x6f, 0x1c, 0x35,
-	0x10, 0xc7, 0x39, 0x09, 0x0a, 0x98, 0xc7, 0x5a, 0x88, 0xa2, 0x20, 0x01, 0x4d, 0x5a, 0x1e, 0x52,
-	0x54, 0xf5, 0x81, 0xf2, 0xfe, 0x2e, 0xbd, 0xb6, 0x41, 0x44, 0x1c, 0x77, 0x8d, 0x82, 0x84, 0x84,
-	0xe4, 0xec, 0x4d, 0x6f, 0x97, 0x78, 0x6d, 0x63, 0x7b, 0xa3, 0xe4, 0x15, 0x12, 0x12, 0xaf, 0x90,
-	0xf8, 0x04, 0x7c, 0x58, 0xb4, 0x0f, 0xf6, 0x8d, 0xef, 0xbc, 0xbe, 0xbb, 0xb7, 0xf9, 0xff, 0x66,
-	0xc6, 0x3b, 0x9e, 0x19, 0xcf, 0x85, 0xec, 0x59, 0x76, 0xce, 0xc1, 0x96, 0x4c, 0xb0, 0x05, 0x68,
-	0x03, 0xfa, 0xb2, 0xc8, 0xe0, 0xbe, 0xd2, 0xd2, 0x4a, 0xfa, 0x51, 0x4c, 0xdb, 0xbb, 0x15, 0xfc,
-	0x75, 0xce, 0x2c, 0x6b, 0xf1, 0x47, 0xff, 0xed, 0x93, 0xf7, 0x5e, 0x36, 0xda, 0x49, 0xab, 0xd1,
-	0x63, 0xf2, 0xfa, 0xa4, 0x10, 0x0b, 0xfa, 0xd9, 0xfd, 0x75, 0x9b, 0x5a, 0x98, 0xc2, 0x1f, 0x15,
-	0x18, 0xbb, 0xf7, 0x79, 0xaf, 0x6e, 0x94, 0x14, 0x06, 0xf6, 0x5f, 0xa3, 0x3f, 0x92, 0x37, 0x66,
-	0x1c, 0x40, 0xd1, 0x18, 0xdb, 0x28, 0xce, 0xd9, 0x17, 0xfd, 0x80, 0xf7, 0xf6, 0x1b, 0x79, 0x67,
-	0x7c, 0x05, 0x59, 0x65, 0xe1, 0x85, 0x94, 0x17, 0xf4, 0x6e, 0xc4, 0x04, 0xe9, 0xce, 0xf3, 0x97,
-	0x9b, 0x30, 0xef, 0xff, 0x17, 0xf2, 0xf6, 0x73, 0xb0, 0xb3, 0x2c, 0x87, 0x92, 0xd1, 0x83, 0x88,
-	0x99, 0x57, 0x9d, 0xef, 0x3b, 0x69, 0xc8, 0x7b, 0x5e, 0x90, 0xf7, 0x9f, 0x83, 0x9d, 0x80, 0x2e,
-	0x0b, 0x63, 0x0a, 0x29, 0x0c, 0xfd, 0x3a, 0x6e, 0x89, 0x10, 0x17, 0xe3, 0x9b, 0x2d, 0x48, 0x9c,
-	0xa2, 0x19, 0xd8, 0x29, 0xb0, 0xf9, 0x4f, 0x82, 0x5f, 0x47, 0x53, 0x84, 0xf4, 0x54, 0x8a, 0x02,
-	0xcc, 0xfb, 0x67, 0xe4, 0xdd, 0x4e, 0x38, 0xd3, 0x85, 0x05, 0x9a, 0xb0, 0x6c, 0x00, 0x17, 0xe1,
-	0xab, 0x8d, 0x9c, 0x0f, 0xf1, 0x2b, 0x21, 0x47, 0x39, 0x13, 0x0b, 0x78, 0x79, 0xad, 0x80, 0xc6,
-	0x32, 0xbc, 0x94, 0x9d, 0xfb, 0xbb, 0x1b, 0x28, 0x7c, 0xfe, 0x29, 0xbc, 0xd2, 0x60, 0xf2, 0x99,
-	0x65, 0x3d, 0xe7, 0xc7, 0x40, 0xea, 0xfc, 0x21, 0x87, 0xef, 0x7a, 0x5a, 0x89, 0x17, 0xc0, 0xb8,
-	0xcd, 0x8f, 0x72, 0xc8, 0x2e, 0xa2, 0x77, 0x1d, 0x22, 0xa9, 0xbb, 0x5e, 0x25, 0x7d, 0x20, 0x45,
-	0x6e, 0x1e, 0x2f, 0x84, 0xd4, 0xd0, 0xca, 0x63, 0xad, 0xa5, 0xa6, 0xf7, 0x22, 0x1e, 0xd6, 0x28,
-	0x17, 0xee, 0xdb, 0xed, 0xe0, 0x30, 0x7b, 0x5c, 0xb2, 0x79, 0xd7, 0x23, 0xf1, 0xec, 0x2d, 0x81,
-	0x74, 0xf6, 0x30, 0xe7, 0x43, 0xfc, 0x4e, 0x3e, 0x98, 0x68, 0x78, 0xc5, 0x8b, 0x45, 0xee, 0x3a,
-	0x31, 0x96, 0x94, 0x15, 0xc6, 0x05, 0x3a, 0xdc, 0x06, 0xc5, 0xcd, 0x32, 0x54, 0x8a, 0x5f, 0x77,
-	0x71, 0x62, 0x45, 0x84, 0xf4, 0x54, 0xb3, 0x04, 0x18, 0xbe, 0xa0, 0x6e, 0xd0, 0x3c, 0x03, 0x9b,
-	0xe5, 0x43, 0xf3, 0xf4, 0x9c, 0x45, 0x2f, 0x68, 0x8d, 0x4a, 0x5d, 0x50, 0x04, 0xf6, 0x11, 0xff,
-	0x24, 0x1f, 0x87, 0xf2, 0x90, 0xf3, 0x89, 0x2e, 0x2e, 0x0d, 0x7d, 0xb0, 0xd1, 0x93, 0x43, 0x5d,
-	0xec, 0x87, 0x3b, 0x58, 0xf4, 0x7f, 0xf2, 0x50, 0xa9, 0x2d, 0x3e, 0x79, 0xa8, 0xd4, 0xf6, 0x9f,
-	0xdc, 0xc0, 0xc1, 0xc4, 0xe3, 0xec, 0x12, 0xea, 0x36, 0xac, 0x4c, 0x7c, 0xe2, 0x2d, 0xf5, 0xe4,
-	0xc4, 0xc3, 0x18, 0x6e, 0xe7, 0x13, 0x66, 0x2c, 0xe8, 0x89, 0x34, 0x85, 0x2d, 0xa4, 0x88, 0xb6,
-	0x73, 0x88, 0xa4, 0xda, 0x79, 0x95, 0xc4, 0xaf, 0xcf, 0xcc, 0x4a, 0xd5, 0x9c, 0x22, 0xfa, 0xfa,
-	0x78, 0x35, 0xf5, 0xfa, 0x20, 0xc8, 0x7b, 0x2e, 0xc9, 0x87, 0xfe, 0xcf, 0x27, 0x85, 0x28, 0xca,
-	0xaa, 0xa4, 0x87, 0x29, 0xdb, 0x0e, 0x72, 0x71, 0xee, 0x6d, 0xc5, 0xe2, 0x01, 0x3e, 0xb3, 0x4c,
-	0xdb, 0xf6, 0x4b, 0xe2, 0x87, 0x74, 0x72, 0x6a, 0x80, 0x63, 0xca, 0x3b, 0xff, 0x67, 0x40, 0xf6,
-	0xda, 0x75, 0x65, 0x7c, 0x65, 0x41, 0x0b, 0xc6, 0xeb, 0xf7, 0x49, 0x31, 0x0d, 0xc2, 0xc2, 0x9c,
-	0x7e, 0x17, 0xf1, 0xd3, 0x8f, 0xbb, 0xe8, 0x4f, 0x76, 0xb4, 0xf2, 0xa7, 0xf9, 0x6b, 0x40, 0x6e,
-	0xad, 0x82, 0x63, 0x0e, 0x59, 0x7d, 0x94, 0x87, 0x5b, 0x38, 0xed, 0x58, 0x77, 0x8e, 0x47, 0xbb,
-	0x98, 0xac, 0xae, 0x2d, 0x75, 0xa2, 0x4c, 0xef, 0xda, 0xd2, 0xa8, 0x9b, 0xd6, 0x96, 0x0e, 0xc2,
-	0xc3, 0xf8, 0x8c, 0x15, 0x76, 0xc4, 0x95, 0x2f, 0xfe, 0x58, 0x49, 0xaf, 0x30, 0xa9, 0x61, 0xbc,
-	0x86, 0xfa, 0x58, 0x53, 0xf2, 0x66, 0x5d, 0x53, 0x23, 0xae, 0xe8, 0xed, 0x9e, 0x7a, 0x1b, 0x71,
-	0x3f, 0x25, 0xf6, 0x53, 0x88, 0xf7, 0x79, 0x4a, 0xde, 0x6a, 0x8a, 0xa8, 0x76, 0xba, 0xdf, 0x57,
-	0x61, 0xc8, 0xeb, 0x41, 0x92, 0xc1, 0x23, 0x67, 0x5a, 0x89, 0x11, 0x57, 0xa7, 0xc2, 0x16, 0x3c,
-	0x3a, 0x72, 0x90, 0x9e, 0x1a, 0x39, 0x01, 0x86, 0xfb, 0x75, 0x0a, 0xa6, 0xde, 0x8e, 0x14, 0x2f,
-	0x32, 0xd6, 0xe4, 0xfd, 0x30, 0xfa, 0x84, 0x86, 0x50, 0xaa, 0x5f, 0xd7, 0x59, 0xdc, 0xaf, 0xc7,
-	0xa2, 0xb0, 0xed, 0x60, 0x8a, 0xf6, 0xeb, 0x52, 0x4e, 0xf5, 0x2b, 0xa6, 0x82, 0x0e, 0x99, 0x48,
-	0x55, 0xf1, 0x66, 0x49, 0x6a, 0x5b, 0xe8, 0x07, 0x59, 0xd5, 0xb5, 0x1c, 0xed, 0x90, 0x1e, 0x36,
-	0xd5, 0x21, 0xbd, 0x26, 0xb8, 0x43, 0xea, 0xc3, 0xf5, 0x8f, 0x56, 0xaf, 0xa6, 0x3a, 0x04, 0x41,
-	0x78, 0x23, 0x7a, 0x0a, 0xa5, 0xb4, 0xd0, 0x65, 0x2f, 0x76, 0xc9, 0x18, 0x48, 0x6d, 0x44, 0x21,
-	0xe7, 0x43, 0xfc, 0x3d, 0x20, 0x9f, 0x4c, 0xb4, 0xac, 0xb5, 0x26, 0xfa, 0x59, 0x0e, 0xe2, 0x88,
-	0x55, 0x8b, 0xdc, 0x9e, 0x2a, 0x1a, 0xcd, 0x47, 0x0f, 0xec, 0x62, 0x3f, 0xde, 0xc9, 0x26, 0x78,
-	0x45, 0x1a, 0x99, 0x99, 0x8e, 0x9e, 0xc7, 0x5f, 0x91, 0x15, 0x28, 0xf9, 0x8a, 0xac, 0xb1, 0xc1,
-	0x73, 0x08, 0xae, 0x28, 0x0f, 0xe2, 0x3f, 0x1f, 0xc2, 0x9c, 0xde, 0x49, 0x43, 0x78, 0x47, 0x71,
-	0x71, 0xa7, 0x60, 0xea, 0xf6, 0x86, 0x39, 0x4d, 0x9d, 0xce, 0x53, 0xa9, 0x1d, 0x25, 0x02, 0xfb,
-	0x88, 0xff, 0x0e, 0xc8, 0xa7, 0xf5, 0x74, 0x42, 0xfd, 0x37, 0x14, 0xf3, 0x7a, 0xe2, 0xb6, 0x4b,
-	0xcb, 0x93, 0x9e, 0x69, 0xd6, 0xc3, 0xbb, 0x63, 0x7c, 0xbf, 0xab, 0x19, 0x2e, 0x5b, 0x7c, 0xe3,
-	0xd1, 0xb2, 0xc5, 0x40, 0xaa, 0x6c, 0x43, 0xce, 0x87, 0xf8, 0x99, 0xdc, 0x18, 0xb1, 0xec, 0xa2,
-	0x52, 0x34, 0xf6, 0xd3, 0xbe, 0x95, 0x9c, 0xdb, 0xdb, 0x09, 0xc2, 0x39, 0x7c, 0x30, 0xa0, 0x9a,
-	0xdc, 0xac, 0xb3, 0x2b, 0x35, 0x3c, 0xd3, 0xb2, 0xec, 0xbc, 0xf7, 0x0c, 0xbb, 0x90, 0x4a, 0x5d,
-	0x5c, 0x04, 0x5e, 0xc6, 0x3c, 0xbf, 0xd1, 0xfc, 0x97, 0xe4, 0xf1, 0xff, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xa7, 0xa6, 0x78, 0xaf, 0x72, 0x11, 0x00, 0x00,
+	// 1013 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x94, 0x98, 0x5b, 0x6f, 0x1c, 0x35,
+	0x14, 0xc7, 0x59, 0x09, 0x0a, 0x98, 0x6b, 0x0d, 0xa2, 0x28, 0x48, 0x40, 0xd3, 0x94, 0x4b, 0x0b,
+	0x51, 0x2f, 0x94, 0xf7, 0xdd, 0x74, 0xdb, 0x06, 0x11, 0xb1, 0xcc, 0x26, 0x0a, 0x12, 0x12, 0x92,
+	0x33, 0x7b, 0xb2, 0x33, 0x8d, 0xc7, 0x36, 0xb6, 0x27, 0x4a, 0x9e, 0x90, 0x90, 0x78, 0x42, 0xe2,
+	0x9d, 0x6f, 0x5b, 0xcd, 0xec, 0xd8, 0x6b, 0xcf, 0xda, 0xde, 0xdd, 0xd7, 0xfd, 0xff, 0xce, 0x39,
+	0x9e, 0xe3, 0x73, 0xb1, 0x16, 0xed, 0x68, 0x72, 0x46, 0x41, 0x57, 0x84, 0x91, 0x39, 0x48, 0x05,
+	0xf2, 0xb2, 0xcc, 0x61, 0x5f, 0x48, 0xae, 0x39, 0xfe, 0x38, 0xa4, 0xed, 0xdc, 0xf2, 0x7e, 0x9d,
+	0x11, 0x4d, 0x16, 0xf8, 0xa3, 0xff, 0xf7, 0xd0, 0x7b, 0xc7, 0xad, 0x76, 0xb4, 0xd0, 0xf0, 0x21,
+	0x7a, 0x7d, 0x52, 0xb2, 0x39, 0xfe, 0x7c, 0x7f, 0xd5, 0xa6, 0x11, 0x32, 0xf8, 0xb3, 0x06, 0xa5,
+	0x77, 0xbe, 0x88, 0xea, 0x4a, 0x70, 0xa6, 0x60, 0xf7, 0x35, 0xfc, 0x33, 0x7a, 0x63, 0x4a, 0x01,
+	0x04, 0x0e, 0xb1, 0xad, 0x62, 0x9c, 0x7d, 0x19, 0x07, 0xac, 0xb7, 0x3f, 0xd0, 0x3b, 0xe3, 0x2b,
+	0xc8, 0x6b, 0x0d, 0x2f, 0x38, 0xbf, 0xc0, 0x77, 0x03, 0x26, 0x8e, 0x6e, 0x3c, 0x7f, 0xb5, 0x0e,
+	0xb3, 0xfe, 0x7f, 0x43, 0x6f, 0x3f, 0x07, 0x3d, 0xcd, 0x0b, 0xa8, 0x08, 0xbe, 0x13, 0x30, 0xb3,
+	0xaa, 0xf1, 0xbd, 0x97, 0x86, 0xac, 0xe7, 0x39, 0x7a, 0xff, 0x39, 0xe8, 0x09, 0xc8, 0xaa, 0x54,
+	0xaa, 0xe4, 0x4c, 0xe1, 0x6f, 0xc2, 0x96, 0x0e, 0x62, 0x62, 0x7c, 0xbb, 0x01, 0xe9, 0xa6, 0x68,
+	0x0a, 0x3a, 0x03, 0x32, 0xfb, 0x85, 0xd1, 0xeb, 0x60, 0x8a, 0x1c, 0x3d, 0x95, 0x22, 0x0f, 0xb3,
+	0xfe, 0x09, 0x7a, 0xb7, 0x13, 0x4e, 0x65, 0xa9, 0x01, 0x27, 0x2c, 0x5b, 0xc0, 0x44, 0xf8, 0x7a,
+	0x2d, 0x67, 0x43, 0xfc, 0x8e, 0xd0, 0x41, 0x41, 0xd8, 0x1c, 0x8e, 0xaf, 0x05, 0xe0, 0x50, 0x86,
+	0x97, 0xb2, 0x71, 0x7f, 0x77, 0x0d, 0xe5, 0x9e, 0x3f, 0x83, 0x73, 0x09, 0xaa, 0x98, 0x6a, 0x12,
+	0x39, 0xbf, 0x0b, 0xa4, 0xce, 0xef, 0x73, 0xee, 0x5d, 0x67, 0x35, 0x7b, 0x01, 0x84, 0xea, 0xe2,
+	0xa0, 0x80, 0xfc, 0x22, 0x78, 0xd7, 0x3e, 0x92, 0xba, 0xeb, 0x3e, 0x69, 0x03, 0x09, 0x74, 0xf3,
+	0x70, 0xce, 0xb8, 0x84, 0x85, 0x3c, 0x96, 0x92, 0x4b, 0x7c, 0x3f, 0xe0, 0x61, 0x85, 0x32, 0xe1,
+	0xbe, 0xdb, 0x0c, 0xf6, 0xb3, 0x47, 0x39, 0x99, 0x75, 0x3d, 0x12, 0xce, 0xde, 0x12, 0x48, 0x67,
+	0xcf, 0xe5, 0x6c, 0x88, 0x97, 0xe8, 0x83, 0x89, 0x84, 0x73, 0x5a, 0xce, 0x0b, 0xd3, 0x89, 0xa1,
+	0xa4, 0xf4, 0x18, 0x13, 0xe8, 0xde, 0x26, 0xa8, 0xdb, 0x2c, 0x43, 0x21, 0xe8, 0x75, 0x17, 0x27,
+	0x54, 0x44, 0x8e, 0x9e, 0x6a, 0x16, 0x0f, 0x73, 0x2f, 0xa8, 0x1b, 0x34, 0xcf, 0x40, 0xe7, 0xc5,
+	0x50, 0x3d, 0x3d, 0x23, 0xc1, 0x0b, 0x5a, 0xa1, 0x52, 0x17, 0x14, 0x80, 0x6d, 0xc4, 0xbf, 0xd0,
+	0x27, 0xbe, 0x3c, 0xa4, 0x74, 0x22, 0xcb, 0x4b, 0x85, 0x1f, 0xac, 0xf5, 0x64, 0x50, 0x13, 0xfb,
+	0xe1, 0x16, 0x16, 0xf1, 0x4f, 0x1e, 0x0a, 0xb1, 0xc1, 0x27, 0x0f, 0x85, 0xd8, 0xfc, 0x93, 0x5b,
+	0xd8, 0x9b, 0x78, 0x94, 0x5c, 0xc2, 0x54, 0x13, 0x5d, 0xab, 0xf0, 0xc4, 0x5b, 0xea, 0xc9, 0x89,
+	0xe7, 0x62, 0x6e, 0x3b, 0x1f, 0x11, 0xa5, 0x41, 0x4e, 0xb8, 0x2a, 0x75, 0xc9, 0x59, 0xb0, 0x9d,
+	0x7d, 0x24, 0xd5, 0xce, 0x7d, 0xd2, 0xdd, 0x3e, 0x53, 0xcd, 0x45, 0x7b, 0x8a, 0xe0, 0xf6, 0xb1,
+	0x6a, 0x6a, 0xfb, 0x38, 0x90, 0xf5, 0x5c, 0xa1, 0x0f, 0xed, 0xcf, 0x47, 0x25, 0x2b, 0xab, 0xba,
+	0xc2, 0xf7, 0x52, 0xb6, 0x1d, 0x64, 0xe2, 0xdc, 0xdf, 0x88, 0xb5, 0xe1, 0x2e, 0xd1, 0x47, 0x56,
+	0x1d, 0xc1, 0x39, 0x97, 0x70, 0x5c, 0x56, 0x80, 0xbf, 0x4f, 0x79, 0x59, 0x72, 0x26, 0xe8, 0xfe,
+	0xa6, 0xb8, 0xbb, 0x38, 0xa6, 0x9a, 0x48, 0xbd, 0xc8, 0x60, 0x38, 0x39, 0x46, 0x4e, 0x2d, 0x0e,
+	0x97, 0xb2, 0xce, 0xff, 0x1e, 0xa0, 0x5b, 0x87, 0xec, 0x25, 0xe4, 0x7a, 0x5c, 0x09, 0x7d, 0x7d,
+	0x2c, 0x09, 0x53, 0x24, 0xd7, 0xed, 0x2e, 0x0f, 0x75, 0x4a, 0x84, 0x35, 0x71, 0x1f, 0x6d, 0x63,
+	0x62, 0x0f, 0xf1, 0xef, 0x00, 0xed, 0x2c, 0xde, 0x6a, 0xe3, 0x2b, 0x0d, 0x92, 0x11, 0xda, 0x2c,
+	0x67, 0x41, 0x24, 0x30, 0x0d, 0x33, 0xfc, 0x43, 0xc0, 0x69, 0x1c, 0x37, 0x47, 0x79, 0xb2, 0xa5,
+	0x95, 0x97, 0x92, 0x3e, 0x38, 0xa6, 0x90, 0x37, 0x47, 0x79, 0xb8, 0x81, 0xd3, 0x8e, 0x4d, 0xa5,
+	0x24, 0x6a, 0xd2, 0x7f, 0xb3, 0x35, 0xb7, 0xa5, 0xa2, 0x6f, 0xb6, 0x56, 0x5d, 0xf7, 0x66, 0xeb,
+	0x20, 0x77, 0x13, 0x9d, 0x92, 0x52, 0x8f, 0xa8, 0xb0, 0x9d, 0x1f, 0xea, 0xe7, 0x1e, 0x93, 0xda,
+	0x44, 0x2b, 0xa8, 0x8d, 0x95, 0xa1, 0x37, 0x9b, 0xda, 0x1e, 0x51, 0x81, 0x6f, 0x47, 0xea, 0x7e,
+	0x44, 0xed, 0x88, 0xdc, 0x4d, 0x21, 0xd6, 0xe7, 0x09, 0x7a, 0xab, 0xad, 0xe4, 0xc6, 0xe9, 0x6e,
+	0xac, 0xcc, 0x1d, 0xaf, 0x77, 0x92, 0x8c, 0x3b, 0x6f, 0xb3, 0x9a, 0x8d, 0xa8, 0x38, 0x61, 0xba,
+	0xa4, 0xc1, 0x79, 0xeb, 0xe8, 0xa9, 0x79, 0xeb, 0x61, 0xee, 0xb0, 0xca, 0x40, 0x81, 0xce, 0x40,
+	0xd0, 0x32, 0x27, 0x6d, 0xde, 0x43, 0xc9, 0xec, 0x43, 0xa9, 0x61, 0xb5, 0xca, 0xba, 0x43, 0xe3,
+	0x90, 0x95, 0x7a, 0x31, 0x95, 0x83, 0x43, 0x63, 0x29, 0xa7, 0x86, 0x86, 0x4b, 0x79, 0x1d, 0x32,
+	0xe1, 0xa2, 0xa6, 0x44, 0x83, 0x69, 0xa1, 0x9f, 0x78, 0xdd, 0xd4, 0x72, 0xb0, 0x43, 0x22, 0x6c,
+	0xaa, 0x43, 0xa2, 0x26, 0x6e, 0x87, 0x34, 0x87, 0x8b, 0xef, 0x15, 0xab, 0xa6, 0x3a, 0xc4, 0x81,
+	0xdc, 0xe7, 0xe0, 0x53, 0xa8, 0xb8, 0x86, 0x2e, 0x7b, 0xa1, 0x4b, 0x76, 0x81, 0xd4, 0x73, 0xd0,
+	0xe7, 0x6c, 0x88, 0x7f, 0x06, 0xe8, 0xd3, 0x89, 0xe4, 0x8d, 0xd6, 0x46, 0x3f, 0x2d, 0x80, 0x1d,
+	0x90, 0x7a, 0x5e, 0xe8, 0x13, 0x81, 0x83, 0xf9, 0x88, 0xc0, 0x26, 0xf6, 0xe3, 0xad, 0x6c, 0xbc,
+	0x15, 0xda, 0xca, 0x44, 0x75, 0xf4, 0x2c, 0xbc, 0x42, 0x7b, 0x50, 0x72, 0x85, 0xae, 0xb0, 0xde,
+	0x5b, 0x00, 0x4c, 0x51, 0x06, 0x1b, 0x13, 0x7a, 0x35, 0xb9, 0x97, 0x86, 0xdc, 0x07, 0x9a, 0x89,
+	0x9b, 0x81, 0xd2, 0x44, 0x36, 0x5f, 0x92, 0x3a, 0x9d, 0xa5, 0x52, 0x0f, 0xb4, 0x00, 0x6c, 0x23,
+	0xfe, 0x37, 0x40, 0x9f, 0x35, 0xd3, 0xc9, 0xe9, 0xbf, 0x21, 0x9b, 0x35, 0x13, 0x77, 0xf1, 0x62,
+	0x7b, 0x12, 0x99, 0x66, 0x11, 0xde, 0x1c, 0xe3, 0xc7, 0x6d, 0xcd, 0xdc, 0xb2, 0x75, 0x6f, 0x3c,
+	0x58, 0xb6, 0x2e, 0x90, 0x2a, 0x5b, 0x9f, 0xb3, 0x21, 0x7e, 0x45, 0x37, 0x46, 0x24, 0xbf, 0xa8,
+	0x05, 0x0e, 0xfd, 0xaf, 0xb1, 0x90, 0x8c, 0xdb, 0xdb, 0x09, 0xc2, 0x38, 0x7c, 0x30, 0xc0, 0x12,
+	0xdd, 0x6c, 0xb2, 0xcb, 0x25, 0x3c, 0x93, 0xbc, 0xea, 0xbc, 0x47, 0x86, 0x9d, 0x4f, 0xa5, 0x2e,
+	0x2e, 0x00, 0x2f, 0x63, 0x9e, 0xdd, 0x68, 0xff, 0x22, 0x7a, 0xfc, 0x6a, 0x00, 0xcb, 0x4d, 0x5c,
+	0x55, 0x6f, 0x12, 0x00, 0x00,
 }
@@ -354,6 +354,11 @@ type Keyspace struct {
 	// ServedFrom will redirect the appropriate traffic to
 	// another keyspace.
 	ServedFroms []*Keyspace_ServedFrom `protobuf:"bytes,4,rep,name=served_froms,json=servedFroms" json:"served_froms,omitempty"`
+	// durability_policy is the name of the durability policy of the
+	// keyspace: it decides which tablets ack the transactions of the
+	// master with semi-sync, and which tablets can be promoted.
+	// Empty if the tablets use their -enable_semi_sync flag.
+	DurabilityPolicy string `protobuf:"bytes,5,opt,name=durability_policy,json=durabilityPolicy" json:"durability_policy,omitempty"`
 }
 
 func (m *Keyspace) Reset()                    { *m = Keyspace{} }
@@ -547,74 +552,75 @@ func init() {
 func init() { proto.RegisterFile("topodata.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1096 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xac, 0x56, 0x6f, 0x6f, 0xe3, 0xc4,
-	0x13, 0xfe, 0xd9, 0x71, 0xd2, 0x64, 0x9c, 0xe6, 0x7c, 0xfb, 0xbb, 0x43, 0x96, 0x11, 0xa2, 0x8a,
-	0x84, 0xa8, 0x0e, 0x11, 0x50, 0x8e, 0x83, 0xea, 0x24, 0xa4, 0xa6, 0xa9, 0x0f, 0xd2, 0x3f, 0x69,
-	0xd8, 0xa4, 0x82, 0xbe, 0xb2, 0x9c, 0x78, 0xdb, 0xb3, 0xea, 0x64, 0xcd, 0xee, 0xa6, 0x52, 0x3e,
-	0xc3, 0xbd, 0xe0, 0x5e, 0xf3, 0x65, 0x78, 0xc9, 0xa7, 0x42, 0x42, 0xbb, 0x6b, 0x27, 0x4e, 0x4a,
-	0x4b, 0x0f, 0xf5, 0x55, 0x67, 0xbc, 0x33, 0xb3, 0xf3, 0x3c, 0xf3, 0xcc, 0x36, 0xd0, 0x10, 0x34,
-	0xa5, 0x51, 0x28, 0xc2, 0x56, 0xca, 0xa8, 0xa0, 0xa8, 0x9a, 0xfb, 0xcd, 0x36, 0x54, 0x8f, 0xc9,
-	0x02, 0x87, 0xb3, 0x2b, 0x82, 0x9e, 0x41, 0x99, 0x8b, 0x90, 0x09, 0xd7, 0xd8, 0x31, 0x76, 0xeb,
-	0x58, 0x3b, 0xc8, 0x81, 0x12, 0x99, 0x45, 0xae, 0xa9, 0xbe, 0x49, 0xb3, 0xf9, 0x12, 0xec, 0x51,
-	0x38, 0x4e, 0x88, 0xe8, 0x24, 0x71, 0xc8, 0x11, 0x02, 0x6b, 0x42, 0x92, 0x44, 0x65, 0xd5, 0xb0,
-	0xb2, 0x65, 0xd2, 0x3c, 0xd6, 0x49, 0xdb, 0x58, 0x9a, 0xcd, 0xbf, 0x4a, 0x50, 0xd1, 0x59, 0xe8,
-	0x0b, 0x28, 0x87, 0x32, 0x53, 0x65, 0xd8, 0xed, 0xe7, 0xad, 0x65, 0x77, 0x85, 0xb2, 0x58, 0xc7,
-	0x20, 0x0f, 0xaa, 0x6f, 0x29, 0x17, 0xb3, 0x70, 0x4a, 0x54, 0xb9, 0x1a, 0x5e, 0xfa, 0xa8, 0x01,
-	0x66, 0x9c, 0xba, 0x25, 0xf5, 0xd5, 0x8c, 0x53, 0xb4, 0x07, 0xd5, 0x94, 0x32, 0x11, 0x4c, 0xc3,
-	0xd4, 0xb5, 0x76, 0x4a, 0xbb, 0x76, 0xfb, 0x93, 0xcd, 0xda, 0xad, 0x01, 0x65, 0xe2, 0x34, 0x4c,
-	0xfd, 0x99, 0x60, 0x0b, 0xbc, 0x95, 0x6a, 0x4f, 0xde, 0x72, 0x4d, 0x16, 0x3c, 0x0d, 0x27, 0xc4,
-	0x2d, 0xeb, 0x5b, 0x72, 0x5f, 0xd1, 0xf2, 0x36, 0x64, 0x91, 0x5b, 0x51, 0x07, 0xda, 0x41, 0x5f,
-	0x41, 0xed, 0x9a, 0x2c, 0x02, 0x26, 0x99, 0x73, 0xb7, 0x14, 0x10, 0xb4, 0xba, 0x2c, 0xe7, 0x54,
-	0x95, 0xd1, 0xec, 0xee, 0x82, 0x25, 0x16, 0x29, 0x71, 0xab, 0x3b, 0xc6, 0x6e, 0xa3, 0xfd, 0x6c,
-	0xb3, 0xb1, 0xd1, 0x22, 0x25, 0x58, 0x45, 0xa0, 0x5d, 0x70, 0xa2, 0x71, 0x20, 0x11, 0x06, 0xf4,
-	0x86, 0x30, 0x16, 0x47, 0xc4, 0xad, 0xa9, 0xbb, 0x1b, 0xd1, 0xb8, 0x1f, 0x4e, 0xc9, 0x59, 0xf6,
-	0x15, 0xb5, 0xc0, 0x12, 0xe1, 0x15, 0x77, 0x41, 0x81, 0xf5, 0x6e, 0x81, 0x1d, 0x85, 0x57, 0x5c,
-	0x23, 0x55, 0x71, 0xde, 0x6b, 0xa8, 0x17, 0xf1, 0xcb, 0x31, 0x5d, 0x93, 0x45, 0x36, 0x39, 0x69,
-	0x4a, 0xb0, 0x37, 0x61, 0x32, 0xd7, 0x5c, 0x97, 0xb1, 0x76, 0x5e, 0x9b, 0x7b, 0x86, 0xf7, 0x1d,
-	0xd4, 0x96, 0xe5, 0xfe, 0x2d, 0xb1, 0x56, 0x48, 0x3c, 0xb2, 0xaa, 0xb6, 0x53, 0x6f, 0xbe, 0xab,
-	0x40, 0x79, 0xa8, 0x98, 0xdb, 0x83, 0xfa, 0x34, 0xe4, 0x82, 0xb0, 0xe0, 0x01, 0x2a, 0xb0, 0x75,
-	0xa8, 0x56, 0xda, 0x1a, 0xe7, 0xe6, 0x03, 0x38, 0xff, 0x1e, 0xea, 0x9c, 0xb0, 0x1b, 0x12, 0x05,
-	0x92, 0x58, 0xee, 0x96, 0x36, 0x79, 0x52, 0x1d, 0xb5, 0x86, 0x2a, 0x46, 0x4d, 0xc0, 0xe6, 0x4b,
-	0x9b, 0xa3, 0x7d, 0xd8, 0xe6, 0x74, 0xce, 0x26, 0x24, 0x50, 0x33, 0xe7, 0x99, 0xa8, 0x3e, 0xbe,
-	0x95, 0xaf, 0x82, 0x94, 0x8d, 0xeb, 0x7c, 0xe5, 0x70, 0xc9, 0x8a, 0xdc, 0x07, 0xee, 0x96, 0x77,
-	0x4a, 0x92, 0x15, 0xe5, 0xa0, 0x37, 0xf0, 0x44, 0x28, 0x8c, 0xc1, 0x84, 0xce, 0x04, 0xa3, 0x09,
-	0x77, 0x2b, 0x9b, 0x72, 0xd5, 0x95, 0x35, 0x15, 0x5d, 0x1d, 0x85, 0x1b, 0xa2, 0xe8, 0x72, 0xef,
-	0x02, 0x60, 0xd5, 0x3a, 0x7a, 0x05, 0x76, 0x56, 0x55, 0xe9, 0xcc, 0xb8, 0x47, 0x67, 0x20, 0x96,
-	0xf6, 0xaa, 0x45, 0xb3, 0xd0, 0xa2, 0xf7, 0xbb, 0x01, 0x76, 0x01, 0x56, 0xbe, 0xd0, 0xc6, 0x72,
-	0xa1, 0xd7, 0x56, 0xc6, 0xbc, 0x6b, 0x65, 0x4a, 0x77, 0xae, 0x8c, 0xf5, 0x80, 0xf1, 0x7d, 0x04,
-	0x15, 0xd5, 0x68, 0x4e, 0x5f, 0xe6, 0x79, 0x7f, 0x18, 0xb0, 0xbd, 0xc6, 0xcc, 0xa3, 0x62, 0x47,
-	0x6d, 0x78, 0x1e, 0xc5, 0x5c, 0x46, 0x05, 0xbf, 0xce, 0x09, 0x5b, 0x04, 0x52, 0x13, 0xf1, 0x84,
-	0x28, 0x34, 0x55, 0xfc, 0xff, 0xec, 0xf0, 0x27, 0x79, 0x36, 0xd4, 0x47, 0xe8, 0x4b, 0x40, 0xe3,
-	0x24, 0x9c, 0x5c, 0x27, 0x31, 0x17, 0x52, 0x6e, 0xba, 0x6d, 0x4b, 0x95, 0x7d, 0x5a, 0x38, 0x51,
-	0x8d, 0xf0, 0xe6, 0x9f, 0xa6, 0x7a, 0x77, 0x35, 0x5b, 0x5f, 0xc3, 0x33, 0x45, 0x50, 0x3c, 0xbb,
-	0x0a, 0x26, 0x34, 0x99, 0x4f, 0x67, 0x6a, 0xf9, 0xb3, 0xed, 0x42, 0xf9, 0x59, 0x57, 0x1d, 0xc9,
-	0xfd, 0x47, 0x47, 0xb7, 0x33, 0x14, 0x6e, 0x53, 0xe1, 0x76, 0xd7, 0x48, 0x55, 0x77, 0xf4, 0xb4,
-	0xba, 0x37, 0x6a, 0x29, 0x0e, 0xf6, 0x97, 0x3b, 0x72, 0xc9, 0xe8, 0x94, 0xdf, 0x7e, 0x38, 0xf3,
-	0x1a, 0xd9, 0x9a, 0xbc, 0x61, 0x74, 0x9a, 0xaf, 0x89, 0xb4, 0xb9, 0x37, 0xcf, 0x65, 0x28, 0xdd,
-	0xc7, 0x1d, 0x45, 0x51, 0x64, 0xa5, 0x75, 0x91, 0x1d, 0x59, 0xd5, 0x92, 0x63, 0x35, 0xdf, 0x19,
-	0xe0, 0xe8, 0xcd, 0x23, 0x69, 0x12, 0x4f, 0x42, 0x11, 0xd3, 0x19, 0x7a, 0x05, 0xe5, 0x19, 0x8d,
-	0x88, 0x7c, 0x5b, 0x24, 0x98, 0x4f, 0x37, 0xd6, 0xaa, 0x10, 0xda, 0xea, 0xd3, 0x88, 0x60, 0x1d,
-	0xed, 0xed, 0x83, 0x25, 0x5d, 0xf9, 0x42, 0x65, 0x10, 0x1e, 0xf2, 0x42, 0x89, 0x95, 0xd3, 0x3c,
-	0x87, 0x46, 0x76, 0xc3, 0x25, 0x61, 0x64, 0x36, 0x21, 0xf2, 0xbf, 0x63, 0x61, 0x98, 0xca, 0xfe,
-	0xe0, 0x77, 0xac, 0xf9, 0xde, 0x02, 0x7b, 0xc8, 0x6e, 0x96, 0x8a, 0xf9, 0x01, 0x20, 0x0d, 0x99,
-	0x88, 0x25, 0x82, 0x1c, 0xe4, 0xe7, 0x05, 0x90, 0xab, 0xd0, 0xe5, 0xf4, 0x06, 0x79, 0x3c, 0x2e,
-	0xa4, 0xde, 0x29, 0x3d, 0xf3, 0x83, 0xa5, 0x57, 0xfa, 0x0f, 0xd2, 0xeb, 0x80, 0x5d, 0x90, 0x5e,
-	0xa6, 0xbc, 0x9d, 0x7f, 0xc6, 0x51, 0x10, 0x1f, 0xac, 0xc4, 0xe7, 0xfd, 0x66, 0xc0, 0xd3, 0x5b,
-	0x10, 0xa5, 0x06, 0x0b, 0xef, 0xfe, 0xfd, 0x1a, 0x5c, 0x3d, 0xf8, 0xa8, 0x0b, 0x8e, 0xea, 0x32,
-	0x60, 0xf9, 0xf8, 0xb4, 0x1c, 0xed, 0x22, 0xae, 0xf5, 0xf9, 0xe2, 0x27, 0x7c, 0xcd, 0xe7, 0x5e,
-	0xf0, 0x18, 0xdb, 0x70, 0xcf, 0xe3, 0x7a, 0x64, 0x55, 0xcb, 0x4e, 0xa5, 0xe9, 0x43, 0xb5, 0x4b,
-	0x92, 0xa4, 0x37, 0xbb, 0xa4, 0xe8, 0x33, 0x68, 0x28, 0x14, 0x2c, 0x08, 0xa3, 0x88, 0x11, 0xce,
-	0x33, 0xb5, 0x6d, 0xeb, 0xaf, 0x1d, 0xfd, 0x51, 0x4a, 0x91, 0x51, 0x2a, 0xb2, 0x82, 0xca, 0x7e,
-	0xd1, 0x86, 0xc6, 0xfa, 0xa0, 0x50, 0x0d, 0xca, 0xe7, 0xfd, 0xa1, 0x3f, 0x72, 0xfe, 0x87, 0x00,
-	0x2a, 0xe7, 0xbd, 0xfe, 0xe8, 0xdb, 0x6f, 0x1c, 0x43, 0x7e, 0x3e, 0xb8, 0x18, 0xf9, 0x43, 0xc7,
-	0x7c, 0xf1, 0xde, 0x00, 0x58, 0xf5, 0x8d, 0x6c, 0xd8, 0x3a, 0xef, 0x1f, 0xf7, 0xcf, 0x7e, 0xee,
-	0xeb, 0x94, 0xd3, 0xce, 0x70, 0xe4, 0x63, 0xc7, 0x90, 0x07, 0xd8, 0x1f, 0x9c, 0xf4, 0xba, 0x1d,
-	0xc7, 0x94, 0x07, 0xf8, 0xf0, 0xac, 0x7f, 0x72, 0xe1, 0x94, 0x54, 0xad, 0xce, 0xa8, 0xfb, 0xa3,
-	0x36, 0x87, 0x83, 0x0e, 0xf6, 0x1d, 0x0b, 0x39, 0x50, 0xf7, 0x7f, 0x19, 0xf8, 0xb8, 0x77, 0xea,
-	0xf7, 0x47, 0x9d, 0x13, 0xa7, 0x2c, 0x73, 0x0e, 0x3a, 0xdd, 0xe3, 0xf3, 0x81, 0x53, 0xd1, 0xc5,
-	0x86, 0xa3, 0x33, 0xec, 0x3b, 0x5b, 0xd2, 0x39, 0xc4, 0x9d, 0x5e, 0xdf, 0x3f, 0x74, 0xaa, 0x9e,
-	0xe9, 0x18, 0x07, 0x1e, 0xb8, 0x13, 0x3a, 0x6d, 0x2d, 0xe8, 0x5c, 0xcc, 0xc7, 0xa4, 0x75, 0x13,
-	0x0b, 0xc2, 0xb9, 0xfe, 0xb1, 0x3b, 0xae, 0xa8, 0x3f, 0x2f, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff,
-	0x06, 0xa6, 0x11, 0xb5, 0x05, 0x0b, 0x00, 0x00,
+	// 1115 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xac, 0x56, 0x5f, 0x6f, 0xe3, 0x44,
+	0x10, 0xc7, 0x8e, 0x93, 0x26, 0xe3, 0x34, 0xe7, 0x5b, 0xee, 0x90, 0x65, 0x84, 0xa8, 0x22, 0x21,
+	0xaa, 0x3b, 0x11, 0x50, 0x8e, 0x83, 0xd3, 0x49, 0x48, 0x4d, 0x53, 0x1f, 0xa4, 0x7f, 0xd2, 0xe0,
+	0xa4, 0x82, 0x3e, 0x59, 0x4e, 0xbc, 0xed, 0x59, 0x75, 0x62, 0xb3, 0xbb, 0xa9, 0x94, 0xcf, 0x70,
+	0x0f, 0xdc, 0x33, 0x5f, 0x86, 0x2f, 0xc5, 0x2b, 0x12, 0xda, 0x59, 0x3b, 0x71, 0x52, 0x5a, 0x7a,
+	0xa8, 0x4f, 0x99, 0xd9, 0xf9, 0xb3, 0xf3, 0x9b, 0xf9, 0xcd, 0x3a, 0xd0, 0x10, 0x49, 0x9a, 0x84,
+	0x81, 0x08, 0x5a, 0x29, 0x4b, 0x44, 0x42, 0xaa, 0xb9, 0xde, 0x6c, 0x43, 0xf5, 0x88, 0x2e, 0xbc,
+	0x60, 0x76, 0x49, 0xc9, 0x13, 0x28, 0x73, 0x11, 0x30, 0x61, 0x6b, 0x3b, 0xda, 0x6e, 0xdd, 0x53,
+	0x0a, 0xb1, 0xa0, 0x44, 0x67, 0xa1, 0xad, 0xe3, 0x99, 0x14, 0x9b, 0x2f, 0xc0, 0x1c, 0x05, 0xe3,
+	0x98, 0x8a, 0x4e, 0x1c, 0x05, 0x9c, 0x10, 0x30, 0x26, 0x34, 0x8e, 0x31, 0xaa, 0xe6, 0xa1, 0x2c,
+	0x83, 0xe6, 0x91, 0x0a, 0xda, 0xf6, 0xa4, 0xd8, 0xfc, 0xbb, 0x04, 0x15, 0x15, 0x45, 0x9e, 0x43,
+	0x39, 0x90, 0x91, 0x18, 0x61, 0xb6, 0x9f, 0xb6, 0x96, 0xd5, 0x15, 0xd2, 0x7a, 0xca, 0x87, 0x38,
+	0x50, 0x7d, 0x9b, 0x70, 0x31, 0x0b, 0xa6, 0x14, 0xd3, 0xd5, 0xbc, 0xa5, 0x4e, 0x1a, 0xa0, 0x47,
+	0xa9, 0x5d, 0xc2, 0x53, 0x3d, 0x4a, 0xc9, 0x2b, 0xa8, 0xa6, 0x09, 0x13, 0xfe, 0x34, 0x48, 0x6d,
+	0x63, 0xa7, 0xb4, 0x6b, 0xb6, 0x3f, 0xdb, 0xcc, 0xdd, 0x1a, 0x24, 0x4c, 0x9c, 0x04, 0xa9, 0x3b,
+	0x13, 0x6c, 0xe1, 0x6d, 0xa5, 0x4a, 0x93, 0xb7, 0x5c, 0xd1, 0x05, 0x4f, 0x83, 0x09, 0xb5, 0xcb,
+	0xea, 0x96, 0x5c, 0xc7, 0xb6, 0xbc, 0x0d, 0x58, 0x68, 0x57, 0xd0, 0xa0, 0x14, 0xf2, 0x35, 0xd4,
+	0xae, 0xe8, 0xc2, 0x67, 0xb2, 0x73, 0xf6, 0x16, 0x02, 0x21, 0xab, 0xcb, 0xf2, 0x9e, 0x62, 0x1a,
+	0x94, 0xc8, 0x2e, 0x18, 0x62, 0x91, 0x52, 0xbb, 0xba, 0xa3, 0xed, 0x36, 0xda, 0x4f, 0x36, 0x0b,
+	0x1b, 0x2d, 0x52, 0xea, 0xa1, 0x07, 0xd9, 0x05, 0x2b, 0x1c, 0xfb, 0x12, 0xa1, 0x9f, 0x5c, 0x53,
+	0xc6, 0xa2, 0x90, 0xda, 0x35, 0xbc, 0xbb, 0x11, 0x8e, 0xfb, 0xc1, 0x94, 0x9e, 0x66, 0xa7, 0xa4,
+	0x05, 0x86, 0x08, 0x2e, 0xb9, 0x0d, 0x08, 0xd6, 0xb9, 0x01, 0x76, 0x14, 0x5c, 0x72, 0x85, 0x14,
+	0xfd, 0x9c, 0xd7, 0x50, 0x2f, 0xe2, 0x97, 0x63, 0xba, 0xa2, 0x8b, 0x6c, 0x72, 0x52, 0x94, 0x60,
+	0xaf, 0x83, 0x78, 0xae, 0x7a, 0x5d, 0xf6, 0x94, 0xf2, 0x5a, 0x7f, 0xa5, 0x39, 0xdf, 0x43, 0x6d,
+	0x99, 0xee, 0xbf, 0x02, 0x6b, 0x85, 0xc0, 0x43, 0xa3, 0x6a, 0x5a, 0xf5, 0xe6, 0xbb, 0x0a, 0x94,
+	0x87, 0xd8, 0xb9, 0x57, 0x50, 0x9f, 0x06, 0x5c, 0x50, 0xe6, 0xdf, 0x83, 0x05, 0xa6, 0x72, 0x45,
+	0x65, 0xbd, 0xe7, 0xfa, 0x3d, 0x7a, 0xfe, 0x03, 0xd4, 0x39, 0x65, 0xd7, 0x34, 0xf4, 0x65, 0x63,
+	0xb9, 0x5d, 0xda, 0xec, 0x13, 0x56, 0xd4, 0x1a, 0xa2, 0x0f, 0x4e, 0xc0, 0xe4, 0x4b, 0x99, 0x93,
+	0x3d, 0xd8, 0xe6, 0xc9, 0x9c, 0x4d, 0xa8, 0x8f, 0x33, 0xe7, 0x19, 0xa9, 0x3e, 0xbd, 0x11, 0x8f,
+	0x4e, 0x28, 0x7b, 0x75, 0xbe, 0x52, 0xb8, 0xec, 0x8a, 0xdc, 0x07, 0x6e, 0x97, 0x77, 0x4a, 0xb2,
+	0x2b, 0xa8, 0x90, 0x37, 0xf0, 0x48, 0x20, 0x46, 0x7f, 0x92, 0xcc, 0x04, 0x4b, 0x62, 0x6e, 0x57,
+	0x36, 0xe9, 0xaa, 0x32, 0xab, 0x56, 0x74, 0x95, 0x97, 0xd7, 0x10, 0x45, 0x95, 0x3b, 0xe7, 0x00,
+	0xab, 0xd2, 0xc9, 0x4b, 0x30, 0xb3, 0xac, 0xc8, 0x33, 0xed, 0x0e, 0x9e, 0x81, 0x58, 0xca, 0xab,
+	0x12, 0xf5, 0x42, 0x89, 0xce, 0x1f, 0x1a, 0x98, 0x05, 0x58, 0xf9, 0x42, 0x6b, 0xcb, 0x85, 0x5e,
+	0x5b, 0x19, 0xfd, 0xb6, 0x95, 0x29, 0xdd, 0xba, 0x32, 0xc6, 0x3d, 0xc6, 0xf7, 0x09, 0x54, 0xb0,
+	0xd0, 0xbc, 0x7d, 0x99, 0xe6, 0xfc, 0xa9, 0xc1, 0xf6, 0x5a, 0x67, 0x1e, 0x14, 0x3b, 0x69, 0xc3,
+	0xd3, 0x30, 0xe2, 0xd2, 0xcb, 0xff, 0x6d, 0x4e, 0xd9, 0xc2, 0x97, 0x9c, 0x88, 0x26, 0x14, 0xd1,
+	0x54, 0xbd, 0x8f, 0x33, 0xe3, 0xcf, 0xd2, 0x36, 0x54, 0x26, 0xf2, 0x15, 0x90, 0x71, 0x1c, 0x4c,
+	0xae, 0xe2, 0x88, 0x0b, 0x49, 0x37, 0x55, 0xb6, 0x81, 0x69, 0x1f, 0x17, 0x2c, 0x58, 0x08, 0x6f,
+	0xfe, 0xa5, 0xe3, 0xbb, 0xab, 0xba, 0xf5, 0x0d, 0x3c, 0xc1, 0x06, 0x45, 0xb3, 0x4b, 0x7f, 0x92,
+	0xc4, 0xf3, 0xe9, 0x0c, 0x97, 0x3f, 0xdb, 0x2e, 0x92, 0xdb, 0xba, 0x68, 0x92, 0xfb, 0x4f, 0x0e,
+	0x6f, 0x46, 0x20, 0x6e, 0x1d, 0x71, 0xdb, 0x6b, 0x4d, 0xc5, 0x3b, 0x7a, 0x8a, 0xdd, 0x1b, 0xb9,
+	0xb0, 0x07, 0x7b, 0xcb, 0x1d, 0xb9, 0x60, 0xc9, 0x94, 0xdf, 0x7c, 0x38, 0xf3, 0x1c, 0xd9, 0x9a,
+	0xbc, 0x61, 0xc9, 0x34, 0x5f, 0x13, 0x29, 0x73, 0xf2, 0x1c, 0x1e, 0x87, 0x73, 0x16, 0x8c, 0xa3,
+	0x38, 0x12, 0x0b, 0x3f, 0x4d, 0xe2, 0x68, 0xb2, 0xc8, 0x5e, 0x51, 0x6b, 0x65, 0x18, 0xe0, 0xb9,
+	0x33, 0xcf, 0x39, 0x2b, 0x63, 0x1f, 0x76, 0x6e, 0x45, 0x46, 0x96, 0xd6, 0x19, 0x79, 0x68, 0x54,
+	0x4b, 0x96, 0xd1, 0x7c, 0xa7, 0x81, 0xa5, 0xd6, 0x94, 0xa6, 0x71, 0x34, 0x09, 0x44, 0x94, 0xcc,
+	0xc8, 0x4b, 0x28, 0xcf, 0x92, 0x90, 0xca, 0x87, 0x48, 0x22, 0xff, 0x7c, 0x63, 0x07, 0x0b, 0xae,
+	0xad, 0x7e, 0x12, 0x52, 0x4f, 0x79, 0x3b, 0x7b, 0x60, 0x48, 0x55, 0x3e, 0x67, 0x19, 0x84, 0xfb,
+	0x3c, 0x67, 0x62, 0xa5, 0x34, 0xcf, 0xa0, 0x91, 0xdd, 0x70, 0x41, 0x19, 0x9d, 0x4d, 0xa8, 0xfc,
+	0x94, 0x16, 0x26, 0x8f, 0xf2, 0x07, 0x3f, 0x7a, 0xcd, 0xf7, 0x06, 0x98, 0x43, 0x76, 0xbd, 0xa4,
+	0xd7, 0x8f, 0x00, 0x69, 0xc0, 0x44, 0x24, 0x11, 0xe4, 0x20, 0xbf, 0x2c, 0x80, 0x5c, 0xb9, 0x2e,
+	0x47, 0x3d, 0xc8, 0xfd, 0xbd, 0x42, 0xe8, 0xad, 0x3c, 0xd5, 0x3f, 0x98, 0xa7, 0xa5, 0xff, 0xc1,
+	0xd3, 0x0e, 0x98, 0x05, 0x9e, 0x66, 0x34, 0xdd, 0xf9, 0x77, 0x1c, 0x05, 0xa6, 0xc2, 0x8a, 0xa9,
+	0xce, 0xef, 0x1a, 0x3c, 0xbe, 0x01, 0x51, 0x72, 0xb0, 0xf0, 0x91, 0xb8, 0x9b, 0x83, 0xab, 0xaf,
+	0x03, 0xe9, 0x82, 0x85, 0x55, 0xfa, 0x2c, 0x1f, 0x9f, 0xa2, 0xa3, 0x59, 0xc4, 0xb5, 0x3e, 0x5f,
+	0xef, 0x11, 0x5f, 0xd3, 0xb9, 0xe3, 0x3f, 0xc4, 0x36, 0xdc, 0xf1, 0x12, 0x1f, 0x1a, 0xd5, 0xb2,
+	0x55, 0x69, 0xba, 0x50, 0xed, 0xd2, 0x38, 0xee, 0xcd, 0x2e, 0x12, 0xf2, 0x05, 0x34, 0x10, 0x05,
+	0xf3, 0x83, 0x30, 0x64, 0x94, 0xf3, 0x8c, 0x6d, 0xdb, 0xea, 0xb4, 0xa3, 0x0e, 0x25, 0x15, 0x59,
+	0x92, 0x88, 0x2c, 0x21, 0xca, 0xcf, 0xda, 0xd0, 0x58, 0x1f, 0x14, 0xa9, 0x41, 0xf9, 0xac, 0x3f,
+	0x74, 0x47, 0xd6, 0x47, 0x04, 0xa0, 0x72, 0xd6, 0xeb, 0x8f, 0xbe, 0xfb, 0xd6, 0xd2, 0xe4, 0xf1,
+	0xfe, 0xf9, 0xc8, 0x1d, 0x5a, 0xfa, 0xb3, 0xf7, 0x1a, 0xc0, 0xaa, 0x6e, 0x62, 0xc2, 0xd6, 0x59,
+	0xff, 0xa8, 0x7f, 0xfa, 0x4b, 0x5f, 0x85, 0x9c, 0x74, 0x86, 0x23, 0xd7, 0xb3, 0x34, 0x69, 0xf0,
+	0xdc, 0xc1, 0x71, 0xaf, 0xdb, 0xb1, 0x74, 0x69, 0xf0, 0x0e, 0x4e, 0xfb, 0xc7, 0xe7, 0x56, 0x09,
+	0x73, 0x75, 0x46, 0xdd, 0x9f, 0x94, 0x38, 0x1c, 0x74, 0x3c, 0xd7, 0x32, 0x88, 0x05, 0x75, 0xf7,
+	0xd7, 0x81, 0xeb, 0xf5, 0x4e, 0xdc, 0xfe, 0xa8, 0x73, 0x6c, 0x95, 0x65, 0xcc, 0x7e, 0xa7, 0x7b,
+	0x74, 0x36, 0xb0, 0x2a, 0x2a, 0xd9, 0x70, 0x74, 0xea, 0xb9, 0xd6, 0x96, 0x54, 0x0e, 0xbc, 0x4e,
+	0xaf, 0xef, 0x1e, 0x58, 0x55, 0x47, 0xb7, 0xb4, 0x7d, 0x07, 0xec, 0x49, 0x32, 0x6d, 0x2d, 0x92,
+	0xb9, 0x98, 0x8f, 0x69, 0xeb, 0x3a, 0x12, 0x94, 0x73, 0xf5, 0xcf, 0x78, 0x5c, 0xc1, 0x9f, 0x17,
+	0xff, 0x0c, 0x00, 0x75, 0x20, 0xf4, 0x42, 0x32, 0x0b, 0x00, 0x00,
 }
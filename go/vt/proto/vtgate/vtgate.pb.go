@@ -80,6 +80,17 @@ type Session struct {
 	// single_db specifies if the transaction should be restricted
 	// to a single database.
 	SingleDb bool `protobuf:"varint,3,opt,name=single_db,json=singleDb" json:"single_db,omitempty"`
+	// savepoints is the list of active savepoint names, in the order
+	// they were set. They are replayed on shards that join the
+	// transaction later.
+	Savepoints []string `protobuf:"bytes,4,rep,name=savepoints" json:"savepoints,omitempty"`
+	// read_your_writes makes the reads sent to non-master tablets
+	// wait until the tablets have replicated the last commit of the
+	// session, on the shards it wrote to.
+	ReadYourWrites bool `protobuf:"varint,5,opt,name=read_your_writes,json=readYourWrites" json:"read_your_writes,omitempty"`
+	// positions has the replication position of the last commit of
+	// the session on each shard, if read_your_writes is set.
+	Positions []*Session_ShardPosition `protobuf:"bytes,6,rep,name=positions" json:"positions,omitempty"`
 }
 
 func (m *Session) Reset()                    { *m = Session{} }
@@ -94,6 +105,13 @@ func (m *Session) GetShardSessions() []*Session_ShardSession {
 	return nil
 }
 
+func (m *Session) GetPositions() []*Session_ShardPosition {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
 type Session_ShardSession struct {
 	Target        *query.Target `protobuf:"bytes,1,opt,name=target" json:"target,omitempty"`
 	TransactionId int64         `protobuf:"varint,2,opt,name=transaction_id,json=transactionId" json:"transaction_id,omitempty"`
@@ -111,6 +129,17 @@ func (m *Session_ShardSession) GetTarget() *query.Target {
 	return nil
 }
 
+type Session_ShardPosition struct {
+	Keyspace string `protobuf:"bytes,1,opt,name=keyspace" json:"keyspace,omitempty"`
+	Shard    string `protobuf:"bytes,2,opt,name=shard" json:"shard,omitempty"`
+	Position string `protobuf:"bytes,3,opt,name=position" json:"position,omitempty"`
+}
+
+func (m *Session_ShardPosition) Reset()                    { *m = Session_ShardPosition{} }
+func (m *Session_ShardPosition) String() string            { return proto.CompactTextString(m) }
+func (*Session_ShardPosition) ProtoMessage()               {}
+func (*Session_ShardPosition) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0, 1} }
+
 // ExecuteRequest is the payload to Execute.
 type ExecuteRequest struct {
 	// caller_id identifies the caller. This is the effective caller ID,
@@ -948,6 +977,10 @@ type StreamExecuteRequest struct {
 	Keyspace string `protobuf:"bytes,4,opt,name=keyspace" json:"keyspace,omitempty"`
 	// options
 	Options *query.ExecuteOptions `protobuf:"bytes,5,opt,name=options" json:"options,omitempty"`
+	// session is only used for read_your_writes: the query waits for
+	// the positions of the session on non-master tablets. It is not
+	// updated.
+	Session *Session `protobuf:"bytes,6,opt,name=session" json:"session,omitempty"`
 }
 
 func (m *StreamExecuteRequest) Reset()                    { *m = StreamExecuteRequest{} }
@@ -976,6 +1009,13 @@ func (m *StreamExecuteRequest) GetOptions() *query.ExecuteOptions {
 	return nil
 }
 
+func (m *StreamExecuteRequest) GetSession() *Session {
+	if m != nil {
+		return m.Session
+	}
+	return nil
+}
+
 // StreamExecuteResponse is the returned value from StreamExecute.
 type StreamExecuteResponse struct {
 	// result contains the result data.
@@ -1206,6 +1246,10 @@ type BeginRequest struct {
 	// single_db specifies if the transaction should be restricted
 	// to a single database.
 	SingleDb bool `protobuf:"varint,2,opt,name=single_db,json=singleDb" json:"single_db,omitempty"`
+	// session is the previous session of the client, if any. Its
+	// read_your_writes flag and positions are carried over to the
+	// session of the new transaction.
+	Session *Session `protobuf:"bytes,3,opt,name=session" json:"session,omitempty"`
 }
 
 func (m *BeginRequest) Reset()                    { *m = BeginRequest{} }
@@ -1220,6 +1264,13 @@ func (m *BeginRequest) GetCallerId() *vtrpc.CallerID {
 	return nil
 }
 
+func (m *BeginRequest) GetSession() *Session {
+	if m != nil {
+		return m.Session
+	}
+	return nil
+}
+
 // BeginResponse is the returned value from Begin.
 type BeginResponse struct {
 	// session is the initial session information to use for subsequent queries.
@@ -1271,6 +1322,9 @@ func (m *CommitRequest) GetSession() *Session {
 
 // CommitResponse is the returned value from Commit.
 type CommitResponse struct {
+	// session is the updated session. It only needs to be used if
+	// read_your_writes is set.
+	Session *Session `protobuf:"bytes,1,opt,name=session" json:"session,omitempty"`
 }
 
 func (m *CommitResponse) Reset()                    { *m = CommitResponse{} }
@@ -1278,6 +1332,13 @@ func (m *CommitResponse) String() string            { return proto.CompactTextSt
 func (*CommitResponse) ProtoMessage()               {}
 func (*CommitResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
 
+func (m *CommitResponse) GetSession() *Session {
+	if m != nil {
+		return m.Session
+	}
+	return nil
+}
+
 // RollbackRequest is the payload to Rollback.
 type RollbackRequest struct {
 	// caller_id identifies the caller. This is the effective caller ID,
@@ -1402,7 +1463,7 @@ type SplitQueryRequest struct {
 	// The algorithm to use to split the query. The split algorithm is performed
 	// on each database shard in parallel. The lists of query-parts generated
 	// by the shards are merged and returned to the caller.
-	// Two algorithms are supported:
+	// Three algorithms are supported:
 	//  EQUAL_SPLITS
 	//    If this algorithm is selected then only the first 'split_column' given
 	//    is used (or the first primary key column if the 'split_column' field is
@@ -1428,6 +1489,15 @@ type SplitQueryRequest struct {
 	//    located between two successive boundary rows.
 	//    This algorithm supports multiple split_column's of any type,
 	//    but is slower than EQUAL_SPLITS.
+	//  SAMPLING
+	//    This algorithm reads a random sample of the values of the
+	//    'split_column's in the table-shard (about 20 rows per query-part, and
+	//    at most 5000 rows), ordered by those columns. It then picks evenly
+	//    spaced rows of the sample as the boundary rows. Like FULL_SCAN it
+	//    supports multiple split_column's of any type, and like EQUAL_SPLITS
+	//    it only needs a single query, but it also produces query-parts of
+	//    roughly the same size when the values of the split columns are skewed.
+	//    The number of rows in each query-part is only approximate.
 	Algorithm query.SplitQueryRequest_Algorithm `protobuf:"varint,7,opt,name=algorithm,enum=query.SplitQueryRequest_Algorithm" json:"algorithm,omitempty"`
 	// TODO(erez): This field is no longer used by the server code.
 	// Remove this field after this new server code is released to prod.
@@ -1652,6 +1722,7 @@ func (m *UpdateStreamResponse) GetEvent() *query.StreamEvent {
 func init() {
 	proto.RegisterType((*Session)(nil), "vtgate.Session")
 	proto.RegisterType((*Session_ShardSession)(nil), "vtgate.Session.ShardSession")
+	proto.RegisterType((*Session_ShardPosition)(nil), "vtgate.Session.ShardPosition")
 	proto.RegisterType((*ExecuteRequest)(nil), "vtgate.ExecuteRequest")
 	proto.RegisterType((*ExecuteResponse)(nil), "vtgate.ExecuteResponse")
 	proto.RegisterType((*ExecuteShardsRequest)(nil), "vtgate.ExecuteShardsRequest")
@@ -1701,105 +1772,111 @@ func init() {
 func init() { proto.RegisterFile("vtgate.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1591 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xd4, 0x59, 0x5b, 0x6f, 0x1b, 0x45,
-	0x14, 0xd6, 0xae, 0xef, 0xc7, 0x8e, 0x93, 0x4e, 0x2e, 0x75, 0xdd, 0xd0, 0xa4, 0x2b, 0xa2, 0xba,
-	0x34, 0x72, 0x69, 0xca, 0x4d, 0xbc, 0x00, 0x49, 0x23, 0x14, 0x15, 0x4a, 0x99, 0x84, 0x9b, 0x44,
-	0xb5, 0xda, 0xd8, 0xa3, 0x64, 0xb1, 0x77, 0xd7, 0xdd, 0x99, 0x75, 0x09, 0x0f, 0xa8, 0xff, 0xa0,
-	0x4f, 0x48, 0xa8, 0x42, 0x42, 0x48, 0xbc, 0xf2, 0x8a, 0xc4, 0x1b, 0x0f, 0x08, 0x7e, 0x02, 0xef,
-	0xfc, 0x01, 0x2e, 0xbf, 0x00, 0xed, 0xcc, 0xec, 0xc5, 0x1b, 0xdb, 0x71, 0x9c, 0xb8, 0x72, 0x9f,
-	0xec, 0xb9, 0x9d, 0xf9, 0xe6, 0x3b, 0xdf, 0x9e, 0x73, 0x76, 0x16, 0x4a, 0x5d, 0x76, 0x60, 0x30,
-	0x52, 0xef, 0xb8, 0x0e, 0x73, 0x50, 0x56, 0xb4, 0xaa, 0xc5, 0x87, 0x1e, 0x71, 0x8f, 0x44, 0x67,
-	0xb5, 0xcc, 0x9c, 0x8e, 0xd3, 0x34, 0x98, 0x21, 0xdb, 0xc5, 0x2e, 0x73, 0x3b, 0x0d, 0xd1, 0xd0,
-	0xfe, 0x51, 0x20, 0xb7, 0x4b, 0x28, 0x35, 0x1d, 0x1b, 0xad, 0x41, 0xd9, 0xb4, 0x75, 0xe6, 0x1a,
-	0x36, 0x35, 0x1a, 0xcc, 0x74, 0xec, 0x8a, 0xb2, 0xaa, 0xd4, 0xf2, 0x78, 0xc6, 0xb4, 0xf7, 0xa2,
-	0x4e, 0xb4, 0x05, 0x65, 0x7a, 0x68, 0xb8, 0x4d, 0x9d, 0x8a, 0x75, 0xb4, 0xa2, 0xae, 0xa6, 0x6a,
-	0xc5, 0x8d, 0xe5, 0xba, 0xc4, 0x22, 0xed, 0xd5, 0x77, 0xfd, 0x59, 0xb2, 0x81, 0x67, 0x68, 0xac,
-	0x45, 0xd1, 0x65, 0x28, 0x50, 0xd3, 0x3e, 0x68, 0x13, 0xbd, 0xb9, 0x5f, 0x49, 0xf1, 0x6d, 0xf2,
-	0xa2, 0xe3, 0xce, 0x7e, 0xf5, 0x73, 0x28, 0xc5, 0xd7, 0xa2, 0x35, 0xc8, 0x32, 0xc3, 0x3d, 0x20,
-	0x8c, 0x03, 0x2a, 0x6e, 0xcc, 0xd4, 0xc5, 0xf9, 0xf6, 0x78, 0x27, 0x96, 0x83, 0x3e, 0xfe, 0x18,
-	0x78, 0xdd, 0x6c, 0x56, 0xd4, 0x55, 0xa5, 0x96, 0xc2, 0x33, 0xb1, 0xde, 0x9d, 0xa6, 0xf6, 0x9b,
-	0x0a, 0xe5, 0xed, 0x2f, 0x49, 0xc3, 0x63, 0x04, 0x93, 0x87, 0x1e, 0xa1, 0x0c, 0xad, 0x43, 0xa1,
-	0x61, 0xb4, 0xdb, 0xc4, 0xf5, 0x17, 0x89, 0x3d, 0x66, 0xeb, 0x82, 0xa6, 0x2d, 0xde, 0xbf, 0x73,
-	0x07, 0xe7, 0xc5, 0x8c, 0x9d, 0x26, 0xba, 0x0e, 0x39, 0x79, 0x74, 0xbe, 0x81, 0x98, 0x1b, 0x3f,
-	0x39, 0x0e, 0xc6, 0xd1, 0x35, 0xc8, 0x70, 0xa8, 0xfc, 0x88, 0xc5, 0x8d, 0x0b, 0x12, 0xf8, 0xa6,
-	0xe3, 0xd9, 0xcd, 0x0f, 0xfd, 0xbf, 0x58, 0x8c, 0xa3, 0x57, 0xa1, 0xc8, 0x8c, 0xfd, 0x36, 0x61,
-	0x3a, 0x3b, 0xea, 0x90, 0x4a, 0x7a, 0x55, 0xa9, 0x95, 0x37, 0x16, 0xea, 0xa1, 0xeb, 0xf6, 0xf8,
-	0xe0, 0xde, 0x51, 0x87, 0x60, 0x60, 0xe1, 0x7f, 0xb4, 0x0e, 0xc8, 0x76, 0x98, 0x9e, 0x70, 0x5b,
-	0x86, 0xf3, 0x39, 0x67, 0x3b, 0x6c, 0xa7, 0xc7, 0x73, 0x55, 0xc8, 0xb7, 0xc8, 0x11, 0xed, 0x18,
-	0x0d, 0x52, 0xc9, 0xae, 0x2a, 0xb5, 0x02, 0x0e, 0xdb, 0xe8, 0x26, 0xe4, 0x9c, 0x0e, 0xe3, 0xee,
-	0xcc, 0x71, 0xac, 0x8b, 0x12, 0xab, 0xa4, 0xea, 0x03, 0x31, 0x88, 0x83, 0x59, 0xda, 0x13, 0x05,
-	0x66, 0x43, 0x1a, 0x69, 0xc7, 0xb1, 0x29, 0x41, 0x6b, 0x90, 0x21, 0xae, 0xeb, 0xb8, 0x09, 0x0e,
-	0xf1, 0xfd, 0xad, 0x6d, 0xbf, 0x1b, 0x8b, 0xd1, 0xd3, 0x10, 0xf8, 0x12, 0x64, 0x5d, 0x42, 0xbd,
-	0x36, 0x93, 0x0c, 0x22, 0x89, 0x4a, 0x90, 0xc7, 0x47, 0xb0, 0x9c, 0xa1, 0xfd, 0xa5, 0xc2, 0x82,
-	0x44, 0xc4, 0xe5, 0x43, 0xa7, 0xc7, 0xbd, 0x71, 0xe6, 0xd3, 0x09, 0xe6, 0x97, 0x20, 0xcb, 0x9f,
-	0x0d, 0x5a, 0xc9, 0xac, 0xa6, 0x6a, 0x05, 0x2c, 0x5b, 0x49, 0x49, 0x64, 0xcf, 0x24, 0x89, 0xdc,
-	0x00, 0x49, 0xc4, 0xdc, 0x9e, 0x1f, 0xc9, 0xed, 0xdf, 0x28, 0xb0, 0x98, 0x20, 0x79, 0x2a, 0x9c,
-	0xff, 0x9f, 0x0a, 0x97, 0x24, 0xae, 0xbb, 0x92, 0xd9, 0x9d, 0xe7, 0x45, 0x01, 0x57, 0xa1, 0x14,
-	0xfc, 0xd7, 0x4d, 0xa9, 0x83, 0x12, 0x2e, 0xb6, 0xa2, 0x73, 0x4c, 0xa9, 0x18, 0x9e, 0x2a, 0x50,
-	0xed, 0x47, 0xfa, 0x54, 0x28, 0xe2, 0x71, 0x0a, 0x2e, 0x46, 0xe0, 0xb0, 0x61, 0x1f, 0x90, 0xe7,
-	0x44, 0x0f, 0xb7, 0x00, 0x5a, 0xe4, 0x48, 0x77, 0x39, 0x64, 0xae, 0x06, 0xff, 0xa4, 0xa1, 0xaf,
-	0x83, 0xd3, 0xe0, 0x42, 0x2b, 0x38, 0xd7, 0x94, 0xea, 0xe3, 0x5b, 0x05, 0x2a, 0xc7, 0x5d, 0x30,
-	0x15, 0xea, 0xf8, 0x25, 0x1d, 0xaa, 0x63, 0xdb, 0x66, 0x26, 0x3b, 0x7a, 0x6e, 0xa2, 0xc5, 0x3a,
-	0x20, 0xc2, 0x11, 0xeb, 0x0d, 0xa7, 0xed, 0x59, 0xb6, 0x6e, 0x1b, 0x16, 0xe1, 0x39, 0xbf, 0x80,
-	0xe7, 0xc4, 0xc8, 0x16, 0x1f, 0xb8, 0x67, 0x58, 0x04, 0x7d, 0x0a, 0xf3, 0x72, 0x76, 0x4f, 0x88,
-	0xc9, 0x72, 0x51, 0xd5, 0x02, 0xa4, 0x03, 0x98, 0xa8, 0x07, 0x1d, 0xf8, 0x82, 0x30, 0x72, 0x77,
-	0x70, 0x48, 0xca, 0x9d, 0x49, 0x72, 0xf9, 0x93, 0x25, 0x57, 0x18, 0x45, 0x72, 0xd5, 0x7d, 0xc8,
-	0x07, 0xa0, 0xd1, 0x0a, 0xa4, 0x39, 0x34, 0x85, 0x43, 0x2b, 0x06, 0x55, 0xa3, 0x8f, 0x88, 0x0f,
-	0xa0, 0x05, 0xc8, 0x74, 0x8d, 0xb6, 0x47, 0xb8, 0xe3, 0x4a, 0x58, 0x34, 0xd0, 0x0a, 0x14, 0x63,
-	0x5c, 0x71, 0x5f, 0x95, 0x30, 0x44, 0xd1, 0x38, 0x2e, 0xeb, 0x18, 0x63, 0x53, 0x21, 0xeb, 0xdf,
-	0x55, 0x98, 0x97, 0xd0, 0x36, 0x0d, 0xd6, 0x38, 0x9c, 0xb8, 0xa4, 0x6f, 0x40, 0xce, 0x47, 0x63,
-	0x12, 0x5a, 0x49, 0x71, 0x4d, 0xf5, 0x11, 0x75, 0x30, 0x63, 0xdc, 0x2a, 0x77, 0x0d, 0xca, 0x06,
-	0xed, 0x53, 0xe1, 0xce, 0x18, 0x74, 0x62, 0xe5, 0xed, 0x53, 0x25, 0x2c, 0x26, 0x25, 0x91, 0x13,
-	0xf3, 0xef, 0xcb, 0x90, 0x13, 0xde, 0x0b, 0x28, 0x5c, 0x92, 0xd8, 0x84, 0x6f, 0x3f, 0x31, 0xd9,
-	0xa1, 0x30, 0x1d, 0x4c, 0xd3, 0x6c, 0x98, 0xe5, 0xf4, 0xf2, 0x0a, 0x8c, 0x73, 0x1c, 0x85, 0x16,
-	0xe5, 0x14, 0xa1, 0x45, 0x1d, 0x58, 0x8a, 0xa6, 0xe2, 0xa5, 0xa8, 0xf6, 0x73, 0x54, 0x5c, 0x71,
-	0x32, 0x9e, 0x51, 0x79, 0x7d, 0x2b, 0xa9, 0xad, 0x8b, 0xc1, 0xd4, 0xc4, 0xe9, 0x9f, 0x95, 0xc2,
-	0x62, 0x2a, 0xca, 0x8e, 0xa4, 0xa2, 0xef, 0xa2, 0x02, 0xa9, 0x87, 0xb8, 0x89, 0x69, 0x69, 0x3d,
-	0xa9, 0xa5, 0x7e, 0xc1, 0x22, 0xd4, 0xd1, 0xd7, 0xb0, 0xc0, 0x99, 0x8c, 0xc2, 0xfa, 0x39, 0x8a,
-	0x29, 0x59, 0xd5, 0xa6, 0x8e, 0x55, 0xb5, 0xda, 0xaf, 0x2a, 0x5c, 0x89, 0xd3, 0xf3, 0x2c, 0x2b,
-	0xf7, 0xd7, 0x92, 0xe2, 0x5a, 0xee, 0x11, 0x57, 0x82, 0x92, 0xa9, 0x55, 0xd8, 0x0f, 0x0a, 0xac,
-	0x0c, 0xa4, 0x70, 0x4a, 0x64, 0xf6, 0xaf, 0x02, 0x0b, 0xbb, 0xcc, 0x25, 0x86, 0x75, 0xa6, 0x7b,
-	0x97, 0x50, 0x95, 0xea, 0xe9, 0x2e, 0x53, 0x52, 0x23, 0xba, 0x68, 0x58, 0xd1, 0x15, 0xf3, 0x4b,
-	0x66, 0x24, 0xbf, 0x6c, 0xc1, 0x62, 0xe2, 0xc8, 0xd2, 0x19, 0x51, 0x36, 0x57, 0x4e, 0xcc, 0xe6,
-	0x4f, 0x54, 0xa8, 0xf6, 0x58, 0x39, 0x4b, 0xe0, 0x1d, 0x99, 0xbe, 0x38, 0x0f, 0xa9, 0x81, 0x19,
-	0x22, 0x3d, 0xec, 0xb2, 0x22, 0x33, 0x22, 0xe5, 0xa7, 0x96, 0xfb, 0x0e, 0x5c, 0xee, 0x4b, 0xc8,
-	0x18, 0xe4, 0x7e, 0xaf, 0xc2, 0x4a, 0x8f, 0xad, 0x33, 0x47, 0x9f, 0x73, 0x61, 0x38, 0x19, 0x36,
-	0xd3, 0x27, 0x5e, 0x06, 0x4c, 0x8c, 0xec, 0x7b, 0xb0, 0x3a, 0x98, 0xa0, 0x31, 0x18, 0xff, 0x49,
-	0x85, 0x17, 0x92, 0x06, 0xcf, 0xf2, 0x5e, 0x7e, 0x2e, 0x7c, 0xf7, 0xbe, 0x6c, 0xa7, 0xc7, 0x78,
-	0xd9, 0x9e, 0x18, 0xff, 0xef, 0xc1, 0x95, 0x41, 0x74, 0x8d, 0xc1, 0xfe, 0x67, 0x50, 0xda, 0x24,
-	0x07, 0xa6, 0x3d, 0x1e, 0xd7, 0x3d, 0x17, 0xf6, 0x6a, 0xef, 0x85, 0xbd, 0xf6, 0x26, 0xcc, 0x48,
-	0xd3, 0x12, 0x57, 0x2c, 0x95, 0x28, 0xc3, 0x53, 0x89, 0xf6, 0x58, 0x81, 0x99, 0x2d, 0xc7, 0xb2,
-	0x4c, 0x36, 0xf1, 0x94, 0xbf, 0x04, 0x59, 0x83, 0x39, 0x96, 0xd9, 0x90, 0x5f, 0x1c, 0x64, 0x4b,
-	0x9b, 0x83, 0x72, 0x80, 0x40, 0xe0, 0xd7, 0xbe, 0x80, 0x59, 0xec, 0xb4, 0xdb, 0xfb, 0x46, 0xa3,
-	0x35, 0x69, 0x54, 0x1a, 0x82, 0xb9, 0x68, 0x2f, 0xb9, 0xff, 0x03, 0xb8, 0x84, 0x09, 0x75, 0xda,
-	0x5d, 0x12, 0x2b, 0x0e, 0xc6, 0x43, 0x82, 0x20, 0xdd, 0x64, 0xf2, 0x5b, 0x48, 0x01, 0xf3, 0xff,
-	0xda, 0x32, 0x54, 0xfb, 0x99, 0x97, 0x9b, 0xff, 0xad, 0xc2, 0x85, 0xdd, 0x4e, 0xdb, 0x64, 0x52,
-	0x45, 0xe3, 0xec, 0x3a, 0xac, 0x30, 0x1c, 0xf9, 0x16, 0xe4, 0x2a, 0x94, 0xa8, 0x8f, 0x43, 0x5e,
-	0x74, 0xc8, 0x94, 0x53, 0xe4, 0x7d, 0xe2, 0x8a, 0xc3, 0x7f, 0x57, 0x0f, 0xa6, 0x78, 0x36, 0xe3,
-	0x8f, 0x62, 0x0a, 0x83, 0x9c, 0xe1, 0xd9, 0x0c, 0xbd, 0x02, 0x17, 0x6d, 0xcf, 0xd2, 0x5d, 0xe7,
-	0x11, 0xd5, 0x3b, 0xc4, 0xd5, 0xb9, 0x65, 0xbd, 0x63, 0xb8, 0x8c, 0x3f, 0x84, 0x29, 0x3c, 0x6f,
-	0x7b, 0x16, 0x76, 0x1e, 0xd1, 0xfb, 0xc4, 0xe5, 0x9b, 0xdf, 0x37, 0x5c, 0x86, 0xde, 0x86, 0x82,
-	0xd1, 0x3e, 0x70, 0x5c, 0x93, 0x1d, 0x5a, 0xf2, 0x66, 0x43, 0x93, 0x30, 0x8f, 0x31, 0x53, 0x7f,
-	0x27, 0x98, 0x89, 0xa3, 0x45, 0xe8, 0x06, 0x20, 0x8f, 0x12, 0x5d, 0x80, 0x13, 0x9b, 0x76, 0x37,
-	0xe4, 0x35, 0xc7, 0xac, 0x47, 0x49, 0x64, 0xe6, 0xe3, 0x0d, 0xed, 0x8f, 0x14, 0xa0, 0xb8, 0x5d,
-	0xf9, 0x14, 0xbd, 0x0e, 0x59, 0xbe, 0x9e, 0x56, 0x14, 0x1e, 0x96, 0x56, 0x42, 0x0d, 0x1d, 0x9b,
-	0x5b, 0xf7, 0x61, 0x63, 0x39, 0xbd, 0xfa, 0x00, 0x4a, 0x41, 0xac, 0xe0, 0xc7, 0x89, 0x7b, 0x43,
-	0x19, 0x1a, 0xff, 0xd4, 0x11, 0xe2, 0x5f, 0xf5, 0x2d, 0x28, 0xf0, 0xbc, 0x7b, 0xa2, 0xed, 0xa8,
-	0x5a, 0x50, 0xe3, 0xd5, 0x42, 0xf5, 0x4f, 0x05, 0xd2, 0x7c, 0xf1, 0xc8, 0x2f, 0x1a, 0xef, 0x43,
-	0x39, 0x44, 0x29, 0xbc, 0x27, 0x1e, 0xab, 0x6b, 0x43, 0x28, 0x89, 0x53, 0x80, 0x4b, 0xad, 0x38,
-	0x21, 0x5b, 0x00, 0xe2, 0x1b, 0x26, 0x37, 0x25, 0x74, 0xf8, 0xe2, 0x10, 0x53, 0xe1, 0x71, 0x71,
-	0x81, 0x86, 0x27, 0x47, 0x90, 0xa6, 0xe6, 0x57, 0xa2, 0x56, 0x4c, 0x61, 0xfe, 0x5f, 0xbb, 0x0d,
-	0x8b, 0xef, 0x12, 0xb6, 0xeb, 0x76, 0x83, 0x5c, 0x19, 0x3c, 0x3e, 0x43, 0x68, 0xd2, 0x30, 0x2c,
-	0x25, 0x17, 0x49, 0x05, 0xbc, 0x01, 0x25, 0xea, 0x76, 0xf5, 0x9e, 0x95, 0x7e, 0xde, 0x08, 0xdd,
-	0x13, 0x5f, 0x54, 0xa4, 0x51, 0x43, 0xfb, 0x51, 0x85, 0xf9, 0x8f, 0x3a, 0x4d, 0x83, 0x11, 0x91,
-	0x42, 0xce, 0xff, 0x31, 0x5e, 0x80, 0x0c, 0xe7, 0x42, 0x66, 0x54, 0xd1, 0x40, 0x37, 0xa1, 0x10,
-	0x3a, 0x8a, 0x33, 0xd3, 0x5f, 0x4d, 0xf9, 0xc0, 0x1d, 0xe3, 0x26, 0xd3, 0x65, 0x28, 0x30, 0xd3,
-	0x22, 0x94, 0x19, 0x56, 0x47, 0x3e, 0xc9, 0x51, 0x87, 0xaf, 0x2b, 0xd2, 0x25, 0x36, 0x93, 0x97,
-	0x3d, 0x81, 0xae, 0xb6, 0xfd, 0xbe, 0x3d, 0xa7, 0x45, 0x6c, 0x2c, 0xc6, 0xb5, 0x16, 0x2c, 0xf4,
-	0xb2, 0x24, 0x89, 0xaf, 0x05, 0x06, 0x7a, 0xf3, 0xaa, 0x4c, 0xc7, 0xfe, 0x88, 0xb4, 0x80, 0xae,
-	0xc3, 0x9c, 0x9f, 0x60, 0x2d, 0xa2, 0x47, 0x78, 0xc4, 0x77, 0xe7, 0x59, 0xd1, 0xbf, 0x17, 0x74,
-	0x6f, 0x56, 0xa1, 0xd2, 0x70, 0xac, 0xfa, 0x91, 0xe3, 0x31, 0x6f, 0x9f, 0xd4, 0xbb, 0x26, 0x23,
-	0x94, 0x8a, 0x0f, 0xf1, 0xfb, 0x59, 0xfe, 0x73, 0xfb, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3a,
-	0xf8, 0x90, 0xb1, 0xd1, 0x1f, 0x00, 0x00,
+	// 1692 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xd4, 0x5a, 0xcb, 0x6f, 0x1b, 0xc7,
+	0x19, 0xc7, 0xee, 0x52, 0x7c, 0x7c, 0x7c, 0x48, 0x1e, 0x3d, 0x4c, 0xd3, 0xb2, 0x25, 0x2f, 0x2a,
+	0x98, 0xae, 0x05, 0xba, 0x96, 0xfb, 0x42, 0x7d, 0x68, 0x2b, 0x59, 0x28, 0x04, 0xb7, 0xae, 0x3a,
+	0x52, 0xeb, 0x16, 0xa8, 0xb1, 0x58, 0x91, 0x03, 0x69, 0x43, 0x72, 0x97, 0xde, 0x99, 0xa5, 0xc3,
+	0x1c, 0x02, 0x1f, 0x72, 0xf7, 0x29, 0x40, 0x60, 0x18, 0x08, 0x02, 0xe4, 0x9a, 0x6b, 0x80, 0xdc,
+	0x72, 0x08, 0x92, 0xff, 0x20, 0xb9, 0xe7, 0x1f, 0x08, 0x90, 0xbf, 0x20, 0xd8, 0x99, 0xd9, 0x07,
+	0x57, 0x24, 0x45, 0x51, 0xa2, 0x41, 0x9f, 0xb4, 0xf3, 0xfa, 0xe6, 0x37, 0xbf, 0xef, 0x37, 0xdf,
+	0x7c, 0x9c, 0x11, 0x14, 0xba, 0xec, 0xd8, 0x64, 0xa4, 0xd6, 0x71, 0x1d, 0xe6, 0xa0, 0xb4, 0x28,
+	0x55, 0xf2, 0xcf, 0x3d, 0xe2, 0xf6, 0x44, 0x65, 0xa5, 0xc4, 0x9c, 0x8e, 0xd3, 0x30, 0x99, 0x29,
+	0xcb, 0xf9, 0x2e, 0x73, 0x3b, 0x75, 0x51, 0xd0, 0xbf, 0xd7, 0x20, 0x73, 0x40, 0x28, 0xb5, 0x1c,
+	0x1b, 0x6d, 0x40, 0xc9, 0xb2, 0x0d, 0xe6, 0x9a, 0x36, 0x35, 0xeb, 0xcc, 0x72, 0xec, 0xb2, 0xb2,
+	0xae, 0x54, 0xb3, 0xb8, 0x68, 0xd9, 0x87, 0x51, 0x25, 0xda, 0x81, 0x12, 0x3d, 0x31, 0xdd, 0x86,
+	0x41, 0xc5, 0x38, 0x5a, 0x56, 0xd7, 0xb5, 0x6a, 0x7e, 0x6b, 0xb5, 0x26, 0xb1, 0x48, 0x7b, 0xb5,
+	0x03, 0xbf, 0x97, 0x2c, 0xe0, 0x22, 0x8d, 0x95, 0x28, 0xba, 0x0e, 0x39, 0x6a, 0xd9, 0xc7, 0x2d,
+	0x62, 0x34, 0x8e, 0xca, 0x1a, 0x9f, 0x26, 0x2b, 0x2a, 0x1e, 0x1d, 0xa1, 0x9b, 0x00, 0xd4, 0xec,
+	0x92, 0x8e, 0x63, 0xd9, 0x8c, 0x96, 0x53, 0xeb, 0x5a, 0x35, 0x87, 0x63, 0x35, 0xa8, 0x0a, 0x0b,
+	0x2e, 0x31, 0x1b, 0x46, 0xcf, 0xf1, 0x5c, 0xe3, 0x85, 0x6b, 0x31, 0x42, 0xcb, 0x73, 0xdc, 0x46,
+	0xc9, 0xaf, 0xff, 0x9f, 0xe3, 0xb9, 0x4f, 0x79, 0x2d, 0x7a, 0x08, 0xb9, 0x8e, 0x43, 0x2d, 0xc6,
+	0x61, 0xa6, 0x39, 0xcc, 0x1b, 0x03, 0x61, 0xee, 0xcb, 0x5e, 0x38, 0xea, 0x5f, 0xf9, 0x3f, 0x14,
+	0xe2, 0x4b, 0x40, 0x1b, 0x90, 0x66, 0xa6, 0x7b, 0x4c, 0x18, 0xe7, 0x25, 0xbf, 0x55, 0xac, 0x09,
+	0x9a, 0x0f, 0x79, 0x25, 0x96, 0x8d, 0x3e, 0x8d, 0x31, 0x0e, 0x0d, 0xab, 0x51, 0x56, 0xd7, 0x95,
+	0xaa, 0x86, 0x8b, 0xb1, 0xda, 0xbd, 0x46, 0xe5, 0x19, 0x14, 0xfb, 0x66, 0x46, 0x15, 0xc8, 0x36,
+	0x49, 0x8f, 0x76, 0xcc, 0x3a, 0xe1, 0x13, 0xe4, 0x70, 0x58, 0x46, 0x4b, 0x30, 0xc7, 0xf9, 0xe3,
+	0xa6, 0x72, 0x58, 0x14, 0xfc, 0x11, 0x01, 0x5a, 0xce, 0x61, 0x0e, 0x87, 0x65, 0xfd, 0x1b, 0x15,
+	0x4a, 0xbb, 0xef, 0x93, 0xba, 0xc7, 0x08, 0x26, 0xcf, 0x3d, 0x42, 0x19, 0xda, 0x84, 0x5c, 0xdd,
+	0x6c, 0xb5, 0x88, 0xeb, 0x63, 0x12, 0x4b, 0x98, 0xaf, 0x09, 0x31, 0xec, 0xf0, 0xfa, 0xbd, 0x47,
+	0x38, 0x2b, 0x7a, 0xec, 0x35, 0xd0, 0x1d, 0xc8, 0x48, 0x07, 0x97, 0xd5, 0xb0, 0x6f, 0x9c, 0x38,
+	0x1c, 0xb4, 0xa3, 0xdb, 0x30, 0xc7, 0x99, 0xe0, 0x20, 0xf2, 0x5b, 0x57, 0x24, 0x2f, 0xdb, 0x8e,
+	0x67, 0x37, 0xfe, 0xe5, 0x7f, 0x62, 0xd1, 0x8e, 0x7e, 0x07, 0x79, 0x66, 0x1e, 0xb5, 0x08, 0x33,
+	0x58, 0xaf, 0x43, 0xca, 0xa9, 0x75, 0xa5, 0x5a, 0xda, 0x5a, 0xaa, 0x85, 0x02, 0x3d, 0xe4, 0x8d,
+	0x87, 0xbd, 0x0e, 0xc1, 0xc0, 0xc2, 0x6f, 0xb4, 0x09, 0xc8, 0x76, 0x98, 0x91, 0x10, 0xa7, 0xf0,
+	0xf8, 0x82, 0xed, 0xb0, 0xbd, 0x3e, 0x7d, 0xc6, 0x79, 0x4c, 0x27, 0x78, 0xbc, 0x07, 0x19, 0xa7,
+	0x23, 0xd4, 0x90, 0xe1, 0x58, 0x97, 0x25, 0x56, 0x49, 0xd5, 0x3f, 0x45, 0x23, 0x0e, 0x7a, 0xe9,
+	0xaf, 0x14, 0x98, 0x0f, 0x69, 0xa4, 0x1d, 0xc7, 0xa6, 0x04, 0x6d, 0xc0, 0x1c, 0x71, 0x5d, 0xc7,
+	0x4d, 0x70, 0x88, 0xf7, 0x77, 0x76, 0xfd, 0x6a, 0x2c, 0x5a, 0xcf, 0x43, 0xe0, 0xaf, 0x21, 0xed,
+	0x12, 0xea, 0xb5, 0x98, 0x64, 0x10, 0x49, 0x54, 0x82, 0x3c, 0xde, 0x82, 0x65, 0x0f, 0xfd, 0x47,
+	0x15, 0x96, 0x24, 0x22, 0xae, 0x1f, 0x3a, 0x3b, 0xee, 0x8d, 0x33, 0x9f, 0x4a, 0x30, 0xbf, 0x02,
+	0x69, 0x2e, 0x5a, 0x7f, 0xa7, 0xfa, 0xfb, 0x59, 0x96, 0x92, 0x92, 0x48, 0x5f, 0x48, 0x12, 0x99,
+	0x21, 0x92, 0x88, 0xb9, 0x3d, 0x3b, 0x96, 0xdb, 0x3f, 0x56, 0x60, 0x39, 0x41, 0xf2, 0x4c, 0x38,
+	0xff, 0x67, 0x15, 0xae, 0x49, 0x5c, 0x8f, 0x25, 0xb3, 0x7b, 0xef, 0x8a, 0x02, 0x6e, 0x41, 0x21,
+	0xf8, 0x36, 0x2c, 0xa9, 0x83, 0x02, 0xce, 0x37, 0xa3, 0x75, 0xcc, 0xa8, 0x18, 0x5e, 0x2b, 0x50,
+	0x19, 0x44, 0xfa, 0x4c, 0x28, 0xe2, 0xa5, 0x06, 0x57, 0x23, 0x70, 0xd8, 0xb4, 0x8f, 0xc9, 0x3b,
+	0xa2, 0x87, 0xfb, 0x00, 0x4d, 0xd2, 0x33, 0x5c, 0x0e, 0x99, 0xab, 0xc1, 0x5f, 0x69, 0xe8, 0xeb,
+	0x60, 0x35, 0x38, 0xd7, 0x94, 0x5f, 0xb3, 0xaa, 0x8f, 0x4f, 0x14, 0x28, 0x9f, 0x76, 0xc1, 0x4c,
+	0xa8, 0xe3, 0xab, 0x54, 0xa8, 0x8e, 0x5d, 0x9b, 0x59, 0xac, 0xf7, 0xce, 0x44, 0x8b, 0x4d, 0x40,
+	0x84, 0x23, 0x36, 0xea, 0x4e, 0xcb, 0x6b, 0xdb, 0x86, 0x6d, 0xb6, 0x09, 0x3f, 0xf3, 0x73, 0x78,
+	0x41, 0xb4, 0xec, 0xf0, 0x86, 0x27, 0x66, 0x9b, 0xa0, 0xff, 0xc2, 0xa2, 0xec, 0xdd, 0x17, 0x62,
+	0x44, 0xc6, 0x57, 0x0d, 0x90, 0x0e, 0x61, 0xa2, 0x16, 0x54, 0xe0, 0x2b, 0xc2, 0xc8, 0xe3, 0xe1,
+	0x21, 0x29, 0x73, 0x21, 0xc9, 0x65, 0xcf, 0x96, 0x5c, 0x6e, 0x1c, 0xc9, 0x55, 0x8e, 0x20, 0x1b,
+	0x80, 0x46, 0x6b, 0x90, 0xe2, 0xd0, 0x14, 0x0e, 0x2d, 0x1f, 0x24, 0xa5, 0x3e, 0x22, 0xde, 0xe0,
+	0x27, 0x8f, 0x5d, 0xb3, 0xe5, 0x11, 0xee, 0xb8, 0x02, 0x16, 0x05, 0xb4, 0x06, 0xf9, 0x18, 0x57,
+	0xdc, 0x57, 0x05, 0x0c, 0x51, 0x34, 0x8e, 0xcb, 0x3a, 0xc6, 0xd8, 0x4c, 0xc8, 0xfa, 0x5b, 0x15,
+	0x16, 0x25, 0xb4, 0x6d, 0x93, 0xd5, 0x4f, 0xa6, 0x2e, 0xe9, 0xbb, 0x90, 0xf1, 0xd1, 0x58, 0x84,
+	0x96, 0xb5, 0x75, 0x6d, 0xb0, 0xa8, 0x83, 0x1e, 0x93, 0x66, 0xb9, 0x1b, 0x50, 0x32, 0xe9, 0x80,
+	0x0c, 0xb7, 0x68, 0xd2, 0xa9, 0xa5, 0xb7, 0xaf, 0x15, 0x58, 0xea, 0x27, 0x72, 0x6a, 0xfe, 0xfd,
+	0x0d, 0x64, 0x84, 0xf7, 0x02, 0x0a, 0x57, 0x24, 0x36, 0xe1, 0xdb, 0xa7, 0x16, 0x3b, 0x11, 0xa6,
+	0x83, 0x6e, 0xba, 0x0d, 0xf3, 0x9c, 0x5e, 0x9e, 0x81, 0x71, 0x8e, 0xa3, 0xd0, 0xa2, 0x9c, 0x23,
+	0xb4, 0xa8, 0x43, 0x53, 0x51, 0x2d, 0x9e, 0x8a, 0xea, 0x5f, 0x46, 0xc9, 0x15, 0x27, 0xe3, 0x2d,
+	0xa5, 0xd7, 0xf7, 0x93, 0xda, 0xba, 0x1a, 0x74, 0x4d, 0xac, 0xfe, 0x6d, 0x29, 0x2c, 0xa6, 0xa2,
+	0xf4, 0x58, 0x2a, 0x7a, 0x13, 0x25, 0x48, 0x7d, 0xc4, 0x4d, 0x4d, 0x4b, 0x9b, 0x49, 0x2d, 0x0d,
+	0x0a, 0x16, 0xa1, 0x8e, 0x3e, 0x84, 0x25, 0xce, 0x64, 0x14, 0xd6, 0x2f, 0x51, 0x4c, 0xc9, 0xac,
+	0x56, 0x3b, 0x95, 0xd5, 0xea, 0x5f, 0xab, 0x70, 0x33, 0x4e, 0xcf, 0xdb, 0xcc, 0xdc, 0x7f, 0x9f,
+	0x14, 0xd7, 0x6a, 0x9f, 0xb8, 0x12, 0x94, 0xcc, 0xac, 0xc2, 0x3e, 0x53, 0x60, 0x6d, 0x28, 0x85,
+	0x33, 0x22, 0xb3, 0x37, 0x2a, 0x2c, 0x1d, 0x30, 0x97, 0x98, 0xed, 0x0b, 0xdd, 0xbb, 0x84, 0xaa,
+	0x54, 0xcf, 0x77, 0x99, 0xa2, 0x8d, 0xe9, 0xa2, 0x51, 0x49, 0x57, 0xcc, 0x2f, 0x73, 0xe3, 0xf8,
+	0x25, 0x4e, 0x66, 0x7a, 0x34, 0x99, 0xfa, 0x0e, 0x2c, 0x27, 0xd8, 0x91, 0x7e, 0x8b, 0x0e, 0x7e,
+	0xe5, 0xcc, 0x83, 0xff, 0x95, 0x0a, 0x95, 0x3e, 0x2b, 0x17, 0x89, 0xd1, 0x63, 0x33, 0x1d, 0xa7,
+	0x4c, 0x1b, 0x7a, 0x98, 0xa4, 0x46, 0xdd, 0x6b, 0xcc, 0x8d, 0xe9, 0x9d, 0x73, 0xef, 0x8c, 0x3d,
+	0xb8, 0x3e, 0x90, 0x90, 0x09, 0xc8, 0xfd, 0x54, 0x85, 0xb5, 0x3e, 0x5b, 0x17, 0x0e, 0x54, 0x97,
+	0xc2, 0x70, 0x32, 0xc2, 0xa6, 0xce, 0xbc, 0x37, 0x98, 0x1a, 0xd9, 0x4f, 0x60, 0x7d, 0x38, 0x41,
+	0x13, 0x30, 0xfe, 0x85, 0x0a, 0x37, 0x92, 0x06, 0x2f, 0xf2, 0x13, 0xfe, 0x52, 0xf8, 0xee, 0xff,
+	0x5d, 0x9e, 0x9a, 0xe0, 0x77, 0xf9, 0xd4, 0xf8, 0xff, 0x3b, 0xdc, 0x1c, 0x46, 0xd7, 0x04, 0xec,
+	0x7f, 0xa4, 0x40, 0x61, 0x9b, 0x1c, 0x5b, 0xf6, 0x64, 0x64, 0xf7, 0x3d, 0x61, 0xa8, 0x89, 0x27,
+	0x8c, 0x58, 0x60, 0xd4, 0xce, 0x08, 0x8c, 0x7f, 0x82, 0xa2, 0x44, 0x21, 0xd7, 0x10, 0x1b, 0xab,
+	0x9c, 0x31, 0xf6, 0xa5, 0x02, 0xc5, 0x1d, 0xa7, 0xdd, 0xb6, 0xd8, 0xd4, 0x33, 0x89, 0x15, 0x48,
+	0x9b, 0xcc, 0x69, 0x5b, 0x75, 0xf9, 0x5c, 0x23, 0x4b, 0xfa, 0x43, 0x28, 0x05, 0x08, 0xce, 0x8f,
+	0xff, 0x3d, 0x98, 0xc7, 0x4e, 0xab, 0x75, 0x64, 0xd6, 0x9b, 0xd3, 0x5e, 0x80, 0x8e, 0x60, 0x21,
+	0x9a, 0x4b, 0x40, 0xd5, 0x9f, 0xc1, 0x35, 0x4c, 0xa8, 0xd3, 0xea, 0x92, 0x58, 0x7a, 0x32, 0x19,
+	0x12, 0x04, 0xa9, 0x06, 0xb3, 0x82, 0x17, 0x1a, 0xfe, 0xad, 0xaf, 0x42, 0x65, 0x90, 0x79, 0x39,
+	0xf9, 0x4f, 0x2a, 0x5c, 0x39, 0xe8, 0xb4, 0x2c, 0x26, 0xc5, 0x39, 0xc9, 0xac, 0xa3, 0x52, 0xd3,
+	0xb1, 0xef, 0x61, 0x6e, 0x41, 0x81, 0xfa, 0x38, 0xe4, 0x55, 0x8b, 0x3c, 0xc9, 0xf2, 0xbc, 0x4e,
+	0x5c, 0xb2, 0xf8, 0xb7, 0x05, 0x41, 0x17, 0xcf, 0x66, 0x7c, 0x87, 0x6b, 0x18, 0x64, 0x0f, 0xcf,
+	0x66, 0xe8, 0xb7, 0x70, 0xd5, 0xf6, 0xda, 0x86, 0xeb, 0xbc, 0xa0, 0x46, 0x87, 0xb8, 0x06, 0xb7,
+	0x6c, 0x74, 0x4c, 0x97, 0xf1, 0xbd, 0xad, 0xe1, 0x45, 0xdb, 0x6b, 0x63, 0xe7, 0x05, 0xdd, 0x27,
+	0x2e, 0x9f, 0x7c, 0xdf, 0x74, 0x19, 0xfa, 0x0b, 0xe4, 0xcc, 0xd6, 0xb1, 0xe3, 0x5a, 0xec, 0xa4,
+	0x2d, 0xef, 0x56, 0x74, 0x09, 0xf3, 0x14, 0x33, 0xb5, 0xbf, 0x06, 0x3d, 0x71, 0x34, 0x08, 0xdd,
+	0x05, 0xe4, 0x51, 0x62, 0x08, 0x70, 0x62, 0xd2, 0xee, 0x96, 0xbc, 0x68, 0x99, 0xf7, 0x28, 0x89,
+	0xcc, 0xfc, 0x67, 0x4b, 0xff, 0x4e, 0x03, 0x14, 0xb7, 0x2b, 0x05, 0xfb, 0x07, 0x48, 0xf3, 0xf1,
+	0xb4, 0xac, 0xf0, 0x68, 0xb7, 0x16, 0x6a, 0xe8, 0x54, 0xdf, 0x9a, 0x0f, 0x1b, 0xcb, 0xee, 0x95,
+	0x67, 0x50, 0x08, 0x42, 0x10, 0x5f, 0xce, 0xa8, 0x27, 0xbc, 0xfe, 0xb0, 0xaa, 0x8e, 0x11, 0x56,
+	0x2b, 0x7f, 0x86, 0x9c, 0x78, 0x22, 0x3c, 0xcb, 0x76, 0x94, 0x84, 0xa8, 0xf1, 0x24, 0xa4, 0xf2,
+	0x83, 0x02, 0x29, 0x3e, 0x78, 0xec, 0x9f, 0x3a, 0xff, 0x80, 0x52, 0x88, 0x52, 0x78, 0x4f, 0x6c,
+	0xab, 0xdb, 0x23, 0x28, 0x89, 0x53, 0x80, 0x0b, 0xcd, 0x38, 0x21, 0x3b, 0x00, 0xe2, 0xad, 0x98,
+	0x9b, 0x12, 0x3a, 0xfc, 0xd5, 0x08, 0x53, 0xe1, 0x72, 0x71, 0x8e, 0x86, 0x2b, 0x47, 0x90, 0xa2,
+	0xd6, 0x07, 0x22, 0x5b, 0xd5, 0x30, 0xff, 0xd6, 0x1f, 0xc0, 0xf2, 0xdf, 0x08, 0x3b, 0x70, 0xbb,
+	0xc1, 0x11, 0x1c, 0x6c, 0x9f, 0x11, 0x34, 0xe9, 0x18, 0x56, 0x92, 0x83, 0xa4, 0x02, 0xfe, 0x08,
+	0x05, 0xea, 0x76, 0x8d, 0xbe, 0x91, 0xfe, 0x71, 0x14, 0xba, 0x27, 0x3e, 0x28, 0x4f, 0xa3, 0x82,
+	0xfe, 0xb9, 0x0a, 0x8b, 0xff, 0xee, 0x34, 0x4c, 0x46, 0xc4, 0xc9, 0x74, 0xf9, 0xdb, 0x38, 0x7c,
+	0xfb, 0xd5, 0xe2, 0x6f, 0xbf, 0xf7, 0x20, 0x17, 0x3a, 0x8a, 0x33, 0x33, 0x58, 0x4d, 0xd9, 0xc0,
+	0x1d, 0x93, 0x9e, 0xd1, 0xab, 0x90, 0x63, 0x56, 0x9b, 0x50, 0x66, 0xb6, 0x3b, 0x72, 0x27, 0x47,
+	0x15, 0xbe, 0xae, 0x48, 0x97, 0xd8, 0xac, 0x9c, 0xe9, 0xd3, 0xd5, 0xae, 0x5f, 0x77, 0xe8, 0x34,
+	0x89, 0x8d, 0x45, 0xbb, 0xde, 0x84, 0xa5, 0x7e, 0x96, 0x24, 0xf1, 0xd5, 0xc0, 0x40, 0xff, 0x71,
+	0x2d, 0x4f, 0x79, 0xbf, 0x45, 0x5a, 0x40, 0x77, 0xfc, 0x47, 0x7f, 0xea, 0xb5, 0x89, 0x11, 0xe1,
+	0x11, 0x0f, 0xeb, 0xf3, 0xa2, 0xfe, 0x30, 0xa8, 0xde, 0xae, 0x40, 0xb9, 0xee, 0xb4, 0x6b, 0x3d,
+	0xc7, 0x63, 0xde, 0x11, 0xa9, 0x75, 0x2d, 0x46, 0x28, 0x15, 0xff, 0xf0, 0x70, 0x94, 0xe6, 0x7f,
+	0x1e, 0xfc, 0x32, 0x00, 0x5b, 0xbe, 0x14, 0xa8, 0x39, 0x21, 0x00, 0x00,
 }
@@ -115,3 +115,26 @@ func StringIn(str string, values ...string) bool {
 	}
 	return false
 }
+
+// IsSavepoint returns true if the query is a SAVEPOINT, ROLLBACK TO
+// or RELEASE SAVEPOINT statement. It only looks at the first tokens,
+// which is cheaper than parsing the query.
+func IsSavepoint(sql string) bool {
+	tkn := NewStringTokenizer(sql)
+	switch nextNonComment(tkn) {
+	case SAVEPOINT, RELEASE:
+		return true
+	case ROLLBACK:
+		return nextNonComment(tkn) == TO
+	}
+	return false
+}
+
+// nextNonComment returns the type of the next token that is not a comment.
+func nextNonComment(tkn *Tokenizer) int {
+	typ, _ := tkn.Scan()
+	for typ == COMMENT {
+		typ, _ = tkn.Scan()
+	}
+	return typ
+}
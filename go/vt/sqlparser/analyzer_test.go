@@ -194,3 +194,34 @@ func TestStringIn(t *testing.T) {
 		}
 	}
 }
+
+func TestIsSavepoint(t *testing.T) {
+	testcases := []struct {
+		in  string
+		out bool
+	}{{
+		in:  "savepoint a",
+		out: true,
+	}, {
+		in:  "/* comment */ SAVEPOINT a",
+		out: true,
+	}, {
+		in:  "rollback to savepoint a",
+		out: true,
+	}, {
+		in:  "release savepoint a",
+		out: true,
+	}, {
+		in: "rollback",
+	}, {
+		in: "select savepoint from t",
+	}, {
+		in: "update rollback set a = 1",
+	}}
+	for _, tc := range testcases {
+		out := IsSavepoint(tc.in)
+		if out != tc.out {
+			t.Errorf("IsSavepoint(%v): %v, want %v", tc.in, out, tc.out)
+		}
+	}
+}
@@ -101,14 +101,15 @@ type Statement interface {
 	SQLNode
 }
 
-func (*Union) iStatement()  {}
-func (*Select) iStatement() {}
-func (*Insert) iStatement() {}
-func (*Update) iStatement() {}
-func (*Delete) iStatement() {}
-func (*Set) iStatement()    {}
-func (*DDL) iStatement()    {}
-func (*Other) iStatement()  {}
+func (*Union) iStatement()     {}
+func (*Select) iStatement()    {}
+func (*Insert) iStatement()    {}
+func (*Update) iStatement()    {}
+func (*Delete) iStatement()    {}
+func (*Set) iStatement()       {}
+func (*DDL) iStatement()       {}
+func (*Other) iStatement()     {}
+func (*Savepoint) iStatement() {}
 
 // SelectStatement any SELECT statement.
 type SelectStatement interface {
@@ -443,6 +444,33 @@ func (node *Other) WalkSubtree(visit Visit) error {
 	return nil
 }
 
+// Savepoint represents a SAVEPOINT, ROLLBACK TO SAVEPOINT
+// or RELEASE SAVEPOINT statement.
+type Savepoint struct {
+	Action string
+	Name   ColIdent
+}
+
+// Savepoint strings.
+const (
+	SavepointStr  = "savepoint"
+	RollbackToStr = "rollback to"
+	ReleaseStr    = "release savepoint"
+)
+
+// Format formats the node.
+func (node *Savepoint) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%s %v", node.Action, node.Name)
+}
+
+// WalkSubtree walks the nodes of the subtree.
+func (node *Savepoint) WalkSubtree(visit Visit) error {
+	if node == nil {
+		return nil
+	}
+	return Walk(visit, node.Name)
+}
+
 // Comments represents a list of comments.
 type Comments [][]byte
 
@@ -586,6 +586,33 @@ func TestValid(t *testing.T) {
 	}, {
 		input:  "explain foobar",
 		output: "other",
+	}, {
+		input: "savepoint a",
+	}, {
+		input: "rollback to a",
+	}, {
+		input:  "rollback to savepoint a",
+		output: "rollback to a",
+	}, {
+		input: "release savepoint a",
+	}, {
+		input:  "savepoint rollback",
+		output: "savepoint `rollback`",
+	}, {
+		input:  "rollback to savepoint",
+		output: "rollback to `savepoint`",
+	}, {
+		input:  "rollback to savepoint savepoint",
+		output: "rollback to `savepoint`",
+	}, {
+		input:  "select rollback, savepoint from t",
+		output: "select `rollback`, `savepoint` from t",
+	}, {
+		input:  "select t.rollback from savepoint as t",
+		output: "select t.`rollback` from `savepoint` as t",
+	}, {
+		input:  "update rollback set savepoint = 1 where rollback.savepoint = 2",
+		output: "update `rollback` set `savepoint` = 1 where `rollback`.`savepoint` = 2",
 	}}
 	for _, tcase := range validSQL {
 		if tcase.output == "" {
@@ -694,6 +721,9 @@ func TestCaseSensitivity(t *testing.T) {
 		output: "select next 1 values from t",
 	}, {
 		input: "select /* use */ 1 from t1 use index (A) where b = 1",
+	}, {
+		input:  "select Rollback from Savepoint",
+		output: "select `Rollback` from `Savepoint`",
 	}}
 	for _, tcase := range validSQL {
 		if tcase.output == "" {
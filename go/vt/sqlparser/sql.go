@@ -160,9 +160,12 @@ const USING = 57434
 const SHOW = 57435
 const DESCRIBE = 57436
 const EXPLAIN = 57437
-const CURRENT_TIMESTAMP = 57438
-const DATABASE = 57439
-const UNUSED = 57440
+const SAVEPOINT = 57438
+const ROLLBACK = 57439
+const RELEASE = 57440
+const CURRENT_TIMESTAMP = 57441
+const DATABASE = 57442
+const UNUSED = 57443
 
 var yyToknames = [...]string{
 	"$end",
@@ -276,6 +279,9 @@ var yyToknames = [...]string{
 	"SHOW",
 	"DESCRIBE",
 	"EXPLAIN",
+	"SAVEPOINT",
+	"ROLLBACK",
+	"RELEASE",
 	"CURRENT_TIMESTAMP",
 	"DATABASE",
 	"UNUSED",
@@ -291,332 +297,353 @@ var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 109,
-	93, 241,
-	-2, 240,
+	-1, 124,
+	93, 247,
+	-2, 245,
+	-1, 125,
+	93, 248,
+	-2, 246,
 }
 
-const yyNprod = 245
+const yyNprod = 254
 const yyPrivate = 57344
 
 var yyTokenNames []string
 var yyStates []string
 
-const yyLast = 987
+const yyLast = 1119
 
 var yyAct = [...]int{
 
-	121, 107, 282, 430, 62, 361, 305, 115, 209, 228,
-	350, 325, 116, 47, 296, 242, 315, 259, 240, 253,
-	102, 208, 3, 113, 172, 156, 244, 241, 74, 67,
-	147, 103, 381, 383, 162, 64, 41, 237, 69, 48,
-	49, 71, 35, 40, 37, 41, 97, 160, 38, 50,
-	14, 15, 16, 17, 413, 81, 43, 44, 45, 58,
-	412, 411, 68, 70, 46, 42, 211, 212, 164, 363,
-	332, 231, 18, 152, 176, 87, 189, 101, 192, 193,
-	194, 195, 189, 94, 65, 96, 436, 64, 88, 177,
-	64, 145, 63, 109, 292, 382, 126, 190, 191, 192,
-	193, 194, 195, 189, 179, 153, 174, 179, 90, 393,
-	297, 92, 144, 205, 207, 167, 84, 159, 161, 158,
-	127, 387, 283, 109, 129, 128, 130, 131, 132, 65,
-	248, 133, 124, 125, 163, 297, 111, 348, 138, 219,
-	19, 20, 22, 21, 23, 178, 177, 60, 178, 177,
-	230, 395, 112, 24, 25, 26, 283, 234, 117, 118,
-	104, 179, 109, 137, 179, 119, 223, 120, 178, 177,
-	60, 235, 328, 93, 174, 266, 227, 250, 75, 251,
-	252, 134, 149, 245, 179, 112, 112, 135, 136, 264,
-	265, 263, 28, 170, 261, 217, 218, 89, 247, 238,
-	220, 239, 254, 256, 257, 127, 270, 255, 333, 334,
-	335, 284, 286, 65, 262, 175, 285, 289, 226, 326,
-	290, 293, 112, 438, 283, 400, 64, 303, 65, 169,
-	301, 82, 250, 83, 283, 287, 288, 304, 291, 294,
-	173, 397, 127, 112, 246, 60, 300, 60, 351, 328,
-	89, 112, 112, 313, 14, 260, 169, 283, 225, 245,
-	129, 128, 130, 131, 132, 331, 148, 133, 65, 336,
-	175, 285, 283, 313, 283, 127, 261, 357, 283, 127,
-	407, 337, 196, 197, 190, 191, 192, 193, 194, 195,
-	189, 112, 112, 127, 343, 351, 60, 345, 73, 349,
-	233, 89, 100, 376, 374, 356, 79, 353, 377, 375,
-	347, 358, 355, 344, 410, 317, 320, 321, 322, 318,
-	246, 319, 323, 364, 409, 206, 245, 245, 245, 245,
-	369, 373, 371, 388, 386, 402, 403, 260, 384, 389,
-	379, 368, 372, 370, 76, 39, 14, 392, 187, 196,
-	197, 190, 191, 192, 193, 194, 195, 189, 398, 378,
-	428, 321, 322, 143, 388, 55, 112, 142, 405, 86,
-	299, 112, 429, 354, 416, 404, 406, 57, 54, 396,
-	85, 154, 99, 330, 51, 52, 306, 246, 246, 246,
-	246, 367, 188, 187, 196, 197, 190, 191, 192, 193,
-	194, 195, 189, 29, 108, 417, 419, 307, 141, 421,
-	422, 420, 312, 229, 399, 140, 150, 366, 148, 31,
-	32, 33, 34, 61, 431, 431, 431, 64, 432, 433,
-	435, 434, 426, 437, 14, 439, 440, 441, 210, 442,
-	112, 28, 443, 213, 214, 215, 216, 188, 187, 196,
-	197, 190, 191, 192, 193, 194, 195, 189, 30, 317,
-	320, 321, 322, 318, 222, 319, 323, 1, 329, 408,
-	324, 354, 171, 155, 36, 236, 157, 66, 232, 139,
-	302, 112, 112, 224, 427, 423, 424, 425, 401, 360,
-	122, 365, 311, 346, 221, 108, 295, 123, 114, 151,
-	352, 80, 298, 180, 110, 380, 258, 316, 314, 267,
-	268, 269, 243, 271, 272, 273, 274, 275, 276, 277,
-	278, 279, 280, 281, 168, 105, 78, 53, 390, 27,
-	56, 13, 59, 12, 11, 10, 9, 8, 7, 6,
-	5, 4, 72, 108, 108, 2, 77, 188, 187, 196,
-	197, 190, 191, 192, 193, 194, 195, 189, 0, 0,
-	0, 59, 0, 0, 0, 0, 91, 0, 0, 0,
-	95, 0, 0, 98, 0, 0, 0, 0, 106, 0,
-	0, 0, 0, 0, 0, 59, 232, 146, 0, 0,
-	338, 339, 340, 0, 0, 0, 0, 165, 0, 0,
-	166, 341, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 342, 0, 0, 0, 0, 0, 0, 108, 126,
-	188, 187, 196, 197, 190, 191, 192, 193, 194, 195,
-	189, 0, 359, 362, 0, 0, 0, 0, 0, 59,
-	0, 0, 0, 127, 0, 283, 109, 129, 128, 130,
-	131, 132, 0, 0, 133, 124, 125, 0, 0, 111,
-	0, 138, 0, 0, 0, 0, 0, 391, 0, 106,
-	59, 0, 0, 0, 394, 0, 249, 0, 0, 0,
-	232, 117, 118, 104, 0, 0, 137, 0, 119, 0,
-	120, 0, 0, 232, 0, 14, 0, 0, 0, 0,
-	0, 0, 0, 0, 134, 0, 0, 0, 0, 0,
-	135, 136, 0, 0, 0, 0, 414, 106, 106, 0,
-	0, 415, 0, 0, 0, 418, 362, 126, 0, 0,
-	0, 308, 0, 309, 127, 0, 310, 109, 129, 128,
-	130, 131, 132, 0, 327, 133, 59, 0, 0, 0,
-	0, 127, 138, 0, 109, 129, 128, 130, 131, 132,
-	0, 0, 133, 124, 125, 0, 0, 111, 0, 138,
-	0, 0, 117, 118, 0, 0, 0, 137, 0, 119,
-	0, 120, 0, 0, 0, 0, 0, 0, 14, 117,
-	118, 104, 106, 0, 137, 134, 119, 0, 120, 0,
-	0, 135, 136, 126, 0, 0, 0, 0, 0, 0,
-	0, 0, 134, 59, 59, 59, 59, 0, 135, 136,
-	126, 0, 0, 0, 0, 0, 327, 127, 0, 385,
-	109, 129, 128, 130, 131, 132, 0, 0, 133, 124,
-	125, 0, 0, 111, 127, 138, 0, 109, 129, 128,
-	130, 131, 132, 0, 0, 133, 124, 125, 0, 0,
-	111, 0, 138, 0, 0, 117, 118, 0, 0, 0,
-	137, 0, 119, 0, 120, 0, 0, 0, 0, 0,
-	0, 0, 117, 118, 65, 0, 0, 137, 134, 119,
-	0, 120, 0, 0, 135, 136, 127, 0, 0, 109,
-	129, 128, 130, 131, 132, 134, 0, 133, 0, 0,
-	0, 135, 136, 0, 138, 188, 187, 196, 197, 190,
-	191, 192, 193, 194, 195, 189, 0, 0, 0, 0,
-	0, 0, 0, 0, 117, 118, 0, 0, 0, 137,
-	0, 119, 0, 120, 188, 187, 196, 197, 190, 191,
-	192, 193, 194, 195, 189, 0, 0, 134, 182, 185,
-	0, 0, 0, 135, 136, 198, 199, 200, 201, 202,
-	203, 204, 186, 183, 184, 181, 188, 187, 196, 197,
-	190, 191, 192, 193, 194, 195, 189,
+	125, 377, 131, 321, 137, 244, 168, 446, 225, 74,
+	331, 341, 257, 312, 258, 275, 366, 256, 224, 3,
+	117, 269, 163, 188, 118, 132, 122, 342, 129, 56,
+	60, 172, 85, 54, 15, 397, 399, 72, 78, 56,
+	298, 51, 56, 76, 45, 56, 80, 72, 253, 82,
+	61, 72, 260, 55, 111, 71, 96, 344, 97, 59,
+	56, 56, 429, 428, 89, 91, 56, 427, 52, 53,
+	95, 79, 55, 143, 72, 81, 71, 178, 72, 50,
+	46, 39, 72, 41, 75, 72, 189, 42, 56, 69,
+	176, 56, 115, 379, 71, 116, 344, 348, 398, 128,
+	72, 56, 72, 247, 56, 76, 227, 228, 76, 403,
+	161, 180, 72, 192, 55, 72, 191, 58, 57, 58,
+	57, 56, 108, 101, 110, 190, 102, 205, 143, 452,
+	44, 71, 45, 193, 128, 128, 58, 57, 124, 195,
+	58, 57, 104, 409, 233, 234, 313, 169, 195, 236,
+	106, 98, 160, 55, 221, 223, 72, 183, 58, 57,
+	175, 177, 174, 55, 107, 191, 71, 242, 235, 84,
+	124, 128, 86, 56, 55, 264, 143, 250, 58, 57,
+	179, 47, 48, 49, 243, 278, 299, 72, 165, 103,
+	56, 246, 128, 262, 190, 58, 57, 266, 194, 193,
+	128, 128, 58, 57, 276, 454, 299, 254, 239, 313,
+	255, 364, 261, 263, 195, 251, 32, 58, 57, 87,
+	267, 268, 301, 277, 286, 185, 299, 58, 57, 164,
+	58, 57, 416, 56, 58, 57, 413, 305, 90, 57,
+	128, 128, 56, 56, 194, 193, 76, 319, 329, 320,
+	72, 317, 266, 72, 307, 310, 303, 304, 299, 316,
+	195, 72, 282, 72, 103, 300, 302, 143, 222, 262,
+	349, 350, 351, 423, 306, 309, 280, 281, 279, 367,
+	347, 103, 270, 272, 273, 352, 276, 271, 261, 208,
+	209, 210, 211, 205, 367, 353, 212, 213, 206, 207,
+	208, 209, 210, 211, 205, 277, 206, 207, 208, 209,
+	210, 211, 205, 301, 299, 128, 329, 299, 369, 249,
+	128, 114, 370, 392, 374, 363, 373, 299, 393, 360,
+	72, 72, 72, 72, 371, 186, 262, 262, 262, 262,
+	365, 93, 384, 72, 386, 385, 72, 387, 359, 56,
+	395, 361, 426, 404, 400, 261, 261, 261, 261, 372,
+	241, 123, 43, 194, 193, 390, 15, 380, 425, 411,
+	391, 185, 389, 166, 56, 388, 159, 143, 414, 195,
+	56, 66, 158, 394, 404, 337, 338, 420, 402, 128,
+	315, 100, 422, 405, 65, 444, 432, 226, 68, 412,
+	99, 408, 229, 230, 231, 232, 170, 445, 33, 421,
+	145, 144, 146, 147, 148, 113, 433, 149, 435, 346,
+	370, 62, 63, 238, 436, 35, 36, 37, 38, 322,
+	128, 128, 157, 383, 439, 440, 441, 248, 323, 156,
+	56, 56, 56, 56, 447, 447, 447, 76, 448, 449,
+	437, 438, 450, 56, 123, 56, 245, 457, 56, 458,
+	382, 167, 459, 328, 164, 274, 73, 15, 283, 284,
+	285, 451, 287, 288, 289, 290, 291, 292, 293, 294,
+	295, 296, 297, 442, 32, 34, 1, 453, 345, 455,
+	456, 340, 187, 333, 336, 337, 338, 334, 70, 335,
+	339, 171, 123, 123, 40, 252, 173, 77, 83, 155,
+	318, 240, 88, 443, 417, 376, 138, 381, 327, 143,
+	362, 237, 124, 145, 144, 146, 147, 148, 311, 139,
+	149, 130, 368, 94, 314, 70, 196, 154, 126, 105,
+	396, 332, 330, 109, 259, 248, 112, 184, 120, 354,
+	355, 356, 92, 64, 121, 31, 67, 133, 134, 14,
+	13, 70, 153, 162, 135, 12, 136, 11, 10, 9,
+	358, 8, 308, 181, 142, 7, 182, 123, 6, 5,
+	150, 4, 2, 0, 0, 0, 58, 57, 0, 151,
+	152, 375, 378, 0, 0, 0, 0, 0, 143, 0,
+	299, 124, 145, 144, 146, 147, 148, 0, 0, 149,
+	140, 141, 0, 0, 127, 0, 154, 70, 333, 336,
+	337, 338, 334, 0, 335, 339, 407, 0, 424, 0,
+	0, 0, 0, 410, 0, 0, 133, 134, 119, 248,
+	0, 153, 0, 135, 0, 136, 0, 121, 70, 0,
+	0, 0, 248, 0, 265, 0, 0, 0, 0, 150,
+	0, 0, 0, 0, 0, 58, 57, 0, 151, 152,
+	0, 0, 0, 0, 0, 430, 0, 0, 0, 0,
+	431, 0, 0, 0, 434, 378, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 121, 121, 0, 0, 0,
+	0, 0, 0, 0, 142, 0, 0, 0, 0, 324,
+	0, 325, 0, 0, 326, 0, 0, 0, 55, 0,
+	0, 0, 343, 0, 70, 0, 0, 0, 143, 0,
+	299, 124, 145, 144, 146, 147, 148, 0, 0, 149,
+	140, 141, 0, 0, 127, 0, 154, 0, 0, 204,
+	203, 212, 213, 206, 207, 208, 209, 210, 211, 205,
+	0, 0, 0, 0, 0, 0, 133, 134, 119, 0,
+	121, 153, 0, 135, 0, 136, 0, 0, 0, 0,
+	0, 142, 58, 57, 0, 15, 0, 0, 0, 150,
+	0, 70, 70, 70, 70, 58, 57, 0, 151, 152,
+	142, 0, 0, 0, 343, 143, 0, 401, 124, 145,
+	144, 146, 147, 148, 0, 0, 149, 140, 141, 0,
+	0, 127, 0, 154, 143, 0, 0, 124, 145, 144,
+	146, 147, 148, 0, 0, 149, 140, 141, 0, 0,
+	127, 0, 154, 133, 134, 119, 0, 0, 153, 0,
+	135, 0, 136, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 133, 134, 0, 0, 150, 153, 0, 135,
+	0, 136, 58, 57, 142, 151, 152, 0, 15, 0,
+	0, 0, 0, 0, 0, 150, 0, 0, 0, 0,
+	0, 58, 57, 0, 151, 152, 0, 0, 143, 0,
+	0, 124, 145, 144, 146, 147, 148, 0, 0, 149,
+	140, 141, 0, 0, 127, 0, 154, 143, 0, 0,
+	124, 145, 144, 146, 147, 148, 0, 0, 149, 15,
+	16, 17, 18, 0, 0, 154, 133, 134, 0, 0,
+	0, 153, 0, 135, 0, 136, 0, 0, 0, 0,
+	0, 19, 0, 0, 0, 133, 134, 0, 0, 150,
+	153, 0, 135, 0, 136, 58, 57, 0, 151, 152,
+	0, 0, 0, 0, 0, 0, 0, 0, 150, 0,
+	0, 0, 198, 201, 58, 57, 0, 151, 152, 214,
+	215, 216, 217, 218, 219, 220, 202, 199, 200, 197,
+	204, 203, 212, 213, 206, 207, 208, 209, 210, 211,
+	205, 418, 419, 415, 0, 0, 0, 0, 0, 20,
+	21, 23, 22, 24, 0, 0, 0, 0, 0, 0,
+	0, 0, 25, 26, 27, 28, 29, 30, 406, 0,
+	0, 0, 0, 0, 0, 0, 204, 203, 212, 213,
+	206, 207, 208, 209, 210, 211, 205, 204, 203, 212,
+	213, 206, 207, 208, 209, 210, 211, 205, 204, 203,
+	212, 213, 206, 207, 208, 209, 210, 211, 205, 357,
+	204, 203, 212, 213, 206, 207, 208, 209, 210, 211,
+	205, 0, 0, 0, 0, 0, 0, 0, 204, 203,
+	212, 213, 206, 207, 208, 209, 210, 211, 205, 203,
+	212, 213, 206, 207, 208, 209, 210, 211, 205,
 }
 var yyPact = [...]int{
 
-	44, -1000, -1000, 436, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -59,
-	-60, -36, -45, -37, -1000, -1000, -1000, 428, 366, 346,
-	-1000, -69, 99, 413, 81, -77, -40, 81, -1000, -38,
-	81, -1000, 99, -78, 130, -78, 99, -1000, -1000, -1000,
-	-1000, -1000, -1000, 271, 180, -1000, 61, 356, 341, -18,
-	-1000, 99, 151, -1000, 40, -1000, 99, 50, 125, -1000,
-	99, -1000, -58, 99, 361, 258, 81, -1000, 706, -1000,
-	398, -1000, 337, 333, -1000, 99, 81, 99, 407, 81,
-	851, -1000, 360, -83, -1000, 20, -1000, 99, -1000, -1000,
-	99, -1000, 183, -1000, -1000, 220, -19, 89, 897, -1000,
-	-1000, 799, 782, -1000, -28, -1000, -1000, 851, 851, 851,
-	851, 234, 234, -1000, -1000, -1000, 234, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 851, 99,
-	-1000, -1000, -1000, -1000, 230, 255, -1000, 399, 799, -1000,
-	865, -22, 689, -1000, -1000, 256, 81, -1000, -67, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 407, 706,
-	197, -1000, -1000, 165, -1000, -1000, 45, 799, 799, 146,
-	851, 160, 113, 851, 851, 851, 146, 851, 851, 851,
-	851, 851, 851, 851, 851, 851, 851, 851, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 32, 897, 109, 187, 225,
-	897, 211, 211, -1000, -1000, -1000, 836, 75, 598, -1000,
-	428, 46, 865, -1000, 340, 81, 81, 399, 370, 392,
-	89, 114, 865, 99, -1000, -1000, 99, -1000, 400, -1000,
-	207, 281, -1000, -1000, 199, 363, 248, -1000, -1000, -23,
-	-1000, 32, 29, -1000, -1000, 152, -1000, -1000, 865, -1000,
-	689, -1000, -1000, 160, 851, 851, 851, 865, 865, 541,
-	-1000, 201, 268, -1000, -7, -7, -13, -13, -13, -13,
-	14, 14, -1000, -1000, -1000, 851, -1000, -1000, -1000, -1000,
-	-1000, 210, 706, -1000, 210, 71, -1000, 799, 251, 234,
-	436, 204, 231, -1000, 370, -1000, 851, 851, -24, -1000,
-	-1000, 404, 376, 197, 197, 197, 197, -1000, 308, 297,
-	-1000, 270, 269, 325, -10, -1000, 122, -1000, -1000, 99,
-	-1000, 227, 36, -1000, -1000, -1000, 225, -1000, 865, 865,
-	468, 851, 865, -1000, 210, -1000, 42, -1000, 851, 86,
-	-1000, 354, 195, -1000, 851, -1000, -1000, 81, -1000, 368,
-	179, -1000, 313, 81, -1000, 399, 799, 851, 281, 236,
-	425, -1000, -1000, -1000, -1000, 290, -1000, 280, -1000, -1000,
-	-1000, -41, -42, -48, -1000, -1000, -1000, -1000, -1000, -1000,
-	851, 865, -1000, -1000, 865, 851, 348, 234, -1000, 851,
-	851, -1000, -1000, -1000, 370, 89, 170, 799, 799, -1000,
-	-1000, 234, 234, 234, 865, 865, 424, -1000, 865, -1000,
-	343, 89, 89, 81, 81, 81, 81, -1000, 422, 8,
-	177, -1000, 177, 177, 151, -1000, 81, -1000, 81, -1000,
-	-1000, 81, -1000, -1000,
+	923, -1000, -1000, 479, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-20, 27, -21, 80, -22, -1000, -1000, -1000, 105, -45,
+	-82, 461, 403, 362, -1000, -61, 118, 456, 105, -68,
+	-31, 105, -1000, -26, 105, -1000, 118, -74, 124, -74,
+	118, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 126,
+	105, -1000, -1000, -1000, 306, 5, -1000, 96, 376, 363,
+	30, -1000, -1000, 118, 143, -1000, 74, 118, 89, 116,
+	-1000, 118, -1000, -50, 118, 394, 277, 105, -1000, -1000,
+	105, -1000, 760, -1000, 422, -1000, 352, 346, -1000, 118,
+	105, 118, 453, 105, 474, -1000, 385, -77, -1000, 63,
+	-1000, 118, -1000, -1000, 118, -1000, -1000, 325, -1000, -1000,
+	66, 20, 185, 921, -1000, -1000, -1000, 853, 779, -1000,
+	12, -1000, -1000, 474, 474, 474, 474, 222, 222, -1000,
+	-1000, -1000, 222, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 474, 118, -1000, -1000, -1000, -1000,
+	332, 218, -1000, 442, 853, -1000, 1001, 10, 872, -1000,
+	-1000, 275, 105, -1000, -56, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 453, 760, 83, -1000, -1000, 115,
+	-1000, -1000, 90, 853, 853, 226, 474, 131, 200, 474,
+	474, 474, 226, 474, 474, 474, 474, 474, 474, 474,
+	474, 474, 474, 474, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 64, 921, 139, 211, 267, 921, 361, 361, -1000,
+	-1000, -1000, 670, 553, 683, -1000, 461, 82, 1001, -1000,
+	360, 105, 105, 442, 413, 423, 185, 122, 1001, 118,
+	-1000, -1000, 118, -1000, 451, -1000, 202, 459, -1000, -1000,
+	7, 399, 28, -1000, -1000, 4, -1000, 64, 73, -1000,
+	-1000, 214, -1000, -1000, 1001, -1000, 872, -1000, -1000, 131,
+	474, 474, 474, 1001, 1001, 1019, -1000, 215, 1029, -1000,
+	204, 204, 38, 38, 38, 38, 223, 223, -1000, -1000,
+	-1000, 474, -1000, -1000, -1000, -1000, -1000, 179, 760, -1000,
+	179, 145, -1000, 853, 250, 222, 479, 235, 280, -1000,
+	413, -1000, 474, 474, 0, -1000, -1000, 447, 418, 83,
+	83, 83, 83, -1000, 341, 338, -1000, 331, 289, 349,
+	-7, -1000, 46, -1000, -1000, 118, -1000, 270, 24, -1000,
+	-1000, -1000, 267, -1000, 1001, 1001, 978, 474, 1001, -1000,
+	179, -1000, 76, -1000, 474, 304, -1000, 374, 190, -1000,
+	474, -1000, -1000, 105, -1000, 967, 186, -1000, 989, 105,
+	-1000, 442, 853, 474, 459, 229, 584, -1000, -1000, -1000,
+	-1000, 334, -1000, 318, -1000, -1000, -1000, -35, -39, -40,
+	-1000, -1000, -1000, -1000, -1000, -1000, 474, 1001, -1000, -1000,
+	1001, 474, 370, 222, -1000, 474, 474, -1000, -1000, -1000,
+	413, 185, 176, 853, 853, -1000, -1000, 222, 222, 222,
+	1001, 1001, 475, -1000, 1001, -1000, 378, 185, 185, 105,
+	105, 105, 105, -1000, 463, 51, 159, -1000, 159, 159,
+	143, -1000, 105, -1000, 105, -1000, -1000, 105, -1000, -1000,
 }
 var yyPgo = [...]int{
 
-	0, 545, 21, 541, 540, 539, 538, 537, 536, 535,
-	534, 533, 531, 403, 530, 529, 527, 526, 20, 31,
-	525, 524, 18, 27, 15, 512, 508, 16, 507, 26,
-	505, 3, 30, 1, 504, 503, 502, 23, 325, 501,
-	19, 17, 8, 500, 7, 12, 498, 497, 496, 14,
-	494, 493, 492, 491, 490, 9, 489, 5, 488, 6,
-	484, 483, 480, 10, 4, 92, 479, 345, 298, 477,
-	476, 475, 474, 473, 0, 24, 472, 499, 11, 470,
-	468, 13, 467, 458, 73, 2,
+	0, 582, 18, 581, 579, 578, 575, 571, 569, 568,
+	567, 565, 560, 559, 408, 556, 555, 553, 552, 20,
+	24, 548, 547, 17, 12, 14, 544, 542, 10, 541,
+	52, 540, 7, 22, 26, 538, 536, 534, 28, 268,
+	533, 21, 15, 8, 532, 2, 25, 531, 529, 528,
+	13, 521, 520, 518, 517, 516, 5, 515, 1, 514,
+	3, 513, 511, 510, 16, 9, 84, 509, 362, 169,
+	507, 506, 505, 504, 501, 0, 4, 23, 492, 461,
+	11, 491, 488, 41, 486, 485, 6, 40,
 }
 var yyR1 = [...]int{
 
-	0, 82, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 2, 2, 2, 3, 3, 4, 5,
-	6, 7, 7, 7, 8, 8, 8, 9, 10, 10,
-	10, 11, 12, 12, 12, 83, 13, 14, 14, 15,
-	15, 15, 16, 16, 17, 17, 18, 18, 19, 19,
-	19, 19, 20, 20, 76, 76, 76, 75, 75, 21,
-	21, 22, 22, 23, 23, 24, 24, 24, 25, 25,
-	25, 25, 80, 80, 79, 79, 79, 78, 78, 26,
-	26, 26, 26, 27, 27, 27, 27, 28, 28, 29,
-	29, 30, 30, 30, 30, 31, 31, 32, 32, 33,
-	33, 33, 33, 33, 33, 34, 34, 34, 34, 34,
-	34, 34, 34, 34, 34, 34, 34, 34, 40, 40,
-	40, 40, 40, 40, 35, 35, 35, 35, 35, 35,
-	35, 41, 41, 41, 45, 42, 42, 38, 38, 38,
-	38, 38, 38, 38, 38, 38, 38, 38, 38, 38,
-	38, 38, 38, 38, 38, 38, 38, 38, 38, 38,
-	38, 38, 38, 38, 54, 54, 54, 54, 47, 50,
-	50, 48, 48, 49, 51, 51, 46, 46, 46, 37,
-	37, 37, 37, 37, 37, 39, 39, 39, 52, 52,
-	53, 53, 55, 55, 56, 56, 57, 58, 58, 58,
-	59, 59, 59, 60, 60, 60, 61, 61, 62, 62,
-	63, 63, 36, 36, 43, 43, 44, 64, 64, 65,
-	66, 66, 68, 68, 69, 69, 67, 67, 70, 70,
-	70, 70, 70, 70, 71, 71, 72, 72, 73, 73,
-	74, 77, 84, 85, 81,
+	0, 84, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 2, 2, 2, 3, 3, 4,
+	5, 6, 7, 7, 7, 8, 8, 8, 9, 10,
+	10, 10, 11, 12, 12, 12, 13, 13, 13, 13,
+	85, 14, 15, 15, 16, 16, 16, 17, 17, 18,
+	18, 19, 19, 20, 20, 20, 20, 21, 21, 78,
+	78, 78, 77, 77, 22, 22, 23, 23, 24, 24,
+	25, 25, 25, 26, 26, 26, 26, 82, 82, 81,
+	81, 81, 80, 80, 27, 27, 27, 27, 28, 28,
+	28, 28, 29, 29, 30, 30, 31, 31, 31, 31,
+	32, 32, 33, 33, 34, 34, 34, 34, 34, 34,
+	35, 35, 35, 35, 35, 35, 35, 35, 35, 35,
+	35, 35, 35, 41, 41, 41, 41, 41, 41, 36,
+	36, 36, 36, 36, 36, 36, 42, 42, 42, 46,
+	43, 43, 39, 39, 39, 39, 39, 39, 39, 39,
+	39, 39, 39, 39, 39, 39, 39, 39, 39, 39,
+	39, 39, 39, 39, 39, 39, 39, 39, 39, 55,
+	55, 55, 55, 48, 51, 51, 49, 49, 50, 52,
+	52, 47, 47, 47, 38, 38, 38, 38, 38, 38,
+	40, 40, 40, 53, 53, 54, 54, 56, 56, 57,
+	57, 58, 59, 59, 59, 60, 60, 60, 61, 61,
+	61, 62, 62, 63, 63, 64, 64, 37, 37, 44,
+	44, 45, 65, 65, 66, 67, 67, 69, 69, 70,
+	70, 68, 68, 71, 71, 71, 71, 71, 71, 72,
+	72, 73, 73, 74, 74, 76, 76, 79, 79, 75,
+	75, 86, 87, 83,
 }
 var yyR2 = [...]int{
 
 	0, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 12, 6, 3, 8, 8, 8, 7,
-	3, 5, 8, 4, 6, 7, 4, 5, 4, 5,
-	5, 3, 2, 2, 2, 0, 2, 0, 2, 1,
-	2, 2, 0, 1, 0, 1, 1, 3, 1, 2,
-	3, 5, 1, 1, 0, 1, 2, 1, 1, 0,
-	2, 1, 3, 1, 1, 3, 3, 3, 3, 5,
-	5, 3, 0, 1, 0, 1, 2, 1, 1, 1,
-	2, 2, 1, 2, 3, 2, 3, 2, 2, 1,
-	3, 0, 5, 5, 5, 1, 3, 0, 2, 1,
-	3, 3, 2, 3, 3, 1, 1, 3, 3, 4,
-	3, 4, 3, 4, 5, 6, 3, 2, 1, 2,
-	1, 2, 1, 2, 1, 1, 1, 1, 1, 1,
-	1, 3, 1, 1, 3, 1, 3, 1, 1, 1,
-	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 2, 2, 2, 3, 3, 4,
-	5, 3, 4, 1, 1, 1, 1, 1, 5, 0,
-	1, 1, 2, 4, 0, 2, 1, 3, 5, 1,
-	1, 1, 1, 1, 1, 1, 2, 2, 0, 3,
-	0, 2, 0, 3, 1, 3, 2, 0, 1, 1,
-	0, 2, 4, 0, 2, 4, 0, 3, 1, 3,
-	0, 5, 2, 1, 1, 3, 3, 1, 3, 3,
-	1, 1, 0, 2, 0, 3, 0, 1, 1, 1,
-	1, 1, 1, 1, 0, 1, 0, 1, 0, 2,
-	1, 1, 1, 1, 0,
+	1, 1, 1, 1, 12, 6, 3, 8, 8, 8,
+	7, 3, 5, 8, 4, 6, 7, 4, 5, 4,
+	5, 5, 3, 2, 2, 2, 2, 3, 4, 3,
+	0, 2, 0, 2, 1, 2, 2, 0, 1, 0,
+	1, 1, 3, 1, 2, 3, 5, 1, 1, 0,
+	1, 2, 1, 1, 0, 2, 1, 3, 1, 1,
+	3, 3, 3, 3, 5, 5, 3, 0, 1, 0,
+	1, 2, 1, 1, 1, 2, 2, 1, 2, 3,
+	2, 3, 2, 2, 1, 3, 0, 5, 5, 5,
+	1, 3, 0, 2, 1, 3, 3, 2, 3, 3,
+	1, 1, 3, 3, 4, 3, 4, 3, 4, 5,
+	6, 3, 2, 1, 2, 1, 2, 1, 2, 1,
+	1, 1, 1, 1, 1, 1, 3, 1, 1, 3,
+	1, 3, 1, 1, 1, 1, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 2,
+	2, 2, 3, 3, 4, 5, 3, 4, 1, 1,
+	1, 1, 1, 5, 0, 1, 1, 2, 4, 0,
+	2, 1, 3, 5, 1, 1, 1, 1, 1, 1,
+	1, 2, 2, 0, 3, 0, 2, 0, 3, 1,
+	3, 2, 0, 1, 1, 0, 2, 4, 0, 2,
+	4, 0, 3, 1, 3, 0, 5, 2, 1, 1,
+	3, 3, 1, 3, 3, 1, 1, 0, 2, 0,
+	3, 0, 1, 1, 1, 1, 1, 1, 1, 0,
+	1, 0, 1, 0, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 0,
 }
 var yyChk = [...]int{
 
-	-1000, -82, -1, -2, -3, -4, -5, -6, -7, -8,
-	-9, -10, -11, -12, 6, 7, 8, 9, 28, 96,
-	97, 99, 98, 100, 109, 110, 111, -15, 5, -13,
-	-83, -13, -13, -13, -13, 101, -72, 103, 107, -67,
-	103, 105, 101, 101, 102, 103, 101, -81, -81, -81,
-	-2, 18, 19, -16, 32, 19, -14, -67, -29, -77,
-	48, 10, -64, -65, -74, 48, -69, 106, 102, -74,
-	101, -74, -77, -68, 106, 48, -68, -77, -17, 35,
-	-39, -74, 51, 53, 55, 24, 28, 93, -29, 46,
-	68, -77, 61, 48, -81, -77, -81, 104, -77, 21,
-	44, -74, -18, -19, 85, -20, -77, -33, -38, 48,
-	-34, 61, -84, -37, -46, -44, -45, 83, 84, 90,
-	92, -74, -54, -47, 57, 58, 21, 45, 50, 49,
-	51, 52, 53, 56, 106, 112, 113, 88, 63, -66,
-	17, 10, 30, 30, -29, -64, -77, -32, 11, -65,
-	-38, -77, -84, -81, 21, -73, 108, -70, 99, 97,
-	27, 98, 14, 114, 48, -77, -77, -81, -21, 46,
-	10, -76, -75, 20, -74, 50, 93, 60, 59, 75,
-	-35, 78, 61, 76, 77, 62, 75, 80, 79, 89,
-	83, 84, 85, 86, 87, 88, 81, 82, 68, 69,
-	70, 71, 72, 73, 74, -33, -38, -33, -2, -42,
-	-38, 94, 95, -38, -38, -38, -38, -84, -84, -45,
-	-84, -50, -38, -29, -61, 28, -84, -32, -55, 14,
-	-33, 93, -38, 44, -74, -81, -71, 104, -32, -19,
-	-22, -23, -24, -25, -29, -45, -84, -75, 85, -77,
-	-74, -33, -33, -40, 56, 61, 57, 58, -38, -41,
-	-84, -45, 54, 78, 76, 77, 62, -38, -38, -38,
-	-40, -38, -38, -38, -38, -38, -38, -38, -38, -38,
-	-38, -38, -85, 47, -85, 46, -85, -37, -37, -74,
-	-85, -18, 19, -85, -18, -48, -49, 64, -36, 30,
-	-2, -64, -62, -74, -55, -59, 16, 15, -77, -77,
-	-77, -52, 12, 46, -26, -27, -28, 34, 38, 40,
-	35, 36, 37, 41, -79, -78, 20, -77, 50, -80,
-	20, -22, 93, 56, 57, 58, -42, -41, -38, -38,
-	-38, 60, -38, -85, -18, -85, -51, -49, 66, -33,
-	-63, 44, -43, -44, -84, -63, -85, 46, -59, -38,
-	-56, -57, -38, 93, -81, -53, 13, 15, -23, -24,
-	-23, -24, 34, 34, 34, 39, 34, 39, 34, -27,
-	-30, 42, 105, 43, -78, -77, -85, 85, -74, -85,
-	60, -38, -85, 67, -38, 65, 25, 46, -74, 46,
-	46, -58, 22, 23, -55, -33, -42, 44, 44, 34,
-	34, 102, 102, 102, -38, -38, 26, -44, -38, -57,
-	-59, -33, -33, -84, -84, -84, 8, -60, 17, 29,
-	-31, -74, -31, -31, -64, 8, 78, -85, 46, -85,
-	-85, -74, -74, -74,
+	-1000, -84, -1, -2, -3, -4, -5, -6, -7, -8,
+	-9, -10, -11, -12, -13, 6, 7, 8, 9, 28,
+	96, 97, 99, 98, 100, 109, 110, 111, 112, 113,
+	114, -16, 5, -14, -85, -14, -14, -14, -14, 101,
+	-73, 103, 107, -68, 103, 105, 101, 101, 102, 103,
+	101, -83, -83, -83, -76, 48, -75, 113, 112, 104,
+	112, -2, 18, 19, -17, 32, 19, -15, -68, -30,
+	-79, 48, -75, 10, -65, -66, -76, -70, 106, 102,
+	-76, 101, -76, -79, -69, 106, 48, -69, -79, -76,
+	112, -76, -18, 35, -40, -76, 51, 53, 55, 24,
+	28, 93, -30, 46, 68, -79, 61, 48, -83, -79,
+	-83, 104, -79, 21, 44, -76, -76, -19, -20, 85,
+	-21, -79, -34, -39, 48, -75, -35, 61, -86, -38,
+	-47, -45, -46, 83, 84, 90, 92, -76, -55, -48,
+	57, 58, 21, 45, 50, 49, 51, 52, 53, 56,
+	106, 115, 116, 88, 63, -67, 17, 10, 30, 30,
+	-30, -65, -79, -33, 11, -66, -39, -79, -86, -83,
+	21, -74, 108, -71, 99, 97, 27, 98, 14, 117,
+	48, -79, -79, -83, -22, 46, 10, -78, -77, 20,
+	-76, 50, 93, 60, 59, 75, -36, 78, 61, 76,
+	77, 62, 75, 80, 79, 89, 83, 84, 85, 86,
+	87, 88, 81, 82, 68, 69, 70, 71, 72, 73,
+	74, -34, -39, -34, -2, -43, -39, 94, 95, -39,
+	-39, -39, -39, -86, -86, -46, -86, -51, -39, -30,
+	-62, 28, -86, -33, -56, 14, -34, 93, -39, 44,
+	-76, -83, -72, 104, -33, -20, -23, -24, -25, -26,
+	-30, -46, -86, -77, 85, -79, -76, -34, -34, -41,
+	56, 61, 57, 58, -39, -42, -86, -46, 54, 78,
+	76, 77, 62, -39, -39, -39, -41, -39, -39, -39,
+	-39, -39, -39, -39, -39, -39, -39, -39, -87, 47,
+	-87, 46, -87, -38, -38, -76, -87, -19, 19, -87,
+	-19, -49, -50, 64, -37, 30, -2, -65, -63, -76,
+	-56, -60, 16, 15, -79, -79, -79, -53, 12, 46,
+	-27, -28, -29, 34, 38, 40, 35, 36, 37, 41,
+	-81, -80, 20, -79, 50, -82, 20, -23, 93, 56,
+	57, 58, -43, -42, -39, -39, -39, 60, -39, -87,
+	-19, -87, -52, -50, 66, -34, -64, 44, -44, -45,
+	-86, -64, -87, 46, -60, -39, -57, -58, -39, 93,
+	-83, -54, 13, 15, -24, -25, -24, -25, 34, 34,
+	34, 39, 34, 39, 34, -28, -31, 42, 105, 43,
+	-80, -79, -87, 85, -76, -87, 60, -39, -87, 67,
+	-39, 65, 25, 46, -76, 46, 46, -59, 22, 23,
+	-56, -34, -43, 44, 44, 34, 34, 102, 102, 102,
+	-39, -39, 26, -45, -39, -58, -60, -34, -34, -86,
+	-86, -86, 8, -61, 17, 29, -32, -76, -32, -32,
+	-65, 8, 78, -87, 46, -87, -87, -76, -76, -76,
 }
 var yyDef = [...]int{
 
 	0, -2, 1, 2, 3, 4, 5, 6, 7, 8,
-	9, 10, 11, 12, 35, 35, 35, 35, 35, 236,
-	226, 0, 0, 0, 244, 244, 244, 0, 39, 42,
-	37, 226, 0, 0, 0, 224, 0, 0, 237, 0,
-	0, 227, 0, 222, 0, 222, 0, 32, 33, 34,
-	15, 40, 41, 44, 0, 43, 36, 0, 0, 89,
-	241, 0, 20, 217, 0, 240, 0, 0, 0, 244,
-	0, 244, 0, 0, 0, 0, 0, 31, 0, 45,
-	0, 185, 0, 0, 38, 0, 0, 0, 97, 0,
-	0, 244, 0, 238, 23, 0, 26, 0, 28, 223,
-	0, 244, 59, 46, 48, 54, 0, 52, 53, -2,
-	99, 0, 0, 137, 138, 139, 140, 0, 0, 0,
-	0, 176, 0, 163, 105, 106, 0, 242, 179, 180,
-	181, 182, 183, 184, 164, 165, 166, 167, 169, 0,
-	220, 221, 186, 187, 206, 97, 90, 192, 0, 218,
-	219, 0, 0, 21, 225, 0, 0, 244, 234, 228,
-	229, 230, 231, 232, 233, 27, 29, 30, 97, 0,
-	0, 49, 55, 0, 57, 58, 0, 0, 0, 0,
+	9, 10, 11, 12, 13, 40, 40, 40, 40, 40,
+	241, 231, 0, 0, 0, 253, 253, 253, 0, 0,
+	0, 0, 44, 47, 42, 231, 0, 0, 0, 229,
+	0, 0, 242, 0, 0, 232, 0, 227, 0, 227,
+	0, 33, 34, 35, 36, 245, 246, 249, 250, 0,
+	0, 16, 45, 46, 49, 0, 48, 41, 0, 0,
+	94, 247, 248, 0, 21, 222, 0, 0, 0, 0,
+	253, 0, 253, 0, 0, 0, 0, 0, 32, 37,
+	250, 39, 0, 50, 0, 190, 0, 0, 43, 0,
+	0, 0, 102, 0, 0, 253, 0, 243, 24, 0,
+	27, 0, 29, 228, 0, 253, 38, 64, 51, 53,
+	59, 0, 57, 58, -2, -2, 104, 0, 0, 142,
+	143, 144, 145, 0, 0, 0, 0, 181, 0, 168,
+	110, 111, 0, 251, 184, 185, 186, 187, 188, 189,
+	169, 170, 171, 172, 174, 0, 225, 226, 191, 192,
+	211, 102, 95, 197, 0, 223, 224, 0, 0, 22,
+	230, 0, 0, 253, 239, 233, 234, 235, 236, 237,
+	238, 28, 30, 31, 102, 0, 0, 54, 60, 0,
+	62, 63, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 124, 125,
-	126, 127, 128, 129, 130, 102, 0, 0, 0, 0,
-	135, 0, 0, 154, 155, 156, 0, 0, 0, 117,
-	0, 0, 170, 14, 0, 0, 0, 192, 200, 0,
-	98, 0, 135, 0, 239, 24, 0, 235, 188, 47,
-	60, 61, 63, 64, 74, 72, 0, 56, 50, 0,
-	177, 100, 101, 104, 118, 0, 120, 122, 107, 108,
-	0, 132, 133, 0, 0, 0, 0, 110, 112, 0,
-	116, 141, 142, 143, 144, 145, 146, 147, 148, 149,
-	150, 151, 103, 243, 134, 0, 216, 152, 153, 157,
-	158, 0, 0, 161, 0, 174, 171, 0, 210, 0,
-	213, 210, 0, 208, 200, 19, 0, 0, 0, 244,
-	25, 190, 0, 0, 0, 0, 0, 79, 0, 0,
-	82, 0, 0, 0, 91, 75, 0, 77, 78, 0,
-	73, 0, 0, 119, 121, 123, 0, 109, 111, 113,
-	0, 0, 136, 159, 0, 162, 0, 172, 0, 0,
-	16, 0, 212, 214, 0, 17, 207, 0, 18, 201,
-	193, 194, 197, 0, 22, 192, 0, 0, 62, 68,
-	0, 71, 80, 81, 83, 0, 85, 0, 87, 88,
-	65, 0, 0, 0, 76, 66, 67, 51, 178, 131,
-	0, 114, 160, 168, 175, 0, 0, 0, 209, 0,
-	0, 196, 198, 199, 200, 191, 189, 0, 0, 84,
-	86, 0, 0, 0, 115, 173, 0, 215, 202, 195,
-	203, 69, 70, 0, 0, 0, 0, 13, 0, 0,
-	0, 95, 0, 0, 211, 204, 0, 92, 0, 93,
-	94, 0, 96, 205,
+	0, 0, 0, 0, 129, 130, 131, 132, 133, 134,
+	135, 107, 0, 0, 0, 0, 140, 0, 0, 159,
+	160, 161, 0, 0, 0, 122, 0, 0, 175, 15,
+	0, 0, 0, 197, 205, 0, 103, 0, 140, 0,
+	244, 25, 0, 240, 193, 52, 65, 66, 68, 69,
+	79, 77, 0, 61, 55, 0, 182, 105, 106, 109,
+	123, 0, 125, 127, 112, 113, 0, 137, 138, 0,
+	0, 0, 0, 115, 117, 0, 121, 146, 147, 148,
+	149, 150, 151, 152, 153, 154, 155, 156, 108, 252,
+	139, 0, 221, 157, 158, 162, 163, 0, 0, 166,
+	0, 179, 176, 0, 215, 0, 218, 215, 0, 213,
+	205, 20, 0, 0, 0, 253, 26, 195, 0, 0,
+	0, 0, 0, 84, 0, 0, 87, 0, 0, 0,
+	96, 80, 0, 82, 83, 0, 78, 0, 0, 124,
+	126, 128, 0, 114, 116, 118, 0, 0, 141, 164,
+	0, 167, 0, 177, 0, 0, 17, 0, 217, 219,
+	0, 18, 212, 0, 19, 206, 198, 199, 202, 0,
+	23, 197, 0, 0, 67, 73, 0, 76, 85, 86,
+	88, 0, 90, 0, 92, 93, 70, 0, 0, 0,
+	81, 71, 72, 56, 183, 136, 0, 119, 165, 173,
+	180, 0, 0, 0, 214, 0, 0, 201, 203, 204,
+	205, 196, 194, 0, 0, 89, 91, 0, 0, 0,
+	120, 178, 0, 220, 207, 200, 208, 74, 75, 0,
+	0, 0, 0, 14, 0, 0, 0, 100, 0, 0,
+	216, 209, 0, 97, 0, 98, 99, 0, 101, 210,
 }
 var yyTok1 = [...]int{
 
@@ -645,7 +672,7 @@ var yyTok2 = [...]int{
 	65, 66, 67, 71, 72, 73, 74, 75, 76, 77,
 	78, 81, 82, 88, 91, 92, 94, 95, 96, 97,
 	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
-	108, 109, 110, 111, 112, 113, 114,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
 }
 var yyTok3 = [...]int{
 	0,
@@ -990,43 +1017,43 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:179
+		//line sql.y:185
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:185
+		//line sql.y:191
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
-	case 13:
+	case 14:
 		yyDollar = yyS[yypt-12 : yypt+1]
-		//line sql.y:201
+		//line sql.y:208
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Distinct: yyDollar[3].str, Hints: yyDollar[4].str, SelectExprs: yyDollar[5].selectExprs, From: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].boolExpr), GroupBy: GroupBy(yyDollar[8].valExprs), Having: NewWhere(HavingStr, yyDollar[9].boolExpr), OrderBy: yyDollar[10].orderBy, Limit: yyDollar[11].limit, Lock: yyDollar[12].str}
 		}
-	case 14:
+	case 15:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:205
+		//line sql.y:212
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), SelectExprs: SelectExprs{Nextval{Expr: yyDollar[4].valExpr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[6].tableName}}}
 		}
-	case 15:
+	case 16:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:209
+		//line sql.y:216
 		{
 			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt}
 		}
-	case 16:
+	case 17:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:215
+		//line sql.y:222
 		{
 			yyVAL.statement = &Insert{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[5].tableName, Columns: yyDollar[6].columns, Rows: yyDollar[7].insRows, OnDup: OnDup(yyDollar[8].updateExprs)}
 		}
-	case 17:
+	case 18:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:219
+		//line sql.y:226
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[7].updateExprs))
@@ -1036,71 +1063,71 @@ yydefault:
 			}
 			yyVAL.statement = &Insert{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[5].tableName, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
 		}
-	case 18:
+	case 19:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:231
+		//line sql.y:238
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Table: yyDollar[3].tableName, Exprs: yyDollar[5].updateExprs, Where: NewWhere(WhereStr, yyDollar[6].boolExpr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
-	case 19:
+	case 20:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:237
+		//line sql.y:244
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Table: yyDollar[4].tableName, Where: NewWhere(WhereStr, yyDollar[5].boolExpr), OrderBy: yyDollar[6].orderBy, Limit: yyDollar[7].limit}
 		}
-	case 20:
+	case 21:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:243
+		//line sql.y:250
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].updateExprs}
 		}
-	case 21:
+	case 22:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:249
+		//line sql.y:256
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, NewName: yyDollar[4].tableIdent}
 		}
-	case 22:
+	case 23:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:253
+		//line sql.y:260
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[7].tableIdent, NewName: yyDollar[7].tableIdent}
 		}
-	case 23:
+	case 24:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:258
+		//line sql.y:265
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, NewName: NewTableIdent(yyDollar[3].colIdent.Lowered())}
 		}
-	case 24:
+	case 25:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:264
+		//line sql.y:271
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableIdent, NewName: yyDollar[4].tableIdent}
 		}
-	case 25:
+	case 26:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:268
+		//line sql.y:275
 		{
 			// Change this to a rename statement
 			yyVAL.statement = &DDL{Action: RenameStr, Table: yyDollar[4].tableIdent, NewName: yyDollar[7].tableIdent}
 		}
-	case 26:
+	case 27:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:273
+		//line sql.y:280
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: NewTableIdent(yyDollar[3].colIdent.Lowered()), NewName: NewTableIdent(yyDollar[3].colIdent.Lowered())}
 		}
-	case 27:
+	case 28:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:279
+		//line sql.y:286
 		{
 			yyVAL.statement = &DDL{Action: RenameStr, Table: yyDollar[3].tableIdent, NewName: yyDollar[5].tableIdent}
 		}
-	case 28:
+	case 29:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:285
+		//line sql.y:292
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -1108,16 +1135,16 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, Table: yyDollar[4].tableIdent, IfExists: exists}
 		}
-	case 29:
+	case 30:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:293
+		//line sql.y:300
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableIdent, NewName: yyDollar[5].tableIdent}
 		}
-	case 30:
+	case 31:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:298
+		//line sql.y:305
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -1125,328 +1152,352 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, Table: NewTableIdent(yyDollar[4].colIdent.Lowered()), IfExists: exists}
 		}
-	case 31:
+	case 32:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:308
+		//line sql.y:315
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableIdent, NewName: yyDollar[3].tableIdent}
 		}
-	case 32:
+	case 33:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:314
+		//line sql.y:321
 		{
 			yyVAL.statement = &Other{}
 		}
-	case 33:
+	case 34:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:318
+		//line sql.y:325
 		{
 			yyVAL.statement = &Other{}
 		}
-	case 34:
+	case 35:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:322
+		//line sql.y:329
 		{
 			yyVAL.statement = &Other{}
 		}
-	case 35:
+	case 36:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:335
+		{
+			yyVAL.statement = &Savepoint{Action: SavepointStr, Name: yyDollar[2].colIdent}
+		}
+	case 37:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:339
+		{
+			yyVAL.statement = &Savepoint{Action: RollbackToStr, Name: yyDollar[3].colIdent}
+		}
+	case 38:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line sql.y:343
+		{
+			yyVAL.statement = &Savepoint{Action: RollbackToStr, Name: yyDollar[4].colIdent}
+		}
+	case 39:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:347
+		{
+			yyVAL.statement = &Savepoint{Action: ReleaseStr, Name: yyDollar[3].colIdent}
+		}
+	case 40:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:327
+		//line sql.y:352
 		{
 			setAllowComments(yylex, true)
 		}
-	case 36:
+	case 41:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:331
+		//line sql.y:356
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 37:
+	case 42:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:337
+		//line sql.y:362
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 38:
+	case 43:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:341
+		//line sql.y:366
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 39:
+	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:347
+		//line sql.y:372
 		{
 			yyVAL.str = UnionStr
 		}
-	case 40:
+	case 45:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:351
+		//line sql.y:376
 		{
 			yyVAL.str = UnionAllStr
 		}
-	case 41:
+	case 46:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:355
+		//line sql.y:380
 		{
 			yyVAL.str = UnionDistinctStr
 		}
-	case 42:
+	case 47:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:360
+		//line sql.y:385
 		{
 			yyVAL.str = ""
 		}
-	case 43:
+	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:364
+		//line sql.y:389
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 44:
+	case 49:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:369
+		//line sql.y:394
 		{
 			yyVAL.str = ""
 		}
-	case 45:
+	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:373
+		//line sql.y:398
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 46:
+	case 51:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:379
+		//line sql.y:404
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 47:
+	case 52:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:383
+		//line sql.y:408
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 48:
+	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:389
+		//line sql.y:414
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 49:
+	case 54:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:393
+		//line sql.y:418
 		{
 			yyVAL.selectExpr = &NonStarExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 50:
+	case 55:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:397
+		//line sql.y:422
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: &TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 51:
+	case 56:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:401
+		//line sql.y:426
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: &TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 52:
+	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:407
+		//line sql.y:432
 		{
 			yyVAL.expr = yyDollar[1].boolExpr
 		}
-	case 53:
+	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:411
+		//line sql.y:436
 		{
 			yyVAL.expr = yyDollar[1].valExpr
 		}
-	case 54:
+	case 59:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:416
+		//line sql.y:441
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 55:
+	case 60:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:420
+		//line sql.y:445
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 56:
+	case 61:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:424
+		//line sql.y:449
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 58:
+	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:431
+		//line sql.y:456
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 59:
+	case 64:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:436
+		//line sql.y:461
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: &TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 60:
+	case 65:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:440
+		//line sql.y:465
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 61:
+	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:446
+		//line sql.y:471
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 62:
+	case 67:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:450
+		//line sql.y:475
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 65:
+	case 70:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:460
+		//line sql.y:485
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
 		}
-	case 66:
+	case 71:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:464
+		//line sql.y:489
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
 		}
-	case 67:
+	case 72:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:468
+		//line sql.y:493
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 68:
+	case 73:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:481
+		//line sql.y:506
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 69:
+	case 74:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:485
+		//line sql.y:510
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, On: yyDollar[5].boolExpr}
 		}
-	case 70:
+	case 75:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:489
+		//line sql.y:514
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, On: yyDollar[5].boolExpr}
 		}
-	case 71:
+	case 76:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:493
+		//line sql.y:518
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 72:
+	case 77:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:498
+		//line sql.y:523
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 73:
+	case 78:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:500
+		//line sql.y:525
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 74:
+	case 79:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:503
+		//line sql.y:528
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 75:
+	case 80:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:507
+		//line sql.y:532
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 76:
+	case 81:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:511
+		//line sql.y:536
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 78:
+	case 83:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:518
+		//line sql.y:543
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 79:
+	case 84:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:524
+		//line sql.y:549
 		{
 			yyVAL.str = JoinStr
 		}
-	case 80:
+	case 85:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:528
+		//line sql.y:553
 		{
 			yyVAL.str = JoinStr
 		}
-	case 81:
+	case 86:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:532
+		//line sql.y:557
 		{
 			yyVAL.str = JoinStr
 		}
-	case 82:
+	case 87:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:536
+		//line sql.y:561
 		{
 			yyVAL.str = StraightJoinStr
 		}
-	case 83:
+	case 88:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:542
+		//line sql.y:567
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 84:
+	case 89:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:546
+		//line sql.y:571
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 85:
+	case 90:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:550
+		//line sql.y:575
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 86:
+	case 91:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:554
+		//line sql.y:579
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 87:
+	case 92:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:560
+		//line sql.y:585
 		{
 			yyVAL.str = NaturalJoinStr
 		}
-	case 88:
+	case 93:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:564
+		//line sql.y:589
 		{
 			if yyDollar[2].str == LeftJoinStr {
 				yyVAL.str = NaturalLeftJoinStr
@@ -1454,393 +1505,393 @@ yydefault:
 				yyVAL.str = NaturalRightJoinStr
 			}
 		}
-	case 89:
+	case 94:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:574
+		//line sql.y:599
 		{
 			yyVAL.tableName = &TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 90:
+	case 95:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:578
+		//line sql.y:603
 		{
 			yyVAL.tableName = &TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 91:
+	case 96:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:583
+		//line sql.y:608
 		{
 			yyVAL.indexHints = nil
 		}
-	case 92:
+	case 97:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:587
+		//line sql.y:612
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].colIdents}
 		}
-	case 93:
+	case 98:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:591
+		//line sql.y:616
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].colIdents}
 		}
-	case 94:
+	case 99:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:595
+		//line sql.y:620
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].colIdents}
 		}
-	case 95:
+	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:601
+		//line sql.y:626
 		{
 			yyVAL.colIdents = []ColIdent{yyDollar[1].colIdent}
 		}
-	case 96:
+	case 101:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:605
+		//line sql.y:630
 		{
 			yyVAL.colIdents = append(yyDollar[1].colIdents, yyDollar[3].colIdent)
 		}
-	case 97:
+	case 102:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:610
+		//line sql.y:635
 		{
 			yyVAL.boolExpr = nil
 		}
-	case 98:
+	case 103:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:614
+		//line sql.y:639
 		{
 			yyVAL.boolExpr = yyDollar[2].boolExpr
 		}
-	case 100:
+	case 105:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:621
+		//line sql.y:646
 		{
 			yyVAL.boolExpr = &AndExpr{Left: yyDollar[1].boolExpr, Right: yyDollar[3].boolExpr}
 		}
-	case 101:
+	case 106:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:625
+		//line sql.y:650
 		{
 			yyVAL.boolExpr = &OrExpr{Left: yyDollar[1].boolExpr, Right: yyDollar[3].boolExpr}
 		}
-	case 102:
+	case 107:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:629
+		//line sql.y:654
 		{
 			yyVAL.boolExpr = &NotExpr{Expr: yyDollar[2].boolExpr}
 		}
-	case 103:
+	case 108:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:633
+		//line sql.y:658
 		{
 			yyVAL.boolExpr = &ParenBoolExpr{Expr: yyDollar[2].boolExpr}
 		}
-	case 104:
+	case 109:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:637
+		//line sql.y:662
 		{
 			yyVAL.boolExpr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].boolExpr}
 		}
-	case 105:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:643
+		//line sql.y:668
 		{
 			yyVAL.boolExpr = BoolVal(true)
 		}
-	case 106:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:647
+		//line sql.y:672
 		{
 			yyVAL.boolExpr = BoolVal(false)
 		}
-	case 107:
+	case 112:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:651
+		//line sql.y:676
 		{
 			yyVAL.boolExpr = &ComparisonExpr{Left: yyDollar[1].valExpr, Operator: yyDollar[2].str, Right: yyDollar[3].valExpr}
 		}
-	case 108:
+	case 113:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:655
+		//line sql.y:680
 		{
 			yyVAL.boolExpr = &ComparisonExpr{Left: yyDollar[1].valExpr, Operator: InStr, Right: yyDollar[3].colTuple}
 		}
-	case 109:
+	case 114:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:659
+		//line sql.y:684
 		{
 			yyVAL.boolExpr = &ComparisonExpr{Left: yyDollar[1].valExpr, Operator: NotInStr, Right: yyDollar[4].colTuple}
 		}
-	case 110:
+	case 115:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:663
+		//line sql.y:688
 		{
 			yyVAL.boolExpr = &ComparisonExpr{Left: yyDollar[1].valExpr, Operator: LikeStr, Right: yyDollar[3].valExpr}
 		}
-	case 111:
+	case 116:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:667
+		//line sql.y:692
 		{
 			yyVAL.boolExpr = &ComparisonExpr{Left: yyDollar[1].valExpr, Operator: NotLikeStr, Right: yyDollar[4].valExpr}
 		}
-	case 112:
+	case 117:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:671
+		//line sql.y:696
 		{
 			yyVAL.boolExpr = &ComparisonExpr{Left: yyDollar[1].valExpr, Operator: RegexpStr, Right: yyDollar[3].valExpr}
 		}
-	case 113:
+	case 118:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:675
+		//line sql.y:700
 		{
 			yyVAL.boolExpr = &ComparisonExpr{Left: yyDollar[1].valExpr, Operator: NotRegexpStr, Right: yyDollar[4].valExpr}
 		}
-	case 114:
+	case 119:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:679
+		//line sql.y:704
 		{
 			yyVAL.boolExpr = &RangeCond{Left: yyDollar[1].valExpr, Operator: BetweenStr, From: yyDollar[3].valExpr, To: yyDollar[5].valExpr}
 		}
-	case 115:
+	case 120:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:683
+		//line sql.y:708
 		{
 			yyVAL.boolExpr = &RangeCond{Left: yyDollar[1].valExpr, Operator: NotBetweenStr, From: yyDollar[4].valExpr, To: yyDollar[6].valExpr}
 		}
-	case 116:
+	case 121:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:687
+		//line sql.y:712
 		{
 			yyVAL.boolExpr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].valExpr}
 		}
-	case 117:
+	case 122:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:691
+		//line sql.y:716
 		{
 			yyVAL.boolExpr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 118:
+	case 123:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:697
+		//line sql.y:722
 		{
 			yyVAL.str = IsNullStr
 		}
-	case 119:
+	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:701
+		//line sql.y:726
 		{
 			yyVAL.str = IsNotNullStr
 		}
-	case 120:
+	case 125:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:705
+		//line sql.y:730
 		{
 			yyVAL.str = IsTrueStr
 		}
-	case 121:
+	case 126:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:709
+		//line sql.y:734
 		{
 			yyVAL.str = IsNotTrueStr
 		}
-	case 122:
+	case 127:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:713
+		//line sql.y:738
 		{
 			yyVAL.str = IsFalseStr
 		}
-	case 123:
+	case 128:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:717
+		//line sql.y:742
 		{
 			yyVAL.str = IsNotFalseStr
 		}
-	case 124:
+	case 129:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:723
+		//line sql.y:748
 		{
 			yyVAL.str = EqualStr
 		}
-	case 125:
+	case 130:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:727
+		//line sql.y:752
 		{
 			yyVAL.str = LessThanStr
 		}
-	case 126:
+	case 131:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:731
+		//line sql.y:756
 		{
 			yyVAL.str = GreaterThanStr
 		}
-	case 127:
+	case 132:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:735
+		//line sql.y:760
 		{
 			yyVAL.str = LessEqualStr
 		}
-	case 128:
+	case 133:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:739
+		//line sql.y:764
 		{
 			yyVAL.str = GreaterEqualStr
 		}
-	case 129:
+	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:743
+		//line sql.y:768
 		{
 			yyVAL.str = NotEqualStr
 		}
-	case 130:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:747
+		//line sql.y:772
 		{
 			yyVAL.str = NullSafeEqualStr
 		}
-	case 131:
+	case 136:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:753
+		//line sql.y:778
 		{
 			yyVAL.colTuple = ValTuple(yyDollar[2].valExprs)
 		}
-	case 132:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:757
+		//line sql.y:782
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 133:
+	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:761
+		//line sql.y:786
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 134:
+	case 139:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:767
+		//line sql.y:792
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 135:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:773
+		//line sql.y:798
 		{
 			yyVAL.valExprs = ValExprs{yyDollar[1].valExpr}
 		}
-	case 136:
+	case 141:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:777
+		//line sql.y:802
 		{
 			yyVAL.valExprs = append(yyDollar[1].valExprs, yyDollar[3].valExpr)
 		}
-	case 137:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:783
+		//line sql.y:808
 		{
 			yyVAL.valExpr = yyDollar[1].valExpr
 		}
-	case 138:
+	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:787
+		//line sql.y:812
 		{
 			yyVAL.valExpr = yyDollar[1].colName
 		}
-	case 139:
+	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:791
+		//line sql.y:816
 		{
 			yyVAL.valExpr = yyDollar[1].valTuple
 		}
-	case 140:
+	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:795
+		//line sql.y:820
 		{
 			yyVAL.valExpr = yyDollar[1].subquery
 		}
-	case 141:
+	case 146:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:799
+		//line sql.y:824
 		{
 			yyVAL.valExpr = &BinaryExpr{Left: yyDollar[1].valExpr, Operator: BitAndStr, Right: yyDollar[3].valExpr}
 		}
-	case 142:
+	case 147:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:803
+		//line sql.y:828
 		{
 			yyVAL.valExpr = &BinaryExpr{Left: yyDollar[1].valExpr, Operator: BitOrStr, Right: yyDollar[3].valExpr}
 		}
-	case 143:
+	case 148:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:807
+		//line sql.y:832
 		{
 			yyVAL.valExpr = &BinaryExpr{Left: yyDollar[1].valExpr, Operator: BitXorStr, Right: yyDollar[3].valExpr}
 		}
-	case 144:
+	case 149:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:811
+		//line sql.y:836
 		{
 			yyVAL.valExpr = &BinaryExpr{Left: yyDollar[1].valExpr, Operator: PlusStr, Right: yyDollar[3].valExpr}
 		}
-	case 145:
+	case 150:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:815
+		//line sql.y:840
 		{
 			yyVAL.valExpr = &BinaryExpr{Left: yyDollar[1].valExpr, Operator: MinusStr, Right: yyDollar[3].valExpr}
 		}
-	case 146:
+	case 151:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:819
+		//line sql.y:844
 		{
 			yyVAL.valExpr = &BinaryExpr{Left: yyDollar[1].valExpr, Operator: MultStr, Right: yyDollar[3].valExpr}
 		}
-	case 147:
+	case 152:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:823
+		//line sql.y:848
 		{
 			yyVAL.valExpr = &BinaryExpr{Left: yyDollar[1].valExpr, Operator: DivStr, Right: yyDollar[3].valExpr}
 		}
-	case 148:
+	case 153:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:827
+		//line sql.y:852
 		{
 			yyVAL.valExpr = &BinaryExpr{Left: yyDollar[1].valExpr, Operator: ModStr, Right: yyDollar[3].valExpr}
 		}
-	case 149:
+	case 154:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:831
+		//line sql.y:856
 		{
 			yyVAL.valExpr = &BinaryExpr{Left: yyDollar[1].valExpr, Operator: ModStr, Right: yyDollar[3].valExpr}
 		}
-	case 150:
+	case 155:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:835
+		//line sql.y:860
 		{
 			yyVAL.valExpr = &BinaryExpr{Left: yyDollar[1].valExpr, Operator: ShiftLeftStr, Right: yyDollar[3].valExpr}
 		}
-	case 151:
+	case 156:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:839
+		//line sql.y:864
 		{
 			yyVAL.valExpr = &BinaryExpr{Left: yyDollar[1].valExpr, Operator: ShiftRightStr, Right: yyDollar[3].valExpr}
 		}
-	case 152:
+	case 157:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:843
+		//line sql.y:868
 		{
 			yyVAL.valExpr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].valExpr}
 		}
-	case 153:
+	case 158:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:847
+		//line sql.y:872
 		{
 			yyVAL.valExpr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].valExpr}
 		}
-	case 154:
+	case 159:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:851
+		//line sql.y:876
 		{
 			if num, ok := yyDollar[2].valExpr.(NumVal); ok {
 				yyVAL.valExpr = num
@@ -1848,9 +1899,9 @@ yydefault:
 				yyVAL.valExpr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].valExpr}
 			}
 		}
-	case 155:
+	case 160:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:859
+		//line sql.y:884
 		{
 			if num, ok := yyDollar[2].valExpr.(NumVal); ok {
 				// Handle double negative
@@ -1863,15 +1914,15 @@ yydefault:
 				yyVAL.valExpr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].valExpr}
 			}
 		}
-	case 156:
+	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:872
+		//line sql.y:897
 		{
 			yyVAL.valExpr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].valExpr}
 		}
-	case 157:
+	case 162:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:876
+		//line sql.y:901
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -1879,171 +1930,171 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.valExpr = &IntervalExpr{Expr: yyDollar[2].valExpr, Unit: yyDollar[3].colIdent}
 		}
-	case 158:
+	case 163:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:884
+		//line sql.y:909
 		{
 			yyVAL.valExpr = &FuncExpr{Name: yyDollar[1].colIdent}
 		}
-	case 159:
+	case 164:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:888
+		//line sql.y:913
 		{
 			yyVAL.valExpr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
 		}
-	case 160:
+	case 165:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:892
+		//line sql.y:917
 		{
 			yyVAL.valExpr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 161:
+	case 166:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:896
+		//line sql.y:921
 		{
 			yyVAL.valExpr = &FuncExpr{Name: yyDollar[1].colIdent}
 		}
-	case 162:
+	case 167:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:900
+		//line sql.y:925
 		{
 			yyVAL.valExpr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
 		}
-	case 163:
+	case 168:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:904
+		//line sql.y:929
 		{
 			yyVAL.valExpr = yyDollar[1].caseExpr
 		}
-	case 164:
+	case 169:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:910
+		//line sql.y:935
 		{
 			yyVAL.colIdent = NewColIdent("if")
 		}
-	case 165:
+	case 170:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:914
+		//line sql.y:939
 		{
 			yyVAL.colIdent = NewColIdent("current_timestamp")
 		}
-	case 166:
+	case 171:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:918
+		//line sql.y:943
 		{
 			yyVAL.colIdent = NewColIdent("database")
 		}
-	case 167:
+	case 172:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:922
+		//line sql.y:947
 		{
 			yyVAL.colIdent = NewColIdent("mod")
 		}
-	case 168:
+	case 173:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:928
+		//line sql.y:953
 		{
 			yyVAL.caseExpr = &CaseExpr{Expr: yyDollar[2].valExpr, Whens: yyDollar[3].whens, Else: yyDollar[4].valExpr}
 		}
-	case 169:
+	case 174:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:933
+		//line sql.y:958
 		{
 			yyVAL.valExpr = nil
 		}
-	case 170:
+	case 175:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:937
+		//line sql.y:962
 		{
 			yyVAL.valExpr = yyDollar[1].valExpr
 		}
-	case 171:
+	case 176:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:943
+		//line sql.y:968
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 172:
+	case 177:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:947
+		//line sql.y:972
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 173:
+	case 178:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:953
+		//line sql.y:978
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].boolExpr, Val: yyDollar[4].valExpr}
 		}
-	case 174:
+	case 179:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:958
+		//line sql.y:983
 		{
 			yyVAL.valExpr = nil
 		}
-	case 175:
+	case 180:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:962
+		//line sql.y:987
 		{
 			yyVAL.valExpr = yyDollar[2].valExpr
 		}
-	case 176:
+	case 181:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:968
+		//line sql.y:993
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 177:
+	case 182:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:972
+		//line sql.y:997
 		{
 			yyVAL.colName = &ColName{Qualifier: &TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 178:
+	case 183:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:976
+		//line sql.y:1001
 		{
 			yyVAL.colName = &ColName{Qualifier: &TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 179:
+	case 184:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:982
+		//line sql.y:1007
 		{
 			yyVAL.valExpr = StrVal(yyDollar[1].bytes)
 		}
-	case 180:
+	case 185:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:986
+		//line sql.y:1011
 		{
 			yyVAL.valExpr = HexVal(yyDollar[1].bytes)
 		}
-	case 181:
+	case 186:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:990
+		//line sql.y:1015
 		{
 			yyVAL.valExpr = NumVal(yyDollar[1].bytes)
 		}
-	case 182:
+	case 187:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:994
+		//line sql.y:1019
 		{
 			yyVAL.valExpr = HexNum(yyDollar[1].bytes)
 		}
-	case 183:
+	case 188:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:998
+		//line sql.y:1023
 		{
 			yyVAL.valExpr = ValArg(yyDollar[1].bytes)
 		}
-	case 184:
+	case 189:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1002
+		//line sql.y:1027
 		{
 			yyVAL.valExpr = &NullVal{}
 		}
-	case 185:
+	case 190:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1008
+		//line sql.y:1033
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -2052,123 +2103,123 @@ yydefault:
 			}
 			yyVAL.valExpr = NumVal("1")
 		}
-	case 186:
+	case 191:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1017
+		//line sql.y:1042
 		{
 			yyVAL.valExpr = NumVal(yyDollar[1].bytes)
 		}
-	case 187:
+	case 192:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1021
+		//line sql.y:1046
 		{
 			yyVAL.valExpr = ValArg(yyDollar[1].bytes)
 		}
-	case 188:
+	case 193:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1026
+		//line sql.y:1051
 		{
 			yyVAL.valExprs = nil
 		}
-	case 189:
+	case 194:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1030
+		//line sql.y:1055
 		{
 			yyVAL.valExprs = yyDollar[3].valExprs
 		}
-	case 190:
+	case 195:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1035
+		//line sql.y:1060
 		{
 			yyVAL.boolExpr = nil
 		}
-	case 191:
+	case 196:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1039
+		//line sql.y:1064
 		{
 			yyVAL.boolExpr = yyDollar[2].boolExpr
 		}
-	case 192:
+	case 197:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1044
+		//line sql.y:1069
 		{
 			yyVAL.orderBy = nil
 		}
-	case 193:
+	case 198:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1048
+		//line sql.y:1073
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 194:
+	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1054
+		//line sql.y:1079
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 195:
+	case 200:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1058
+		//line sql.y:1083
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 196:
+	case 201:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1064
+		//line sql.y:1089
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].valExpr, Direction: yyDollar[2].str}
 		}
-	case 197:
+	case 202:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1069
+		//line sql.y:1094
 		{
 			yyVAL.str = AscScr
 		}
-	case 198:
+	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1073
+		//line sql.y:1098
 		{
 			yyVAL.str = AscScr
 		}
-	case 199:
+	case 204:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1077
+		//line sql.y:1102
 		{
 			yyVAL.str = DescScr
 		}
-	case 200:
+	case 205:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1082
+		//line sql.y:1107
 		{
 			yyVAL.limit = nil
 		}
-	case 201:
+	case 206:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1086
+		//line sql.y:1111
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].valExpr}
 		}
-	case 202:
+	case 207:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1090
+		//line sql.y:1115
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].valExpr, Rowcount: yyDollar[4].valExpr}
 		}
-	case 203:
+	case 208:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1095
+		//line sql.y:1120
 		{
 			yyVAL.str = ""
 		}
-	case 204:
+	case 209:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1099
+		//line sql.y:1124
 		{
 			yyVAL.str = ForUpdateStr
 		}
-	case 205:
+	case 210:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1103
+		//line sql.y:1128
 		{
 			if yyDollar[3].colIdent.Lowered() != "share" {
 				yylex.Error("expecting share")
@@ -2180,228 +2231,240 @@ yydefault:
 			}
 			yyVAL.str = ShareModeStr
 		}
-	case 206:
+	case 211:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1116
+		//line sql.y:1141
 		{
 			yyVAL.columns = nil
 		}
-	case 207:
+	case 212:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1120
+		//line sql.y:1145
 		{
 			yyVAL.columns = yyDollar[2].columns
 		}
-	case 208:
+	case 213:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1126
+		//line sql.y:1151
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 209:
+	case 214:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1130
+		//line sql.y:1155
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 210:
+	case 215:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1135
+		//line sql.y:1160
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 211:
+	case 216:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1139
+		//line sql.y:1164
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 212:
+	case 217:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1145
+		//line sql.y:1170
 		{
 			yyVAL.insRows = yyDollar[2].values
 		}
-	case 213:
+	case 218:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1149
+		//line sql.y:1174
 		{
 			yyVAL.insRows = yyDollar[1].selStmt
 		}
-	case 214:
+	case 219:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1155
+		//line sql.y:1180
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 215:
+	case 220:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1159
+		//line sql.y:1184
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 216:
+	case 221:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1165
+		//line sql.y:1190
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].valExprs)
 		}
-	case 217:
+	case 222:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1171
+		//line sql.y:1196
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 218:
+	case 223:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1175
+		//line sql.y:1200
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 219:
+	case 224:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1181
+		//line sql.y:1206
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colIdent, Expr: yyDollar[3].valExpr}
 		}
-	case 222:
+	case 227:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1190
+		//line sql.y:1215
 		{
 			yyVAL.byt = 0
 		}
-	case 223:
+	case 228:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1192
+		//line sql.y:1217
 		{
 			yyVAL.byt = 1
 		}
-	case 224:
+	case 229:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1195
+		//line sql.y:1220
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 225:
+	case 230:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1197
+		//line sql.y:1222
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 226:
+	case 231:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1200
+		//line sql.y:1225
 		{
 			yyVAL.str = ""
 		}
-	case 227:
+	case 232:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1202
+		//line sql.y:1227
 		{
 			yyVAL.str = IgnoreStr
 		}
-	case 228:
+	case 233:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1206
+		//line sql.y:1231
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 229:
+	case 234:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1208
+		//line sql.y:1233
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 230:
+	case 235:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1210
+		//line sql.y:1235
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 231:
+	case 236:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1212
+		//line sql.y:1237
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 232:
+	case 237:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1214
+		//line sql.y:1239
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 233:
+	case 238:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1216
+		//line sql.y:1241
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 234:
+	case 239:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1219
+		//line sql.y:1244
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 235:
+	case 240:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1221
+		//line sql.y:1246
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 236:
+	case 241:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1224
+		//line sql.y:1249
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 237:
+	case 242:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1226
+		//line sql.y:1251
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 238:
+	case 243:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1229
+		//line sql.y:1254
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 239:
+	case 244:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1231
+		//line sql.y:1256
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 240:
+	case 245:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1235
+		//line sql.y:1260
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 241:
+	case 246:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1241
+		//line sql.y:1264
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 247:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1270
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 242:
+	case 248:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:1274
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 251:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1247
+		//line sql.y:1286
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 243:
+	case 252:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1256
+		//line sql.y:1295
 		{
 			decNesting(yylex)
 		}
-	case 244:
+	case 253:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1261
+		//line sql.y:1300
 		{
 			forceEOF(yylex)
 		}
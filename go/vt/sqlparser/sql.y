@@ -110,6 +110,11 @@ func forceEOF(yylex interface{}) {
 %token <empty> TABLE INDEX VIEW TO IGNORE IF UNIQUE USING
 %token <empty> SHOW DESCRIBE EXPLAIN
 
+// Savepoint Tokens
+// SAVEPOINT and ROLLBACK are not reserved, so they carry their text.
+%token <bytes> SAVEPOINT ROLLBACK
+%token <empty> RELEASE
+
 // Functions
 %token <empty> CURRENT_TIMESTAMP DATABASE
 
@@ -120,7 +125,7 @@ func forceEOF(yylex interface{}) {
 %type <selStmt> select_statement
 %type <statement> insert_statement update_statement delete_statement set_statement
 %type <statement> create_statement alter_statement rename_statement drop_statement
-%type <statement> analyze_statement other_statement
+%type <statement> analyze_statement other_statement savepoint_statement
 %type <bytes2> comment_opt comment_list
 %type <str> union_op
 %type <str> distinct_opt straight_join_opt
@@ -165,6 +170,7 @@ func forceEOF(yylex interface{}) {
 %type <str> ignore_opt
 %type <byt> exists_opt
 %type <empty> not_exists_opt non_rename_operation to_opt constraint_opt using_opt
+%type <bytes> non_reserved_keyword
 %type <colIdent> sql_id col_alias as_ci_opt
 %type <tableIdent> table_id table_alias as_opt_id
 %type <empty> as_opt
@@ -195,6 +201,7 @@ command:
 | drop_statement
 | analyze_statement
 | other_statement
+| savepoint_statement
 
 select_statement:
   SELECT comment_opt distinct_opt straight_join_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt order_by_opt limit_opt lock_opt
@@ -323,6 +330,24 @@ other_statement:
     $$ = &Other{}
   }
 
+savepoint_statement:
+  SAVEPOINT sql_id
+  {
+    $$ = &Savepoint{Action: SavepointStr, Name: $2}
+  }
+| ROLLBACK TO sql_id
+  {
+    $$ = &Savepoint{Action: RollbackToStr, Name: $3}
+  }
+| ROLLBACK TO SAVEPOINT sql_id
+  {
+    $$ = &Savepoint{Action: RollbackToStr, Name: $4}
+  }
+| RELEASE SAVEPOINT sql_id
+  {
+    $$ = &Savepoint{Action: ReleaseStr, Name: $3}
+  }
+
 comment_opt:
   {
     setAllowComments(yylex, true)
@@ -1235,12 +1260,26 @@ sql_id:
   {
     $$ = NewColIdent(string($1))
   }
+| non_reserved_keyword
+  {
+    $$ = NewColIdent(string($1))
+  }
 
 table_id:
   ID
   {
     $$ = NewTableIdent(string($1))
   }
+| non_reserved_keyword
+  {
+    $$ = NewTableIdent(string($1))
+  }
+
+// non_reserved_keyword lists the keywords that can also be used
+// as identifiers, like in MySQL.
+non_reserved_keyword:
+  ROLLBACK
+| SAVEPOINT
 
 openb:
   '('
@@ -201,7 +201,7 @@ var keywords = map[string]int{
 	"real":                UNUSED,
 	"references":          UNUSED,
 	"regexp":              REGEXP,
-	"release":             UNUSED,
+	"release":             RELEASE,
 	"rename":              RENAME,
 	"repeat":              UNUSED,
 	"replace":             UNUSED,
@@ -212,6 +212,8 @@ var keywords = map[string]int{
 	"revoke":              UNUSED,
 	"right":               RIGHT,
 	"rlike":               REGEXP,
+	"rollback":            ROLLBACK,
+	"savepoint":           SAVEPOINT,
 	"schema":              UNUSED,
 	"schemas":             UNUSED,
 	"second_microsecond":  UNUSED,
@@ -274,6 +276,14 @@ var keywords = map[string]int{
 	"zerofill":            UNUSED,
 }
 
+// nonReservedKeywords are the keywords that can also be used as
+// identifiers. They must be listed in the non_reserved_keyword rule
+// of the grammar.
+var nonReservedKeywords = map[int]bool{
+	ROLLBACK:  true,
+	SAVEPOINT: true,
+}
+
 // Lex returns the next token form the Tokenizer.
 // This function is used by go yacc.
 func (tkn *Tokenizer) Lex(lval *yySymType) int {
@@ -285,7 +295,7 @@ func (tkn *Tokenizer) Lex(lval *yySymType) int {
 		typ, val = tkn.Scan()
 	}
 	switch typ {
-	case ID, STRING, HEX, NUMBER, HEXNUM, VALUE_ARG, LIST_ARG, COMMENT:
+	case ID, STRING, HEX, NUMBER, HEXNUM, VALUE_ARG, LIST_ARG, COMMENT, ROLLBACK, SAVEPOINT:
 		lval.bytes = val
 	}
 	tkn.lastToken = val
@@ -435,6 +445,10 @@ func (tkn *Tokenizer) scanIdentifier(firstByte byte) (int, []byte) {
 	lowered := bytes.ToLower(buffer.Bytes())
 	loweredStr := string(lowered)
 	if keywordID, found := keywords[loweredStr]; found {
+		if nonReservedKeywords[keywordID] {
+			// They can be identifiers, so their case is kept.
+			return keywordID, buffer.Bytes()
+		}
 		return keywordID, lowered
 	}
 	// dual must always be case-insensitive
@@ -271,6 +271,9 @@ func NewActionAgent(
 		agent.initHealthCheck()
 	}
 
+	// Start periodic binlog archiving, if configured.
+	agent.initBinlogArchiver()
+
 	// Start periodic Orchestrator self-registration, if configured.
 	if agent.orc != nil {
 		go agent.orc.DiscoverLoop(agent)
@@ -725,6 +725,26 @@ func agentRPCTestStopSlaveMinimumPanic(ctx context.Context, t *testing.T, client
 	expectHandleRPCPanic(t, "StopSlaveMinimum", true /*verbose*/, err)
 }
 
+var testStopSlaveBeforeTime = time.Unix(1456789012, 345678901)
+
+func (fra *fakeRPCAgent) StopSlaveBeforeTime(ctx context.Context, stopTime time.Time) (string, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "StopSlaveBeforeTime stopTime", stopTime, testStopSlaveBeforeTime)
+	return testReplicationPositionReturned, nil
+}
+
+func agentRPCTestStopSlaveBeforeTime(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	pos, err := client.StopSlaveBeforeTime(ctx, tablet, testStopSlaveBeforeTime)
+	compareError(t, "StopSlaveBeforeTime", err, pos, testReplicationPositionReturned)
+}
+
+func agentRPCTestStopSlaveBeforeTimePanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.StopSlaveBeforeTime(ctx, tablet, testStopSlaveBeforeTime)
+	expectHandleRPCPanic(t, "StopSlaveBeforeTime", true /*verbose*/, err)
+}
+
 var testStartSlaveCalled = false
 
 func (fra *fakeRPCAgent) StartSlave(ctx context.Context) error {
@@ -745,6 +765,28 @@ func agentRPCTestStartSlavePanic(ctx context.Context, t *testing.T, client tmcli
 	expectHandleRPCPanic(t, "StartSlave", true /*verbose*/, err)
 }
 
+var testInjectEmptyTransactionsGTIDs = "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-2"
+
+func (fra *fakeRPCAgent) InjectEmptyTransactions(ctx context.Context, gtids string) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "InjectEmptyTransactions gtids", gtids, testInjectEmptyTransactionsGTIDs)
+	return nil
+}
+
+func agentRPCTestInjectEmptyTransactions(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.InjectEmptyTransactions(ctx, tablet, testInjectEmptyTransactionsGTIDs)
+	if err != nil {
+		t.Errorf("InjectEmptyTransactions failed: %v", err)
+	}
+}
+
+func agentRPCTestInjectEmptyTransactionsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.InjectEmptyTransactions(ctx, tablet, testInjectEmptyTransactionsGTIDs)
+	expectHandleRPCPanic(t, "InjectEmptyTransactions", true /*verbose*/, err)
+}
+
 var testTabletExternallyReparentedCalled = false
 
 func (fra *fakeRPCAgent) TabletExternallyReparented(ctx context.Context, externalID string) error {
@@ -1115,21 +1157,25 @@ func agentRPCTestPromoteSlavePanic(ctx context.Context, t *testing.T, client tmc
 //
 
 var testBackupConcurrency = 24
+var testBackupIncremental = true
 var testBackupCalled = false
 var testRestoreFromBackupCalled = false
+var testRestoreToTime = time.Unix(1456789012, 345678000)
+var testRestoreToPos = "MariaDB/1-123-456"
 
-func (fra *fakeRPCAgent) Backup(ctx context.Context, concurrency int, logger logutil.Logger) error {
+func (fra *fakeRPCAgent) Backup(ctx context.Context, concurrency int, incremental bool, logger logutil.Logger) error {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
 	compare(fra.t, "Backup args", concurrency, testBackupConcurrency)
+	compare(fra.t, "Backup incremental", incremental, testBackupIncremental)
 	logStuff(logger, 10)
 	testBackupCalled = true
 	return nil
 }
 
 func agentRPCTestBackup(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.Backup(ctx, tablet, testBackupConcurrency)
+	stream, err := client.Backup(ctx, tablet, testBackupConcurrency, testBackupIncremental)
 	if err != nil {
 		t.Fatalf("Backup failed: %v", err)
 	}
@@ -1138,7 +1184,7 @@ func agentRPCTestBackup(ctx context.Context, t *testing.T, client tmclient.Table
 }
 
 func agentRPCTestBackupPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.Backup(ctx, tablet, testBackupConcurrency)
+	stream, err := client.Backup(ctx, tablet, testBackupConcurrency, testBackupIncremental)
 	if err != nil {
 		t.Fatalf("Backup failed: %v", err)
 	}
@@ -1149,17 +1195,19 @@ func agentRPCTestBackupPanic(ctx context.Context, t *testing.T, client tmclient.
 	expectHandleRPCPanic(t, "Backup", true /*verbose*/, err)
 }
 
-func (fra *fakeRPCAgent) RestoreFromBackup(ctx context.Context, logger logutil.Logger) error {
+func (fra *fakeRPCAgent) RestoreFromBackup(ctx context.Context, logger logutil.Logger, restoreToTime time.Time, restoreToPos string) error {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
+	compareBool(fra.t, "RestoreFromBackup restoreToTime", restoreToTime.Equal(testRestoreToTime))
+	compare(fra.t, "RestoreFromBackup restoreToPos", restoreToPos, testRestoreToPos)
 	logStuff(logger, 10)
 	testRestoreFromBackupCalled = true
 	return nil
 }
 
 func agentRPCTestRestoreFromBackup(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.RestoreFromBackup(ctx, tablet)
+	stream, err := client.RestoreFromBackup(ctx, tablet, testRestoreToTime, testRestoreToPos)
 	if err != nil {
 		t.Fatalf("RestoreFromBackup failed: %v", err)
 	}
@@ -1168,7 +1216,7 @@ func agentRPCTestRestoreFromBackup(ctx context.Context, t *testing.T, client tmc
 }
 
 func agentRPCTestRestoreFromBackupPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.RestoreFromBackup(ctx, tablet)
+	stream, err := client.RestoreFromBackup(ctx, tablet, testRestoreToTime, testRestoreToPos)
 	if err != nil {
 		t.Fatalf("RestoreFromBackup failed: %v", err)
 	}
@@ -1227,7 +1275,9 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	agentRPCTestMasterPosition(ctx, t, client, tablet)
 	agentRPCTestStopSlave(ctx, t, client, tablet)
 	agentRPCTestStopSlaveMinimum(ctx, t, client, tablet)
+	agentRPCTestStopSlaveBeforeTime(ctx, t, client, tablet)
 	agentRPCTestStartSlave(ctx, t, client, tablet)
+	agentRPCTestInjectEmptyTransactions(ctx, t, client, tablet)
 	agentRPCTestTabletExternallyReparented(ctx, t, client, tablet)
 	agentRPCTestGetSlaves(ctx, t, client, tablet)
 	agentRPCTestWaitBlpPosition(ctx, t, client, tablet)
@@ -1280,7 +1330,9 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	agentRPCTestMasterPositionPanic(ctx, t, client, tablet)
 	agentRPCTestStopSlavePanic(ctx, t, client, tablet)
 	agentRPCTestStopSlaveMinimumPanic(ctx, t, client, tablet)
+	agentRPCTestStopSlaveBeforeTimePanic(ctx, t, client, tablet)
 	agentRPCTestStartSlavePanic(ctx, t, client, tablet)
+	agentRPCTestInjectEmptyTransactionsPanic(ctx, t, client, tablet)
 	agentRPCTestTabletExternallyReparentedPanic(ctx, t, client, tablet)
 	agentRPCTestGetSlavesPanic(ctx, t, client, tablet)
 	agentRPCTestWaitBlpPositionPanic(ctx, t, client, tablet)
@@ -0,0 +1,66 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabletmanager
+
+// This file handles the periodic archiving of the binlogs into the
+// BackupStorage, which point-in-time recovery replays on top of a
+// backup. It is only enabled if binlog_archive_interval is set.
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	log "github.com/golang/glog"
+	"github.com/youtube/vitess/go/timer"
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/mysqlctl"
+	"github.com/youtube/vitess/go/vt/servenv"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+var binlogArchiveInterval = flag.Duration("binlog_archive_interval", 0, "if non-zero, how often to copy the binlog files mysqld is done with into the BackupStorage, for point-in-time recovery")
+
+// initBinlogArchiver starts the periodic archiving of the binlogs,
+// if configured. It is only run by NewActionAgent.
+func (agent *ActionAgent) initBinlogArchiver() {
+	if *binlogArchiveInterval == 0 {
+		return
+	}
+
+	log.Infof("Starting periodic binlog archiving every %v", *binlogArchiveInterval)
+	t := timer.NewTimer(*binlogArchiveInterval)
+	servenv.OnTermSync(func() {
+		log.Info("Stopping periodic binlog archiving timer")
+		t.Stop()
+	})
+	t.Start(func() {
+		if err := agent.archiveBinlogs(); err != nil {
+			log.Warningf("binlog archiving failed: %v", err)
+		}
+	})
+}
+
+// archiveBinlogs archives the binlog files that are not archived yet.
+// Tablets that are being backed up or restored are skipped, as
+// their mysqld may not be running.
+func (agent *ActionAgent) archiveBinlogs() error {
+	tablet := agent.Tablet()
+	switch tablet.Type {
+	case topodatapb.TabletType_BACKUP, topodatapb.TabletType_RESTORE:
+		return nil
+	}
+
+	start := time.Now()
+	dir := fmt.Sprintf("%v/%v", tablet.Keyspace, tablet.Shard)
+	prefix := topoproto.TabletAliasString(agent.TabletAlias)
+	count, err := mysqlctl.ArchiveBinlogs(agent.batchCtx, agent.MysqlDaemon, logutil.NewConsoleLogger(), dir, prefix, agent.hookExtraEnv())
+	if count > 0 {
+		log.Infof("archived %v binlog files in %v", count, time.Now().Sub(start))
+	}
+	return err
+}
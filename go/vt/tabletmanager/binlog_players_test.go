@@ -140,8 +140,8 @@ func (ftc *fakeTabletConn) Begin(ctx context.Context, target *querypb.Target) (t
 }
 
 // Commit is part of the TabletConn interface
-func (ftc *fakeTabletConn) Commit(ctx context.Context, target *querypb.Target, transactionID int64) error {
-	return fmt.Errorf("not implemented in this test")
+func (ftc *fakeTabletConn) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (string, error) {
+	return "", fmt.Errorf("not implemented in this test")
 }
 
 // Rollback is part of the TabletConn interface
@@ -155,8 +155,8 @@ func (ftc *fakeTabletConn) Prepare(ctx context.Context, target *querypb.Target,
 }
 
 // CommitPrepared is part of the TabletConn interface
-func (ftc *fakeTabletConn) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) (err error) {
-	return fmt.Errorf("not implemented in this test")
+func (ftc *fakeTabletConn) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) (string, error) {
+	return "", fmt.Errorf("not implemented in this test")
 }
 
 // RollbackPrepared is part of the TabletConn interface
@@ -170,8 +170,8 @@ func (ftc *fakeTabletConn) CreateTransaction(ctx context.Context, target *queryp
 }
 
 // StartCommit is part of the TabletConn interface
-func (ftc *fakeTabletConn) StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (err error) {
-	return fmt.Errorf("not implemented in this test")
+func (ftc *fakeTabletConn) StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (string, error) {
+	return "", fmt.Errorf("not implemented in this test")
 }
 
 // SetRollback is part of the TabletConn interface
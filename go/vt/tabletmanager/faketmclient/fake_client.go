@@ -168,11 +168,21 @@ func (client *FakeTabletManagerClient) StopSlaveMinimum(ctx context.Context, tab
 	return "", nil
 }
 
+// StopSlaveBeforeTime is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) StopSlaveBeforeTime(ctx context.Context, tablet *topodatapb.Tablet, stopTime time.Time) (string, error) {
+	return "", nil
+}
+
 // StartSlave is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) StartSlave(ctx context.Context, tablet *topodatapb.Tablet) error {
 	return nil
 }
 
+// InjectEmptyTransactions is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) InjectEmptyTransactions(ctx context.Context, tablet *topodatapb.Tablet, gtids string) error {
+	return nil
+}
+
 // TabletExternallyReparented is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) TabletExternallyReparented(ctx context.Context, tablet *topodatapb.Tablet, externalID string) error {
 	return nil
@@ -281,12 +291,12 @@ func (e *eofEventStream) Recv() (*logutilpb.Event, error) {
 }
 
 // Backup is part of the tmclient.TabletManagerClient interface.
-func (client *FakeTabletManagerClient) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int) (logutil.EventStream, error) {
+func (client *FakeTabletManagerClient) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, incremental bool) (logutil.EventStream, error) {
 	return &eofEventStream{}, nil
 }
 
 // RestoreFromBackup is part of the tmclient.TabletManagerClient interface.
-func (client *FakeTabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
+func (client *FakeTabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, restoreToTime time.Time, restoreToPos string) (logutil.EventStream, error) {
 	return &eofEventStream{}, nil
 }
 
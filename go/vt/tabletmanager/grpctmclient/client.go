@@ -474,6 +474,22 @@ func (client *Client) StopSlaveMinimum(ctx context.Context, tablet *topodatapb.T
 	return response.Position, nil
 }
 
+// StopSlaveBeforeTime is part of the tmclient.TabletManagerClient interface.
+func (client *Client) StopSlaveBeforeTime(ctx context.Context, tablet *topodatapb.Tablet, stopTime time.Time) (string, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return "", err
+	}
+	defer cc.Close()
+	response, err := c.StopSlaveBeforeTime(ctx, &tabletmanagerdatapb.StopSlaveBeforeTimeRequest{
+		StopTimeNs: stopTime.UnixNano(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return response.Position, nil
+}
+
 // StartSlave is part of the tmclient.TabletManagerClient interface.
 func (client *Client) StartSlave(ctx context.Context, tablet *topodatapb.Tablet) error {
 	cc, c, err := client.dial(tablet)
@@ -485,6 +501,19 @@ func (client *Client) StartSlave(ctx context.Context, tablet *topodatapb.Tablet)
 	return err
 }
 
+// InjectEmptyTransactions is part of the tmclient.TabletManagerClient interface.
+func (client *Client) InjectEmptyTransactions(ctx context.Context, tablet *topodatapb.Tablet, gtids string) error {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.InjectEmptyTransactions(ctx, &tabletmanagerdatapb.InjectEmptyTransactionsRequest{
+		Gtids: gtids,
+	})
+	return err
+}
+
 // TabletExternallyReparented is part of the tmclient.TabletManagerClient interface.
 func (client *Client) TabletExternallyReparented(ctx context.Context, tablet *topodatapb.Tablet, externalID string) error {
 	cc, c, err := client.dial(tablet)
@@ -743,7 +772,7 @@ func (e *backupStreamAdapter) Recv() (*logutilpb.Event, error) {
 }
 
 // Backup is part of the tmclient.TabletManagerClient interface.
-func (client *Client) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int) (logutil.EventStream, error) {
+func (client *Client) Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, incremental bool) (logutil.EventStream, error) {
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return nil, err
@@ -751,6 +780,7 @@ func (client *Client) Backup(ctx context.Context, tablet *topodatapb.Tablet, con
 
 	stream, err := c.Backup(ctx, &tabletmanagerdatapb.BackupRequest{
 		Concurrency: int64(concurrency),
+		Incremental: incremental,
 	})
 	if err != nil {
 		cc.Close()
@@ -777,13 +807,19 @@ func (e *restoreFromBackupStreamAdapter) Recv() (*logutilpb.Event, error) {
 }
 
 // RestoreFromBackup is part of the tmclient.TabletManagerClient interface.
-func (client *Client) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
+func (client *Client) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, restoreToTime time.Time, restoreToPos string) (logutil.EventStream, error) {
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return nil, err
 	}
 
-	stream, err := c.RestoreFromBackup(ctx, &tabletmanagerdatapb.RestoreFromBackupRequest{})
+	request := &tabletmanagerdatapb.RestoreFromBackupRequest{
+		RestoreToPos: restoreToPos,
+	}
+	if !restoreToTime.IsZero() {
+		request.RestoreToTimeNs = restoreToTime.UnixNano()
+	}
+	stream, err := c.RestoreFromBackup(ctx, request)
 	if err != nil {
 		cc.Close()
 		return nil, err
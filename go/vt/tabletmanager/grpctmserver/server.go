@@ -246,6 +246,17 @@ func (s *server) StopSlaveMinimum(ctx context.Context, request *tabletmanagerdat
 	return response, err
 }
 
+func (s *server) StopSlaveBeforeTime(ctx context.Context, request *tabletmanagerdatapb.StopSlaveBeforeTimeRequest) (response *tabletmanagerdatapb.StopSlaveBeforeTimeResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "StopSlaveBeforeTime", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.StopSlaveBeforeTimeResponse{}
+	position, err := s.agent.StopSlaveBeforeTime(ctx, time.Unix(0, request.StopTimeNs))
+	if err == nil {
+		response.Position = position
+	}
+	return response, err
+}
+
 func (s *server) StartSlave(ctx context.Context, request *tabletmanagerdatapb.StartSlaveRequest) (response *tabletmanagerdatapb.StartSlaveResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "StartSlave", request, response, true /*verbose*/, &err)
 	ctx = callinfo.GRPCCallInfo(ctx)
@@ -253,6 +264,13 @@ func (s *server) StartSlave(ctx context.Context, request *tabletmanagerdatapb.St
 	return response, s.agent.StartSlave(ctx)
 }
 
+func (s *server) InjectEmptyTransactions(ctx context.Context, request *tabletmanagerdatapb.InjectEmptyTransactionsRequest) (response *tabletmanagerdatapb.InjectEmptyTransactionsResponse, err error) {
+	defer s.agent.HandleRPCPanic(ctx, "InjectEmptyTransactions", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.InjectEmptyTransactionsResponse{}
+	return response, s.agent.InjectEmptyTransactions(ctx, request.Gtids)
+}
+
 func (s *server) TabletExternallyReparented(ctx context.Context, request *tabletmanagerdatapb.TabletExternallyReparentedRequest) (response *tabletmanagerdatapb.TabletExternallyReparentedResponse, err error) {
 	defer s.agent.HandleRPCPanic(ctx, "TabletExternallyReparented", request, response, false /*verbose*/, &err)
 	ctx = callinfo.GRPCCallInfo(ctx)
@@ -427,7 +445,7 @@ func (s *server) Backup(request *tabletmanagerdatapb.BackupRequest, stream table
 		})
 	})
 
-	return s.agent.Backup(ctx, int(request.Concurrency), logger)
+	return s.agent.Backup(ctx, int(request.Concurrency), request.Incremental, logger)
 }
 
 func (s *server) RestoreFromBackup(request *tabletmanagerdatapb.RestoreFromBackupRequest, stream tabletmanagerservicepb.TabletManager_RestoreFromBackupServer) (err error) {
@@ -445,7 +463,11 @@ func (s *server) RestoreFromBackup(request *tabletmanagerdatapb.RestoreFromBacku
 		})
 	})
 
-	return s.agent.RestoreFromBackup(ctx, logger)
+	var restoreToTime time.Time
+	if request.RestoreToTimeNs != 0 {
+		restoreToTime = time.Unix(0, request.RestoreToTimeNs)
+	}
+	return s.agent.RestoreFromBackup(ctx, logger, restoreToTime, request.RestoreToPos)
 }
 
 // registration glue
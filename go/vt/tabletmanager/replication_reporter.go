@@ -74,8 +74,12 @@ func (r *replicationReporter) Report(isSlaveType, shouldQueryServiceBeRunning bo
 		return elapsed + r.lastKnownValue, nil
 	}
 
-	// we got a real value, save it.
-	r.lastKnownValue = time.Duration(status.SecondsBehindMaster) * time.Second
+	// we got a real value, save it. A delayed replica is only
+	// lagging if it is behind its configured delay.
+	r.lastKnownValue = time.Duration(status.SecondsBehindMaster)*time.Second - mysqlctl.MasterDelay()
+	if r.lastKnownValue < 0 {
+		r.lastKnownValue = 0
+	}
 	r.lastKnownTime = r.now()
 	return r.lastKnownValue, nil
 }
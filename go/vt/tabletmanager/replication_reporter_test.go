@@ -2,6 +2,7 @@ package tabletmanager
 
 import (
 	"errors"
+	"flag"
 	"testing"
 	"time"
 
@@ -94,3 +95,28 @@ func TestNoExtrapolatedMySQLReplicationLag(t *testing.T) {
 		t.Fatalf("wrong Report error: %v", err)
 	}
 }
+
+func TestDelayedReplicaMySQLReplicationLag(t *testing.T) {
+	mysqld := mysqlctl.NewFakeMysqlDaemon(nil)
+	mysqld.Replicating = true
+	mysqld.SecondsBehindMaster = 3610
+	slaveStopped := true
+	flag.Set("master_delay", "1h")
+	defer flag.Set("master_delay", "0")
+
+	rep := &replicationReporter{
+		agent: &ActionAgent{MysqlDaemon: mysqld, _slaveStopped: &slaveStopped},
+		now:   time.Now,
+	}
+	dur, err := rep.Report(true, true)
+	if err != nil || dur != 10*time.Second {
+		t.Fatalf("wrong Report result: %v %v", dur, err)
+	}
+
+	// A delayed replica that's not behind its delay yet is not lagging.
+	mysqld.SecondsBehindMaster = 1200
+	dur, err = rep.Report(true, true)
+	if err != nil || dur != 0 {
+		t.Fatalf("wrong Report result: %v %v", dur, err)
+	}
+}
@@ -33,10 +33,13 @@ var (
 func (agent *ActionAgent) RestoreData(ctx context.Context, logger logutil.Logger, deleteBeforeRestore bool) error {
 	agent.actionMutex.Lock()
 	defer agent.actionMutex.Unlock()
-	return agent.restoreDataLocked(ctx, logger, deleteBeforeRestore)
+	return agent.restoreDataLocked(ctx, logger, deleteBeforeRestore, mysqlctl.RecoveryTarget{})
 }
 
-func (agent *ActionAgent) restoreDataLocked(ctx context.Context, logger logutil.Logger, deleteBeforeRestore bool) error {
+// restoreDataLocked restores the latest backup. If target is set, it
+// runs a point-in-time recovery instead: the tablet is then left
+// DRAINED and not replicating, so it doesn't go past the target.
+func (agent *ActionAgent) restoreDataLocked(ctx context.Context, logger logutil.Logger, deleteBeforeRestore bool, target mysqlctl.RecoveryTarget) error {
 	// change type to RESTORE (using UpdateTabletFields so it's
 	// always authorized)
 	var originalType topodatapb.TabletType
@@ -60,9 +63,13 @@ func (agent *ActionAgent) restoreDataLocked(ctx context.Context, logger logutil.
 	localMetadata := agent.getLocalMetadataValues(originalType)
 	tablet := agent.Tablet()
 	dir := fmt.Sprintf("%v/%v", tablet.Keyspace, tablet.Shard)
-	pos, err := mysqlctl.Restore(ctx, agent.MysqlDaemon, dir, *restoreConcurrency, agent.hookExtraEnv(), localMetadata, logger, deleteBeforeRestore, topoproto.TabletDbName(tablet))
-	switch err {
-	case nil:
+	pos, err := mysqlctl.Restore(ctx, agent.MysqlDaemon, dir, *restoreConcurrency, agent.hookExtraEnv(), localMetadata, logger, deleteBeforeRestore, topoproto.TabletDbName(tablet), target)
+	switch {
+	case err == nil && !target.IsZero():
+		logger.Infof("Restore: recovered up to %v, leaving the tablet DRAINED without replication", pos)
+		agent.setSlaveStopped(true)
+		originalType = topodatapb.TabletType_DRAINED
+	case err == nil:
 		// Starting from here we won't be able to recover if we get stopped by a cancelled
 		// context. Thus we use the background context to get through to the finish.
 
@@ -70,9 +77,9 @@ func (agent *ActionAgent) restoreDataLocked(ctx context.Context, logger logutil.
 		if err := agent.startReplication(context.Background(), pos, originalType); err != nil {
 			return err
 		}
-	case mysqlctl.ErrNoBackup:
+	case err == mysqlctl.ErrNoBackup:
 		// No-op, starting with empty database.
-	case mysqlctl.ErrExistingDB:
+	case err == mysqlctl.ErrExistingDB:
 		// No-op, assuming we've just restarted.  Note the
 		// replication reporter may restart replication at the
 		// next health check if it thinks it should. We do not
@@ -144,7 +151,7 @@ func (agent *ActionAgent) startReplication(ctx context.Context, pos replication.
 	}
 
 	// If using semi-sync, we need to enable it before connecting to master.
-	if err := agent.fixSemiSync(tabletType); err != nil {
+	if err := agent.fixSemiSync(ctx, tabletType, si.MasterAlias); err != nil {
 		return err
 	}
 
@@ -62,7 +62,7 @@ func (agent *ActionAgent) ChangeType(ctx context.Context, tabletType topodatapb.
 	}
 
 	// Let's see if we need to fix semi-sync acking.
-	if err := agent.fixSemiSyncAndReplication(agent.Tablet().Type); err != nil {
+	if err := agent.fixSemiSyncAndReplication(ctx, agent.Tablet().Type); err != nil {
 		return fmt.Errorf("fixSemiSyncAndReplication failed, may not ack correctly: %v", err)
 	}
 
@@ -69,8 +69,12 @@ type RPCAgent interface {
 
 	StopSlaveMinimum(ctx context.Context, position string, waitTime time.Duration) (string, error)
 
+	StopSlaveBeforeTime(ctx context.Context, stopTime time.Time) (string, error)
+
 	StartSlave(ctx context.Context) error
 
+	InjectEmptyTransactions(ctx context.Context, gtids string) error
+
 	TabletExternallyReparented(ctx context.Context, externalID string) error
 
 	GetSlaves(ctx context.Context) ([]string, error)
@@ -109,9 +113,9 @@ type RPCAgent interface {
 
 	// Backup / restore related methods
 
-	Backup(ctx context.Context, concurrency int, logger logutil.Logger) error
+	Backup(ctx context.Context, concurrency int, incremental bool, logger logutil.Logger) error
 
-	RestoreFromBackup(ctx context.Context, logger logutil.Logger) error
+	RestoreFromBackup(ctx context.Context, logger logutil.Logger, restoreToTime time.Time, restoreToPos string) error
 
 	// HandleRPCPanic is to be called in a defer statement in each
 	// RPC input point.
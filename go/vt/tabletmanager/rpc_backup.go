@@ -5,11 +5,14 @@
 package tabletmanager
 
 import (
+	"flag"
 	"fmt"
 	"time"
 
 	"github.com/youtube/vitess/go/vt/logutil"
 	"github.com/youtube/vitess/go/vt/mysqlctl"
+	"github.com/youtube/vitess/go/vt/mysqlctl/backupstorage"
+	"github.com/youtube/vitess/go/vt/mysqlctl/replication"
 	"github.com/youtube/vitess/go/vt/topo/topoproto"
 	"github.com/youtube/vitess/go/vt/topotools"
 	"golang.org/x/net/context"
@@ -17,29 +20,48 @@ import (
 	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
 )
 
-// Backup takes a db backup and sends it to the BackupStorage
-func (agent *ActionAgent) Backup(ctx context.Context, concurrency int, logger logutil.Logger) error {
+var (
+	backupRetentionCount    = flag.Int("backup_retention_count", 0, "if non-zero, how many of the most recent valid backups of the shard to keep when pruning backups after a successful backup")
+	backupRetentionDuration = flag.Duration("backup_retention_duration", 0, "if non-zero, the age under which backups of the shard are kept when pruning backups after a successful backup")
+)
+
+// Backup takes a db backup and sends it to the BackupStorage.
+// If the BackupEngine needs mysqld to stop serving, the tablet
+// is of type BACKUP for the duration of the backup. Incremental
+// backups only copy binlogs, so the tablet keeps serving.
+func (agent *ActionAgent) Backup(ctx context.Context, concurrency int, incremental bool, logger logutil.Logger) error {
 	if err := agent.lock(ctx); err != nil {
 		return err
 	}
 	defer agent.unlock()
 
-	// update our type to BACKUP
+	drain := false
+	if !incremental {
+		engine, err := mysqlctl.GetBackupEngine()
+		if err != nil {
+			return err
+		}
+		drain = engine.ShouldDrainForBackup()
+	}
 	tablet, err := agent.TopoServer.GetTablet(ctx, agent.TabletAlias)
 	if err != nil {
 		return err
 	}
-	if tablet.Type == topodatapb.TabletType_MASTER {
+	if drain && tablet.Type == topodatapb.TabletType_MASTER {
 		return fmt.Errorf("type MASTER cannot take backup, if you really need to do this, restart vttablet in replica mode")
 	}
+
+	// update our type to BACKUP
 	originalType := tablet.Type
-	if _, err := topotools.ChangeType(ctx, agent.TopoServer, tablet.Alias, topodatapb.TabletType_BACKUP); err != nil {
-		return err
-	}
+	if drain {
+		if _, err := topotools.ChangeType(ctx, agent.TopoServer, tablet.Alias, topodatapb.TabletType_BACKUP); err != nil {
+			return err
+		}
 
-	// let's update our internal state (stop query service and other things)
-	if err := agent.refreshTablet(ctx, "before backup"); err != nil {
-		return err
+		// let's update our internal state (stop query service and other things)
+		if err := agent.refreshTablet(ctx, "before backup"); err != nil {
+			return err
+		}
 	}
 
 	// create the loggers: tee to console and source
@@ -47,8 +69,19 @@ func (agent *ActionAgent) Backup(ctx context.Context, concurrency int, logger lo
 
 	// now we can run the backup
 	dir := fmt.Sprintf("%v/%v", tablet.Keyspace, tablet.Shard)
-	name := fmt.Sprintf("%v.%v", time.Now().UTC().Format("2006-01-02.150405"), topoproto.TabletAliasString(tablet.Alias))
-	returnErr := mysqlctl.Backup(ctx, agent.MysqlDaemon, l, dir, name, concurrency, agent.hookExtraEnv())
+	name := fmt.Sprintf("%v.%v", time.Now().UTC().Format(mysqlctl.BackupTimestampFormat), topoproto.TabletAliasString(tablet.Alias))
+	var returnErr error
+	if incremental {
+		returnErr = mysqlctl.IncrementalBackup(ctx, agent.MysqlDaemon, l, dir, name, agent.hookExtraEnv())
+	} else {
+		returnErr = mysqlctl.Backup(ctx, agent.MysqlDaemon, l, dir, name, concurrency, agent.hookExtraEnv())
+	}
+	if returnErr == nil {
+		agent.pruneBackups(ctx, l, dir)
+	}
+	if !drain {
+		return returnErr
+	}
 
 	// change our type back to the original value
 	_, err = topotools.ChangeType(ctx, agent.TopoServer, tablet.Alias, originalType)
@@ -72,8 +105,31 @@ func (agent *ActionAgent) Backup(ctx context.Context, concurrency int, logger lo
 	return returnErr
 }
 
+// pruneBackups removes the backups the retention policy doesn't keep.
+// Failing to do so doesn't fail the backup, so errors are only logged.
+func (agent *ActionAgent) pruneBackups(ctx context.Context, logger logutil.Logger, dir string) {
+	policy := mysqlctl.RetentionPolicy{
+		KeepCount:    *backupRetentionCount,
+		KeepDuration: *backupRetentionDuration,
+	}
+	if policy.IsZero() {
+		return
+	}
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		logger.Errorf("cannot prune backups: %v", err)
+		return
+	}
+	defer bs.Close()
+	if _, err := mysqlctl.PruneBackups(ctx, bs, logger, dir, policy, false /* dryRun */); err != nil {
+		logger.Errorf("cannot prune backups: %v", err)
+	}
+}
+
 // RestoreFromBackup deletes all local data and restores anew from the latest backup.
-func (agent *ActionAgent) RestoreFromBackup(ctx context.Context, logger logutil.Logger) error {
+// If restoreToTime or restoreToPos is set, it restores the latest backup
+// taken before that point instead, and replays the archived binlogs up to it.
+func (agent *ActionAgent) RestoreFromBackup(ctx context.Context, logger logutil.Logger, restoreToTime time.Time, restoreToPos string) error {
 	if err := agent.lock(ctx); err != nil {
 		return err
 	}
@@ -86,12 +142,19 @@ func (agent *ActionAgent) RestoreFromBackup(ctx context.Context, logger logutil.
 	if tablet.Type == topodatapb.TabletType_MASTER {
 		return fmt.Errorf("type MASTER cannot restore from backup, if you really need to do this, restart vttablet in replica mode")
 	}
+	target := mysqlctl.RecoveryTarget{Time: restoreToTime}
+	if restoreToPos != "" {
+		target.Position, err = replication.DecodePosition(restoreToPos)
+		if err != nil {
+			return fmt.Errorf("cannot decode restore position %v: %v", restoreToPos, err)
+		}
+	}
 
 	// create the loggers: tee to console and source
 	l := logutil.NewTeeLogger(logutil.NewConsoleLogger(), logger)
 
 	// now we can run restore
-	err = agent.restoreDataLocked(ctx, l, true /* deleteBeforeRestore */)
+	err = agent.restoreDataLocked(ctx, l, true /* deleteBeforeRestore */, target)
 
 	// re-run health check to be sure to capture any replication delay
 	agent.runHealthCheckLocked()
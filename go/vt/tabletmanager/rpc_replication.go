@@ -23,9 +23,18 @@ import (
 )
 
 var (
-	enableSemiSync = flag.Bool("enable_semi_sync", false, "Enable semi-sync when configuring replication, on master and replica tablets only (rdonly tablets will not ack).")
+	enableSemiSync = flag.Bool("enable_semi_sync", false, "Enable semi-sync when configuring replication, on master and replica tablets only (rdonly tablets will not ack). The durability policy of the keyspace, if any, decides which tablets ack.")
 )
 
+// checkNotDelayedReplica returns an error if this tablet is a delayed
+// replica, which must never become the master.
+func checkNotDelayedReplica() error {
+	if delay := mysqlctl.MasterDelay(); delay > 0 {
+		return fmt.Errorf("this tablet is a delayed replica (-master_delay %v), it can't become the master", delay)
+	}
+	return nil
+}
+
 // SlaveStatus returns the replication status
 func (agent *ActionAgent) SlaveStatus(ctx context.Context) (*replicationdatapb.Status, error) {
 	status, err := agent.MysqlDaemon.SlaveStatus()
@@ -103,6 +112,35 @@ func (agent *ActionAgent) StopSlaveMinimum(ctx context.Context, position string,
 	return replication.EncodePosition(pos), nil
 }
 
+// StopSlaveBeforeTime stops the replication of a delayed replica right
+// before the first transaction committed at or after stopTime, so the
+// tablet can be used to recover data lost on the master at that time.
+// It returns the position replication will stop at.
+func (agent *ActionAgent) StopSlaveBeforeTime(ctx context.Context, stopTime time.Time) (string, error) {
+	delay := mysqlctl.MasterDelay()
+	if delay <= 0 {
+		return "", fmt.Errorf("StopSlaveBeforeTime only works on delayed replicas, started with -master_delay")
+	}
+	if applied := time.Now().Add(-delay); !stopTime.After(applied) {
+		return "", fmt.Errorf("stop time %v is before %v, which this tablet has already applied", stopTime, applied)
+	}
+
+	if err := agent.lock(ctx); err != nil {
+		return "", err
+	}
+	defer agent.unlock()
+
+	// Remember that we were told to stop, so we don't restart
+	// replication once it stops by itself (in replication_reporter).
+	agent.setSlaveStopped(true)
+	pos, err := mysqlctl.StopSlaveBeforeTime(ctx, agent.MysqlDaemon, agent.hookExtraEnv(), stopTime)
+	if err != nil {
+		agent.setSlaveStopped(false)
+		return "", err
+	}
+	return replication.EncodePosition(pos), nil
+}
+
 // StartSlave will start the replication. Works both when Vitess manages
 // replication or not (using hook if not).
 func (agent *ActionAgent) StartSlave(ctx context.Context) error {
@@ -124,12 +162,40 @@ func (agent *ActionAgent) StartSlave(ctx context.Context) error {
 		}
 	}()
 
-	if err := agent.fixSemiSync(agent.Tablet().Type); err != nil {
-		return err
+	// Starting replication doesn't need the topology: if the
+	// durability policy can't be read, semi-sync is left as is.
+	if err := agent.fixSemiSync(ctx, agent.Tablet().Type, nil); err != nil {
+		log.Warningf("StartSlave can't fix semi-sync, leaving it as is: %v", err)
 	}
 	return mysqlctl.StartSlave(agent.MysqlDaemon, agent.hookExtraEnv())
 }
 
+// InjectEmptyTransactions commits an empty transaction for each of the
+// provided GTIDs, so this tablet has them without changing its data.
+// It is used on a master, to repair the errant GTIDs of a slave: its
+// other slaves then replicate the empty transactions, and the slave
+// with the errant GTIDs skips them.
+func (agent *ActionAgent) InjectEmptyTransactions(ctx context.Context, gtids string) error {
+	pos, err := replication.DecodePosition(gtids)
+	if err != nil {
+		return err
+	}
+	if pos.IsZero() {
+		return fmt.Errorf("no GTIDs to inject")
+	}
+
+	if err := agent.lock(ctx); err != nil {
+		return err
+	}
+	defer agent.unlock()
+
+	cmds, err := agent.MysqlDaemon.InjectEmptyTransactionsCommands(pos.GTIDSet)
+	if err != nil {
+		return err
+	}
+	return agent.MysqlDaemon.ExecuteSuperQueryList(ctx, cmds)
+}
+
 // GetSlaves returns the address of all the slaves
 func (agent *ActionAgent) GetSlaves(ctx context.Context) ([]string, error) {
 	return mysqlctl.FindSlaves(agent.MysqlDaemon)
@@ -153,6 +219,9 @@ func (agent *ActionAgent) ResetReplication(ctx context.Context) error {
 
 // InitMaster enables writes and returns the replication position.
 func (agent *ActionAgent) InitMaster(ctx context.Context) (string, error) {
+	if err := checkNotDelayedReplica(); err != nil {
+		return "", err
+	}
 	if err := agent.lock(ctx); err != nil {
 		return "", err
 	}
@@ -175,7 +244,7 @@ func (agent *ActionAgent) InitMaster(ctx context.Context) (string, error) {
 	}
 
 	// If using semi-sync, we need to enable it before going read-write.
-	if err := agent.fixSemiSync(topodatapb.TabletType_MASTER); err != nil {
+	if err := agent.fixSemiSync(ctx, topodatapb.TabletType_MASTER, nil); err != nil {
 		return "", err
 	}
 
@@ -240,7 +309,7 @@ func (agent *ActionAgent) InitSlave(ctx context.Context, parent *topodatapb.Tabl
 	if tt == topodatapb.TabletType_MASTER {
 		tt = topodatapb.TabletType_REPLICA
 	}
-	if err := agent.fixSemiSync(tt); err != nil {
+	if err := agent.fixSemiSync(ctx, tt, parent); err != nil {
 		return err
 	}
 
@@ -302,7 +371,7 @@ func (agent *ActionAgent) DemoteMaster(ctx context.Context) (string, error) {
 	}
 
 	// If using semi-sync, we need to disable master-side.
-	if err := agent.fixSemiSync(topodatapb.TabletType_REPLICA); err != nil {
+	if err := agent.fixSemiSync(ctx, topodatapb.TabletType_REPLICA, nil); err != nil {
 		return "", err
 	}
 
@@ -321,6 +390,9 @@ func (agent *ActionAgent) DemoteMaster(ctx context.Context) (string, error) {
 // replication up to the provided point, and then makes the slave the
 // shard master.
 func (agent *ActionAgent) PromoteSlaveWhenCaughtUp(ctx context.Context, position string) (string, error) {
+	if err := checkNotDelayedReplica(); err != nil {
+		return "", err
+	}
 	if err := agent.lock(ctx); err != nil {
 		return "", err
 	}
@@ -341,7 +413,7 @@ func (agent *ActionAgent) PromoteSlaveWhenCaughtUp(ctx context.Context, position
 	}
 
 	// If using semi-sync, we need to enable it before going read-write.
-	if err := agent.fixSemiSync(topodatapb.TabletType_MASTER); err != nil {
+	if err := agent.fixSemiSync(ctx, topodatapb.TabletType_MASTER, nil); err != nil {
 		return "", err
 	}
 
@@ -410,14 +482,12 @@ func (agent *ActionAgent) setMasterLocked(ctx context.Context, parentAlias *topo
 	}
 
 	// If using semi-sync, we need to enable it before connecting to master.
-	if *enableSemiSync {
-		tt := agent.Tablet().Type
-		if tt == topodatapb.TabletType_MASTER {
-			tt = topodatapb.TabletType_REPLICA
-		}
-		if err := agent.fixSemiSync(tt); err != nil {
-			return err
-		}
+	tt := agent.Tablet().Type
+	if tt == topodatapb.TabletType_MASTER {
+		tt = topodatapb.TabletType_REPLICA
+	}
+	if err := agent.fixSemiSync(ctx, tt, parentAlias); err != nil {
+		return err
 	}
 
 	// Create the list of commands to set the master
@@ -528,6 +598,9 @@ func (agent *ActionAgent) StopReplicationAndGetStatus(ctx context.Context) (*rep
 
 // PromoteSlave makes the current tablet the master
 func (agent *ActionAgent) PromoteSlave(ctx context.Context) (string, error) {
+	if err := checkNotDelayedReplica(); err != nil {
+		return "", err
+	}
 	if err := agent.lock(ctx); err != nil {
 		return "", err
 	}
@@ -539,7 +612,7 @@ func (agent *ActionAgent) PromoteSlave(ctx context.Context) (string, error) {
 	}
 
 	// If using semi-sync, we need to enable it before going read-write.
-	if err := agent.fixSemiSync(topodatapb.TabletType_MASTER); err != nil {
+	if err := agent.fixSemiSync(ctx, topodatapb.TabletType_MASTER, nil); err != nil {
 		return "", err
 	}
 
@@ -570,29 +643,82 @@ func isMasterEligible(tabletType topodatapb.TabletType) bool {
 	return false
 }
 
-func (agent *ActionAgent) fixSemiSync(tabletType topodatapb.TabletType) error {
+// semiSyncSettings returns whether the master side and the slave side
+// of semi-sync should be enabled on this tablet, if it is of type
+// tabletType and replicates from masterAlias (the master in the shard
+// record if nil). They follow the durability policy of the keyspace,
+// or the defaults of -enable_semi_sync if it doesn't have one. managed
+// is false if semi-sync is not handled by vttablet at all, in which
+// case the topology is not read.
+func (agent *ActionAgent) semiSyncSettings(ctx context.Context, tabletType topodatapb.TabletType, masterAlias *topodatapb.TabletAlias) (managed, master, slave bool, err error) {
 	if !*enableSemiSync {
 		// Semi-sync handling is not enabled.
-		return nil
+		return false, false, false, nil
 	}
 
-	// Only enable if we're eligible for becoming master (REPLICA type).
-	// Ineligible slaves (RDONLY) shouldn't ACK because we'll never promote them.
-	if !isMasterEligible(tabletType) {
-		return agent.MysqlDaemon.SetSemiSyncEnabled(false, false)
+	tablet := agent.Tablet()
+	policy := ""
+	ki, err := agent.TopoServer.GetKeyspace(ctx, tablet.Keyspace)
+	switch err {
+	case nil:
+		policy = ki.DurabilityPolicy
+	case topo.ErrNoNode:
+	default:
+		return false, false, false, fmt.Errorf("cannot read keyspace %v for its durability policy: %v", tablet.Keyspace, err)
+	}
+
+	if policy == "" {
+		// Only enable if we're eligible for becoming master (REPLICA type).
+		// Ineligible slaves (RDONLY) shouldn't ACK because we'll never promote them.
+		if !isMasterEligible(tabletType) {
+			return true, false, false, nil
+		}
+
+		// Always enable slave-side since it doesn't hurt to keep it on for a master.
+		// The master-side needs to be off for a slave, or else it will get stuck.
+		return true, tabletType == topodatapb.TabletType_MASTER, true, nil
+	}
+
+	if tabletType == topodatapb.TabletType_MASTER {
+		return true, topotools.IsMasterSemiSync(policy), false, nil
 	}
+	if masterAlias == nil {
+		si, err := agent.TopoServer.GetShard(ctx, tablet.Keyspace, tablet.Shard)
+		if err != nil {
+			return false, false, false, fmt.Errorf("cannot read shard %v/%v for its master cell: %v", tablet.Keyspace, tablet.Shard, err)
+		}
+		masterAlias = si.MasterAlias
+	}
+	var masterCell string
+	if masterAlias != nil {
+		masterCell = masterAlias.Cell
+	}
+	return true, false, topotools.IsSemiSyncAcker(policy, tabletType, tablet.Alias.Cell, masterCell), nil
+}
 
-	// Always enable slave-side since it doesn't hurt to keep it on for a master.
-	// The master-side needs to be off for a slave, or else it will get stuck.
-	return agent.MysqlDaemon.SetSemiSyncEnabled(tabletType == topodatapb.TabletType_MASTER, true)
+// fixSemiSync enables or disables semi-sync, as returned by
+// semiSyncSettings.
+func (agent *ActionAgent) fixSemiSync(ctx context.Context, tabletType topodatapb.TabletType, masterAlias *topodatapb.TabletAlias) error {
+	managed, master, slave, err := agent.semiSyncSettings(ctx, tabletType, masterAlias)
+	if err != nil || !managed {
+		return err
+	}
+	return agent.setSemiSync(master, slave)
 }
 
-func (agent *ActionAgent) fixSemiSyncAndReplication(tabletType topodatapb.TabletType) error {
-	if !*enableSemiSync {
-		// Semi-sync handling is not enabled.
-		return nil
+// setSemiSync enables or disables both sides of semi-sync.
+func (agent *ActionAgent) setSemiSync(master, slave bool) error {
+	if !master && !slave {
+		// Don't require the semi-sync plugins if semi-sync is
+		// not used.
+		if m, s := agent.MysqlDaemon.SemiSyncEnabled(); !m && !s {
+			return nil
+		}
 	}
+	return agent.MysqlDaemon.SetSemiSyncEnabled(master, slave)
+}
 
+func (agent *ActionAgent) fixSemiSyncAndReplication(ctx context.Context, tabletType topodatapb.TabletType) error {
 	if tabletType == topodatapb.TabletType_MASTER {
 		// Master is special. It is always handled at the
 		// right time by the reparent operations, it doesn't
@@ -600,7 +726,16 @@ func (agent *ActionAgent) fixSemiSyncAndReplication(tabletType topodatapb.Tablet
 		return nil
 	}
 
-	if err := agent.fixSemiSync(tabletType); err != nil {
+	managed, master, shouldAck, err := agent.semiSyncSettings(ctx, tabletType, nil)
+	if err != nil {
+		return err
+	}
+	if !managed {
+		// Semi-sync handling is not enabled.
+		return nil
+	}
+
+	if err := agent.setSemiSync(master, shouldAck); err != nil {
 		return fmt.Errorf("failed to fixSemiSync(%v): %v", tabletType, err)
 	}
 
@@ -617,7 +752,6 @@ func (agent *ActionAgent) fixSemiSyncAndReplication(tabletType topodatapb.Tablet
 		return nil
 	}
 
-	shouldAck := isMasterEligible(tabletType)
 	acking, err := agent.MysqlDaemon.SemiSyncSlaveStatus()
 	if err != nil {
 		return fmt.Errorf("failed to get SemiSyncSlaveStatus: %v", err)
@@ -94,6 +94,7 @@ func (agent *ActionAgent) broadcastHealth() {
 	// FIXME(alainjobart,liguo) add CpuUsage
 	stats := &querypb.RealtimeStats{
 		SecondsBehindMaster: uint32(replicationDelay.Seconds()),
+		MasterDelaySeconds:  uint32(mysqlctl.MasterDelay().Seconds()),
 	}
 	if agent.BinlogPlayerMap != nil {
 		stats.SecondsBehindMasterFilteredReplication, stats.BinlogPlayersCount = agent.BinlogPlayerMap.StatusSummary()
@@ -106,9 +106,18 @@ type TabletManagerClient interface {
 	// the provided minimum point
 	StopSlaveMinimum(ctx context.Context, tablet *topodatapb.Tablet, stopPos string, waitTime time.Duration) (string, error)
 
+	// StopSlaveBeforeTime stops the mysql replication of a delayed
+	// replica right before the first transaction committed at or
+	// after stopTime, and returns the position it will stop at.
+	StopSlaveBeforeTime(ctx context.Context, tablet *topodatapb.Tablet, stopTime time.Time) (string, error)
+
 	// StartSlave starts the mysql replication
 	StartSlave(ctx context.Context, tablet *topodatapb.Tablet) error
 
+	// InjectEmptyTransactions commits an empty transaction for each
+	// of the provided GTIDs, given as an encoded replication position.
+	InjectEmptyTransactions(ctx context.Context, tablet *topodatapb.Tablet, gtids string) error
+
 	// TabletExternallyReparented tells a tablet it is now the master, after an
 	// external tool has already promoted the underlying mysqld to master and
 	// reparented the other mysqld servers to it.
@@ -187,11 +196,14 @@ type TabletManagerClient interface {
 	// Backup / restore related methods
 	//
 
-	// Backup creates a database backup
-	Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int) (logutil.EventStream, error)
+	// Backup creates a database backup. If incremental is set,
+	// only the binlogs since the latest backup are backed up.
+	Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, incremental bool) (logutil.EventStream, error)
 
-	// RestoreFromBackup deletes local data and restores database from backup
-	RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error)
+	// RestoreFromBackup deletes local data and restores database from backup.
+	// If restoreToTime or restoreToPos is set, it restores the latest backup
+	// before that point, and replays the archived binlogs up to it.
+	RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, restoreToTime time.Time, restoreToPos string) (logutil.EventStream, error)
 
 	//
 	// Management methods
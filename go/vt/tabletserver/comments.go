@@ -4,10 +4,18 @@
 
 package tabletserver
 
-import "github.com/youtube/vitess/go/vt/sqlparser"
+import (
+	"strings"
+
+	"github.com/youtube/vitess/go/vt/sqlparser"
+)
 
 const trailingComment = "_trailingComment"
 
+// priorityComment prefixes the transaction priority in the trailing
+// comments of a query, e.g. /* priority:batch */.
+const priorityComment = "priority:"
+
 // stripTrailing strips out trailing comments if any and puts them in a bind variable.
 func stripTrailing(sql string, bindVariables map[string]interface{}) string {
 	query, comments := sqlparser.SplitTrailingComments(sql)
@@ -25,3 +33,16 @@ func restoreTrailing(sql []byte, bindVars map[string]interface{}) []byte {
 	}
 	return sql
 }
+
+// queryPriority returns the transaction priority set in the trailing
+// comments of a query, or "" if there is none.
+func queryPriority(sql string) string {
+	_, comments := sqlparser.SplitTrailingComments(sql)
+	comments = strings.NewReplacer("/*", " ", "*/", " ").Replace(comments)
+	for _, field := range strings.Fields(comments) {
+		if strings.HasPrefix(field, priorityComment) {
+			return field[len(priorityComment):]
+		}
+	}
+	return ""
+}
@@ -39,3 +39,21 @@ func TestComments(t *testing.T) {
 		}
 	}
 }
+
+func TestQueryPriority(t *testing.T) {
+	testCases := []struct {
+		input, want string
+	}{
+		{"select 1", ""},
+		{"select 1 /* priority:batch */", "batch"},
+		{"select 1 /* vtgate:: keyspace_id:80 */ /*priority:web*/", "web"},
+		{"select 1 /* nopriority:web */", ""},
+		// Only the trailing comments count.
+		{"select /* priority:batch */ 1", ""},
+	}
+	for _, tc := range testCases {
+		if got := queryPriority(tc.input); got != tc.want {
+			t.Errorf("queryPriority(%q) = %q, want %q", tc.input, got, tc.want)
+		}
+	}
+}
@@ -30,14 +30,20 @@ func init() {
 	flag.IntVar(&qsConfig.PoolSize, "queryserver-config-pool-size", DefaultQsConfig.PoolSize, "query server connection pool size, connection pool is used by regular queries (non streaming, not in a transaction)")
 	flag.IntVar(&qsConfig.StreamPoolSize, "queryserver-config-stream-pool-size", DefaultQsConfig.StreamPoolSize, "query server stream pool size, stream pool is used by stream queries: queries that return results to client in a streaming fashion")
 	flag.IntVar(&qsConfig.TransactionCap, "queryserver-config-transaction-cap", DefaultQsConfig.TransactionCap, "query server transaction cap is the maximum number of transactions allowed to happen at any given point of a time for a single vttablet. E.g. by setting transaction cap to 100, there are at most 100 transactions will be processed by a vttablet and the 101th transaction will be blocked (and fail if it cannot get connection within specified timeout)")
+	flag.StringVar(&qsConfig.TransactionPartitions, "queryserver-config-transaction-partitions", DefaultQsConfig.TransactionPartitions, "comma separated list of name:reserved transaction pool partitions. Each partition reserves the given number of connections out of the transaction cap for transactions whose query comment priority (e.g. /* priority:batch */ on the first query of the transaction), effective caller principal or component matches its name, in that order. Transactions overflow into the shared part of the pool once their partition is full; transactions that don't match any partition only use the shared part.")
 	flag.Float64Var(&qsConfig.TransactionTimeout, "queryserver-config-transaction-timeout", DefaultQsConfig.TransactionTimeout, "query server transaction timeout (in seconds), a transaction will be killed if it takes longer than this value")
 	flag.Float64Var(&qsConfig.TxShutDownGracePeriod, "transaction_shutdown_grace_period", DefaultQsConfig.TxShutDownGracePeriod, "how long to wait (in seconds) for transactions to complete during graceful shutdown.")
 	flag.IntVar(&qsConfig.MaxResultSize, "queryserver-config-max-result-size", DefaultQsConfig.MaxResultSize, "query server max result size, maximum number of rows allowed to return from vttablet for non-streaming queries.")
+	flag.IntVar(&qsConfig.MaxResultBytes, "queryserver-config-max-result-bytes", DefaultQsConfig.MaxResultBytes, "query server max result bytes, maximum size in bytes of the values returned from vttablet for a non-streaming query. Selects fail as soon as they exceed it, without keeping their remaining rows, which are still read from MySQL. 0 means no limit.")
+	flag.IntVar(&qsConfig.ResultMemoryBudget, "queryserver-config-result-memory-budget", DefaultQsConfig.ResultMemoryBudget, "query server result memory budget, maximum number of bytes that all the non-streaming results held by vttablet at any given time can add up to. Queries whose results don't fit in the budget fail with a RESOURCE_EXHAUSTED error. 0 means no limit.")
 	flag.IntVar(&qsConfig.MaxDMLRows, "queryserver-config-max-dml-rows", DefaultQsConfig.MaxDMLRows, "query server max dml rows per statement, maximum number of rows allowed to return at a time for an upadte or delete with either 1) an equality where clauses on primary keys, or 2) a subselect statement. For update and delete statements in above two categories, vttablet will split the original query into multiple small queries based on this configuration value. ")
 	flag.IntVar(&qsConfig.StreamBufferSize, "queryserver-config-stream-buffer-size", DefaultQsConfig.StreamBufferSize, "query server stream buffer size, the maximum number of bytes sent from vttablet for each stream call.")
 	flag.IntVar(&qsConfig.QueryCacheSize, "queryserver-config-query-cache-size", DefaultQsConfig.QueryCacheSize, "query server query cache size, maximum number of queries to be cached. vttablet analyzes every incoming query and generate a query plan, these plans are being cached in a lru cache. This config controls the capacity of the lru cache.")
+	flag.IntVar(&qsConfig.ResultCacheSize, "queryserver-config-result-cache-size", DefaultQsConfig.ResultCacheSize, "query server result cache size, maximum number of bytes of select results cached by REPLICA and RDONLY tablets. Cached results of a table are invalidated as soon as the replication stream shows a change to it, which requires -watch_replication_stream. 0 disables the cache.")
+	flag.IntVar(&qsConfig.ResultCacheMaxEntrySize, "queryserver-config-result-cache-max-entry-size", DefaultQsConfig.ResultCacheMaxEntrySize, "query server result cache max entry size, results bigger than this number of bytes are never cached.")
 	flag.Float64Var(&qsConfig.SchemaReloadTime, "queryserver-config-schema-reload-time", DefaultQsConfig.SchemaReloadTime, "query server schema reload time, how often vttablet reloads schemas from underlying MySQL instance in seconds. vttablet keeps table schemas in its own memory and periodically refreshes it from MySQL. This config controls the reload time.")
 	flag.Float64Var(&qsConfig.QueryTimeout, "queryserver-config-query-timeout", DefaultQsConfig.QueryTimeout, "query server query timeout (in seconds), this is the query timeout in vttablet side. If a query takes more than this timeout, it will be killed.")
+	flag.Float64Var(&qsConfig.PositionWaitTimeout, "queryserver-config-position-wait-timeout", DefaultQsConfig.PositionWaitTimeout, "query server position wait timeout (in seconds), how long a non-master tablet waits for its replication to reach the position requested by a query (see wait_for_position in ExecuteOptions). If it isn't reached in time, the query fails with a retryable error.")
 	flag.Float64Var(&qsConfig.TxPoolTimeout, "queryserver-config-txpool-timeout", DefaultQsConfig.TxPoolTimeout, "query server transaction pool timeout, it is how long vttablet waits if tx pool is full")
 	flag.Float64Var(&qsConfig.IdleTimeout, "queryserver-config-idle-timeout", DefaultQsConfig.IdleTimeout, "query server idle timeout (in seconds), vttablet manages various mysql connection pools. This config means if a connection has not been used in given idle timeout, this connection will be removed from pool. This effectively manages number of connection objects and optimize the pool performance.")
 	flag.BoolVar(&qsConfig.StrictMode, "queryserver-config-strict-mode", DefaultQsConfig.StrictMode, "allow only predictable DMLs and enforces MySQL's STRICT_TRANS_TABLES")
@@ -67,14 +73,20 @@ type Config struct {
 	PoolSize                int
 	StreamPoolSize          int
 	TransactionCap          int
+	TransactionPartitions   string
 	TransactionTimeout      float64
 	TxShutDownGracePeriod   float64
 	MaxResultSize           int
+	MaxResultBytes          int
+	ResultMemoryBudget      int
 	MaxDMLRows              int
 	StreamBufferSize        int
 	QueryCacheSize          int
+	ResultCacheSize         int
+	ResultCacheMaxEntrySize int
 	SchemaReloadTime        float64
 	QueryTimeout            float64
+	PositionWaitTimeout     float64
 	TxPoolTimeout           float64
 	IdleTimeout             float64
 	StrictMode              bool
@@ -103,13 +115,19 @@ var DefaultQsConfig = Config{
 	PoolSize:                16,
 	StreamPoolSize:          200,
 	TransactionCap:          20,
+	TransactionPartitions:   "",
 	TransactionTimeout:      30,
 	TxShutDownGracePeriod:   0,
 	MaxResultSize:           10000,
+	MaxResultBytes:          0,
+	ResultMemoryBudget:      0,
 	MaxDMLRows:              500,
 	QueryCacheSize:          5000,
+	ResultCacheSize:         0,
+	ResultCacheMaxEntrySize: 64 * 1024,
 	SchemaReloadTime:        30 * 60,
 	QueryTimeout:            30,
+	PositionWaitTimeout:     1,
 	TxPoolTimeout:           1,
 	IdleTimeout:             30 * 60,
 	StreamBufferSize:        32 * 1024,
@@ -110,6 +110,21 @@ func (cp *ConnPool) Get(ctx context.Context) (*DBConn, error) {
 	return r.(*DBConn), nil
 }
 
+// TryGet returns a connection if one is immediately available.
+// It returns nil with no error otherwise.
+// You must call Recycle on a non-nil DBConn once done.
+func (cp *ConnPool) TryGet() (*DBConn, error) {
+	p := cp.pool()
+	if p == nil {
+		return nil, ErrConnPoolClosed
+	}
+	r, err := p.TryGet()
+	if err != nil || r == nil {
+		return nil, err
+	}
+	return r.(*DBConn), nil
+}
+
 // Put puts a connection into the pool.
 func (cp *ConnPool) Put(conn *DBConn) {
 	p := cp.pool()
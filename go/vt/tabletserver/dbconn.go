@@ -102,6 +102,12 @@ func (dbc *DBConn) ExecOnce(ctx context.Context, query string, maxrows int, want
 	return dbc.execOnce(ctx, query, maxrows, wantfields)
 }
 
+// StreamOnce streams the results of the query, but does not retry on
+// connection errors.
+func (dbc *DBConn) StreamOnce(ctx context.Context, query string, callback func(*sqltypes.Result) error, streamBufferSize int) error {
+	return dbc.streamOnce(ctx, query, callback, streamBufferSize)
+}
+
 // Stream executes the query and streams the results.
 func (dbc *DBConn) Stream(ctx context.Context, query string, callback func(*sqltypes.Result) error, streamBufferSize int, excludeFieldNames bool) error {
 	span := trace.NewSpanFromContext(ctx)
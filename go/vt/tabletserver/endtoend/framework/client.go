@@ -57,7 +57,8 @@ func (client *QueryClient) Begin() error {
 // Commit commits the current transaction.
 func (client *QueryClient) Commit() error {
 	defer func() { client.transactionID = 0 }()
-	return client.server.Commit(client.ctx, &client.target, client.transactionID)
+	_, err := client.server.Commit(client.ctx, &client.target, client.transactionID)
+	return err
 }
 
 // Rollback rolls back the current transaction.
@@ -74,7 +75,8 @@ func (client *QueryClient) Prepare(dtid string) error {
 
 // CommitPrepared commits a prepared transaction.
 func (client *QueryClient) CommitPrepared(dtid string) error {
-	return client.server.CommitPrepared(client.ctx, &client.target, dtid)
+	_, err := client.server.CommitPrepared(client.ctx, &client.target, dtid)
+	return err
 }
 
 // RollbackPrepared rollsback a prepared transaction.
@@ -90,7 +92,8 @@ func (client *QueryClient) CreateTransaction(dtid string, participants []*queryp
 // StartCommit issues a StartCommit to TabletServer for the current transaction.
 func (client *QueryClient) StartCommit(dtid string) error {
 	defer func() { client.transactionID = 0 }()
-	return client.server.StartCommit(client.ctx, &client.target, client.transactionID, dtid)
+	_, err := client.server.StartCommit(client.ctx, &client.target, client.transactionID, dtid)
+	return err
 }
 
 // SetRollback issues a SetRollback to TabletServer.
@@ -110,10 +110,11 @@ func (q *query) Commit(ctx context.Context, request *querypb.CommitRequest) (res
 		request.EffectiveCallerId,
 		request.ImmediateCallerId,
 	)
-	if err := q.server.Commit(ctx, request.Target, request.TransactionId); err != nil {
+	position, err := q.server.Commit(ctx, request.Target, request.TransactionId)
+	if err != nil {
 		return nil, vterrors.ToGRPCError(err)
 	}
-	return &querypb.CommitResponse{}, nil
+	return &querypb.CommitResponse{Position: position}, nil
 }
 
 // Rollback is part of the queryservice.QueryServer interface
@@ -151,11 +152,12 @@ func (q *query) CommitPrepared(ctx context.Context, request *querypb.CommitPrepa
 		request.EffectiveCallerId,
 		request.ImmediateCallerId,
 	)
-	if err := q.server.CommitPrepared(ctx, request.Target, request.Dtid); err != nil {
+	position, err := q.server.CommitPrepared(ctx, request.Target, request.Dtid)
+	if err != nil {
 		return nil, vterrors.ToGRPCError(err)
 	}
 
-	return &querypb.CommitPreparedResponse{}, nil
+	return &querypb.CommitPreparedResponse{Position: position}, nil
 }
 
 // RollbackPrepared is part of the queryservice.QueryServer interface
@@ -193,11 +195,12 @@ func (q *query) StartCommit(ctx context.Context, request *querypb.StartCommitReq
 		request.EffectiveCallerId,
 		request.ImmediateCallerId,
 	)
-	if err := q.server.StartCommit(ctx, request.Target, request.TransactionId, request.Dtid); err != nil {
+	position, err := q.server.StartCommit(ctx, request.Target, request.TransactionId, request.Dtid)
+	if err != nil {
 		return nil, vterrors.ToGRPCError(err)
 	}
 
-	return &querypb.StartCommitResponse{}, nil
+	return &querypb.StartCommitResponse{Position: position}, nil
 }
 
 // SetRollback is part of the queryservice.QueryServer interface
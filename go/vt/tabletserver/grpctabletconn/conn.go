@@ -206,11 +206,11 @@ func (conn *gRPCQueryClient) Begin(ctx context.Context, target *querypb.Target)
 }
 
 // Commit commits the ongoing transaction.
-func (conn *gRPCQueryClient) Commit(ctx context.Context, target *querypb.Target, transactionID int64) error {
+func (conn *gRPCQueryClient) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (string, error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
 	if conn.cc == nil {
-		return tabletconn.ConnClosed
+		return "", tabletconn.ConnClosed
 	}
 
 	req := &querypb.CommitRequest{
@@ -219,11 +219,11 @@ func (conn *gRPCQueryClient) Commit(ctx context.Context, target *querypb.Target,
 		ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
 		TransactionId:     transactionID,
 	}
-	_, err := conn.c.Commit(ctx, req)
+	cr, err := conn.c.Commit(ctx, req)
 	if err != nil {
-		return tabletconn.TabletErrorFromGRPC(err)
+		return "", tabletconn.TabletErrorFromGRPC(err)
 	}
-	return nil
+	return cr.Position, nil
 }
 
 // Rollback rolls back the ongoing transaction.
@@ -270,11 +270,11 @@ func (conn *gRPCQueryClient) Prepare(ctx context.Context, target *querypb.Target
 }
 
 // CommitPrepared commits the prepared transaction.
-func (conn *gRPCQueryClient) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) error {
+func (conn *gRPCQueryClient) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) (string, error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
 	if conn.cc == nil {
-		return tabletconn.ConnClosed
+		return "", tabletconn.ConnClosed
 	}
 
 	req := &querypb.CommitPreparedRequest{
@@ -283,11 +283,11 @@ func (conn *gRPCQueryClient) CommitPrepared(ctx context.Context, target *querypb
 		ImmediateCallerId: callerid.ImmediateCallerIDFromContext(ctx),
 		Dtid:              dtid,
 	}
-	_, err := conn.c.CommitPrepared(ctx, req)
+	response, err := conn.c.CommitPrepared(ctx, req)
 	if err != nil {
-		return tabletconn.TabletErrorFromGRPC(err)
+		return "", tabletconn.TabletErrorFromGRPC(err)
 	}
-	return nil
+	return response.Position, nil
 }
 
 // RollbackPrepared rolls back the prepared transaction.
@@ -336,11 +336,11 @@ func (conn *gRPCQueryClient) CreateTransaction(ctx context.Context, target *quer
 
 // StartCommit atomically commits the transaction along with the
 // decision to commit the associated 2pc transaction.
-func (conn *gRPCQueryClient) StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) error {
+func (conn *gRPCQueryClient) StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (string, error) {
 	conn.mu.RLock()
 	defer conn.mu.RUnlock()
 	if conn.cc == nil {
-		return tabletconn.ConnClosed
+		return "", tabletconn.ConnClosed
 	}
 
 	req := &querypb.StartCommitRequest{
@@ -350,11 +350,11 @@ func (conn *gRPCQueryClient) StartCommit(ctx context.Context, target *querypb.Ta
 		TransactionId:     transactionID,
 		Dtid:              dtid,
 	}
-	_, err := conn.c.StartCommit(ctx, req)
+	response, err := conn.c.StartCommit(ctx, req)
 	if err != nil {
-		return tabletconn.TabletErrorFromGRPC(err)
+		return "", tabletconn.TabletErrorFromGRPC(err)
 	}
-	return nil
+	return response.Position, nil
 }
 
 // SetRollback transitions the 2pc transaction to the Rollback state.
@@ -85,6 +85,11 @@ func TestLogStatsFormatQuerySources(t *testing.T) {
 	if !strings.Contains(logStats.FmtQuerySources(), "consolidator") {
 		t.Fatalf("'consolidator' should be in formated query sources")
 	}
+
+	logStats.QuerySources |= QuerySourceResultCache
+	if !strings.Contains(logStats.FmtQuerySources(), "resultcache") {
+		t.Fatalf("'resultcache' should be in formated query sources")
+	}
 }
 
 func TestLogStatsContextHTML(t *testing.T) {
@@ -54,6 +54,8 @@ const (
 	PlanSelectStream
 	// PlanOther is for SHOW, DESCRIBE & EXPLAIN statements
 	PlanOther
+	// PlanSavepoint is for SAVEPOINT, ROLLBACK TO & RELEASE SAVEPOINT statements
+	PlanSavepoint
 	// NumPlans stores the total number of plans
 	NumPlans
 )
@@ -73,6 +75,7 @@ var planName = []string{
 	"DDL",
 	"SELECT_STREAM",
 	"OTHER",
+	"SAVEPOINT",
 }
 
 func (pt PlanType) String() string {
@@ -121,6 +124,7 @@ var tableAclRoles = map[PlanType]tableacl.Role{
 	PlanOther:          tableacl.ADMIN,
 	PlanUpsertPK:       tableacl.WRITER,
 	PlanNextval:        tableacl.WRITER,
+	PlanSavepoint:      tableacl.READER,
 }
 
 // ReasonType indicates why a query plan fails to build
@@ -232,6 +236,8 @@ func GetExecPlan(sql string, getTable TableGetter) (plan *ExecPlan, err error) {
 		return analyzeDDL(stmt, getTable), nil
 	case *sqlparser.Other:
 		return &ExecPlan{PlanID: PlanOther}, nil
+	case *sqlparser.Savepoint:
+		return &ExecPlan{PlanID: PlanSavepoint, FullQuery: GenerateFullQuery(stmt)}, nil
 	}
 	return nil, errors.New("invalid SQL")
 }
@@ -48,6 +48,7 @@ type QueryEngine struct {
 
 	// Services
 	consolidator *sync2.Consolidator
+	resultCache  *ResultCache
 	streamQList  *QueryList
 
 	// Vars
@@ -56,6 +57,13 @@ type QueryEngine struct {
 	maxResultSize    sync2.AtomicInt64
 	maxDMLRows       sync2.AtomicInt64
 	streamBufferSize sync2.AtomicInt64
+	// maxResultBytes limits the size of a single result.
+	// resultMemoryBudget limits the total size of the results
+	// held at any given time, which is tracked by resultMemoryInUse.
+	maxResultBytes         sync2.AtomicInt64
+	resultMemoryBudget     sync2.AtomicInt64
+	resultMemoryInUse      sync2.AtomicInt64
+	resultMemoryRejections sync2.AtomicInt64
 	// tableaclExemptCount count the number of accesses allowed
 	// based on membership in the superuser ACL
 	tableaclExemptCount  sync2.AtomicInt64
@@ -131,6 +139,7 @@ func NewQueryEngine(checker MySQLChecker, config Config, queryServiceStats *Quer
 
 	qe.consolidator = sync2.NewConsolidator()
 	http.Handle(config.DebugURLPrefix+"/consolidations", qe.consolidator)
+	qe.resultCache = NewResultCache(config.StatsPrefix, int64(config.ResultCacheSize), int64(config.ResultCacheMaxEntrySize), config.EnablePublishStats)
 	qe.streamQList = NewQueryList()
 
 	if config.StrictMode {
@@ -158,6 +167,8 @@ func NewQueryEngine(checker MySQLChecker, config Config, queryServiceStats *Quer
 	qe.maxResultSize = sync2.NewAtomicInt64(int64(config.MaxResultSize))
 	qe.maxDMLRows = sync2.NewAtomicInt64(int64(config.MaxDMLRows))
 	qe.streamBufferSize = sync2.NewAtomicInt64(int64(config.StreamBufferSize))
+	qe.maxResultBytes = sync2.NewAtomicInt64(int64(config.MaxResultBytes))
+	qe.resultMemoryBudget = sync2.NewAtomicInt64(int64(config.ResultMemoryBudget))
 
 	qe.accessCheckerLogger = logutil.NewThrottledLogger("accessChecker", 1*time.Second)
 
@@ -168,6 +179,10 @@ func NewQueryEngine(checker MySQLChecker, config Config, queryServiceStats *Quer
 		stats.Publish(config.StatsPrefix+"MaxResultSize", stats.IntFunc(qe.maxResultSize.Get))
 		stats.Publish(config.StatsPrefix+"MaxDMLRows", stats.IntFunc(qe.maxDMLRows.Get))
 		stats.Publish(config.StatsPrefix+"StreamBufferSize", stats.IntFunc(qe.streamBufferSize.Get))
+		stats.Publish(config.StatsPrefix+"MaxResultBytes", stats.IntFunc(qe.maxResultBytes.Get))
+		stats.Publish(config.StatsPrefix+"ResultMemoryBudget", stats.IntFunc(qe.resultMemoryBudget.Get))
+		stats.Publish(config.StatsPrefix+"ResultMemoryInUse", stats.IntFunc(qe.resultMemoryInUse.Get))
+		stats.Publish(config.StatsPrefix+"ResultMemoryRejections", stats.IntFunc(qe.resultMemoryRejections.Get))
 		stats.Publish(config.StatsPrefix+"TableACLExemptCount", stats.IntFunc(qe.tableaclExemptCount.Get))
 		tableACLAllowedName = "TableACLAllowed"
 		tableACLDeniedName = "TableACLDenied"
@@ -212,11 +227,59 @@ func (qe *QueryEngine) IsMySQLReachable() bool {
 	return true
 }
 
+// limitsResultBytes returns true if results are subject to byte limits.
+func (qe *QueryEngine) limitsResultBytes() bool {
+	return qe.maxResultBytes.Get() > 0 || qe.resultMemoryBudget.Get() > 0
+}
+
+// reserveResultMemory accounts for size bytes of results being held in
+// memory. total is the size of the entire result the bytes belong to so
+// far, which is checked against the per-query limit. The reservation
+// must be released with releaseResultMemory.
+func (qe *QueryEngine) reserveResultMemory(size, total int64) error {
+	if max := qe.maxResultBytes.Get(); max > 0 && total > max {
+		return NewTabletError(vtrpcpb.ErrorCode_RESOURCE_EXHAUSTED, "Result size exceeded %d bytes, use a streaming query instead", max)
+	}
+	inUse := qe.resultMemoryInUse.Add(size)
+	if budget := qe.resultMemoryBudget.Get(); budget > 0 && inUse > budget {
+		qe.resultMemoryInUse.Add(-size)
+		qe.resultMemoryRejections.Add(1)
+		return NewTabletError(vtrpcpb.ErrorCode_RESOURCE_EXHAUSTED, "Result memory budget of %d bytes exceeded, use a streaming query instead", budget)
+	}
+	return nil
+}
+
+// releaseResultMemory releases a reservation made by reserveResultMemory.
+func (qe *QueryEngine) releaseResultMemory(size int64) {
+	qe.resultMemoryInUse.Add(-size)
+}
+
+// releaseResultMemoryWhenDone releases a reservation made by
+// reserveResultMemory once ctx is done. The RPC servers cancel the
+// context of a request after its reply was sent, so the result stays
+// accounted for until then. If ctx can never be done, the reservation
+// is released right away.
+func (qe *QueryEngine) releaseResultMemoryWhenDone(ctx context.Context, size int64) {
+	if size == 0 {
+		return
+	}
+	done := ctx.Done()
+	if done == nil {
+		qe.releaseResultMemory(size)
+		return
+	}
+	go func() {
+		<-done
+		qe.releaseResultMemory(size)
+	}()
+}
+
 // Close must be called to shut down QueryEngine.
 // You must ensure that no more queries will be sent
 // before calling Close.
 func (qe *QueryEngine) Close() {
 	// Close in reverse order of Open.
+	qe.resultCache.Close()
 	qe.streamConnPool.Close()
 	qe.connPool.Close()
 	qe.schemaInfo.Close()
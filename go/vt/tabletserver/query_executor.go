@@ -33,6 +33,13 @@ type QueryExecutor struct {
 	logStats      *LogStats
 	qe            *QueryEngine
 	te            *TxEngine
+	// includePosition marks the transaction so that Commit returns
+	// the replication position.
+	includePosition bool
+	// resultBytes is the amount of result memory reserved by
+	// the executor. It's released at the end of Execute if it
+	// fails, and by its caller once the result was sent otherwise.
+	resultBytes int64
 }
 
 var sequenceFields = []*querypb.Field{
@@ -42,15 +49,35 @@ var sequenceFields = []*querypb.Field{
 	},
 }
 
-func addUserTableQueryStats(queryServiceStats *QueryServiceStats, ctx context.Context, tableName sqlparser.TableIdent, queryType string, duration int64) {
+func statsUsername(ctx context.Context) string {
 	username := callerid.GetPrincipal(callerid.EffectiveCallerIDFromContext(ctx))
 	if username == "" {
 		username = callerid.GetUsername(callerid.ImmediateCallerIDFromContext(ctx))
 	}
+	return username
+}
+
+func addUserTableQueryStats(queryServiceStats *QueryServiceStats, ctx context.Context, tableName sqlparser.TableIdent, queryType string, duration int64) {
+	username := statsUsername(ctx)
 	queryServiceStats.UserTableQueryCount.Add([]string{tableName.String(), username, queryType}, 1)
 	queryServiceStats.UserTableQueryTimesNs.Add([]string{tableName.String(), username, queryType}, int64(duration))
 }
 
+func addUserTableResultBytes(queryServiceStats *QueryServiceStats, ctx context.Context, tableName sqlparser.TableIdent, queryType string, size int64) {
+	queryServiceStats.UserTableResultBytes.Add([]string{tableName.String(), statsUsername(ctx), queryType}, size)
+}
+
+// resultSize returns the number of bytes held by the values of qr.
+func resultSize(qr *sqltypes.Result) int64 {
+	size := 0
+	for _, row := range qr.Rows {
+		for _, v := range row {
+			size += v.Len()
+		}
+	}
+	return int64(size)
+}
+
 // Execute performs a non-streaming query execution.
 func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 	qre.logStats.TransactionID = qre.transactionID
@@ -62,6 +89,8 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		addUserTableQueryStats(qre.qe.queryServiceStats, qre.ctx, qre.plan.TableName, "Execute", int64(duration))
 
 		if reply == nil {
+			qre.qe.releaseResultMemory(qre.resultBytes)
+			qre.resultBytes = 0
 			qre.plan.AddStats(1, duration, qre.logStats.MysqlResponseTime, 0, 1)
 			return
 		}
@@ -69,6 +98,7 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		qre.logStats.RowsAffected = int(reply.RowsAffected)
 		qre.logStats.Rows = reply.Rows
 		qre.qe.queryServiceStats.ResultStats.Add(int64(len(reply.Rows)))
+		addUserTableResultBytes(qre.qe.queryServiceStats, qre.ctx, qre.plan.TableName, "Execute", resultSize(reply))
 	}(time.Now())
 
 	if err := qre.checkPermissions(); err != nil {
@@ -89,6 +119,9 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 			return nil, err
 		}
 		defer conn.Recycle()
+		if qre.includePosition {
+			conn.IncludePosition = true
+		}
 		switch qre.plan.PlanID {
 		case planbuilder.PlanPassDML:
 			if qre.qe.strictMode.Get() != 0 {
@@ -107,7 +140,7 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 			return qre.execSQL(conn, qre.query, true)
 		case planbuilder.PlanUpsertPK:
 			return qre.execUpsertPK(conn)
-		case planbuilder.PlanSet:
+		case planbuilder.PlanSet, planbuilder.PlanSavepoint:
 			return qre.txFetch(conn, qre.plan.FullQuery, qre.bindVars, nil, false, true)
 		default:
 			return qre.execDirect(conn)
@@ -116,7 +149,7 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		switch qre.plan.PlanID {
 		case planbuilder.PlanPassSelect:
 			return qre.execSelect()
-		case planbuilder.PlanSelectLock:
+		case planbuilder.PlanSelectLock, planbuilder.PlanSavepoint:
 			return nil, NewTabletError(vtrpcpb.ErrorCode_BAD_INPUT, "Disallowed outside transaction")
 		case planbuilder.PlanSet:
 			return qre.execSet()
@@ -161,7 +194,10 @@ func (qre *QueryExecutor) Stream(excludeFieldNames bool, sendReply func(*sqltype
 	qre.qe.streamQList.Add(qd)
 	defer qre.qe.streamQList.Remove(qd)
 
-	return qre.streamFetch(conn, qre.plan.FullQuery, qre.bindVars, nil, excludeFieldNames, sendReply)
+	return qre.streamFetch(conn, qre.plan.FullQuery, qre.bindVars, nil, excludeFieldNames, func(qr *sqltypes.Result) error {
+		addUserTableResultBytes(qre.qe.queryServiceStats, qre.ctx, qre.plan.TableName, "Stream", resultSize(qr))
+		return sendReply(qr)
+	})
 }
 
 func (qre *QueryExecutor) execDmlAutoCommit() (reply *sqltypes.Result, err error) {
@@ -570,6 +606,17 @@ func (qre *QueryExecutor) qFetch(logStats *LogStats, parsedQuery *sqlparser.Pars
 	if err != nil {
 		return nil, err
 	}
+	// The generation must be fetched before the query runs, so that
+	// its result doesn't get cached if the table changes meanwhile.
+	useResultCache := qre.plan.ResultCacheable && qre.qe.resultCache.IsOpen()
+	var generation int64
+	if useResultCache {
+		if result, ok := qre.qe.resultCache.Get(string(sql), qre.plan.TableName.String()); ok {
+			logStats.QuerySources |= QuerySourceResultCache
+			return result, nil
+		}
+		generation = qre.qe.resultCache.Generation()
+	}
 	q, ok := qre.qe.consolidator.Create(string(sql))
 	if ok {
 		defer q.Broadcast()
@@ -580,7 +627,12 @@ func (qre *QueryExecutor) qFetch(logStats *LogStats, parsedQuery *sqlparser.Pars
 			q.Err = NewTabletErrorSQL(vtrpcpb.ErrorCode_INTERNAL_ERROR, err)
 		} else {
 			defer conn.Recycle()
-			q.Result, q.Err = qre.execSQL(conn, sql, false)
+			var result *sqltypes.Result
+			result, q.Err = qre.execSQL(conn, sql, false)
+			q.Result = result
+			if q.Err == nil && useResultCache {
+				qre.qe.resultCache.Set(string(sql), qre.plan.TableName.String(), generation, result)
+			}
 		}
 	} else {
 		logStats.QuerySources |= QuerySourceConsolidator
@@ -649,7 +701,81 @@ type poolConn interface {
 
 func (qre *QueryExecutor) execSQL(conn poolConn, sql string, wantfields bool) (*sqltypes.Result, error) {
 	defer qre.logStats.AddRewrittenSQL(sql, time.Now())
-	return conn.Exec(qre.ctx, sql, int(qre.qe.maxResultSize.Get()), wantfields)
+	// The rows of selects are streamed to enforce the byte limits as
+	// they come in. The other statements return no rows, or a number
+	// of them bounded by other limits, like maxDMLRows for the
+	// primary keys of DMLs.
+	if qre.plan != nil && qre.plan.PlanID.IsSelect() && qre.qe.limitsResultBytes() {
+		bufferSize := int(qre.qe.streamBufferSize.Get())
+		switch conn := conn.(type) {
+		case *DBConn:
+			return qre.execLimitedSQL(func(callback func(*sqltypes.Result) error) error {
+				return conn.Stream(qre.ctx, sql, callback, bufferSize, false)
+			}, wantfields)
+		case *TxConnection:
+			// A transaction cannot be retried on a new connection.
+			return qre.execLimitedSQL(func(callback func(*sqltypes.Result) error) error {
+				return conn.Stream(qre.ctx, sql, callback, bufferSize)
+			}, wantfields)
+		}
+	}
+	qr, err := conn.Exec(qre.ctx, sql, int(qre.qe.maxResultSize.Get()), wantfields)
+	if err != nil {
+		return nil, err
+	}
+	if qre.qe.limitsResultBytes() {
+		if err := qre.reserveResultBytes(resultSize(qr)); err != nil {
+			return nil, err
+		}
+	}
+	return qr, nil
+}
+
+// execLimitedSQL is like execSQL, but it fetches the rows in chunks with
+// stream, and fails the query as soon as the result exceeds the row or
+// byte limits: the rows it doesn't keep are still read from MySQL, but
+// dropped. The memory of every chunk is reserved before it's kept.
+func (qre *QueryExecutor) execLimitedSQL(stream func(callback func(*sqltypes.Result) error) error, wantfields bool) (*sqltypes.Result, error) {
+	maxrows := int(qre.qe.maxResultSize.Get())
+	result := &sqltypes.Result{}
+	err := stream(func(qr *sqltypes.Result) error {
+		if qr.Fields != nil {
+			if wantfields {
+				result.Fields = qr.Fields
+			}
+			return nil
+		}
+		if len(result.Rows)+len(qr.Rows) > maxrows {
+			return NewTabletError(vtrpcpb.ErrorCode_UNKNOWN_ERROR, "Row count exceeded %d", maxrows)
+		}
+		if err := qre.reserveResultBytes(resultSize(qr)); err != nil {
+			return err
+		}
+		// The stream reuses its row buffer across callbacks.
+		result.Rows = append(result.Rows, qr.Rows...)
+		return nil
+	})
+	if err != nil {
+		if terr, ok := err.(*TabletError); ok {
+			return nil, terr
+		}
+		if IsConnErr(err) {
+			return nil, NewTabletErrorSQL(vtrpcpb.ErrorCode_INTERNAL_ERROR, err)
+		}
+		return nil, NewTabletErrorSQL(vtrpcpb.ErrorCode_UNKNOWN_ERROR, err)
+	}
+	result.RowsAffected = uint64(len(result.Rows))
+	return result, nil
+}
+
+// reserveResultBytes reserves size bytes of result memory on behalf
+// of the executor.
+func (qre *QueryExecutor) reserveResultBytes(size int64) error {
+	if err := qre.qe.reserveResultMemory(size, qre.resultBytes+size); err != nil {
+		return err
+	}
+	qre.resultBytes += size
+	return nil
 }
 
 func (qre *QueryExecutor) execStreamSQL(conn *DBConn, sql string, excludeFieldNames bool, callback func(*sqltypes.Result) error) error {
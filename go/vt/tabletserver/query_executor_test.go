@@ -10,6 +10,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"golang.org/x/net/context"
 
@@ -508,6 +509,51 @@ func TestQueryExecutorPlanOtherWithinATransaction(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorPlanSavepointWithinATransaction(t *testing.T) {
+	db := setUpQueryExecutorTest()
+	query := "rollback to savepoint a"
+	db.AddQuery("rollback to a", &sqltypes.Result{})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, enableStrict, db)
+	txid := newTransaction(tsv)
+	qre := newTestQueryExecutor(ctx, tsv, query, txid)
+	defer tsv.StopService()
+	defer testCommitHelper(t, tsv, qre)
+	checkPlanID(t, planbuilder.PlanSavepoint, qre.plan.PlanID)
+	got, err := qre.Execute()
+	if err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	want := &sqltypes.Result{
+		Rows: make([][]sqltypes.Value, 0),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got: %v, want: %v", got, want)
+	}
+	wantqueries := []string{"rollback to a"}
+	gotqueries := fetchRecordedQueries(qre)
+	if !reflect.DeepEqual(gotqueries, wantqueries) {
+		t.Errorf("queries: %v, want %v", gotqueries, wantqueries)
+	}
+}
+
+func TestQueryExecutorPlanSavepointOutsideATransaction(t *testing.T) {
+	db := setUpQueryExecutorTest()
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, enableStrict, db)
+	qre := newTestQueryExecutor(ctx, tsv, "savepoint a", 0)
+	defer tsv.StopService()
+	checkPlanID(t, planbuilder.PlanSavepoint, qre.plan.PlanID)
+	_, err := qre.Execute()
+	got, ok := err.(*TabletError)
+	if !ok {
+		t.Fatalf("got: %v, want: *TabletError", err)
+	}
+	if got.ErrorCode != vtrpcpb.ErrorCode_BAD_INPUT {
+		t.Fatalf("got: %s, want: BAD_INPUT", got.ErrorCode)
+	}
+}
+
 func TestQueryExecutorPlanPassSelectWithInATransaction(t *testing.T) {
 	db := setUpQueryExecutorTest()
 	fields := []*querypb.Field{
@@ -600,6 +646,241 @@ func TestQueryExecutorPlanPassSelect(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorPlanPassSelectResultCache(t *testing.T) {
+	db := setUpQueryExecutorTest()
+	query := "select * from test_table limit 1000"
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+		Rows:   [][]sqltypes.Value{},
+	}
+	db.AddQuery(query, want)
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, enableStrict, db)
+	defer tsv.StopService()
+	tsv.SetResultCacheSize(1024 * 1024)
+	tsv.qe.resultCache.Open()
+
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	if !qre.plan.ResultCacheable {
+		t.Fatalf("plan for %s is not cacheable", query)
+	}
+	got, err := qre.Execute()
+	if err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got: %v, want: %v", got, want)
+	}
+	if qre.logStats.QuerySources&QuerySourceResultCache != 0 {
+		t.Errorf("first execution was served from the result cache")
+	}
+
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	got, err = qre.Execute()
+	if err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got: %v, want: %v", got, want)
+	}
+	if qre.logStats.QuerySources&QuerySourceResultCache == 0 {
+		t.Errorf("second execution was not served from the result cache")
+	}
+	if n := db.GetQueryCalledNum(query); n != 1 {
+		t.Errorf("query was sent to MySQL %d times, want 1", n)
+	}
+
+	tsv.qe.resultCache.Invalidate("test_table")
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	if _, err := qre.Execute(); err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if n := db.GetQueryCalledNum(query); n != 2 {
+		t.Errorf("query was sent to MySQL %d times after invalidation, want 2", n)
+	}
+}
+
+func TestQueryExecutorPlanPassSelectMaxResultBytes(t *testing.T) {
+	db := setUpQueryExecutorTest()
+	query := "select * from test_table limit 1000"
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+		Rows: [][]sqltypes.Value{
+			{
+				sqltypes.MakeTrusted(sqltypes.Int32, []byte("1")),
+				sqltypes.MakeTrusted(sqltypes.Int32, []byte("20")),
+				sqltypes.MakeTrusted(sqltypes.Int32, []byte("300")),
+			},
+		},
+		RowsAffected: 1,
+	}
+	db.AddQuery(query, want)
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, enableStrict, db)
+	defer tsv.StopService()
+
+	tsv.SetMaxResultBytes(6)
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	got, err := qre.Execute()
+	if err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got: %v, want: %v", got, want)
+	}
+	// The result stays reserved until the reply was sent.
+	if inUse := tsv.qe.resultMemoryInUse.Get(); inUse != 6 {
+		t.Errorf("resultMemoryInUse: %d, want 6", inUse)
+	}
+	tsv.qe.releaseResultMemory(qre.resultBytes)
+
+	tsv.SetMaxResultBytes(5)
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err = qre.Execute()
+	wantErr := "tx_pool_full: Result size exceeded 5 bytes, use a streaming query instead"
+	if err == nil || err.Error() != wantErr {
+		t.Errorf("qre.Execute() = %v, want %s", err, wantErr)
+	}
+	if inUse := tsv.qe.resultMemoryInUse.Get(); inUse != 0 {
+		t.Errorf("resultMemoryInUse: %d, want 0", inUse)
+	}
+}
+
+func TestQueryExecutorResultMemoryBudget(t *testing.T) {
+	db := setUpQueryExecutorTest()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{
+		Fields: getTestTableFields(),
+		Rows: [][]sqltypes.Value{
+			{
+				sqltypes.MakeTrusted(sqltypes.Int32, []byte("1")),
+				sqltypes.MakeTrusted(sqltypes.Int32, []byte("20")),
+				sqltypes.MakeTrusted(sqltypes.Int32, []byte("300")),
+			},
+		},
+		RowsAffected: 1,
+	})
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, enableStrict, db)
+	defer tsv.StopService()
+
+	tsv.SetResultMemoryBudget(10)
+	// Simulate another query holding most of the budget.
+	if err := tsv.qe.reserveResultMemory(5, 5); err != nil {
+		t.Fatal(err)
+	}
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err := qre.Execute()
+	wantErr := "tx_pool_full: Result memory budget of 10 bytes exceeded, use a streaming query instead"
+	if err == nil || err.Error() != wantErr {
+		t.Errorf("qre.Execute() = %v, want %s", err, wantErr)
+	}
+	if got := tsv.qe.resultMemoryRejections.Get(); got != 1 {
+		t.Errorf("resultMemoryRejections: %d, want 1", got)
+	}
+
+	tsv.qe.releaseResultMemory(5)
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	if _, err := qre.Execute(); err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if inUse := tsv.qe.resultMemoryInUse.Get(); inUse != 6 {
+		t.Errorf("resultMemoryInUse: %d, want 6", inUse)
+	}
+
+	// The budget is freed once the request is done.
+	reqCtx, cancel := context.WithCancel(ctx)
+	tsv.qe.releaseResultMemoryWhenDone(reqCtx, qre.resultBytes)
+	if inUse := tsv.qe.resultMemoryInUse.Get(); inUse != 6 {
+		t.Errorf("resultMemoryInUse: %d, want 6", inUse)
+	}
+	cancel()
+	for i := 0; tsv.qe.resultMemoryInUse.Get() != 0; i++ {
+		if i == 100 {
+			t.Fatalf("resultMemoryInUse: %d, want 0", tsv.qe.resultMemoryInUse.Get())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestQueryExecutorNoResultLimits(t *testing.T) {
+	db := setUpQueryExecutorTest()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{
+		Fields: getTestTableFields(),
+		Rows: [][]sqltypes.Value{
+			{
+				sqltypes.MakeTrusted(sqltypes.Int32, []byte("1")),
+				sqltypes.MakeTrusted(sqltypes.Int32, []byte("20")),
+				sqltypes.MakeTrusted(sqltypes.Int32, []byte("300")),
+			},
+		},
+		RowsAffected: 1,
+	})
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, enableStrict, db)
+	defer tsv.StopService()
+
+	// Without limits, the results are not accounted for.
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	if _, err := qre.Execute(); err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	if qre.resultBytes != 0 {
+		t.Errorf("resultBytes: %d, want 0", qre.resultBytes)
+	}
+	if inUse := tsv.qe.resultMemoryInUse.Get(); inUse != 0 {
+		t.Errorf("resultMemoryInUse: %d, want 0", inUse)
+	}
+}
+
+func TestQueryExecutorMaxResultBytesInTransaction(t *testing.T) {
+	db := setUpQueryExecutorTest()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{
+		Fields: getTestTableFields(),
+		Rows: [][]sqltypes.Value{
+			{
+				sqltypes.MakeTrusted(sqltypes.Int32, []byte("1")),
+				sqltypes.MakeTrusted(sqltypes.Int32, []byte("20")),
+				sqltypes.MakeTrusted(sqltypes.Int32, []byte("300")),
+			},
+		},
+		RowsAffected: 1,
+	})
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, enableStrict, db)
+	defer tsv.StopService()
+
+	tsv.SetMaxResultBytes(5)
+	txid := newTransaction(tsv)
+	defer tsv.Rollback(ctx, &tsv.target, txid)
+	qre := newTestQueryExecutor(ctx, tsv, query, txid)
+	_, err := qre.Execute()
+	wantErr := "tx_pool_full: Result size exceeded 5 bytes, use a streaming query instead"
+	if err == nil || err.Error() != wantErr {
+		t.Errorf("qre.Execute() = %v, want %s", err, wantErr)
+	}
+	if inUse := tsv.qe.resultMemoryInUse.Get(); inUse != 0 {
+		t.Errorf("resultMemoryInUse: %d, want 0", inUse)
+	}
+}
+
 func TestQueryExecutorPlanSet(t *testing.T) {
 	db := setUpQueryExecutorTest()
 	setQuery := "set unknown_key = 1"
@@ -1220,7 +1501,7 @@ func newTestQueryExecutor(ctx context.Context, tsv *TabletServer, sql string, tx
 }
 
 func testCommitHelper(t *testing.T, tsv *TabletServer, queryExecutor *QueryExecutor) {
-	if err := tsv.Commit(queryExecutor.ctx, &tsv.target, queryExecutor.transactionID); err != nil {
+	if _, err := tsv.Commit(queryExecutor.ctx, &tsv.target, queryExecutor.transactionID); err != nil {
 		t.Fatalf("failed to commit transaction: %d, err: %v", queryExecutor.transactionID, err)
 	}
 }
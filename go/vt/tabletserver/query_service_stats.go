@@ -32,6 +32,8 @@ type QueryServiceStats struct {
 	UserTableQueryCount *stats.MultiCounters
 	// UserTableQueryTimesNs shows total latency for each CallerID/table combination.
 	UserTableQueryTimesNs *stats.MultiCounters
+	// UserTableResultBytes shows the number of bytes returned for each CallerID/table combination.
+	UserTableResultBytes *stats.MultiCounters
 	// UserTransactionCount shows number of transactions received for each CallerID.
 	UserTransactionCount *stats.MultiCounters
 	// UserTransactionTimesNs shows total transaction latency for each CallerID.
@@ -56,6 +58,7 @@ func NewQueryServiceStats(statsPrefix string, enablePublishStats bool) *QuerySer
 	resultStatsName := ""
 	userTableQueryCountName := ""
 	userTableQueryTimesNsName := ""
+	userTableResultBytesName := ""
 	userTransactionCountName := ""
 	userTransactionTimesNsName := ""
 	if enablePublishStats {
@@ -71,6 +74,7 @@ func NewQueryServiceStats(statsPrefix string, enablePublishStats bool) *QuerySer
 		resultStatsName = statsPrefix + "Results"
 		userTableQueryCountName = statsPrefix + "UserTableQueryCount"
 		userTableQueryTimesNsName = statsPrefix + "UserTableQueryTimesNs"
+		userTableResultBytesName = statsPrefix + "UserTableResultBytes"
 		userTransactionCountName = statsPrefix + "UserTransactionCount"
 		userTransactionTimesNsName = statsPrefix + "UserTransactionTimesNs"
 	}
@@ -89,6 +93,8 @@ func NewQueryServiceStats(statsPrefix string, enablePublishStats bool) *QuerySer
 			userTableQueryCountName, []string{"TableName", "CallerID", "Type"}),
 		UserTableQueryTimesNs: stats.NewMultiCounters(
 			userTableQueryTimesNsName, []string{"TableName", "CallerID", "Type"}),
+		UserTableResultBytes: stats.NewMultiCounters(
+			userTableResultBytesName, []string{"TableName", "CallerID", "Type"}),
 		UserTransactionCount: stats.NewMultiCounters(
 			userTransactionCountName, []string{"CallerID", "Conclusion"}),
 		UserTransactionTimesNs: stats.NewMultiCounters(
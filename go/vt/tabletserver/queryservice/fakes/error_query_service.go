@@ -25,8 +25,8 @@ func (e *ErrorQueryService) Begin(ctx context.Context, target *querypb.Target) (
 }
 
 // Commit is part of QueryService interface
-func (e *ErrorQueryService) Commit(ctx context.Context, target *querypb.Target, transactionID int64) error {
-	return fmt.Errorf("ErrorQueryService does not implement any method")
+func (e *ErrorQueryService) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (string, error) {
+	return "", fmt.Errorf("ErrorQueryService does not implement any method")
 }
 
 // Rollback is part of QueryService interface
@@ -40,8 +40,8 @@ func (e *ErrorQueryService) Prepare(ctx context.Context, target *querypb.Target,
 }
 
 // CommitPrepared is part of QueryService interface
-func (e *ErrorQueryService) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) (err error) {
-	return fmt.Errorf("ErrorQueryService does not implement any method")
+func (e *ErrorQueryService) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) (string, error) {
+	return "", fmt.Errorf("ErrorQueryService does not implement any method")
 }
 
 // RollbackPrepared is part of QueryService interface
@@ -55,8 +55,8 @@ func (e *ErrorQueryService) CreateTransaction(ctx context.Context, target *query
 }
 
 // StartCommit is part of QueryService interface
-func (e *ErrorQueryService) StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (err error) {
-	return fmt.Errorf("ErrorQueryService does not implement any method")
+func (e *ErrorQueryService) StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (string, error) {
+	return "", fmt.Errorf("ErrorQueryService does not implement any method")
 }
 
 // SetRollback is part of QueryService interface
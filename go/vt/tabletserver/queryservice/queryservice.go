@@ -22,8 +22,10 @@ type QueryService interface {
 	// Begin returns the transaction id to use for further operations
 	Begin(ctx context.Context, target *querypb.Target) (int64, error)
 
-	// Commit commits the current transaction
-	Commit(ctx context.Context, target *querypb.Target, transactionID int64) error
+	// Commit commits the current transaction. It returns the
+	// replication position after the commit if the include_position
+	// option was set on the statements of the transaction.
+	Commit(ctx context.Context, target *querypb.Target, transactionID int64) (position string, err error)
 
 	// Rollback aborts the current transaction
 	Rollback(ctx context.Context, target *querypb.Target, transactionID int64) error
@@ -31,8 +33,10 @@ type QueryService interface {
 	// Prepare prepares the specified transaction.
 	Prepare(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (err error)
 
-	// CommitPrepared commits the prepared transaction.
-	CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) (err error)
+	// CommitPrepared commits the prepared transaction. Like Commit,
+	// it returns the replication position after the commit if the
+	// include_position option was set.
+	CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) (position string, err error)
 
 	// RollbackPrepared rolls back the prepared transaction.
 	RollbackPrepared(ctx context.Context, target *querypb.Target, dtid string, originalID int64) (err error)
@@ -41,8 +45,10 @@ type QueryService interface {
 	CreateTransaction(ctx context.Context, target *querypb.Target, dtid string, participants []*querypb.Target) (err error)
 
 	// StartCommit atomically commits the transaction along with the
-	// decision to commit the associated 2pc transaction.
-	StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (err error)
+	// decision to commit the associated 2pc transaction. Like Commit,
+	// it returns the replication position after the commit if the
+	// include_position option was set.
+	StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (position string, err error)
 
 	// SetRollback transitions the 2pc transaction to the Rollback state.
 	// If a transaction id is provided, that transaction is also rolled back.
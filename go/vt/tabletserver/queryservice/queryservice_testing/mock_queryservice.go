@@ -43,10 +43,11 @@ func (_mr *_MockQueryServiceRecorder) Begin(arg0, arg1 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "Begin", arg0, arg1)
 }
 
-func (_m *MockQueryService) Commit(ctx context.Context, target *query.Target, transactionID int64) error {
+func (_m *MockQueryService) Commit(ctx context.Context, target *query.Target, transactionID int64) (string, error) {
 	ret := _m.ctrl.Call(_m, "Commit", ctx, target, transactionID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 func (_mr *_MockQueryServiceRecorder) Commit(arg0, arg1, arg2 interface{}) *gomock.Call {
@@ -73,10 +74,11 @@ func (_mr *_MockQueryServiceRecorder) Prepare(arg0, arg1, arg2, arg3 interface{}
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "Prepare", arg0, arg1, arg2, arg3)
 }
 
-func (_m *MockQueryService) CommitPrepared(ctx context.Context, target *query.Target, dtid string) error {
+func (_m *MockQueryService) CommitPrepared(ctx context.Context, target *query.Target, dtid string) (string, error) {
 	ret := _m.ctrl.Call(_m, "CommitPrepared", ctx, target, dtid)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 func (_mr *_MockQueryServiceRecorder) CommitPrepared(arg0, arg1, arg2 interface{}) *gomock.Call {
@@ -103,10 +105,11 @@ func (_mr *_MockQueryServiceRecorder) CreateTransaction(arg0, arg1, arg2, arg3 i
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "CreateTransaction", arg0, arg1, arg2, arg3)
 }
 
-func (_m *MockQueryService) StartCommit(ctx context.Context, target *query.Target, transactionID int64, dtid string) error {
+func (_m *MockQueryService) StartCommit(ctx context.Context, target *query.Target, transactionID int64, dtid string) (string, error) {
 	ret := _m.ctrl.Call(_m, "StartCommit", ctx, target, transactionID, dtid)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 func (_mr *_MockQueryServiceRecorder) StartCommit(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
@@ -53,6 +53,23 @@ var (
 			<td>{{.ErrorsPQ}}</td>
 		</tr>
 	`))
+	txPartitionzHeader = []byte(`<thead>
+		<tr>
+			<th>Transaction Partition</th>
+			<th>Capacity</th>
+			<th>In Use</th>
+			<th>Overflows</th>
+		</tr>
+        </thead>
+	`)
+	txPartitionzTmpl = template.Must(template.New("example").Parse(`
+		<tr class="{{.Color}}">
+			<td>{{.Name}}</td>
+			<td>{{.Capacity}}</td>
+			<td>{{.InUse}}</td>
+			<td>{{.Overflows}}</td>
+		</tr>
+	`))
 )
 
 // queryzRow is used for rendering query stats
@@ -115,13 +132,16 @@ func (s *queryzSorter) Len() int           { return len(s.rows) }
 func (s *queryzSorter) Swap(i, j int)      { s.rows[i], s.rows[j] = s.rows[j], s.rows[i] }
 func (s *queryzSorter) Less(i, j int) bool { return s.less(s.rows[i], s.rows[j]) }
 
-func queryzHandler(si *SchemaInfo, w http.ResponseWriter, r *http.Request) {
+func queryzHandler(si *SchemaInfo, partitions []TxPartitionStats, w http.ResponseWriter, r *http.Request) {
 	if err := acl.CheckAccessHTTP(r, acl.DEBUGGING); err != nil {
 		acl.SendError(w, err)
 		return
 	}
 	logz.StartHTMLTable(w)
 	defer logz.EndHTMLTable(w)
+	if len(partitions) != 0 {
+		writeTxPartitionz(w, partitions)
+	}
 	w.Write(queryzHeader)
 
 	keys := si.queries.Keys()
@@ -163,3 +183,29 @@ func queryzHandler(si *SchemaInfo, w http.ResponseWriter, r *http.Request) {
 		}
 	}
 }
+
+// writeTxPartitionz renders the usage of the transaction pool partitions
+// as a separate table, and starts a new table for what follows.
+func writeTxPartitionz(w http.ResponseWriter, partitions []TxPartitionStats) {
+	w.Write(txPartitionzHeader)
+	for _, ps := range partitions {
+		var color string
+		switch {
+		case ps.InUse*2 < ps.Capacity:
+			color = "low"
+		case ps.InUse < ps.Capacity:
+			color = "medium"
+		default:
+			color = "high"
+		}
+		tmplData := struct {
+			TxPartitionStats
+			Color string
+		}{ps, color}
+		if err := txPartitionzTmpl.Execute(w, tmplData); err != nil {
+			log.Errorf("queryz: couldn't execute template: %v", err)
+		}
+	}
+	logz.EndHTMLTable(w)
+	w.Write([]byte(`<br><table class="gridtable">`))
+}
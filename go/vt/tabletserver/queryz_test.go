@@ -53,7 +53,7 @@ func TestQueryzHandler(t *testing.T) {
 	schemaInfo.queries.Set("show tables", plan3)
 	schemaInfo.queries.Set("", (*ExecPlan)(nil))
 
-	queryzHandler(schemaInfo, resp, req)
+	queryzHandler(schemaInfo, nil, resp, req)
 	body, _ := ioutil.ReadAll(resp.Body)
 	planPattern1 := []string{
 		`<tr class="high">`,
@@ -108,6 +108,36 @@ func TestQueryzHandler(t *testing.T) {
 	checkQueryzHasPlan(t, planPattern3, plan3, body)
 }
 
+func TestQueryzHandlerWithPartitions(t *testing.T) {
+	resp := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/queryz", nil)
+	schemaInfo := newTestSchemaInfo(100, 10*time.Second, 10*time.Second, false)
+	partitions := []TxPartitionStats{
+		{Name: TxSharedPartition, Capacity: 10, InUse: 1},
+		{Name: "batch", Capacity: 2, InUse: 2, Overflows: 3},
+	}
+	queryzHandler(schemaInfo, partitions, resp, req)
+	body, _ := ioutil.ReadAll(resp.Body)
+	for _, pattern := range [][]string{{
+		`<tr class="low">`,
+		`<td>shared</td>`,
+		`<td>10</td>`,
+		`<td>1</td>`,
+		`<td>0</td>`,
+	}, {
+		`<tr class="high">`,
+		`<td>batch</td>`,
+		`<td>2</td>`,
+		`<td>2</td>`,
+		`<td>3</td>`,
+	}} {
+		matcher := regexp.MustCompile(strings.Join(pattern, `\s*`))
+		if !matcher.Match(body) {
+			t.Errorf("queryz page does not contain partition %v, page: %s", pattern, body)
+		}
+	}
+}
+
 func checkQueryzHasPlan(t *testing.T, planPattern []string, plan *ExecPlan, page []byte) {
 	matcher := regexp.MustCompile(strings.Join(planPattern, `\s*`))
 	if !matcher.Match(page) {
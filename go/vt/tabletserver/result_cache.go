@@ -0,0 +1,268 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabletserver
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/youtube/vitess/go/cache"
+	"github.com/youtube/vitess/go/sqltypes"
+	"github.com/youtube/vitess/go/stats"
+	"github.com/youtube/vitess/go/sync2"
+	"github.com/youtube/vitess/go/vt/sqlparser"
+)
+
+// nonDeterministicFuncs are the functions that make the result
+// of a select depend on something else than the data of its table.
+var nonDeterministicFuncs = map[string]bool{
+	"connection_id":     true,
+	"curdate":           true,
+	"current_date":      true,
+	"current_time":      true,
+	"current_timestamp": true,
+	"current_user":      true,
+	"curtime":           true,
+	"database":          true,
+	"found_rows":        true,
+	"get_lock":          true,
+	"is_free_lock":      true,
+	"is_used_lock":      true,
+	"last_insert_id":    true,
+	"localtime":         true,
+	"localtimestamp":    true,
+	"now":               true,
+	"rand":              true,
+	"release_lock":      true,
+	"row_count":         true,
+	"schema":            true,
+	"session_user":      true,
+	"sleep":             true,
+	"sysdate":           true,
+	"system_user":       true,
+	"unix_timestamp":    true,
+	"user":              true,
+	"utc_date":          true,
+	"utc_time":          true,
+	"utc_timestamp":     true,
+	"uuid":              true,
+	"uuid_short":        true,
+}
+
+// ResultCache caches the results of selects outside of transactions
+// on REPLICA and RDONLY tablets. Results are keyed on the final SQL
+// sent to MySQL, which is the normalized query with its bind variables
+// substituted.
+//
+// The data of such tablets only changes through replication, so the
+// cache is only opened while the replication stream is watched. Every
+// change the stream shows invalidates the cached results of the table
+// it affects. Invalidations don't go through the entries: each table
+// has a generation that is bumped on every change, and entries
+// cached under an older generation are treated as misses. Since
+// callers fetch the generation before running the query, a result
+// that was computed while a change was in flight is never served.
+type ResultCache struct {
+	mu         sync.Mutex
+	isOpen     bool
+	generation int64
+	// tableGenerations and clearGeneration record the generation
+	// of the last change to each table and to all tables.
+	tableGenerations map[string]int64
+	clearGeneration  int64
+
+	results      *cache.LRUCache
+	maxEntrySize sync2.AtomicInt64
+
+	hits          *stats.Counters
+	misses        *stats.Counters
+	invalidations *stats.Counters
+}
+
+type resultCacheEntry struct {
+	table      string
+	generation int64
+	result     *sqltypes.Result
+	size       int
+}
+
+// Size is part of the cache.Value interface.
+func (entry *resultCacheEntry) Size() int {
+	return entry.size
+}
+
+// NewResultCache creates a new ResultCache. Its capacity and
+// maxEntrySize are in bytes. The cache stays disabled until Open
+// is called, and while its capacity is 0.
+func NewResultCache(statsPrefix string, capacity, maxEntrySize int64, enablePublishStats bool) *ResultCache {
+	rc := &ResultCache{
+		tableGenerations: make(map[string]int64),
+		results:          cache.NewLRUCache(capacity),
+		maxEntrySize:     sync2.NewAtomicInt64(maxEntrySize),
+	}
+	hitsName := ""
+	missesName := ""
+	invalidationsName := ""
+	if enablePublishStats {
+		hitsName = statsPrefix + "ResultCacheHits"
+		missesName = statsPrefix + "ResultCacheMisses"
+		invalidationsName = statsPrefix + "ResultCacheInvalidations"
+		stats.Publish(statsPrefix+"ResultCacheCapacity", stats.IntFunc(rc.results.Capacity))
+		stats.Publish(statsPrefix+"ResultCacheSize", stats.IntFunc(rc.results.Size))
+		stats.Publish(statsPrefix+"ResultCacheLength", stats.IntFunc(rc.results.Length))
+		stats.Publish(statsPrefix+"ResultCacheMaxEntrySize", stats.IntFunc(rc.maxEntrySize.Get))
+	}
+	rc.hits = stats.NewCounters(hitsName)
+	rc.misses = stats.NewCounters(missesName)
+	rc.invalidations = stats.NewCounters(invalidationsName)
+	return rc
+}
+
+// Open enables the cache. It must only be called while something
+// reports the changes to the data through Invalidate.
+func (rc *ResultCache) Open() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.isOpen = true
+}
+
+// Close disables the cache and drops all the cached results.
+func (rc *ResultCache) Close() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.isOpen = false
+	rc.clearLocked()
+}
+
+// IsOpen returns true if the cache is enabled and has a non-zero capacity.
+func (rc *ResultCache) IsOpen() bool {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return rc.isOpen && rc.results.Capacity() > 0
+}
+
+// Generation returns the current generation. It must be fetched
+// before running the query whose result is passed to Set.
+func (rc *ResultCache) Generation() int64 {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return rc.generation
+}
+
+// Get returns the cached result for the query, if any. The result
+// is shared and must not be modified.
+func (rc *ResultCache) Get(query, table string) (*sqltypes.Result, bool) {
+	v, ok := rc.results.Get(query)
+	if ok {
+		entry := v.(*resultCacheEntry)
+		if rc.isCurrent(entry.table, entry.generation) {
+			rc.hits.Add(table, 1)
+			return entry.result, true
+		}
+		rc.results.Delete(query)
+	}
+	rc.misses.Add(table, 1)
+	return nil, false
+}
+
+// Set caches the result of the query, which only reads from table.
+// generation is the value Generation returned before the query was
+// run. The result is ignored if the cache is disabled, if it's too
+// big, or if the table changed since generation.
+func (rc *ResultCache) Set(query, table string, generation int64, result *sqltypes.Result) {
+	size := int64(len(query)) + resultSize(result)
+	if max := rc.maxEntrySize.Get(); max > 0 && size > max {
+		return
+	}
+	if !rc.IsOpen() || !rc.isCurrent(table, generation) {
+		return
+	}
+	rc.results.Set(query, &resultCacheEntry{
+		table:      table,
+		generation: generation,
+		result:     result,
+		size:       int(size),
+	})
+}
+
+// Invalidate drops the cached results of the tables.
+func (rc *ResultCache) Invalidate(tables ...string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.generation++
+	for _, table := range tables {
+		rc.tableGenerations[strings.ToLower(table)] = rc.generation
+		rc.invalidations.Add(table, 1)
+	}
+}
+
+// Clear drops all the cached results. It must be called when the
+// changes to some tables can't be identified.
+func (rc *ResultCache) Clear() {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.clearLocked()
+}
+
+func (rc *ResultCache) clearLocked() {
+	rc.generation++
+	rc.clearGeneration = rc.generation
+	rc.tableGenerations = make(map[string]int64)
+	rc.results.Clear()
+}
+
+func (rc *ResultCache) isCurrent(table string, generation int64) bool {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return generation >= rc.clearGeneration && generation >= rc.tableGenerations[strings.ToLower(table)]
+}
+
+// SetCapacity changes the capacity of the cache, in bytes.
+// A capacity of 0 disables the cache.
+func (rc *ResultCache) SetCapacity(capacity int64) {
+	rc.results.SetCapacity(capacity)
+}
+
+// Capacity returns the capacity of the cache, in bytes.
+func (rc *ResultCache) Capacity() int64 {
+	return rc.results.Capacity()
+}
+
+// SetMaxEntrySize changes the size of the biggest result that can be cached.
+func (rc *ResultCache) SetMaxEntrySize(maxEntrySize int64) {
+	rc.maxEntrySize.Set(maxEntrySize)
+}
+
+// MaxEntrySize returns the size of the biggest result that can be cached.
+func (rc *ResultCache) MaxEntrySize() int64 {
+	return rc.maxEntrySize.Get()
+}
+
+// isResultCacheable returns true if the result of the select can
+// be cached: it must only read from its own table, and must not
+// use functions whose value changes without the data changing.
+func isResultCacheable(sql string) bool {
+	statement, err := sqlparser.Parse(sql)
+	if err != nil {
+		return false
+	}
+	sel, ok := statement.(*sqlparser.Select)
+	if !ok {
+		return false
+	}
+	cacheable := true
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.Subquery:
+			cacheable = false
+		case *sqlparser.FuncExpr:
+			if nonDeterministicFuncs[node.Name.Lowered()] {
+				cacheable = false
+			}
+		}
+		return cacheable, nil
+	}, sel)
+	return cacheable
+}
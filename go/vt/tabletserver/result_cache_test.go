@@ -0,0 +1,158 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tabletserver
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/youtube/vitess/go/sqltypes"
+)
+
+func newTestResultCache() *ResultCache {
+	rc := NewResultCache("", 1024*1024, 0, false)
+	rc.Open()
+	return rc
+}
+
+func testResult(value string) *sqltypes.Result {
+	return &sqltypes.Result{
+		Rows: [][]sqltypes.Value{{
+			sqltypes.MakeTrusted(sqltypes.VarChar, []byte(value)),
+		}},
+		RowsAffected: 1,
+	}
+}
+
+func TestResultCacheGetSet(t *testing.T) {
+	rc := newTestResultCache()
+	query := "select * from a where id = 1"
+	if _, ok := rc.Get(query, "a"); ok {
+		t.Fatalf("Get(%s) found a result in an empty cache", query)
+	}
+	want := testResult("1")
+	rc.Set(query, "a", rc.Generation(), want)
+	got, ok := rc.Get(query, "a")
+	if !ok {
+		t.Fatalf("Get(%s) found no result", query)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Get(%s): %v, want %v", query, got, want)
+	}
+	if hits := rc.hits.Counts()["a"]; hits != 1 {
+		t.Errorf("hits: %d, want 1", hits)
+	}
+	if misses := rc.misses.Counts()["a"]; misses != 1 {
+		t.Errorf("misses: %d, want 1", misses)
+	}
+}
+
+func TestResultCacheInvalidate(t *testing.T) {
+	rc := newTestResultCache()
+	rc.Set("select * from a", "a", rc.Generation(), testResult("a"))
+	rc.Set("select * from b", "b", rc.Generation(), testResult("b"))
+
+	rc.Invalidate("A")
+	if _, ok := rc.Get("select * from a", "a"); ok {
+		t.Errorf("result of a is still cached after its invalidation")
+	}
+	if _, ok := rc.Get("select * from b", "b"); !ok {
+		t.Errorf("result of b was dropped by the invalidation of a")
+	}
+
+	// A result computed before an invalidation must not be cached.
+	generation := rc.Generation()
+	rc.Invalidate("a")
+	rc.Set("select * from a", "a", generation, testResult("a"))
+	if _, ok := rc.Get("select * from a", "a"); ok {
+		t.Errorf("stale result of a was cached")
+	}
+	rc.Set("select * from a", "a", rc.Generation(), testResult("a"))
+	if _, ok := rc.Get("select * from a", "a"); !ok {
+		t.Errorf("result of a was not cached")
+	}
+	if count := rc.invalidations.Counts()["a"]; count != 1 {
+		t.Errorf("invalidations of a: %d, want 1", count)
+	}
+}
+
+func TestResultCacheClear(t *testing.T) {
+	rc := newTestResultCache()
+	generation := rc.Generation()
+	rc.Set("select * from a", "a", generation, testResult("a"))
+	rc.Clear()
+	if _, ok := rc.Get("select * from a", "a"); ok {
+		t.Errorf("result of a is still cached after Clear")
+	}
+	rc.Set("select * from a", "a", generation, testResult("a"))
+	if _, ok := rc.Get("select * from a", "a"); ok {
+		t.Errorf("result computed before Clear was cached")
+	}
+}
+
+func TestResultCacheMaxEntrySize(t *testing.T) {
+	rc := newTestResultCache()
+	rc.SetMaxEntrySize(10)
+	rc.Set("select * from a", "a", rc.Generation(), testResult("a"))
+	if _, ok := rc.Get("select * from a", "a"); ok {
+		t.Errorf("result bigger than the max entry size was cached")
+	}
+	rc.SetMaxEntrySize(0)
+	rc.Set("select * from a", "a", rc.Generation(), testResult("a"))
+	if _, ok := rc.Get("select * from a", "a"); !ok {
+		t.Errorf("result was not cached without a max entry size")
+	}
+}
+
+func TestResultCacheOpenClose(t *testing.T) {
+	rc := NewResultCache("", 1024*1024, 0, false)
+	if rc.IsOpen() {
+		t.Errorf("new cache is open")
+	}
+	rc.Set("select * from a", "a", rc.Generation(), testResult("a"))
+	if _, ok := rc.Get("select * from a", "a"); ok {
+		t.Errorf("closed cache stored a result")
+	}
+	rc.Open()
+	if !rc.IsOpen() {
+		t.Errorf("cache is not open after Open")
+	}
+	rc.Set("select * from a", "a", rc.Generation(), testResult("a"))
+	rc.Close()
+	if rc.IsOpen() {
+		t.Errorf("cache is open after Close")
+	}
+	if _, ok := rc.Get("select * from a", "a"); ok {
+		t.Errorf("result is still cached after Close")
+	}
+
+	rc.Open()
+	rc.SetCapacity(0)
+	if rc.IsOpen() {
+		t.Errorf("cache without capacity is open")
+	}
+}
+
+func TestIsResultCacheable(t *testing.T) {
+	testcases := []struct {
+		sql  string
+		want bool
+	}{
+		{"select * from a where id = 1", true},
+		{"select count(*) from a", true},
+		{"select id, concat(name, 'x') from a order by id limit 10", true},
+		{"select now() from a", false},
+		{"select * from a where ts > NOW()", false},
+		{"select rand() from a", false},
+		{"select * from a where id in (select id from b)", false},
+		{"insert into a values (1)", false},
+		{"not a query", false},
+	}
+	for _, tcase := range testcases {
+		if got := isResultCacheable(tcase.sql); got != tcase.want {
+			t.Errorf("isResultCacheable(%s): %v, want %v", tcase.sql, got, tcase.want)
+		}
+	}
+}
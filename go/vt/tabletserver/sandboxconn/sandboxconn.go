@@ -39,6 +39,7 @@ type SandboxConn struct {
 	MustFailPermissionDenied int
 	MustFailTransientError   int
 	MustFailUnauthenticated  int
+	MustFailResultSize       int
 
 	// These errors are triggered only for specific functions.
 	// For now these are just for the 2PC functions.
@@ -84,6 +85,10 @@ type SandboxConn struct {
 	// ReadTransactionResults is used for returning results for ReadTransaction.
 	ReadTransactionResults []*querypb.TransactionMetadata
 
+	// CommitPosition is returned by Commit, CommitPrepared and
+	// StartCommit.
+	CommitPosition string
+
 	// transaction id generator
 	TransactionID sync2.AtomicInt64
 }
@@ -186,6 +191,13 @@ func (sbc *SandboxConn) getError() error {
 			ServerCode: vtrpcpb.ErrorCode_UNAUTHENTICATED,
 		}
 	}
+	if sbc.MustFailResultSize > 0 {
+		sbc.MustFailResultSize--
+		return &tabletconn.ServerError{
+			Err:        "tx_pool_full: Result size exceeded 10 bytes, use a streaming query instead",
+			ServerCode: vtrpcpb.ErrorCode_RESOURCE_EXHAUSTED,
+		}
+	}
 
 	return nil
 }
@@ -275,9 +287,12 @@ func (sbc *SandboxConn) Begin(ctx context.Context, target *querypb.Target) (int6
 }
 
 // Commit is part of the TabletConn interface.
-func (sbc *SandboxConn) Commit(ctx context.Context, target *querypb.Target, transactionID int64) error {
+func (sbc *SandboxConn) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (string, error) {
 	sbc.CommitCount.Add(1)
-	return sbc.getError()
+	if err := sbc.getError(); err != nil {
+		return "", err
+	}
+	return sbc.CommitPosition, nil
 }
 
 // Rollback is part of the TabletConn interface.
@@ -300,16 +315,19 @@ func (sbc *SandboxConn) Prepare(ctx context.Context, target *querypb.Target, tra
 }
 
 // CommitPrepared commits the prepared transaction.
-func (sbc *SandboxConn) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) (err error) {
+func (sbc *SandboxConn) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) (string, error) {
 	sbc.CommitPreparedCount.Add(1)
 	if sbc.MustFailCommitPrepared > 0 {
 		sbc.MustFailCommitPrepared--
-		return &tabletconn.ServerError{
+		return "", &tabletconn.ServerError{
 			Err:        "error: err",
 			ServerCode: vtrpcpb.ErrorCode_QUERY_NOT_SERVED,
 		}
 	}
-	return sbc.getError()
+	if err := sbc.getError(); err != nil {
+		return "", err
+	}
+	return sbc.CommitPosition, nil
 }
 
 // RollbackPrepared rolls back the prepared transaction.
@@ -340,16 +358,19 @@ func (sbc *SandboxConn) CreateTransaction(ctx context.Context, target *querypb.T
 
 // StartCommit atomically commits the transaction along with the
 // decision to commit the associated 2pc transaction.
-func (sbc *SandboxConn) StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (err error) {
+func (sbc *SandboxConn) StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (string, error) {
 	sbc.StartCommitCount.Add(1)
 	if sbc.MustFailStartCommit > 0 {
 		sbc.MustFailStartCommit--
-		return &tabletconn.ServerError{
+		return "", &tabletconn.ServerError{
 			Err:        "error: err",
 			ServerCode: vtrpcpb.ErrorCode_QUERY_NOT_SERVED,
 		}
 	}
-	return sbc.getError()
+	if err := sbc.getError(); err != nil {
+		return "", err
+	}
+	return sbc.CommitPosition, nil
 }
 
 // SetRollback transitions the 2pc transaction to the Rollback state.
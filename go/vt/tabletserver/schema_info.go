@@ -50,6 +50,9 @@ type ExecPlan struct {
 	Fields     []*querypb.Field
 	Rules      *QueryRules
 	Authorized *tableacl.ACLResult
+	// ResultCacheable is true if the results of the plan
+	// can be served from the ResultCache.
+	ResultCacheable bool
 
 	mu         sync.Mutex
 	QueryCount int64
@@ -438,6 +441,9 @@ func (si *SchemaInfo) GetPlan(ctx context.Context, logStats *LogStats, sql strin
 	plan := &ExecPlan{ExecPlan: splan, TableInfo: tableInfo}
 	plan.Rules = si.queryRuleSources.filterByPlan(sql, plan.PlanID, plan.TableName.String())
 	plan.Authorized = tableacl.Authorized(plan.TableName.String(), plan.PlanID.MinRole())
+	if plan.PlanID == planbuilder.PlanPassSelect && !plan.TableName.IsEmpty() {
+		plan.ResultCacheable = isResultCacheable(sql)
+	}
 	if plan.PlanID.IsSelect() {
 		if plan.FieldQuery == nil {
 			log.Warningf("Cannot cache field info: %s", sql)
@@ -0,0 +1,172 @@
+package splitquery
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	"github.com/youtube/vitess/go/vt/schema"
+	"github.com/youtube/vitess/go/vt/sqlparser"
+	"github.com/youtube/vitess/go/vt/tabletserver/querytypes"
+)
+
+const (
+	// samplesPerQueryPart is the number of sampled rows the algorithm aims to get for each
+	// query part. More samples give query parts whose sizes are closer to each other.
+	samplesPerQueryPart int64 = 20
+	// maxSampleSize bounds the total number of sampled rows, so that the sample query stays
+	// well below the tablet's max result size.
+	maxSampleSize int64 = 5000
+)
+
+// SamplingAlgorithm implements the SplitAlgorithmInterface and represents the sampling
+// algorithm for generating the boundary tuples. Unlike the EqualSplitsAlgorithm, it does not
+// assume that the values of the split columns are uniformly distributed, and unlike the
+// FullScanAlgorithm it only reads the table once. It works as follows:
+// It executes the following query over the replica's database:
+//    SELECT <split_columns> FROM <table>
+//                           WHERE RAND() < <sample_rate>
+//                           ORDER BY <split_columns>
+// where <sample_rate> is chosen so that the query is expected to return about
+// samplesPerQueryPart rows for each query part (and no more than maxSampleSize rows overall),
+// based on the approximate number of rows in the table. The returned rows are a random sample
+// of the table ordered by the split columns, so taking every (sample_size/split_count)-th row
+// of the sample yields boundary tuples that split the table into parts with roughly the same
+// number of rows, even if the data is skewed.
+// If the sample rate would be at least 1, the WHERE clause is omitted and every row is
+// returned.
+// If the table has no statistics (its approximate number of rows is 0), the sample rate can't
+// be computed. The WHERE clause is then replaced with a LIMIT of maxSampleSize+1 rows: if the
+// table turns out to have more than maxSampleSize rows, generateBoundaries fails instead of
+// reading the whole table.
+//
+// The split columns are not required to be unique: duplicate boundary tuples are dropped, which
+// may result in fewer query parts than requested.
+type SamplingAlgorithm struct {
+	splitParams *SplitParams
+	sqlExecuter SQLExecuter
+
+	sampleQuery *querytypes.BoundQuery
+	// sampleLimit is the LIMIT of sampleQuery, or 0 if it has none.
+	sampleLimit int64
+}
+
+// NewSamplingAlgorithm constructs a new SamplingAlgorithm.
+func NewSamplingAlgorithm(
+	splitParams *SplitParams, sqlExecuter SQLExecuter) (*SamplingAlgorithm, error) {
+
+	if len(splitParams.splitColumns) == 0 {
+		panic(fmt.Sprintf("len(splitParams.splitColumns) == 0." +
+			" SplitParams should have defaulted the split columns to the primary key columns."))
+	}
+	if splitParams.splitCount <= 0 {
+		return nil, fmt.Errorf("using the SAMPLING algorithm in SplitQuery requires a positive"+
+			" splitParams.splitCount. Got: %v", splitParams.splitCount)
+	}
+	sampleQuery, sampleLimit := buildSampleQuery(splitParams)
+	return &SamplingAlgorithm{
+		splitParams: splitParams,
+		sqlExecuter: sqlExecuter,
+		sampleQuery: sampleQuery,
+		sampleLimit: sampleLimit,
+	}, nil
+}
+
+// getSplitColumns is part of the SplitAlgorithmInterface interface
+func (a *SamplingAlgorithm) getSplitColumns() []*schema.TableColumn {
+	return a.splitParams.splitColumns
+}
+
+func (a *SamplingAlgorithm) generateBoundaries() ([]tuple, error) {
+	result := []tuple{}
+	if a.splitParams.splitCount <= 1 {
+		return result, nil
+	}
+	sqlResult, err := a.sqlExecuter.SQLExecute(a.sampleQuery.Sql, a.sampleQuery.BindVariables)
+	if err != nil {
+		return nil, err
+	}
+	numSamples := int64(len(sqlResult.Rows))
+	if a.sampleLimit != 0 && numSamples >= a.sampleLimit {
+		return nil, fmt.Errorf("splitquery: the SAMPLING algorithm requires the statistics of"+
+			" table %v, which has more than %v rows but no statistics. Run ANALYZE TABLE or use"+
+			" another algorithm", a.splitParams.splitTableSchema.Name, maxSampleSize)
+	}
+	for i := int64(1); i < a.splitParams.splitCount; i++ {
+		index := i * numSamples / a.splitParams.splitCount
+		if index >= numSamples {
+			break
+		}
+		boundary := tuple(sqlResult.Rows[index])
+		if len(boundary) != len(a.splitParams.splitColumns) {
+			panic(fmt.Sprintf("splitquery.SamplingAlgorithm: expected a tuple of length %v."+
+				" Got tuple: %v", len(a.splitParams.splitColumns), boundary))
+		}
+		if len(result) > 0 && tuplesEqual(result[len(result)-1], boundary) {
+			continue
+		}
+		result = append(result, boundary)
+	}
+	return result, nil
+}
+
+// buildSampleQuery returns the query to execute to get the sample of the split columns.
+// If the query to split (given in splitParams.sql) is
+//    "SELECT <select exprs> FROM <table> WHERE <where>",
+// the Sql field of the result will be:
+// "SELECT sc_1,sc_2,...,sc_n FROM <table>
+//                            WHERE RAND() < <sample_rate>
+//                            ORDER BY <split_columns>",
+// or, if the table has no statistics:
+// "SELECT sc_1,sc_2,...,sc_n FROM <table>
+//                            ORDER BY <split_columns>
+//                            LIMIT <maxSampleSize+1>",
+// in which case the limit is returned too.
+// The BindVariables field of the result will contain a deep-copy of splitParams.BindVariables.
+func buildSampleQuery(splitParams *SplitParams) (*querytypes.BoundQuery, int64) {
+	if splitParams.selectAST.Limit != nil {
+		panic(fmt.Sprintf(
+			"splitquery.buildSampleQuery(): splitParams query already has a LIMIT clause: %v",
+			*splitParams))
+	}
+	resultSelectAST := *splitParams.selectAST
+	resultSelectAST.Where = nil
+	resultSelectAST.SelectExprs = convertColumnsToSelectExprs(splitParams.splitColumns)
+	resultSelectAST.OrderBy = buildOrderByClause(splitParams.splitColumns)
+	sampleSize := splitParams.splitCount * samplesPerQueryPart
+	if sampleSize > maxSampleSize {
+		sampleSize = maxSampleSize
+	}
+	var limit int64
+	switch tableRows := splitParams.splitTableSchema.TableRows.Get(); {
+	case tableRows <= 0:
+		limit = maxSampleSize + 1
+		resultSelectAST.Limit = &sqlparser.Limit{
+			Rowcount: sqlparser.NumVal(strconv.FormatInt(limit, 10)),
+		}
+	case sampleSize < tableRows:
+		sampleRate := float64(sampleSize) / float64(tableRows)
+		addAndTermToWhereClause(&resultSelectAST, &sqlparser.ComparisonExpr{
+			Operator: sqlparser.LessThanStr,
+			Left:     &sqlparser.FuncExpr{Name: sqlparser.NewColIdent("rand")},
+			Right:    sqlparser.NumVal(strconv.FormatFloat(sampleRate, 'f', -1, 64)),
+		})
+	}
+	return &querytypes.BoundQuery{
+		Sql:           sqlparser.String(&resultSelectAST),
+		BindVariables: cloneBindVariables(splitParams.bindVariables),
+	}, limit
+}
+
+// tuplesEqual returns true if the two given tuples hold the same values.
+func tuplesEqual(t1, t2 tuple) bool {
+	if len(t1) != len(t2) {
+		return false
+	}
+	for i := range t1 {
+		if t1[i].Type() != t2[i].Type() || !bytes.Equal(t1[i].Raw(), t2[i].Raw()) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,200 @@
+package splitquery
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/youtube/vitess/go/sqltypes"
+	"github.com/youtube/vitess/go/vt/sqlparser"
+	"github.com/youtube/vitess/go/vt/tabletserver/splitquery/splitquery_testing"
+)
+
+func TestSamplingBoundaries(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	splitParams, err := NewSplitParamsGivenSplitCount(
+		"select * from test_table where int_col > 5",
+		nil, /* bindVariables */
+		[]sqlparser.ColIdent{
+			sqlparser.NewColIdent("id"),
+			sqlparser.NewColIdent("user_id"),
+		}, /* splitColumns */
+		4,
+		getTestSchema(),
+	)
+	if err != nil {
+		t.Fatalf("NewSplitParamsGivenSplitCount failed with: %v", err)
+	}
+	mockSQLExecuter := splitquery_testing.NewMockSQLExecuter(mockCtrl)
+	// The samples are skewed towards small ids, and the second quarter starts
+	// on a duplicate of the first boundary.
+	mockSQLExecuter.EXPECT().SQLExecute(
+		"select id, user_id from test_table"+
+			" where rand() < 0.08"+
+			" order by id asc, user_id asc",
+		map[string]interface{}{}).Return(
+		&sqltypes.Result{
+			Rows: [][]sqltypes.Value{
+				{int64Value(1), int64Value(1)},
+				{int64Value(1), int64Value(2)},
+				{int64Value(2), int64Value(1)},
+				{int64Value(2), int64Value(1)},
+				{int64Value(2), int64Value(1)},
+				{int64Value(3), int64Value(7)},
+				{int64Value(4), int64Value(1)},
+				{int64Value(1000), int64Value(5)},
+			},
+		},
+		nil)
+
+	algorithm, err := NewSamplingAlgorithm(splitParams, mockSQLExecuter)
+	if err != nil {
+		t.Fatalf("NewSamplingAlgorithm failed with: %v", err)
+	}
+	boundaries, err := algorithm.generateBoundaries()
+	if err != nil {
+		t.Fatalf("SamplingAlgorithm.generateBoundaries() failed with: %v", err)
+	}
+	expectedBoundaries := []tuple{
+		{int64Value(2), int64Value(1)},
+		{int64Value(4), int64Value(1)},
+	}
+	if !reflect.DeepEqual(expectedBoundaries, boundaries) {
+		t.Fatalf("expected: %v, got: %v", expectedBoundaries, boundaries)
+	}
+}
+
+func TestSamplingWholeTable(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	splitParams, err := NewSplitParamsGivenNumRowsPerQueryPart(
+		"select * from test_table where int_col > 5",
+		nil, /* bindVariables */
+		[]sqlparser.ColIdent{
+			sqlparser.NewColIdent("id"),
+		}, /* splitColumns */
+		20,
+		getTestSchema(),
+	)
+	if err != nil {
+		t.Fatalf("NewSplitParamsGivenNumRowsPerQueryPart failed with: %v", err)
+	}
+	mockSQLExecuter := splitquery_testing.NewMockSQLExecuter(mockCtrl)
+	// The table has 1000 rows and we split it into 50 parts, so the
+	// sample would be the whole table anyway. With fewer samples than query
+	// parts, every sample is a boundary.
+	mockSQLExecuter.EXPECT().SQLExecute(
+		"select id from test_table order by id asc",
+		map[string]interface{}{}).Return(
+		&sqltypes.Result{
+			Rows: [][]sqltypes.Value{
+				{int64Value(1)},
+				{int64Value(2)},
+				{int64Value(3)},
+			},
+		},
+		nil)
+
+	algorithm, err := NewSamplingAlgorithm(splitParams, mockSQLExecuter)
+	if err != nil {
+		t.Fatalf("NewSamplingAlgorithm failed with: %v", err)
+	}
+	boundaries, err := algorithm.generateBoundaries()
+	if err != nil {
+		t.Fatalf("SamplingAlgorithm.generateBoundaries() failed with: %v", err)
+	}
+	expectedBoundaries := []tuple{{int64Value(1)}, {int64Value(2)}, {int64Value(3)}}
+	if !reflect.DeepEqual(expectedBoundaries, boundaries) {
+		t.Fatalf("expected: %v, got: %v", expectedBoundaries, boundaries)
+	}
+}
+
+func TestSamplingEmptyTable(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	splitParams, err := NewSplitParamsGivenSplitCount(
+		"select * from test_table",
+		nil, /* bindVariables */
+		[]sqlparser.ColIdent{
+			sqlparser.NewColIdent("id"),
+		}, /* splitColumns */
+		10,
+		getTestSchema(),
+	)
+	if err != nil {
+		t.Fatalf("NewSplitParamsGivenSplitCount failed with: %v", err)
+	}
+	mockSQLExecuter := splitquery_testing.NewMockSQLExecuter(mockCtrl)
+	mockSQLExecuter.EXPECT().SQLExecute(
+		"select id from test_table where rand() < 0.2 order by id asc",
+		map[string]interface{}{}).Return(&sqltypes.Result{Rows: [][]sqltypes.Value{}}, nil)
+
+	algorithm, err := NewSamplingAlgorithm(splitParams, mockSQLExecuter)
+	if err != nil {
+		t.Fatalf("NewSamplingAlgorithm failed with: %v", err)
+	}
+	boundaries, err := algorithm.generateBoundaries()
+	if err != nil {
+		t.Fatalf("SamplingAlgorithm.generateBoundaries() failed with: %v", err)
+	}
+	if len(boundaries) != 0 {
+		t.Fatalf("expected no boundaries, got: %v", boundaries)
+	}
+}
+
+func TestSamplingNoTableStatistics(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	schema := getTestSchema()
+	schema["test_table"].TableRows.Set(0)
+	splitParams, err := NewSplitParamsGivenSplitCount(
+		"select * from test_table",
+		nil, /* bindVariables */
+		[]sqlparser.ColIdent{
+			sqlparser.NewColIdent("id"),
+		}, /* splitColumns */
+		2,
+		schema,
+	)
+	if err != nil {
+		t.Fatalf("NewSplitParamsGivenSplitCount failed with: %v", err)
+	}
+	mockSQLExecuter := splitquery_testing.NewMockSQLExecuter(mockCtrl)
+	sampleQuery := "select id from test_table order by id asc limit 5001"
+
+	// A small table is read up to the limit.
+	mockSQLExecuter.EXPECT().SQLExecute(sampleQuery, map[string]interface{}{}).Return(
+		&sqltypes.Result{
+			Rows: [][]sqltypes.Value{
+				{int64Value(1)},
+				{int64Value(2)},
+				{int64Value(3)},
+				{int64Value(4)},
+			},
+		},
+		nil)
+	algorithm, err := NewSamplingAlgorithm(splitParams, mockSQLExecuter)
+	if err != nil {
+		t.Fatalf("NewSamplingAlgorithm failed with: %v", err)
+	}
+	boundaries, err := algorithm.generateBoundaries()
+	if err != nil {
+		t.Fatalf("SamplingAlgorithm.generateBoundaries() failed with: %v", err)
+	}
+	expectedBoundaries := []tuple{{int64Value(3)}}
+	if !reflect.DeepEqual(expectedBoundaries, boundaries) {
+		t.Fatalf("expected: %v, got: %v", expectedBoundaries, boundaries)
+	}
+
+	// A table that reaches the limit fails, as the sample would be skewed.
+	rows := make([][]sqltypes.Value, 5001)
+	for i := range rows {
+		rows[i] = []sqltypes.Value{int64Value(int64(i))}
+	}
+	mockSQLExecuter.EXPECT().SQLExecute(sampleQuery, map[string]interface{}{}).Return(
+		&sqltypes.Result{Rows: rows}, nil)
+	if _, err := algorithm.generateBoundaries(); err == nil {
+		t.Fatalf("SamplingAlgorithm.generateBoundaries() succeeded for a table without statistics over the limit")
+	}
+}
@@ -105,13 +105,13 @@ type TabletConn interface {
 
 	// Transaction support
 	Begin(ctx context.Context, target *querypb.Target) (transactionID int64, err error)
-	Commit(ctx context.Context, target *querypb.Target, transactionID int64) error
+	Commit(ctx context.Context, target *querypb.Target, transactionID int64) (position string, err error)
 	Rollback(ctx context.Context, target *querypb.Target, transactionID int64) error
 	Prepare(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) error
-	CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) error
+	CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) (position string, err error)
 	RollbackPrepared(ctx context.Context, target *querypb.Target, dtid string, originalID int64) error
 	CreateTransaction(ctx context.Context, target *querypb.Target, dtid string, participants []*querypb.Target) error
-	StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) error
+	StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (position string, err error)
 	SetRollback(ctx context.Context, target *querypb.Target, dtid string, transactionID int64) error
 	ConcludeTransaction(ctx context.Context, target *querypb.Target, dtid string) error
 	ReadTransaction(ctx context.Context, target *querypb.Target, dtid string) (metadata *querypb.TransactionMetadata, err error)
@@ -77,6 +77,8 @@ var TestExecuteOptions = &querypb.ExecuteOptions{
 		Shard:     "ssss",
 		Position:  "pppp",
 	},
+	WaitForPosition: "wwww",
+	IncludePosition: true,
 }
 
 const TestAsTransaction bool = true
@@ -121,10 +123,14 @@ func (f *FakeQueryService) Begin(ctx context.Context, target *querypb.Target) (i
 
 const CommitTransactionID int64 = 999044
 
+// CommitPosition is the position returned by Commit, CommitPrepared
+// and StartCommit.
+const CommitPosition = "MariaDB/1-2-3"
+
 // Commit is part of the queryservice.QueryService interface
-func (f *FakeQueryService) Commit(ctx context.Context, target *querypb.Target, transactionID int64) error {
+func (f *FakeQueryService) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (string, error) {
 	if f.HasError {
-		return f.TabletError
+		return "", f.TabletError
 	}
 	if f.Panics {
 		panic(fmt.Errorf("test-triggered panic"))
@@ -133,7 +139,7 @@ func (f *FakeQueryService) Commit(ctx context.Context, target *querypb.Target, t
 	if transactionID != CommitTransactionID {
 		f.t.Errorf("Commit: invalid TransactionId: got %v expected %v", transactionID, CommitTransactionID)
 	}
-	return nil
+	return CommitPosition, nil
 }
 
 const RollbackTransactionID int64 = 999044
@@ -174,9 +180,9 @@ func (f *FakeQueryService) Prepare(ctx context.Context, target *querypb.Target,
 }
 
 // CommitPrepared is part of the queryservice.QueryService interface
-func (f *FakeQueryService) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) (err error) {
+func (f *FakeQueryService) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) (string, error) {
 	if f.HasError {
-		return f.TabletError
+		return "", f.TabletError
 	}
 	if f.Panics {
 		panic(fmt.Errorf("test-triggered panic"))
@@ -185,7 +191,7 @@ func (f *FakeQueryService) CommitPrepared(ctx context.Context, target *querypb.T
 	if dtid != Dtid {
 		f.t.Errorf("CommitPrepared: invalid dtid: got %s expected %s", dtid, Dtid)
 	}
-	return nil
+	return CommitPosition, nil
 }
 
 // RollbackPrepared is part of the queryservice.QueryService interface
@@ -233,9 +239,9 @@ func (f *FakeQueryService) CreateTransaction(ctx context.Context, target *queryp
 }
 
 // StartCommit is part of the queryservice.QueryService interface
-func (f *FakeQueryService) StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (err error) {
+func (f *FakeQueryService) StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (string, error) {
 	if f.HasError {
-		return f.TabletError
+		return "", f.TabletError
 	}
 	if f.Panics {
 		panic(fmt.Errorf("test-triggered panic"))
@@ -247,7 +253,7 @@ func (f *FakeQueryService) StartCommit(ctx context.Context, target *querypb.Targ
 	if dtid != Dtid {
 		f.t.Errorf("StartCommit: invalid dtid: got %s expected %s", dtid, Dtid)
 	}
-	return nil
+	return CommitPosition, nil
 }
 
 // SetRollback is part of the queryservice.QueryService interface
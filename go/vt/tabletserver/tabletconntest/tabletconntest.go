@@ -121,17 +121,21 @@ func testCommit(t *testing.T, conn tabletconn.TabletConn, f *FakeQueryService) {
 	t.Log("testCommit")
 	ctx := context.Background()
 	ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
-	err := conn.Commit(ctx, TestTarget, CommitTransactionID)
+	position, err := conn.Commit(ctx, TestTarget, CommitTransactionID)
 	if err != nil {
 		t.Fatalf("Commit failed: %v", err)
 	}
+	if position != CommitPosition {
+		t.Errorf("Commit returned position %v, want %v", position, CommitPosition)
+	}
 }
 
 func testCommitError(t *testing.T, conn tabletconn.TabletConn, f *FakeQueryService) {
 	t.Log("testCommitError")
 	f.HasError = true
 	testErrorHelper(t, f, "Commit", func(ctx context.Context) error {
-		return conn.Commit(ctx, TestTarget, CommitTransactionID)
+		_, err := conn.Commit(ctx, TestTarget, CommitTransactionID)
+		return err
 	})
 	f.HasError = false
 }
@@ -139,7 +143,8 @@ func testCommitError(t *testing.T, conn tabletconn.TabletConn, f *FakeQueryServi
 func testCommitPanics(t *testing.T, conn tabletconn.TabletConn, f *FakeQueryService) {
 	t.Log("testCommitPanics")
 	testPanicHelper(t, f, "Commit", func(ctx context.Context) error {
-		return conn.Commit(ctx, TestTarget, CommitTransactionID)
+		_, err := conn.Commit(ctx, TestTarget, CommitTransactionID)
+		return err
 	})
 }
 
@@ -199,17 +204,21 @@ func testCommitPrepared(t *testing.T, conn tabletconn.TabletConn, f *FakeQuerySe
 	t.Log("testCommitPrepared")
 	ctx := context.Background()
 	ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
-	err := conn.CommitPrepared(ctx, TestTarget, Dtid)
+	position, err := conn.CommitPrepared(ctx, TestTarget, Dtid)
 	if err != nil {
 		t.Fatalf("CommitPrepared failed: %v", err)
 	}
+	if position != CommitPosition {
+		t.Errorf("CommitPrepared returned position %v, want %v", position, CommitPosition)
+	}
 }
 
 func testCommitPreparedError(t *testing.T, conn tabletconn.TabletConn, f *FakeQueryService) {
 	t.Log("testCommitPreparedError")
 	f.HasError = true
 	testErrorHelper(t, f, "CommitPrepared", func(ctx context.Context) error {
-		return conn.CommitPrepared(ctx, TestTarget, Dtid)
+		_, err := conn.CommitPrepared(ctx, TestTarget, Dtid)
+		return err
 	})
 	f.HasError = false
 }
@@ -217,7 +226,8 @@ func testCommitPreparedError(t *testing.T, conn tabletconn.TabletConn, f *FakeQu
 func testCommitPreparedPanics(t *testing.T, conn tabletconn.TabletConn, f *FakeQueryService) {
 	t.Log("testCommitPreparedPanics")
 	testPanicHelper(t, f, "CommitPrepared", func(ctx context.Context) error {
-		return conn.CommitPrepared(ctx, TestTarget, Dtid)
+		_, err := conn.CommitPrepared(ctx, TestTarget, Dtid)
+		return err
 	})
 }
 
@@ -277,17 +287,21 @@ func testStartCommit(t *testing.T, conn tabletconn.TabletConn, f *FakeQueryServi
 	t.Log("testStartCommit")
 	ctx := context.Background()
 	ctx = callerid.NewContext(ctx, TestCallerID, TestVTGateCallerID)
-	err := conn.StartCommit(ctx, TestTarget, CommitTransactionID, Dtid)
+	position, err := conn.StartCommit(ctx, TestTarget, CommitTransactionID, Dtid)
 	if err != nil {
 		t.Fatalf("StartCommit failed: %v", err)
 	}
+	if position != CommitPosition {
+		t.Errorf("StartCommit returned position %v, want %v", position, CommitPosition)
+	}
 }
 
 func testStartCommitError(t *testing.T, conn tabletconn.TabletConn, f *FakeQueryService) {
 	t.Log("testStartCommitError")
 	f.HasError = true
 	testErrorHelper(t, f, "StartCommit", func(ctx context.Context) error {
-		return conn.StartCommit(ctx, TestTarget, CommitTransactionID, Dtid)
+		_, err := conn.StartCommit(ctx, TestTarget, CommitTransactionID, Dtid)
+		return err
 	})
 	f.HasError = false
 }
@@ -295,7 +309,8 @@ func testStartCommitError(t *testing.T, conn tabletconn.TabletConn, f *FakeQuery
 func testStartCommitPanics(t *testing.T, conn tabletconn.TabletConn, f *FakeQueryService) {
 	t.Log("testStartCommitPanics")
 	testPanicHelper(t, f, "StartCommit", func(ctx context.Context) error {
-		return conn.StartCommit(ctx, TestTarget, CommitTransactionID, Dtid)
+		_, err := conn.StartCommit(ctx, TestTarget, CommitTransactionID, Dtid)
+		return err
 	})
 }
 
@@ -37,7 +37,6 @@ import (
 	"github.com/youtube/vitess/go/vt/utils"
 	"golang.org/x/net/context"
 
-	binlogdatapb "github.com/youtube/vitess/go/vt/proto/binlogdata"
 	querypb "github.com/youtube/vitess/go/vt/proto/query"
 	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
 	vtrpcpb "github.com/youtube/vitess/go/vt/proto/vtrpc"
@@ -131,6 +130,13 @@ type TabletServer struct {
 	// UpdateStream helpers.
 	updateStreamCancel context.CancelFunc
 
+	// resultCacheMutex protects replicationStreams, the number of
+	// replication streamers that are reading the binlogs. The result
+	// cache is only open while there is one and the tablet is not a
+	// master. It must not be acquired while holding mu.
+	resultCacheMutex   sync.Mutex
+	replicationStreams int
+
 	// eventTokenMutex protects the current EventToken
 	eventTokenMutex sync.RWMutex
 	eventToken      *querypb.EventToken
@@ -430,6 +436,7 @@ func (tsv *TabletServer) serveNewType() (err error) {
 			err = x.(error)
 		}
 	}()
+	tsv.updateResultCache()
 	if tsv.target.TabletType == topodatapb.TabletType_MASTER {
 		tsv.te.Open(tsv.dbconfigs)
 	} else {
@@ -598,7 +605,7 @@ func (tsv *TabletServer) QueryServiceStats() *QueryServiceStats {
 }
 
 func (tsv *TabletServer) startReplicationStreamer() {
-	if !*watchReplicationStream {
+	if !*watchReplicationStream || tsv.updateStreamCancel != nil {
 		return
 	}
 	ctx, cancel := context.WithCancel(context.Background())
@@ -607,6 +614,33 @@ func (tsv *TabletServer) startReplicationStreamer() {
 	go tsv.replicationStreamer(ctx)
 }
 
+// addReplicationStream adds delta to the number of replication
+// streamers that are reading the binlogs, and opens or closes the
+// result cache accordingly.
+func (tsv *TabletServer) addReplicationStream(delta int) {
+	tsv.resultCacheMutex.Lock()
+	tsv.replicationStreams += delta
+	tsv.resultCacheMutex.Unlock()
+	tsv.updateResultCache()
+}
+
+// updateResultCache opens the result cache if a replication streamer
+// reads the binlogs and the tablet is not a master, and closes it
+// otherwise. The cache must never be used by a master, whose data
+// changes before its replication stream shows it.
+func (tsv *TabletServer) updateResultCache() {
+	tsv.resultCacheMutex.Lock()
+	defer tsv.resultCacheMutex.Unlock()
+	tsv.mu.Lock()
+	isMaster := tsv.target.TabletType == topodatapb.TabletType_MASTER
+	tsv.mu.Unlock()
+	if tsv.replicationStreams > 0 && !isMaster {
+		tsv.qe.resultCache.Open()
+	} else {
+		tsv.qe.resultCache.Close()
+	}
+}
+
 func (tsv *TabletServer) stopReplicationStreamer() {
 	if tsv.updateStreamCancel != nil {
 		tsv.updateStreamCancel()
@@ -616,23 +650,40 @@ func (tsv *TabletServer) stopReplicationStreamer() {
 
 // replicationStreamer is the background thread that reads the
 // replication stream. It will run in a loop. If it errors out, it
-// will wait for 5 seconds before restarting.
+// will wait for 5 seconds before restarting. The result cache is
+// only open while the stream is read, as it relies on the stream
+// to invalidate the results of the tables that change.
 func (tsv *TabletServer) replicationStreamer(ctx context.Context) {
 	for {
 		log.Infof("Starting a binlog Streamer from current replication position to monitor binlogs")
-		streamer := binlog.NewStreamer(tsv.dbconfigs.App.DbName, tsv.mysqld, nil /*clientCharset*/, replication.Position{}, 0 /*timestamp*/, func(trans *binlogdatapb.BinlogTransaction) error {
+		streamer := binlog.NewEventStreamer(tsv.dbconfigs.App.DbName, tsv.mysqld, replication.Position{}, 0 /*timestamp*/, func(event *querypb.StreamEvent) error {
 			// Save the event token.
 			tsv.eventTokenMutex.Lock()
-			tsv.eventToken = trans.EventToken
+			tsv.eventToken = event.EventToken
 			tsv.eventTokenMutex.Unlock()
 
-			// If it's a DDL, trigger a schema reload.
+			// Invalidate the cached results of the tables that changed.
+			// If a change can't be tied to a table, drop all of them.
+			// If it's a DDL, also trigger a schema reload.
 			isDDL := false
-			for _, statement := range trans.Statements {
-				if statement.Category == binlogdatapb.BinlogTransaction_Statement_BL_DDL {
+			clearResultCache := false
+			var tables []string
+			for _, statement := range event.Statements {
+				switch statement.Category {
+				case querypb.StreamEvent_Statement_DML:
+					tables = append(tables, statement.TableName)
+				case querypb.StreamEvent_Statement_DDL:
 					isDDL = true
+					clearResultCache = true
+				default:
+					clearResultCache = true
 				}
 			}
+			if clearResultCache {
+				tsv.qe.resultCache.Clear()
+			} else if len(tables) != 0 {
+				tsv.qe.resultCache.Invalidate(tables...)
+			}
 			if isDDL {
 				err := tsv.ReloadSchema(ctx)
 				log.Infof("Streamer triggered a schema reload, with result: %v", err)
@@ -641,7 +692,18 @@ func (tsv *TabletServer) replicationStreamer(ctx context.Context) {
 			return nil
 		})
 
-		if err := streamer.Stream(ctx); err != nil {
+		// The streamer starts at the current position, so every
+		// change is invalidated once it reads the binlogs.
+		started := false
+		streamer.SetStartedCallback(func() {
+			started = true
+			tsv.addReplicationStream(1)
+		})
+		err := streamer.Stream(ctx)
+		if started {
+			tsv.addReplicationStream(-1)
+		}
+		if err != nil {
 			log.Infof("Streamer stopped: %v", err)
 		}
 
@@ -654,6 +716,9 @@ func (tsv *TabletServer) replicationStreamer(ctx context.Context) {
 }
 
 // Begin starts a new transaction. This is allowed only if the state is StateServing.
+// Begin has no query, so no priority comment: the transaction belongs to the
+// partition of its effective caller, if any, or else to the shared pool. Use
+// BeginExecute or BeginExecuteBatch to select a partition by priority.
 func (tsv *TabletServer) Begin(ctx context.Context, target *querypb.Target) (transactionID int64, err error) {
 	err = tsv.execRequest(
 		ctx, tsv.BeginTimeout.Get(),
@@ -669,18 +734,32 @@ func (tsv *TabletServer) Begin(ctx context.Context, target *querypb.Target) (tra
 	return transactionID, err
 }
 
-// Commit commits the specified transaction.
-func (tsv *TabletServer) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (err error) {
-	return tsv.execRequest(
+// Commit commits the specified transaction. If the include_position
+// option was set on its statements, it returns the replication position
+// after the commit.
+func (tsv *TabletServer) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (position string, err error) {
+	err = tsv.execRequest(
 		ctx, tsv.QueryTimeout.Get(),
 		"Commit", "commit", nil,
 		target, true, true,
 		func(ctx context.Context, logStats *LogStats) error {
 			defer tsv.qe.queryServiceStats.QueryStats.Record("COMMIT", time.Now())
 			logStats.TransactionID = transactionID
-			return tsv.te.txPool.Commit(ctx, transactionID)
+			conn, err := tsv.te.txPool.Get(transactionID, "for commit")
+			if err != nil {
+				return err
+			}
+			includePosition := conn.IncludePosition
+			if err := tsv.te.txPool.LocalCommit(ctx, conn); err != nil {
+				return err
+			}
+			if includePosition {
+				position = tsv.replicationPosition()
+			}
+			return nil
 		},
 	)
+	return position, err
 }
 
 // Rollback rollsback the specified transaction.
@@ -714,9 +793,11 @@ func (tsv *TabletServer) Prepare(ctx context.Context, target *querypb.Target, tr
 	)
 }
 
-// CommitPrepared commits the prepared transaction.
-func (tsv *TabletServer) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) (err error) {
-	return tsv.execRequest(
+// CommitPrepared commits the prepared transaction. Like Commit, it
+// returns the replication position after the commit if the
+// include_position option was set on the statements of the transaction.
+func (tsv *TabletServer) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) (position string, err error) {
+	err = tsv.execRequest(
 		ctx, tsv.QueryTimeout.Get(),
 		"CommitPrepared", "commit_prepared", nil,
 		target, true, true,
@@ -726,9 +807,17 @@ func (tsv *TabletServer) CommitPrepared(ctx context.Context, target *querypb.Tar
 				logStats: logStats,
 				te:       tsv.te,
 			}
-			return txe.CommitPrepared(dtid)
+			includePosition, err := txe.CommitPrepared(dtid)
+			if err != nil {
+				return err
+			}
+			if includePosition {
+				position = tsv.replicationPosition()
+			}
+			return nil
 		},
 	)
+	return position, err
 }
 
 // RollbackPrepared commits the prepared transaction.
@@ -766,9 +855,11 @@ func (tsv *TabletServer) CreateTransaction(ctx context.Context, target *querypb.
 }
 
 // StartCommit atomically commits the transaction along with the
-// decision to commit the associated 2pc transaction.
-func (tsv *TabletServer) StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (err error) {
-	return tsv.execRequest(
+// decision to commit the associated 2pc transaction. Like Commit, it
+// returns the replication position after the commit if the
+// include_position option was set on the statements of the transaction.
+func (tsv *TabletServer) StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (position string, err error) {
+	err = tsv.execRequest(
 		ctx, tsv.QueryTimeout.Get(),
 		"StartCommit", "start_commit", nil,
 		target, true, true,
@@ -778,9 +869,17 @@ func (tsv *TabletServer) StartCommit(ctx context.Context, target *querypb.Target
 				logStats: logStats,
 				te:       tsv.te,
 			}
-			return txe.StartCommit(transactionID, dtid)
+			includePosition, err := txe.StartCommit(transactionID, dtid)
+			if err != nil {
+				return err
+			}
+			if includePosition {
+				position = tsv.replicationPosition()
+			}
+			return nil
 		},
 	)
+	return position, err
 }
 
 // SetRollback transitions the 2pc transaction to the Rollback state.
@@ -841,6 +940,9 @@ func (tsv *TabletServer) ReadTransaction(ctx context.Context, target *querypb.Ta
 // Execute executes the query and returns the result as response.
 func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sql string, bindVariables map[string]interface{}, transactionID int64, options *querypb.ExecuteOptions) (result *sqltypes.Result, err error) {
 	allowOnShutdown := (transactionID != 0)
+	// The result memory is released once the reply was sent, when
+	// the context of the request is done.
+	requestCtx := ctx
 	err = tsv.execRequest(
 		ctx, tsv.QueryTimeout.Get(),
 		"Execute", sql, bindVariables,
@@ -849,24 +951,43 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 			if bindVariables == nil {
 				bindVariables = make(map[string]interface{})
 			}
+			if err := tsv.waitForPosition(ctx, options); err != nil {
+				return err
+			}
 			sql = stripTrailing(sql, bindVariables)
 			plan := tsv.qe.schemaInfo.GetPlan(ctx, logStats, sql)
+			includePosition := options != nil && options.IncludePosition
 			qre := &QueryExecutor{
-				query:         sql,
-				bindVars:      bindVariables,
-				transactionID: transactionID,
-				plan:          plan,
-				ctx:           ctx,
-				logStats:      logStats,
-				qe:            tsv.qe,
-				te:            tsv.te,
+				query:           sql,
+				bindVars:        bindVariables,
+				transactionID:   transactionID,
+				plan:            plan,
+				ctx:             ctx,
+				logStats:        logStats,
+				qe:              tsv.qe,
+				te:              tsv.te,
+				includePosition: includePosition,
 			}
 			extras := tsv.computeExtras(options)
 			result, err = qre.Execute()
 			if err != nil {
 				return err
 			}
+			tsv.qe.releaseResultMemoryWhenDone(requestCtx, qre.resultBytes)
+			// The result can be shared with other requests through
+			// the result cache or the consolidator, so the extras
+			// are set on a copy.
+			r := *result
+			result = &r
 			result.Extras = extras
+			if includePosition && transactionID == 0 && !plan.PlanID.IsSelect() {
+				// The DML was committed, return the position
+				// right away.
+				if result.Extras == nil {
+					result.Extras = &querypb.ResultExtras{}
+				}
+				result.Extras.Position = tsv.replicationPosition()
+			}
 			if options != nil && options.ExcludeFieldNames {
 				result = result.StripFieldNames()
 			}
@@ -922,6 +1043,47 @@ func (tsv *TabletServer) computeExtras(options *querypb.ExecuteOptions) *querypb
 	return extras
 }
 
+// waitForPosition waits until the replication position of a non-master
+// tablet reaches the wait_for_position option, for at most the position
+// wait timeout. It fails with QUERY_NOT_SERVED otherwise, so the query
+// can be retried on another tablet.
+func (tsv *TabletServer) waitForPosition(ctx context.Context, options *querypb.ExecuteOptions) error {
+	if options == nil || options.WaitForPosition == "" {
+		return nil
+	}
+	tsv.mu.Lock()
+	tabletType := tsv.target.TabletType
+	tsv.mu.Unlock()
+	if tabletType == topodatapb.TabletType_MASTER {
+		return nil
+	}
+	pos, err := replication.DecodePosition(options.WaitForPosition)
+	if err != nil {
+		return NewTabletError(vtrpcpb.ErrorCode_BAD_INPUT, "invalid wait_for_position %v: %v", options.WaitForPosition, err)
+	}
+	defer tsv.qe.queryServiceStats.WaitStats.Record("PositionWait", time.Now())
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(tsv.config.PositionWaitTimeout*1e9))
+	defer cancel()
+	if err := tsv.mysqld.WaitMasterPos(ctx, pos); err != nil {
+		tsv.qe.queryServiceStats.InfoErrors.Add("PositionWait", 1)
+		return NewTabletError(vtrpcpb.ErrorCode_QUERY_NOT_SERVED, "replication position %v not reached: %v", options.WaitForPosition, err)
+	}
+	return nil
+}
+
+// replicationPosition returns the current replication position of
+// MySQL, or "" if it can't be read. The write it follows already
+// happened, so failing the request would be misleading.
+func (tsv *TabletServer) replicationPosition() string {
+	pos, err := tsv.mysqld.MasterPosition()
+	if err != nil {
+		tsv.qe.queryServiceStats.InternalErrors.Add("Position", 1)
+		log.Warningf("Cannot read the replication position: %v", err)
+		return ""
+	}
+	return replication.EncodePosition(pos)
+}
+
 // StreamExecute executes the query and streams the result.
 // The first QueryResult will have Fields set (and Rows nil).
 // The subsequent QueryResult will have Rows set (and Fields nil).
@@ -934,6 +1096,9 @@ func (tsv *TabletServer) StreamExecute(ctx context.Context, target *querypb.Targ
 			if bindVariables == nil {
 				bindVariables = make(map[string]interface{})
 			}
+			if err := tsv.waitForPosition(ctx, options); err != nil {
+				return err
+			}
 			sql = stripTrailing(sql, bindVariables)
 			qre := &QueryExecutor{
 				query:    sql,
@@ -995,18 +1160,29 @@ func (tsv *TabletServer) ExecuteBatch(ctx context.Context, target *querypb.Targe
 		results = append(results, *localReply)
 	}
 	if asTransaction {
-		if err = tsv.Commit(ctx, target, transactionID); err != nil {
+		position, err := tsv.Commit(ctx, target, transactionID)
+		if err != nil {
 			transactionID = 0
 			return nil, tsv.handleError("batch", nil, err, nil)
 		}
 		transactionID = 0
+		if position != "" && len(results) > 0 {
+			// Return the commit position with the last result.
+			last := &results[len(results)-1]
+			if last.Extras == nil {
+				last.Extras = &querypb.ResultExtras{}
+			}
+			last.Extras.Position = position
+		}
 	}
 	return results, nil
 }
 
-// BeginExecute combines Begin and Execute.
+// BeginExecute combines Begin and Execute. A priority in the trailing
+// comments of the query, e.g. /* priority:batch */, selects the
+// transaction pool partition of the transaction.
 func (tsv *TabletServer) BeginExecute(ctx context.Context, target *querypb.Target, sql string, bindVariables map[string]interface{}, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error) {
-	transactionID, err := tsv.Begin(ctx, target)
+	transactionID, err := tsv.Begin(withTxPriority(ctx, queryPriority(sql)), target)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -1015,8 +1191,12 @@ func (tsv *TabletServer) BeginExecute(ctx context.Context, target *querypb.Targe
 	return result, transactionID, err
 }
 
-// BeginExecuteBatch combines Begin and ExecuteBatch.
+// BeginExecuteBatch combines Begin and ExecuteBatch. The priority of
+// the transaction is read from the first query, as in BeginExecute.
 func (tsv *TabletServer) BeginExecuteBatch(ctx context.Context, target *querypb.Target, queries []querytypes.BoundQuery, asTransaction bool, options *querypb.ExecuteOptions) ([]sqltypes.Result, int64, error) {
+	if len(queries) > 0 {
+		ctx = withTxPriority(ctx, queryPriority(queries[0].Sql))
+	}
 	transactionID, err := tsv.Begin(ctx, target)
 	if err != nil {
 		return nil, 0, err
@@ -1260,7 +1440,8 @@ func validateSplitQueryParameters(
 			querytypes.QueryAsString(sql, bindVariables))
 	}
 	if algorithm != querypb.SplitQueryRequest_EQUAL_SPLITS &&
-		algorithm != querypb.SplitQueryRequest_FULL_SCAN {
+		algorithm != querypb.SplitQueryRequest_FULL_SCAN &&
+		algorithm != querypb.SplitQueryRequest_SAMPLING {
 		return NewTabletError(
 			vtrpcpb.ErrorCode_BAD_INPUT,
 			"splitquery: unsupported algorithm: %v. SQL: %v",
@@ -1305,7 +1486,8 @@ type splitQuerySQLExecuter struct {
 }
 
 // Constructs a new splitQuerySQLExecuter object. The 'done' method must be called on
-// the object after it's no longer used, to recycle the database connection.
+// the object after it's no longer used, to recycle the database connection and
+// release the result memory of its queries.
 func newSplitQuerySQLExecuter(
 	ctx context.Context, logStats *LogStats, queryEngine *QueryEngine,
 ) (*splitQuerySQLExecuter, error) {
@@ -1327,6 +1509,8 @@ func newSplitQuerySQLExecuter(
 
 func (se *splitQuerySQLExecuter) done() {
 	se.conn.Recycle()
+	se.queryExecutor.qe.releaseResultMemory(se.queryExecutor.resultBytes)
+	se.queryExecutor.resultBytes = 0
 }
 
 // SQLExecute is part of the SQLExecuter interface.
@@ -1376,6 +1560,8 @@ func createSplitQueryAlgorithmObject(
 		return splitquery.NewFullScanAlgorithm(splitParams, sqlExecuter)
 	case querypb.SplitQueryRequest_EQUAL_SPLITS:
 		return splitquery.NewEqualSplitsAlgorithm(splitParams, sqlExecuter)
+	case querypb.SplitQueryRequest_SAMPLING:
+		return splitquery.NewSamplingAlgorithm(splitParams, sqlExecuter)
 	default:
 		panic(fmt.Sprintf("Unknown algorithm enum: %+v", algorithm))
 	}
@@ -1567,7 +1753,7 @@ func (tsv *TabletServer) registerDebugHealthHandler() {
 
 func (tsv *TabletServer) registerQueryzHandler() {
 	http.HandleFunc("/queryz", func(w http.ResponseWriter, r *http.Request) {
-		queryzHandler(tsv.qe.schemaInfo, w, r)
+		queryzHandler(tsv.qe.schemaInfo, tsv.te.txPool.PartitionStats(), w, r)
 	})
 }
 
@@ -1619,11 +1805,13 @@ func (tsv *TabletServer) StreamPoolSize() int {
 }
 
 // SetTxPoolSize changes the tx pool size to the specified value.
+// If the pool is partitioned, only its shared part is resized.
 func (tsv *TabletServer) SetTxPoolSize(val int) {
 	tsv.te.txPool.pool.SetCapacity(val)
 }
 
-// TxPoolSize returns the tx pool size.
+// TxPoolSize returns the tx pool size. If the pool is partitioned,
+// this is the size of its shared part.
 func (tsv *TabletServer) TxPoolSize() int {
 	return int(tsv.te.txPool.pool.Capacity())
 }
@@ -1676,6 +1864,46 @@ func (tsv *TabletServer) MaxResultSize() int {
 	return int(tsv.qe.maxResultSize.Get())
 }
 
+// SetMaxResultBytes changes the max result bytes to the specified value.
+func (tsv *TabletServer) SetMaxResultBytes(val int) {
+	tsv.qe.maxResultBytes.Set(int64(val))
+}
+
+// MaxResultBytes returns the max result bytes.
+func (tsv *TabletServer) MaxResultBytes() int {
+	return int(tsv.qe.maxResultBytes.Get())
+}
+
+// SetResultMemoryBudget changes the result memory budget to the specified value.
+func (tsv *TabletServer) SetResultMemoryBudget(val int) {
+	tsv.qe.resultMemoryBudget.Set(int64(val))
+}
+
+// ResultMemoryBudget returns the result memory budget.
+func (tsv *TabletServer) ResultMemoryBudget() int {
+	return int(tsv.qe.resultMemoryBudget.Get())
+}
+
+// SetResultCacheSize changes the result cache size to the specified value.
+func (tsv *TabletServer) SetResultCacheSize(val int) {
+	tsv.qe.resultCache.SetCapacity(int64(val))
+}
+
+// ResultCacheSize returns the result cache size.
+func (tsv *TabletServer) ResultCacheSize() int {
+	return int(tsv.qe.resultCache.Capacity())
+}
+
+// SetResultCacheMaxEntrySize changes the result cache max entry size to the specified value.
+func (tsv *TabletServer) SetResultCacheMaxEntrySize(val int) {
+	tsv.qe.resultCache.SetMaxEntrySize(int64(val))
+}
+
+// ResultCacheMaxEntrySize returns the result cache max entry size.
+func (tsv *TabletServer) ResultCacheMaxEntrySize() int {
+	return int(tsv.qe.resultCache.MaxEntrySize())
+}
+
 // SetMaxDMLRows changes the max result size to the specified value.
 func (tsv *TabletServer) SetMaxDMLRows(val int) {
 	tsv.qe.maxDMLRows.Set(int64(val))
@@ -11,6 +11,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -18,6 +19,8 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/youtube/vitess/go/sqltypes"
+	"github.com/youtube/vitess/go/vt/mysqlctl"
+	"github.com/youtube/vitess/go/vt/mysqlctl/replication"
 	"github.com/youtube/vitess/go/vt/tabletserver/querytypes"
 	"github.com/youtube/vitess/go/vt/vttest/fakesqldb"
 
@@ -583,7 +586,7 @@ func TestTabletServerTarget(t *testing.T) {
 	if err == nil || !strings.Contains(err.Error(), want) {
 		t.Errorf("err: %v, must contain %s", err, want)
 	}
-	err = tsv.Commit(ctx, &target1, 1)
+	_, err = tsv.Commit(ctx, &target1, 1)
 	if err == nil || !strings.Contains(err.Error(), want) {
 		t.Errorf("err: %v, must contain %s", err, want)
 	}
@@ -685,6 +688,92 @@ func TestTabletServerMasterToReplica(t *testing.T) {
 	<-ch
 }
 
+func TestTabletServerResultCacheLifetime(t *testing.T) {
+	// Reuse code from tx_executor_test.
+	_, tsv, db := newTestTxExecutor()
+	defer tsv.StopService()
+	db.AddQuery(tsv.te.twoPC.readAllRedo, &sqltypes.Result{})
+	tsv.SetResultCacheSize(1000)
+	tsv.SetServingType(topodatapb.TabletType_REPLICA, true, nil)
+
+	// The cache stays closed until the binlogs are read.
+	if tsv.qe.resultCache.IsOpen() {
+		t.Errorf("result cache is open without a replication stream")
+	}
+	tsv.addReplicationStream(1)
+	if !tsv.qe.resultCache.IsOpen() {
+		t.Errorf("result cache is closed with a replication stream")
+	}
+
+	// It's closed while the tablet is a master, and reopened
+	// when it becomes a replica again.
+	tsv.SetServingType(topodatapb.TabletType_MASTER, true, nil)
+	if tsv.qe.resultCache.IsOpen() {
+		t.Errorf("result cache is open on a master")
+	}
+	tsv.SetServingType(topodatapb.TabletType_REPLICA, true, nil)
+	if !tsv.qe.resultCache.IsOpen() {
+		t.Errorf("result cache is closed on a replica with a replication stream")
+	}
+
+	tsv.addReplicationStream(-1)
+	if tsv.qe.resultCache.IsOpen() {
+		t.Errorf("result cache is open after the replication stream stopped")
+	}
+}
+
+func TestTabletServerResultCacheExtras(t *testing.T) {
+	db := setUpTabletServerTest()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{
+		Fields: getTestTableFields(),
+		Rows:   [][]sqltypes.Value{},
+	})
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, enableStrict, db)
+	defer tsv.StopService()
+	tsv.SetResultCacheSize(1024 * 1024)
+	tsv.qe.resultCache.Open()
+	tsv.eventToken = &querypb.EventToken{Timestamp: 10, Position: "MariaDB/0-1-5"}
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+
+	// Two callers share the cached result, with different extras.
+	var wg sync.WaitGroup
+	for _, options := range []*querypb.ExecuteOptions{
+		{IncludeEventToken: true},
+		{},
+	} {
+		wg.Add(1)
+		go func(options *querypb.ExecuteOptions) {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				result, err := tsv.Execute(ctx, &target, query, nil, 0, options)
+				if err != nil {
+					t.Errorf("Execute failed: %v", err)
+					return
+				}
+				if got := result.Extras != nil; got != options.IncludeEventToken {
+					t.Errorf("Execute with %v returned extras %v", options, result.Extras)
+					return
+				}
+			}
+		}(options)
+	}
+	wg.Wait()
+
+	// The cached result itself is never changed.
+	if _, err := tsv.Execute(ctx, &target, query, nil, 0, &querypb.ExecuteOptions{IncludeEventToken: true}); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	cached, ok := tsv.qe.resultCache.Get(query, "test_table")
+	if !ok || cached.Extras != nil {
+		t.Errorf("cached result: %v %v, want a result without extras", cached, ok)
+	}
+}
+
 func TestTabletServerReplicaToMaster(t *testing.T) {
 	// Reuse code from tx_executor_test.
 	_, tsv, db := newTestTxExecutor()
@@ -784,14 +873,36 @@ func TestTabletServerStartCommit(t *testing.T) {
 	commitTransition := fmt.Sprintf("update `_vt`.dt_state set state = %d where dtid = 'aa' and state = %d", int(querypb.TransactionState_COMMIT), int(querypb.TransactionState_PREPARE))
 	db.AddQuery(commitTransition, &sqltypes.Result{RowsAffected: 1})
 	txid := newTxForPrep(tsv)
-	err := tsv.StartCommit(ctx, &target, txid, "aa")
+	position, err := tsv.StartCommit(ctx, &target, txid, "aa")
+	if err != nil {
+		t.Error(err)
+	}
+	if position != "" {
+		t.Errorf("StartCommit returned position %v, want none", position)
+	}
+
+	// The position is returned if the transaction asked for it.
+	mysqld := mysqlctl.NewFakeMysqlDaemon(db)
+	mysqld.CurrentMasterPosition, err = replication.DecodePosition("MariaDB/0-1-5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tsv.mysqld = mysqld
+	txid = newTransaction(tsv)
+	if _, err := tsv.Execute(ctx, &target, "update test_table set name = 2 where pk = 1", nil, txid, &querypb.ExecuteOptions{IncludePosition: true}); err != nil {
+		t.Fatal(err)
+	}
+	position, err = tsv.StartCommit(ctx, &target, txid, "aa")
 	if err != nil {
 		t.Error(err)
 	}
+	if position != "MariaDB/0-1-5" {
+		t.Errorf("StartCommit returned position %v, want MariaDB/0-1-5", position)
+	}
 
 	db.AddQuery(commitTransition, &sqltypes.Result{})
 	txid = newTxForPrep(tsv)
-	err = tsv.StartCommit(ctx, &target, txid, "aa")
+	_, err = tsv.StartCommit(ctx, &target, txid, "aa")
 	want := "error: could not transition to COMMIT: aa"
 	if err == nil || err.Error() != want {
 		t.Errorf("Prepare err: %v, want %s", err, want)
@@ -965,11 +1076,143 @@ func TestTabletServerCommitTransaction(t *testing.T) {
 	if _, err := tsv.Execute(ctx, &target, executeSQL, nil, transactionID, nil); err != nil {
 		t.Fatalf("failed to execute query: %s: %s", executeSQL, err)
 	}
-	if err := tsv.Commit(ctx, &target, transactionID); err != nil {
+	if _, err := tsv.Commit(ctx, &target, transactionID); err != nil {
 		t.Fatalf("call TabletServer.Commit failed: %v", err)
 	}
 }
 
+func TestTabletServerIncludePosition(t *testing.T) {
+	db := setUpTabletServerTest()
+	testUtils := newTestUtils()
+	sql := "insert into test_table values (1, 2)"
+	expanedSQL := "insert into test_table values (1, 2) /* _stream test_table (pk ) (1 ); */"
+	db.AddQuery(sql, &sqltypes.Result{})
+	db.AddQuery(expanedSQL, &sqltypes.Result{})
+	selectSQL := "select * from test_table limit 1000"
+	db.AddQuery(selectSQL, &sqltypes.Result{})
+	config := testUtils.newQueryServiceConfig()
+	config.EnableAutoCommit = true
+	tsv := NewTabletServer(config)
+	dbconfigs := testUtils.newDBConfigs(db)
+	mysqld := mysqlctl.NewFakeMysqlDaemon(db)
+	pos, err := replication.DecodePosition("MariaDB/0-1-5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mysqld.CurrentMasterPosition = pos
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	if err := tsv.StartService(target, dbconfigs, mysqld); err != nil {
+		t.Fatalf("StartService failed: %v", err)
+	}
+	defer tsv.StopService()
+	ctx := context.Background()
+	options := &querypb.ExecuteOptions{IncludePosition: true}
+
+	// An autocommitted DML returns the position.
+	result, err := tsv.Execute(ctx, &target, sql, nil, 0, options)
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if result.Extras == nil || result.Extras.Position != "MariaDB/0-1-5" {
+		t.Errorf("Execute returned extras %v, want position MariaDB/0-1-5", result.Extras)
+	}
+
+	// A select doesn't.
+	result, err = tsv.Execute(ctx, &target, selectSQL, nil, 0, options)
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if result.Extras != nil {
+		t.Errorf("Execute returned extras %v, want none", result.Extras)
+	}
+
+	// In a transaction, Commit returns the position.
+	transactionID, err := tsv.Begin(ctx, &target)
+	if err != nil {
+		t.Fatalf("Begin failed: %v", err)
+	}
+	if _, err := tsv.Execute(ctx, &target, sql, nil, transactionID, options); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	position, err := tsv.Commit(ctx, &target, transactionID)
+	if err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+	if position != "MariaDB/0-1-5" {
+		t.Errorf("Commit returned position %v, want MariaDB/0-1-5", position)
+	}
+
+	// Unless the option wasn't set.
+	transactionID, err = tsv.Begin(ctx, &target)
+	if err != nil {
+		t.Fatalf("Begin failed: %v", err)
+	}
+	if _, err := tsv.Execute(ctx, &target, sql, nil, transactionID, nil); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	position, err = tsv.Commit(ctx, &target, transactionID)
+	if err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+	if position != "" {
+		t.Errorf("Commit returned position %v, want none", position)
+	}
+}
+
+func TestTabletServerWaitForPosition(t *testing.T) {
+	db := setUpTabletServerTest()
+	testUtils := newTestUtils()
+	sql := "select * from test_table limit 1000"
+	db.AddQuery(sql, &sqltypes.Result{})
+	config := testUtils.newQueryServiceConfig()
+	tsv := NewTabletServer(config)
+	dbconfigs := testUtils.newDBConfigs(db)
+	mysqld := mysqlctl.NewFakeMysqlDaemon(db)
+	pos, err := replication.DecodePosition("MariaDB/0-1-5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mysqld.WaitMasterPosition = pos
+	target := querypb.Target{TabletType: topodatapb.TabletType_REPLICA}
+	if err := tsv.StartService(target, dbconfigs, mysqld); err != nil {
+		t.Fatalf("StartService failed: %v", err)
+	}
+	defer tsv.StopService()
+	ctx := context.Background()
+
+	if _, err := tsv.Execute(ctx, &target, sql, nil, 0, &querypb.ExecuteOptions{WaitForPosition: "MariaDB/0-1-5"}); err != nil {
+		t.Errorf("Execute failed: %v", err)
+	}
+
+	// The fake daemon fails to wait for any other position.
+	_, err = tsv.Execute(ctx, &target, sql, nil, 0, &querypb.ExecuteOptions{WaitForPosition: "MariaDB/0-1-6"})
+	if terr, ok := err.(*TabletError); !ok || terr.ErrorCode != vtrpcpb.ErrorCode_QUERY_NOT_SERVED {
+		t.Errorf("Execute returned %v, want a QUERY_NOT_SERVED error", err)
+	}
+
+	_, err = tsv.Execute(ctx, &target, sql, nil, 0, &querypb.ExecuteOptions{WaitForPosition: "foo"})
+	if terr, ok := err.(*TabletError); !ok || terr.ErrorCode != vtrpcpb.ErrorCode_BAD_INPUT {
+		t.Errorf("Execute returned %v, want a BAD_INPUT error", err)
+	}
+
+	// Streaming queries wait too.
+	sendReply := func(*sqltypes.Result) error { return nil }
+	if err := tsv.StreamExecute(ctx, &target, sql, nil, &querypb.ExecuteOptions{WaitForPosition: "MariaDB/0-1-5"}, sendReply); err != nil {
+		t.Errorf("StreamExecute failed: %v", err)
+	}
+	err = tsv.StreamExecute(ctx, &target, sql, nil, &querypb.ExecuteOptions{WaitForPosition: "MariaDB/0-1-6"}, sendReply)
+	if terr, ok := err.(*TabletError); !ok || terr.ErrorCode != vtrpcpb.ErrorCode_QUERY_NOT_SERVED {
+		t.Errorf("StreamExecute returned %v, want a QUERY_NOT_SERVED error", err)
+	}
+
+	// The master doesn't wait.
+	tsv.SetServingType(topodatapb.TabletType_MASTER, true, nil)
+	target.TabletType = topodatapb.TabletType_MASTER
+	if _, err := tsv.Execute(ctx, &target, sql, nil, 0, &querypb.ExecuteOptions{WaitForPosition: "MariaDB/0-1-6"}); err != nil {
+		t.Errorf("Execute failed: %v", err)
+	}
+}
+
 func TestTabletServerCommiRollbacktFail(t *testing.T) {
 	db := setUpTabletServerTest()
 	testUtils := newTestUtils()
@@ -983,7 +1226,7 @@ func TestTabletServerCommiRollbacktFail(t *testing.T) {
 	}
 	defer tsv.StopService()
 	ctx := context.Background()
-	err = tsv.Commit(ctx, &target, -1)
+	_, err = tsv.Commit(ctx, &target, -1)
 	want := "not_in_tx: Transaction -1: not found"
 	if err == nil || err.Error() != want {
 		t.Fatalf("Commit err: %v, want %v", err, want)
@@ -1057,16 +1300,26 @@ func TestTabletServerCommitPrepared(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if _, err := tsv.Execute(ctx, &target, "update test_table set name = 2 where pk = 1", nil, transactionID, nil); err != nil {
+	if _, err := tsv.Execute(ctx, &target, "update test_table set name = 2 where pk = 1", nil, transactionID, &querypb.ExecuteOptions{IncludePosition: true}); err != nil {
 		t.Fatal(err)
 	}
 	if err := tsv.Prepare(ctx, &target, transactionID, "aa"); err != nil {
 		t.Fatal(err)
 	}
 	defer tsv.RollbackPrepared(ctx, &target, "aa", 0)
-	if err := tsv.CommitPrepared(ctx, &target, "aa"); err != nil {
+	mysqld := mysqlctl.NewFakeMysqlDaemon(nil)
+	mysqld.CurrentMasterPosition, err = replication.DecodePosition("MariaDB/0-1-5")
+	if err != nil {
 		t.Fatal(err)
 	}
+	tsv.mysqld = mysqld
+	position, err := tsv.CommitPrepared(ctx, &target, "aa")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if position != "MariaDB/0-1-5" {
+		t.Errorf("CommitPrepared returned position %v, want MariaDB/0-1-5", position)
+	}
 }
 
 func TestTabletServerRollbackPrepared(t *testing.T) {
@@ -1431,6 +1684,92 @@ func TestTabletServerSplitQuery(t *testing.T) {
 	}
 }
 
+func TestTabletServerSplitQuerySampling(t *testing.T) {
+	db := setUpTabletServerTest()
+	db.AddQuery("select pk from test_table order by pk asc", &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "pk", Type: sqltypes.Int32},
+		},
+		RowsAffected: 4,
+		Rows: [][]sqltypes.Value{
+			{sqltypes.MakeTrusted(sqltypes.Int32, []byte("1"))},
+			{sqltypes.MakeTrusted(sqltypes.Int32, []byte("2"))},
+			{sqltypes.MakeTrusted(sqltypes.Int32, []byte("50"))},
+			{sqltypes.MakeTrusted(sqltypes.Int32, []byte("1000"))},
+		},
+	})
+	testUtils := newTestUtils()
+	config := testUtils.newQueryServiceConfig()
+	tsv := NewTabletServer(config)
+	dbconfigs := testUtils.newDBConfigs(db)
+	target := querypb.Target{TabletType: topodatapb.TabletType_RDONLY}
+	err := tsv.StartService(target, dbconfigs, testUtils.newMysqld(&dbconfigs))
+	if err != nil {
+		t.Fatalf("StartService failed: %v", err)
+	}
+	defer tsv.StopService()
+	ctx := context.Background()
+	sql := "select * from test_table where count > :count"
+	splits, err := tsv.SplitQuery(
+		ctx,
+		&querypb.Target{TabletType: topodatapb.TabletType_RDONLY},
+		sql,
+		nil,        /* bindVariables */
+		[]string{}, /* splitColumns */
+		2,          /* splitCount */
+		0,          /* numRowsPerQueryPart */
+		querypb.SplitQueryRequest_SAMPLING)
+	if err != nil {
+		t.Fatalf("TabletServer.SplitQuery should succeed: %v, but get error: %v", sql, err)
+	}
+	if len(splits) != 2 {
+		t.Fatalf("got: %v, want: %v.\nsplits: %+v", len(splits), 2, splits)
+	}
+}
+
+func TestTabletServerSplitQueryResultMemory(t *testing.T) {
+	db := setUpTabletServerTest()
+	db.AddQuery("SELECT MIN(pk), MAX(pk) FROM test_table", &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "pk", Type: sqltypes.Int32},
+		},
+		RowsAffected: 1,
+		Rows: [][]sqltypes.Value{
+			{
+				sqltypes.MakeTrusted(sqltypes.Int32, []byte("1")),
+				sqltypes.MakeTrusted(sqltypes.Int32, []byte("100")),
+			},
+		},
+	})
+	testUtils := newTestUtils()
+	config := testUtils.newQueryServiceConfig()
+	config.ResultMemoryBudget = 1000
+	tsv := NewTabletServer(config)
+	dbconfigs := testUtils.newDBConfigs(db)
+	target := querypb.Target{TabletType: topodatapb.TabletType_RDONLY}
+	err := tsv.StartService(target, dbconfigs, testUtils.newMysqld(&dbconfigs))
+	if err != nil {
+		t.Fatalf("StartService failed: %v", err)
+	}
+	defer tsv.StopService()
+	sql := "select * from test_table where count > :count"
+	if _, err := tsv.SplitQuery(
+		context.Background(),
+		&querypb.Target{TabletType: topodatapb.TabletType_RDONLY},
+		sql,
+		nil,        /* bindVariables */
+		[]string{}, /* splitColumns */
+		10,         /* splitCount */
+		0,          /* numRowsPerQueryPart */
+		querypb.SplitQueryRequest_EQUAL_SPLITS); err != nil {
+		t.Fatalf("TabletServer.SplitQuery should succeed: %v, but get error: %v", sql, err)
+	}
+	// The result memory of the split queries is released.
+	if inUse := tsv.qe.resultMemoryInUse.Get(); inUse != 0 {
+		t.Errorf("resultMemoryInUse: %d, want 0", inUse)
+	}
+}
+
 func TestTabletServerSplitQueryInvalidQuery(t *testing.T) {
 	db := setUpTabletServerTest()
 	testUtils := newTestUtils()
@@ -1699,6 +2038,38 @@ func TestConfigChanges(t *testing.T) {
 	if val := int(tsv.qe.maxDMLRows.Get()); val != newSize {
 		t.Errorf("tsv.qe.maxDMLRows.Get: %d, want %d", val, newSize)
 	}
+
+	tsv.SetMaxResultBytes(newSize)
+	if val := tsv.MaxResultBytes(); val != newSize {
+		t.Errorf("MaxResultBytes: %d, want %d", val, newSize)
+	}
+	if val := int(tsv.qe.maxResultBytes.Get()); val != newSize {
+		t.Errorf("tsv.qe.maxResultBytes.Get: %d, want %d", val, newSize)
+	}
+
+	tsv.SetResultMemoryBudget(newSize)
+	if val := tsv.ResultMemoryBudget(); val != newSize {
+		t.Errorf("ResultMemoryBudget: %d, want %d", val, newSize)
+	}
+	if val := int(tsv.qe.resultMemoryBudget.Get()); val != newSize {
+		t.Errorf("tsv.qe.resultMemoryBudget.Get: %d, want %d", val, newSize)
+	}
+
+	tsv.SetResultCacheSize(newSize)
+	if val := tsv.ResultCacheSize(); val != newSize {
+		t.Errorf("ResultCacheSize: %d, want %d", val, newSize)
+	}
+	if val := int(tsv.qe.resultCache.Capacity()); val != newSize {
+		t.Errorf("tsv.qe.resultCache.Capacity: %d, want %d", val, newSize)
+	}
+
+	tsv.SetResultCacheMaxEntrySize(newSize)
+	if val := tsv.ResultCacheMaxEntrySize(); val != newSize {
+		t.Errorf("ResultCacheMaxEntrySize: %d, want %d", val, newSize)
+	}
+	if val := int(tsv.qe.resultCache.MaxEntrySize()); val != newSize {
+		t.Errorf("tsv.qe.resultCache.MaxEntrySize: %d, want %d", val, newSize)
+	}
 }
 
 func setUpTabletServerTest() *fakesqldb.DB {
@@ -128,7 +128,7 @@ func twopczHandler(txe *TxExecutor, w http.ResponseWriter, r *http.Request) {
 	case "Discard", "Rollback":
 		err = txe.RollbackPrepared(dtid, 0)
 	case "Commit":
-		err = txe.CommitPrepared(dtid)
+		_, err = txe.CommitPrepared(dtid)
 	case "Conclude":
 		err = txe.ConcludeTransaction(dtid)
 	}
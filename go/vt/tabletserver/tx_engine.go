@@ -38,10 +38,15 @@ func NewTxEngine(checker MySQLChecker, config Config, queryServiceStats *QuerySe
 	te := &TxEngine{
 		shutdownGracePeriod: time.Duration(config.TxShutDownGracePeriod * 1e9),
 	}
+	partitions, err := ParseTxPoolPartitions(config.TransactionPartitions, config.TransactionCap)
+	if err != nil {
+		log.Fatalf("Invalid transaction pool partitions: %v", err)
+	}
 	te.txPool = NewTxPool(
 		config.PoolNamePrefix+"TransactionPool",
 		config.StatsPrefix,
 		config.TransactionCap,
+		partitions,
 		time.Duration(config.TransactionTimeout*1e9),
 		time.Duration(config.IdleTimeout*1e9),
 		config.EnablePublishStats,
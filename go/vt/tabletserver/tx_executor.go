@@ -73,18 +73,20 @@ func (txe *TxExecutor) Prepare(transactionID int64, dtid string) error {
 
 // CommitPrepared commits a prepared transaction. If the operation
 // fails, an error counter is incremented and the transaction is
-// marked as failed in the redo log.
-func (txe *TxExecutor) CommitPrepared(dtid string) error {
+// marked as failed in the redo log. It returns true if the
+// replication position after the commit was requested with the
+// include_position option.
+func (txe *TxExecutor) CommitPrepared(dtid string) (includePosition bool, err error) {
 	if !txe.te.twopcEnabled {
-		return NewTabletError(vtrpcpb.ErrorCode_BAD_INPUT, "2pc is not enabled")
+		return false, NewTabletError(vtrpcpb.ErrorCode_BAD_INPUT, "2pc is not enabled")
 	}
 	defer txe.te.queryServiceStats.QueryStats.Record("COMMIT_PREPARED", time.Now())
 	conn, err := txe.te.preparedPool.FetchForCommit(dtid)
 	if err != nil {
-		return NewTabletError(vtrpcpb.ErrorCode_BAD_INPUT, "cannot commit dtid %s, state: %v", dtid, err)
+		return false, NewTabletError(vtrpcpb.ErrorCode_BAD_INPUT, "cannot commit dtid %s, state: %v", dtid, err)
 	}
 	if conn == nil {
-		return nil
+		return false, nil
 	}
 	// We have to use a context that will never give up,
 	// even if the original context expires.
@@ -93,15 +95,16 @@ func (txe *TxExecutor) CommitPrepared(dtid string) error {
 	err = txe.te.twoPC.DeleteRedo(ctx, conn, dtid)
 	if err != nil {
 		txe.markFailed(ctx, dtid)
-		return err
+		return false, err
 	}
+	includePosition = conn.IncludePosition
 	err = txe.te.txPool.LocalCommit(ctx, conn)
 	if err != nil {
 		txe.markFailed(ctx, dtid)
-		return err
+		return false, err
 	}
 	txe.te.preparedPool.Forget(dtid)
-	return nil
+	return includePosition, nil
 }
 
 // markFailed does the necessary work to mark a CommitPrepared
@@ -198,25 +201,31 @@ func (txe *TxExecutor) CreateTransaction(dtid string, participants []*querypb.Ta
 }
 
 // StartCommit atomically commits the transaction along with the
-// decision to commit the associated 2pc transaction.
-func (txe *TxExecutor) StartCommit(transactionID int64, dtid string) error {
+// decision to commit the associated 2pc transaction. Like
+// CommitPrepared, it returns true if the replication position after
+// the commit was requested.
+func (txe *TxExecutor) StartCommit(transactionID int64, dtid string) (includePosition bool, err error) {
 	if !txe.te.twopcEnabled {
-		return NewTabletError(vtrpcpb.ErrorCode_BAD_INPUT, "2pc is not enabled")
+		return false, NewTabletError(vtrpcpb.ErrorCode_BAD_INPUT, "2pc is not enabled")
 	}
 	defer txe.te.queryServiceStats.QueryStats.Record("START_COMMIT", time.Now())
 	txe.logStats.TransactionID = transactionID
 
 	conn, err := txe.te.txPool.Get(transactionID, "for 2pc commit")
 	if err != nil {
-		return err
+		return false, err
 	}
 	defer txe.te.txPool.LocalConclude(txe.ctx, conn)
 
 	err = txe.te.twoPC.Transition(txe.ctx, conn, dtid, querypb.TransactionState_COMMIT)
 	if err != nil {
-		return err
+		return false, err
 	}
-	return txe.te.txPool.LocalCommit(txe.ctx, conn)
+	includePosition = conn.IncludePosition
+	if err := txe.te.txPool.LocalCommit(txe.ctx, conn); err != nil {
+		return false, err
+	}
+	return includePosition, nil
 }
 
 // SetRollback transitions the 2pc transaction to the Rollback state.
@@ -134,12 +134,12 @@ func TestTxExecutorCommit(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
-	err = txe.CommitPrepared("aa")
+	_, err = txe.CommitPrepared("aa")
 	if err != nil {
 		t.Error(err)
 	}
 	// Commiting an absent transaction should succeed.
-	err = txe.CommitPrepared("bb")
+	_, err = txe.CommitPrepared("bb")
 	if err != nil {
 		t.Error(err)
 	}
@@ -157,13 +157,13 @@ func TestTxExecutorCommitRedoFail(t *testing.T) {
 	}
 	defer txe.RollbackPrepared("bb", 0)
 	db.AddQuery("update `_vt`.redo_state set state = 'Failed' where dtid = 'bb'", &sqltypes.Result{})
-	err = txe.CommitPrepared("bb")
+	_, err = txe.CommitPrepared("bb")
 	want := "is not supported"
 	if err == nil || !strings.Contains(err.Error(), want) {
 		t.Errorf("txe.CommitPrepared err: %v, must contain %s", err, want)
 	}
 	// A retry should fail differently.
-	err = txe.CommitPrepared("bb")
+	_, err = txe.CommitPrepared("bb")
 	want = "cannot commit dtid bb, state: failed"
 	if err == nil || !strings.Contains(err.Error(), want) {
 		t.Errorf("txe.CommitPrepared err: %v, must contain %s", err, want)
@@ -180,7 +180,7 @@ func TestTxExecutorCommitRedoCommitFail(t *testing.T) {
 	}
 	defer txe.RollbackPrepared("aa", 0)
 	db.AddRejectedQuery("commit", errors.New("commit fail"))
-	err = txe.CommitPrepared("aa")
+	_, err = txe.CommitPrepared("aa")
 	want := "error: error: commit fail"
 	if err == nil || err.Error() != want {
 		t.Errorf("Prepare err: %v, want %s", err, want)
@@ -242,14 +242,14 @@ func TestExecutorStartCommit(t *testing.T) {
 	commitTransition := fmt.Sprintf("update `_vt`.dt_state set state = %d where dtid = 'aa' and state = %d", int(querypb.TransactionState_COMMIT), int(querypb.TransactionState_PREPARE))
 	db.AddQuery(commitTransition, &sqltypes.Result{RowsAffected: 1})
 	txid := newTxForPrep(tsv)
-	err := txe.StartCommit(txid, "aa")
+	_, err := txe.StartCommit(txid, "aa")
 	if err != nil {
 		t.Error(err)
 	}
 
 	db.AddQuery(commitTransition, &sqltypes.Result{})
 	txid = newTxForPrep(tsv)
-	err = txe.StartCommit(txid, "aa")
+	_, err = txe.StartCommit(txid, "aa")
 	want := "error: could not transition to COMMIT: aa"
 	if err == nil || err.Error() != want {
 		t.Errorf("Prepare err: %v, want %s", err, want)
@@ -448,7 +448,7 @@ func TestNoTwopc(t *testing.T) {
 		fun:  func() error { return txe.Prepare(1, "aa") },
 	}, {
 		desc: "CommitPrepared",
-		fun:  func() error { return txe.CommitPrepared("aa") },
+		fun:  func() error { _, err := txe.CommitPrepared("aa"); return err },
 	}, {
 		desc: "RollbackPrepared",
 		fun:  func() error { return txe.RollbackPrepared("aa", 1) },
@@ -457,7 +457,7 @@ func TestNoTwopc(t *testing.T) {
 		fun:  func() error { return txe.CreateTransaction("aa", nil) },
 	}, {
 		desc: "StartCommit",
-		fun:  func() error { return txe.StartCommit(1, "aa") },
+		fun:  func() error { _, err := txe.StartCommit(1, "aa"); return err },
 	}, {
 		desc: "SetRollback",
 		fun:  func() error { return txe.SetRollback("aa", 1) },
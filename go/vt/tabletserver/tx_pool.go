@@ -7,6 +7,8 @@ package tabletserver
 import (
 	"fmt"
 	"net/url"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -41,9 +43,22 @@ const (
 
 const txLogInterval = time.Duration(1 * time.Minute)
 
+// TxSharedPartition is the name reported for transactions that
+// were served by the shared part of the transaction pool.
+const TxSharedPartition = "shared"
+
 // TxPool is the transaction pool for the query service.
+//
+// The pool can optionally be split into partitions. Every partition
+// has a minimum number of connections reserved for it. Transactions
+// of a partition use its reserved connections first, and overflow into
+// the shared pool once those are exhausted. Transactions that don't
+// belong to any partition only use the shared pool.
 type TxPool struct {
 	pool              *ConnPool
+	partitions        map[string]*ConnPool
+	partitionNames    []string
+	overflows         *stats.Counters
 	activePool        *pools.Numbered
 	lastID            sync2.AtomicInt64
 	timeout           sync2.AtomicDuration
@@ -61,6 +76,7 @@ func NewTxPool(
 	name string,
 	txStatsPrefix string,
 	capacity int,
+	partitions map[string]int,
 	timeout time.Duration,
 	idleTimeout time.Duration,
 	enablePublishStats bool,
@@ -68,12 +84,27 @@ func NewTxPool(
 	checker MySQLChecker) *TxPool {
 
 	txStatsName := ""
+	overflowsName := ""
 	if enablePublishStats {
 		txStatsName = txStatsPrefix + "Transactions"
+		overflowsName = txStatsPrefix + "TransactionPartitionOverflows"
+	}
+
+	sharedCapacity := capacity
+	partitionPools := make(map[string]*ConnPool, len(partitions))
+	partitionNames := make([]string, 0, len(partitions))
+	for partition, reserved := range partitions {
+		partitionPools[partition] = NewConnPool("", reserved, idleTimeout, false, qStats, checker)
+		partitionNames = append(partitionNames, partition)
+		sharedCapacity -= reserved
 	}
+	sort.Strings(partitionNames)
 
 	axp := &TxPool{
-		pool:              NewConnPool(name, capacity, idleTimeout, enablePublishStats, qStats, checker),
+		pool:              NewConnPool(name, sharedCapacity, idleTimeout, enablePublishStats, qStats, checker),
+		partitions:        partitionPools,
+		partitionNames:    partitionNames,
+		overflows:         stats.NewCounters(overflowsName),
 		activePool:        pools.NewNumbered(),
 		lastID:            sync2.NewAtomicInt64(time.Now().UnixNano()),
 		timeout:           sync2.NewAtomicDuration(timeout),
@@ -86,15 +117,57 @@ func NewTxPool(
 	// but we know it doesn't export Timeout.
 	if enablePublishStats {
 		stats.Publish(name+"Timeout", stats.DurationFunc(axp.timeout.Get))
+		stats.Publish(name+"PartitionCapacity", stats.CountersFunc(func() map[string]int64 {
+			return axp.partitionCounts((*ConnPool).Capacity)
+		}))
+		stats.Publish(name+"PartitionAvailable", stats.CountersFunc(func() map[string]int64 {
+			return axp.partitionCounts((*ConnPool).Available)
+		}))
 	}
 	return axp
 }
 
+// ParseTxPoolPartitions parses a comma separated list of name:reserved
+// pairs into a map. The total reserved capacity must leave at least one
+// connection for the shared pool out of capacity.
+func ParseTxPoolPartitions(spec string, capacity int) (map[string]int, error) {
+	partitions := make(map[string]int)
+	if spec == "" {
+		return partitions, nil
+	}
+	total := 0
+	for _, entry := range strings.Split(spec, ",") {
+		parts := strings.Split(strings.TrimSpace(entry), ":")
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid transaction pool partition %q, expecting name:reserved", entry)
+		}
+		if parts[0] == TxSharedPartition {
+			return nil, fmt.Errorf("transaction pool partition name %q is reserved", TxSharedPartition)
+		}
+		if _, ok := partitions[parts[0]]; ok {
+			return nil, fmt.Errorf("duplicate transaction pool partition %q", parts[0])
+		}
+		reserved, err := strconv.Atoi(parts[1])
+		if err != nil || reserved <= 0 {
+			return nil, fmt.Errorf("invalid reserved capacity for transaction pool partition %q: %q", parts[0], parts[1])
+		}
+		partitions[parts[0]] = reserved
+		total += reserved
+	}
+	if total >= capacity {
+		return nil, fmt.Errorf("transaction pool partitions reserve %d connections, which leaves no shared capacity out of %d", total, capacity)
+	}
+	return partitions, nil
+}
+
 // Open makes the TxPool operational. This also starts the transaction killer
 // that will kill long-running transactions.
 func (axp *TxPool) Open(appParams, dbaParams *sqldb.ConnParams) {
 	log.Infof("Starting transaction id: %d", axp.lastID)
 	axp.pool.Open(appParams, dbaParams)
+	for _, p := range axp.partitions {
+		p.Open(appParams, dbaParams)
+	}
 	axp.ticks.Start(func() { axp.transactionKiller() })
 }
 
@@ -108,6 +181,9 @@ func (axp *TxPool) Close() {
 		conn.Close()
 		conn.conclude(TxClose)
 	}
+	for _, p := range axp.partitions {
+		p.Close()
+	}
 	axp.pool.Close()
 }
 
@@ -149,7 +225,7 @@ func (axp *TxPool) WaitForEmpty() {
 // Begin begins a transaction, and returns the associated transaction id.
 // Subsequent statements can access the connection through the transaction id.
 func (axp *TxPool) Begin(ctx context.Context) (int64, error) {
-	conn, err := axp.pool.Get(ctx)
+	partition, conn, err := axp.getConn(ctx)
 	if err != nil {
 		switch err {
 		case ErrConnPoolClosed:
@@ -173,11 +249,125 @@ func (axp *TxPool) Begin(ctx context.Context) (int64, error) {
 			axp,
 			callerid.ImmediateCallerIDFromContext(ctx),
 			callerid.EffectiveCallerIDFromContext(ctx),
+			partition,
 		),
 	)
 	return transactionID, nil
 }
 
+// getConn returns a connection for a new transaction, along with the name
+// of the partition it was taken from. The reserved connections of the
+// transaction's partition are used first, and the shared pool otherwise.
+func (axp *TxPool) getConn(ctx context.Context) (string, *DBConn, error) {
+	partition, p := axp.partitionFor(ctx)
+	if p != nil {
+		conn, err := p.TryGet()
+		if err != nil {
+			return "", nil, err
+		}
+		if conn != nil {
+			return partition, conn, nil
+		}
+	}
+	conn, err := axp.pool.Get(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	if p != nil {
+		axp.overflows.Add(partition, 1)
+	}
+	return TxSharedPartition, conn, nil
+}
+
+// partitionFor returns the partition of a new transaction. The priority
+// from the query comments is matched first, then the effective caller's
+// principal, then its component.
+func (axp *TxPool) partitionFor(ctx context.Context) (string, *ConnPool) {
+	if len(axp.partitions) == 0 {
+		return "", nil
+	}
+	if priority := txPriorityFromContext(ctx); priority != "" {
+		if p, ok := axp.partitions[priority]; ok {
+			return priority, p
+		}
+	}
+	ef := callerid.EffectiveCallerIDFromContext(ctx)
+	if ef == nil {
+		return "", nil
+	}
+	if p, ok := axp.partitions[ef.Principal]; ok {
+		return ef.Principal, p
+	}
+	if p, ok := axp.partitions[ef.Component]; ok {
+		return ef.Component, p
+	}
+	return "", nil
+}
+
+// txPriorityKey is the context key for the priority of a transaction.
+type txPriorityKey int
+
+// withTxPriority returns a context that asks for the transactions begun
+// with it to use the partition named priority, if there is one.
+func withTxPriority(ctx context.Context, priority string) context.Context {
+	if priority == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, txPriorityKey(0), priority)
+}
+
+// txPriorityFromContext returns the priority set by withTxPriority,
+// or "".
+func txPriorityFromContext(ctx context.Context) string {
+	priority, _ := ctx.Value(txPriorityKey(0)).(string)
+	return priority
+}
+
+// partitionCounts returns the value of f for the shared pool
+// and every partition, keyed by partition name.
+func (axp *TxPool) partitionCounts(f func(*ConnPool) int64) map[string]int64 {
+	counts := make(map[string]int64, len(axp.partitions)+1)
+	counts[TxSharedPartition] = f(axp.pool)
+	for name, p := range axp.partitions {
+		counts[name] = f(p)
+	}
+	return counts
+}
+
+// TxPartitionStats describes the usage of a transaction pool partition.
+type TxPartitionStats struct {
+	Name      string
+	Capacity  int64
+	InUse     int64
+	Overflows int64
+}
+
+// PartitionStats returns the usage of the shared pool followed by
+// every partition, sorted by name. It returns nil if the pool
+// is not partitioned.
+func (axp *TxPool) PartitionStats() []TxPartitionStats {
+	if len(axp.partitions) == 0 {
+		return nil
+	}
+	overflows := axp.overflows.Counts()
+	result := make([]TxPartitionStats, 0, len(axp.partitions)+1)
+	result = append(result, TxPartitionStats{
+		Name:     TxSharedPartition,
+		Capacity: axp.pool.Capacity(),
+		InUse:    axp.pool.Capacity() - axp.pool.Available(),
+	})
+	for _, name := range axp.partitionNames {
+		p := axp.partitions[name]
+		result = append(result, TxPartitionStats{
+			Name:      name,
+			Capacity:  p.Capacity(),
+			InUse:     p.Capacity() - p.Available(),
+			Overflows: overflows[name],
+		})
+	}
+	return result
+}
+
 // Commit commits the specified transaction.
 func (axp *TxPool) Commit(ctx context.Context, transactionID int64) error {
 	conn, err := axp.Get(transactionID, "for commit")
@@ -286,9 +476,13 @@ type TxConnection struct {
 	LogToFile         sync2.AtomicInt32
 	ImmediateCallerID *querypb.VTGateCallerID
 	EffectiveCallerID *vtrpcpb.CallerID
+	Partition         string
+	// IncludePosition is set if the replication position after
+	// the commit must be returned.
+	IncludePosition bool
 }
 
-func newTxConnection(conn *DBConn, transactionID int64, pool *TxPool, immediate *querypb.VTGateCallerID, effective *vtrpcpb.CallerID) *TxConnection {
+func newTxConnection(conn *DBConn, transactionID int64, pool *TxPool, immediate *querypb.VTGateCallerID, effective *vtrpcpb.CallerID, partition string) *TxConnection {
 	return &TxConnection{
 		DBConn:            conn,
 		TransactionID:     transactionID,
@@ -297,6 +491,7 @@ func newTxConnection(conn *DBConn, transactionID int64, pool *TxPool, immediate
 		Queries:           make([]string, 0, 8),
 		ImmediateCallerID: immediate,
 		EffectiveCallerID: effective,
+		Partition:         partition,
 	}
 }
 
@@ -313,6 +508,23 @@ func (txc *TxConnection) Exec(ctx context.Context, query string, maxrows int, wa
 	return r, nil
 }
 
+// Stream streams the results of the query within the transaction. It
+// does not retry on connection errors, as the transaction would be lost.
+func (txc *TxConnection) Stream(ctx context.Context, query string, callback func(*sqltypes.Result) error, streamBufferSize int) error {
+	err := txc.DBConn.StreamOnce(ctx, query, callback, streamBufferSize)
+	if err != nil {
+		if _, ok := err.(*TabletError); ok {
+			return err
+		}
+		if IsConnErr(err) {
+			txc.pool.checker.CheckMySQL()
+			return NewTabletErrorSQL(vtrpcpb.ErrorCode_INTERNAL_ERROR, err)
+		}
+		return NewTabletErrorSQL(vtrpcpb.ErrorCode_UNKNOWN_ERROR, err)
+	}
+	return nil
+}
+
 // Recycle returns the connection to the pool. The transaction remains
 // active.
 func (txc *TxConnection) Recycle() {
@@ -360,7 +572,7 @@ func (txc *TxConnection) EventTime() time.Time {
 // Format returns a printable version of the connection info.
 func (txc *TxConnection) Format(params url.Values) string {
 	return fmt.Sprintf(
-		"%v\t'%v'\t'%v'\t%v\t%v\t%.6f\t%v\t%v\t\n",
+		"%v\t'%v'\t'%v'\t%v\t%v\t%.6f\t%v\t%v\t%v\t\n",
 		txc.TransactionID,
 		callerid.GetPrincipal(txc.EffectiveCallerID),
 		callerid.GetUsername(txc.ImmediateCallerID),
@@ -369,5 +581,6 @@ func (txc *TxConnection) Format(params url.Values) string {
 		txc.EndTime.Sub(txc.StartTime).Seconds(),
 		txc.Conclusion,
 		strings.Join(txc.Queries, ";"),
+		txc.Partition,
 	)
 }
@@ -7,6 +7,7 @@ package tabletserver
 import (
 	"fmt"
 	"math/rand"
+	"reflect"
 	"testing"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 
 	"github.com/youtube/vitess/go/sqldb"
 	"github.com/youtube/vitess/go/sqltypes"
+	"github.com/youtube/vitess/go/vt/callerid"
 	"github.com/youtube/vitess/go/vt/vttest/fakesqldb"
 )
 
@@ -353,7 +355,135 @@ func TestTxPoolLocal(t *testing.T) {
 	}
 }
 
+func TestTxPoolPartitions(t *testing.T) {
+	db := fakesqldb.Register()
+	db.AddQuery("begin", &sqltypes.Result{})
+	db.AddQuery("rollback", &sqltypes.Result{})
+	txPool := newPartitionedTxPool(false, map[string]int{"batch": 1, "web": 2})
+	appParams := sqldb.ConnParams{Engine: db.Name}
+	dbaParams := sqldb.ConnParams{Engine: db.Name}
+	txPool.Open(&appParams, &dbaParams)
+	defer txPool.Close()
+
+	batchCtx := callerid.NewContext(context.Background(), callerid.NewEffectiveCallerID("batch", "", ""), nil)
+	webCtx := callerid.NewContext(context.Background(), callerid.NewEffectiveCallerID("user1", "web", ""), nil)
+	otherCtx := callerid.NewContext(context.Background(), callerid.NewEffectiveCallerID("user2", "other", ""), nil)
+	testcases := []struct {
+		ctx  context.Context
+		want string
+	}{
+		{batchCtx, "batch"},
+		// The batch reservation is exhausted.
+		{batchCtx, TxSharedPartition},
+		// Matched by component.
+		{webCtx, "web"},
+		// The priority comes first.
+		{withTxPriority(otherCtx, "web"), "web"},
+		{withTxPriority(otherCtx, "unknown"), TxSharedPartition},
+		{context.Background(), TxSharedPartition},
+	}
+	var conns []*TxConnection
+	for _, tcase := range testcases {
+		conn, err := txPool.LocalBegin(tcase.ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if conn.Partition != tcase.want {
+			t.Errorf("Partition for %v: %s, want %s", callerid.EffectiveCallerIDFromContext(tcase.ctx), conn.Partition, tcase.want)
+		}
+		conns = append(conns, conn)
+	}
+
+	// An overflow that cannot get a shared connection is not counted.
+	cancelledCtx, cancel := context.WithCancel(batchCtx)
+	cancel()
+	if _, err := txPool.LocalBegin(cancelledCtx); err == nil {
+		t.Errorf("LocalBegin with a cancelled context succeeded")
+	}
+
+	got := txPool.PartitionStats()
+	want := []TxPartitionStats{
+		{Name: TxSharedPartition, Capacity: 297, InUse: 3},
+		{Name: "batch", Capacity: 1, InUse: 1, Overflows: 1},
+		{Name: "web", Capacity: 2, InUse: 2},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("PartitionStats: %+v, want %+v", got, want)
+	}
+
+	for _, conn := range conns {
+		txPool.LocalConclude(context.Background(), conn)
+	}
+	conn, err := txPool.LocalBegin(batchCtx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if conn.Partition != "batch" {
+		t.Errorf("Partition after conclude: %s, want batch", conn.Partition)
+	}
+	txPool.LocalConclude(context.Background(), conn)
+}
+
+func TestTxPoolPartitionStatsUnpartitioned(t *testing.T) {
+	txPool := newTxPool(false)
+	if got := txPool.PartitionStats(); got != nil {
+		t.Errorf("PartitionStats: %+v, want nil", got)
+	}
+}
+
+func TestParseTxPoolPartitions(t *testing.T) {
+	testcases := []struct {
+		spec string
+		want map[string]int
+		err  string
+	}{{
+		spec: "",
+		want: map[string]int{},
+	}, {
+		spec: "batch:2, web:5",
+		want: map[string]int{"batch": 2, "web": 5},
+	}, {
+		spec: "batch",
+		err:  `invalid transaction pool partition "batch", expecting name:reserved`,
+	}, {
+		spec: "batch:0",
+		err:  `invalid reserved capacity for transaction pool partition "batch": "0"`,
+	}, {
+		spec: "batch:a",
+		err:  `invalid reserved capacity for transaction pool partition "batch": "a"`,
+	}, {
+		spec: "batch:1,batch:2",
+		err:  `duplicate transaction pool partition "batch"`,
+	}, {
+		spec: "shared:1",
+		err:  `transaction pool partition name "shared" is reserved`,
+	}, {
+		spec: "batch:5,web:5",
+		err:  "transaction pool partitions reserve 10 connections, which leaves no shared capacity out of 10",
+	}}
+	for _, tcase := range testcases {
+		got, err := ParseTxPoolPartitions(tcase.spec, 10)
+		if tcase.err != "" {
+			if err == nil || err.Error() != tcase.err {
+				t.Errorf("ParseTxPoolPartitions(%q) err: %v, want %s", tcase.spec, err, tcase.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseTxPoolPartitions(%q): %v", tcase.spec, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tcase.want) {
+			t.Errorf("ParseTxPoolPartitions(%q): %v, want %v", tcase.spec, got, tcase.want)
+		}
+	}
+}
+
 func newTxPool(enablePublishStats bool) *TxPool {
+	return newPartitionedTxPool(enablePublishStats, nil)
+}
+
+func newPartitionedTxPool(enablePublishStats bool, partitions map[string]int) *TxPool {
 	randID := rand.Int63()
 	poolName := fmt.Sprintf("TestTransactionPool-%d", randID)
 	txStatsPrefix := fmt.Sprintf("TxStats-%d-", randID)
@@ -365,6 +495,7 @@ func newTxPool(enablePublishStats bool) *TxPool {
 		poolName,
 		txStatsPrefix,
 		transactionCap,
+		partitions,
 		transactionTimeout,
 		idleTimeout,
 		enablePublishStats,
@@ -31,6 +31,7 @@ var (
 				<th>End</th>
 				<th>Duration</th>
 				<th>Decision</th>
+				<th>Partition</th>
 				<th>Statements</th>
 			</tr>
 		</thead>
@@ -49,6 +50,7 @@ var (
 			<td>{{.EndTime | stampMicro}}</td>
 			<td>{{.Duration}}</td>
 			<td>{{.Conclusion}}</td>
+			<td>{{.Partition}}</td>
 			<td>
 				{{ range .Queries }}
 					{{.}}<br>
@@ -0,0 +1,627 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	log "github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	"github.com/pmezard/go-difflib/difflib"
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/stats"
+	"github.com/youtube/vitess/go/vt/callerid"
+	"github.com/youtube/vitess/go/vt/callinfo"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+	vschemapb "github.com/youtube/vitess/go/vt/proto/vschema"
+	workflowpb "github.com/youtube/vitess/go/vt/proto/workflow"
+)
+
+// This file provides the audit log of the topology: a decorator of
+// Impl that records every change in the topology Backend of the cell
+// of the changed object, and the utility methods to read it.
+
+var (
+	topoAuditLog = flag.Bool("topo_audit_log", false, "record every change this process makes to the topology in the audit log of the cell of the changed object")
+
+	// auditErrors counts the changes that couldn't be recorded.
+	auditErrors = stats.NewCounters("TopoAuditErrors")
+)
+
+const (
+	auditPath = "/audit"
+
+	// The actions recorded in an AuditEntry.
+	auditCreate = "Create"
+	auditUpdate = "Update"
+	auditDelete = "Delete"
+)
+
+// AuditEntry describes a change of the topology.
+type AuditEntry struct {
+	// Name is the name of the entry in the audit log. It is set
+	// when the entry is read.
+	Name string `json:"-"`
+
+	Time time.Time
+	Cell string
+
+	// Path is the path of the changed object in the cell, as the
+	// Backend methods use it, e.g. /keyspaces/ks/Keyspace.
+	Path string
+
+	// Action is Create, Update or Delete.
+	Action     string
+	OldVersion string
+	NewVersion string
+
+	// Caller describes the caller found in the context of the
+	// change, and Process the process that made it.
+	Caller  string
+	Process string
+
+	// Diff is a unified diff of the text representations of the
+	// old and new values.
+	Diff string
+}
+
+// GetAuditEntries returns the latest entries of the audit log of a
+// cell, oldest first. Only the entries about the paths that start
+// with pathPrefix, and the ones recorded after since, are
+// returned. If limit is not 0, at most limit entries are returned.
+func (ts Server) GetAuditEntries(ctx context.Context, cell, pathPrefix string, since time.Time, limit int) ([]*AuditEntry, error) {
+	names, err := ts.GetAuditEntryNames(ctx, cell)
+	if err != nil {
+		return nil, err
+	}
+
+	// The names are sorted by time, we read them from the latest.
+	var sinceName string
+	if !since.IsZero() {
+		sinceName = auditEntryName(since)
+	}
+	var result []*AuditEntry
+	for i := len(names) - 1; i >= 0; i-- {
+		if names[i] < sinceName || (limit != 0 && len(result) == limit) {
+			break
+		}
+		entry, err := ts.GetAuditEntry(ctx, cell, names[i])
+		switch err {
+		case nil:
+		case ErrNoNode:
+			// The entry was pruned since we listed them.
+			continue
+		default:
+			return nil, err
+		}
+		if strings.HasPrefix(entry.Path, pathPrefix) {
+			result = append(result, entry)
+		}
+	}
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+	return result, nil
+}
+
+// GetAuditEntryNames returns the names of the entries of the audit
+// log of a cell. They are sorted by time.
+func (ts Server) GetAuditEntryNames(ctx context.Context, cell string) ([]string, error) {
+	names, err := ts.ListDir(ctx, cell, auditPath)
+	switch err {
+	case ErrNoNode:
+		return nil, nil
+	case nil:
+		return names, nil
+	default:
+		return nil, err
+	}
+}
+
+// GetAuditEntry reads an entry of the audit log of a cell.
+// Can return ErrNoNode.
+func (ts Server) GetAuditEntry(ctx context.Context, cell, name string) (*AuditEntry, error) {
+	contents, _, err := ts.Get(ctx, cell, path.Join(auditPath, name))
+	if err != nil {
+		return nil, err
+	}
+	entry := &AuditEntry{}
+	if err := json.Unmarshal(contents, entry); err != nil {
+		return nil, fmt.Errorf("bad audit entry %v in cell %v: %v", name, cell, err)
+	}
+	entry.Name = name
+	return entry, nil
+}
+
+// DeleteAuditEntry deletes an entry of the audit log of a cell.
+func (ts Server) DeleteAuditEntry(ctx context.Context, cell, name string) error {
+	return ts.Delete(ctx, cell, path.Join(auditPath, name), nil)
+}
+
+// PruneAuditEntries deletes the entries of the audit log of a cell,
+// except the keepCount latest ones and the ones recorded less than
+// keepDuration ago. It returns the names of the deleted entries. With
+// dryRun, it only returns the names.
+func (ts Server) PruneAuditEntries(ctx context.Context, cell string, keepCount int, keepDuration time.Duration, dryRun bool) ([]string, error) {
+	names, err := ts.GetAuditEntryNames(ctx, cell)
+	if err != nil {
+		return nil, err
+	}
+	end := len(names) - keepCount
+	if keepDuration != 0 {
+		keepName := auditEntryName(time.Now().Add(-keepDuration))
+		for end > 0 && names[end-1] >= keepName {
+			end--
+		}
+	}
+	if end <= 0 {
+		return nil, nil
+	}
+	if dryRun {
+		return names[:end], nil
+	}
+	var pruned []string
+	for _, name := range names[:end] {
+		if err := ts.DeleteAuditEntry(ctx, cell, name); err != nil && err != ErrNoNode {
+			return pruned, err
+		}
+		pruned = append(pruned, name)
+	}
+	return pruned, nil
+}
+
+// createAuditEntry saves a new entry in the audit log of its cell.
+func (ts Server) createAuditEntry(ctx context.Context, entry *AuditEntry) error {
+	contents, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	// The names are the zero-padded time of the entries, so they
+	// sort in time order. Another process may have used the same
+	// name, we then try the next nanoseconds.
+	t := entry.Time
+	for i := 0; i < 10; i++ {
+		_, err = ts.Create(ctx, entry.Cell, path.Join(auditPath, auditEntryName(t)), contents)
+		if err != ErrNodeExists {
+			return err
+		}
+		t = t.Add(time.Nanosecond)
+	}
+	return err
+}
+
+func auditEntryName(t time.Time) string {
+	return fmt.Sprintf("%019d", t.UnixNano())
+}
+
+// auditProcess describes the current process in the AuditEntry.
+var auditProcess = func() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("%v@%v(pid %v)", path.Base(os.Args[0]), hostname, os.Getpid())
+}()
+
+// auditCaller describes the caller found in the context, or returns
+// "" if there is none.
+func auditCaller(ctx context.Context) string {
+	var parts []string
+	if ef := callerid.EffectiveCallerIDFromContext(ctx); ef != nil {
+		parts = append(parts, fmt.Sprintf("effective caller %v/%v/%v", ef.Principal, ef.Component, ef.Subcomponent))
+	}
+	if im := callerid.ImmediateCallerIDFromContext(ctx); im != nil {
+		parts = append(parts, fmt.Sprintf("immediate caller %v", im.Username))
+	}
+	if ci, ok := callinfo.FromContext(ctx); ok {
+		parts = append(parts, ci.Text())
+	}
+	return strings.Join(parts, ", ")
+}
+
+// auditText returns the text representation of a value for the diff
+// of an AuditEntry.
+func auditText(value proto.Message) string {
+	if value == nil || reflect.ValueOf(value).IsNil() {
+		return ""
+	}
+	return proto.MarshalTextString(value)
+}
+
+// auditFileText returns the text representation of the contents of
+// a Backend file. The contents of the known files are decoded.
+func auditFileText(filePath string, contents []byte) string {
+	if contents == nil {
+		return ""
+	}
+	var value proto.Message
+	switch path.Base(filePath) {
+	case CellInfoFile:
+		value = &topodatapb.CellInfo{}
+	case KeyspaceFile:
+		value = &topodatapb.Keyspace{}
+	case ShardFile:
+		value = &topodatapb.Shard{}
+	case VSchemaFile:
+		value = &vschemapb.Keyspace{}
+	case ShardReplicationFile:
+		value = &topodatapb.ShardReplication{}
+	case TabletFile:
+		value = &topodatapb.Tablet{}
+	case SrvVSchemaFile:
+		value = &vschemapb.SrvVSchema{}
+	case SrvKeyspaceFile:
+		value = &topodatapb.SrvKeyspace{}
+	case workflowFilename:
+		value = &workflowpb.Workflow{}
+	}
+	if value != nil && proto.Unmarshal(contents, value) == nil {
+		return auditText(value)
+	}
+	if utf8.Valid(contents) {
+		return string(contents)
+	}
+	return fmt.Sprintf("<%v bytes>\n", len(contents))
+}
+
+// auditDiff returns a unified diff between two text representations.
+func auditDiff(filePath, oldText, newText string) string {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        auditLines(oldText),
+		B:        auditLines(newText),
+		FromFile: "old " + filePath,
+		ToFile:   "new " + filePath,
+		Context:  3,
+	})
+	if err != nil {
+		return fmt.Sprintf("cannot diff: %v", err)
+	}
+	return diff
+}
+
+// auditLines splits a text representation in lines. Unlike
+// difflib.SplitLines, it returns no line for an empty text.
+func auditLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return difflib.SplitLines(strings.TrimSuffix(text, "\n"))
+}
+
+func versionString(version Version) string {
+	if version == nil {
+		return ""
+	}
+	return version.String()
+}
+
+// int64Version returns the text representation of the version
+// returned by a read, or "" if the read failed.
+func int64Version(version int64, err error) string {
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", version)
+}
+
+// updateAction returns the action of an unconditional update, given
+// the error of the read of the old value: the update creates the
+// object if it didn't exist.
+func updateAction(err error) string {
+	if err == ErrNoNode {
+		return auditCreate
+	}
+	return auditUpdate
+}
+
+// auditImpl is the Impl decorator that records the changes in the
+// audit log. Failing to record a change doesn't fail the change: it
+// is logged, and counted in TopoAuditErrors.
+//
+// To compute the diffs, auditImpl reads the old value before
+// changing it. When the change doesn't check the version, the old
+// value can be off if another process changes it at the same time.
+type auditImpl struct {
+	Impl
+}
+
+// NewAuditImpl returns an Impl that records the changes made through
+// it in the audit log of the cell of the changed object.
+func NewAuditImpl(impl Impl) Impl {
+	return &auditImpl{Impl: impl}
+}
+
+// record saves an AuditEntry for a change.
+func (a *auditImpl) record(ctx context.Context, cell, filePath, action, oldVersion, newVersion, oldText, newText string) {
+	entry := &AuditEntry{
+		Time:       time.Now(),
+		Cell:       cell,
+		Path:       filePath,
+		Action:     action,
+		OldVersion: oldVersion,
+		NewVersion: newVersion,
+		Caller:     auditCaller(ctx),
+		Process:    auditProcess,
+		Diff:       auditDiff(filePath, oldText, newText),
+	}
+	if err := (Server{Impl: a.Impl}).createAuditEntry(ctx, entry); err != nil {
+		auditErrors.Add(cell, 1)
+		log.Warningf("cannot record %v of %v in the audit log of cell %v: %v", action, filePath, cell, err)
+	}
+}
+
+//
+// Backend API
+//
+
+// Create is part of the topo.Backend interface.
+func (a *auditImpl) Create(ctx context.Context, cell, filePath string, contents []byte) (Version, error) {
+	version, err := a.Impl.Create(ctx, cell, filePath, contents)
+	if err == nil && !isAuditPath(filePath) {
+		a.record(ctx, cell, filePath, auditCreate, "", versionString(version), "", auditFileText(filePath, contents))
+	}
+	return version, err
+}
+
+// Update is part of the topo.Backend interface.
+func (a *auditImpl) Update(ctx context.Context, cell, filePath string, contents []byte, version Version) (Version, error) {
+	if isAuditPath(filePath) {
+		return a.Impl.Update(ctx, cell, filePath, contents, version)
+	}
+	oldContents, oldVersion, oldErr := a.Impl.Get(ctx, cell, filePath)
+	newVersion, err := a.Impl.Update(ctx, cell, filePath, contents, version)
+	if err == nil {
+		a.record(ctx, cell, filePath, updateAction(oldErr), versionString(oldVersion), versionString(newVersion), auditFileText(filePath, oldContents), auditFileText(filePath, contents))
+	}
+	return newVersion, err
+}
+
+// Delete is part of the topo.Backend interface.
+func (a *auditImpl) Delete(ctx context.Context, cell, filePath string, version Version) error {
+	if isAuditPath(filePath) {
+		return a.Impl.Delete(ctx, cell, filePath, version)
+	}
+	oldContents, oldVersion, _ := a.Impl.Get(ctx, cell, filePath)
+	err := a.Impl.Delete(ctx, cell, filePath, version)
+	if err == nil {
+		a.record(ctx, cell, filePath, auditDelete, versionString(oldVersion), "", auditFileText(filePath, oldContents), "")
+	}
+	return err
+}
+
+// isAuditPath returns true for the paths of the audit log itself,
+// which are not recorded.
+func isAuditPath(filePath string) bool {
+	return strings.HasPrefix(path.Clean(filePath), auditPath+"/")
+}
+
+//
+// Keyspace management, global.
+//
+
+func keyspaceAuditPath(keyspace string) string {
+	return path.Join("/keyspaces", keyspace, KeyspaceFile)
+}
+
+// CreateKeyspace is part of the topo.Impl interface.
+func (a *auditImpl) CreateKeyspace(ctx context.Context, keyspace string, value *topodatapb.Keyspace) error {
+	err := a.Impl.CreateKeyspace(ctx, keyspace, value)
+	if err == nil {
+		a.record(ctx, GlobalCell, keyspaceAuditPath(keyspace), auditCreate, "", "", "", auditText(value))
+	}
+	return err
+}
+
+// UpdateKeyspace is part of the topo.Impl interface.
+func (a *auditImpl) UpdateKeyspace(ctx context.Context, keyspace string, value *topodatapb.Keyspace, existingVersion int64) (int64, error) {
+	old, oldVersion, oldErr := a.Impl.GetKeyspace(ctx, keyspace)
+	newVersion, err := a.Impl.UpdateKeyspace(ctx, keyspace, value, existingVersion)
+	if err == nil {
+		a.record(ctx, GlobalCell, keyspaceAuditPath(keyspace), auditUpdate, int64Version(oldVersion, oldErr), int64Version(newVersion, nil), auditText(old), auditText(value))
+	}
+	return newVersion, err
+}
+
+// DeleteKeyspace is part of the topo.Impl interface.
+func (a *auditImpl) DeleteKeyspace(ctx context.Context, keyspace string) error {
+	old, oldVersion, oldErr := a.Impl.GetKeyspace(ctx, keyspace)
+	err := a.Impl.DeleteKeyspace(ctx, keyspace)
+	if err == nil {
+		a.record(ctx, GlobalCell, keyspaceAuditPath(keyspace), auditDelete, int64Version(oldVersion, oldErr), "", auditText(old), "")
+	}
+	return err
+}
+
+//
+// Shard management, global.
+//
+
+func shardAuditPath(keyspace, shard string) string {
+	return path.Join("/keyspaces", keyspace, "shards", shard, ShardFile)
+}
+
+// CreateShard is part of the topo.Impl interface.
+func (a *auditImpl) CreateShard(ctx context.Context, keyspace, shard string, value *topodatapb.Shard) error {
+	err := a.Impl.CreateShard(ctx, keyspace, shard, value)
+	if err == nil {
+		a.record(ctx, GlobalCell, shardAuditPath(keyspace, shard), auditCreate, "", "", "", auditText(value))
+	}
+	return err
+}
+
+// UpdateShard is part of the topo.Impl interface.
+func (a *auditImpl) UpdateShard(ctx context.Context, keyspace, shard string, value *topodatapb.Shard, existingVersion int64) (int64, error) {
+	old, oldVersion, oldErr := a.Impl.GetShard(ctx, keyspace, shard)
+	newVersion, err := a.Impl.UpdateShard(ctx, keyspace, shard, value, existingVersion)
+	if err == nil {
+		a.record(ctx, GlobalCell, shardAuditPath(keyspace, shard), auditUpdate, int64Version(oldVersion, oldErr), int64Version(newVersion, nil), auditText(old), auditText(value))
+	}
+	return newVersion, err
+}
+
+// DeleteShard is part of the topo.Impl interface.
+func (a *auditImpl) DeleteShard(ctx context.Context, keyspace, shard string) error {
+	old, oldVersion, oldErr := a.Impl.GetShard(ctx, keyspace, shard)
+	err := a.Impl.DeleteShard(ctx, keyspace, shard)
+	if err == nil {
+		a.record(ctx, GlobalCell, shardAuditPath(keyspace, shard), auditDelete, int64Version(oldVersion, oldErr), "", auditText(old), "")
+	}
+	return err
+}
+
+//
+// Tablet management, per cell.
+//
+
+func tabletAuditPath(alias *topodatapb.TabletAlias) string {
+	return path.Join("/tablets", topoproto.TabletAliasString(alias), TabletFile)
+}
+
+// CreateTablet is part of the topo.Impl interface.
+func (a *auditImpl) CreateTablet(ctx context.Context, tablet *topodatapb.Tablet) error {
+	err := a.Impl.CreateTablet(ctx, tablet)
+	if err == nil {
+		a.record(ctx, tablet.Alias.Cell, tabletAuditPath(tablet.Alias), auditCreate, "", "", "", auditText(tablet))
+	}
+	return err
+}
+
+// UpdateTablet is part of the topo.Impl interface.
+func (a *auditImpl) UpdateTablet(ctx context.Context, tablet *topodatapb.Tablet, existingVersion int64) (int64, error) {
+	old, oldVersion, oldErr := a.Impl.GetTablet(ctx, tablet.Alias)
+	newVersion, err := a.Impl.UpdateTablet(ctx, tablet, existingVersion)
+	if err == nil {
+		a.record(ctx, tablet.Alias.Cell, tabletAuditPath(tablet.Alias), auditUpdate, int64Version(oldVersion, oldErr), int64Version(newVersion, nil), auditText(old), auditText(tablet))
+	}
+	return newVersion, err
+}
+
+// DeleteTablet is part of the topo.Impl interface.
+func (a *auditImpl) DeleteTablet(ctx context.Context, alias *topodatapb.TabletAlias) error {
+	old, oldVersion, oldErr := a.Impl.GetTablet(ctx, alias)
+	err := a.Impl.DeleteTablet(ctx, alias)
+	if err == nil {
+		a.record(ctx, alias.Cell, tabletAuditPath(alias), auditDelete, int64Version(oldVersion, oldErr), "", auditText(old), "")
+	}
+	return err
+}
+
+//
+// Replication graph management, per cell.
+//
+
+func shardReplicationAuditPath(keyspace, shard string) string {
+	return path.Join("/keyspaces", keyspace, "shards", shard, ShardReplicationFile)
+}
+
+// UpdateShardReplicationFields is part of the topo.Impl interface.
+func (a *auditImpl) UpdateShardReplicationFields(ctx context.Context, cell, keyspace, shard string, update func(*topodatapb.ShardReplication) error) error {
+	// The update function can be called several times, we
+	// record the values of the last call. If it returned
+	// ErrNoUpdateNeeded, nothing was written and the Impl
+	// returns nil: there is nothing to record.
+	var oldText, newText string
+	updated := false
+	err := a.Impl.UpdateShardReplicationFields(ctx, cell, keyspace, shard, func(sr *topodatapb.ShardReplication) error {
+		updated = false
+		oldText = auditText(sr)
+		if err := update(sr); err != nil {
+			return err
+		}
+		updated = true
+		newText = auditText(sr)
+		return nil
+	})
+	if err == nil && updated {
+		a.record(ctx, cell, shardReplicationAuditPath(keyspace, shard), auditUpdate, "", "", oldText, newText)
+	}
+	return err
+}
+
+// DeleteShardReplication is part of the topo.Impl interface.
+func (a *auditImpl) DeleteShardReplication(ctx context.Context, cell, keyspace, shard string) error {
+	var oldText string
+	if sri, err := a.Impl.GetShardReplication(ctx, cell, keyspace, shard); err == nil {
+		oldText = auditText(sri.ShardReplication)
+	}
+	err := a.Impl.DeleteShardReplication(ctx, cell, keyspace, shard)
+	if err == nil {
+		a.record(ctx, cell, shardReplicationAuditPath(keyspace, shard), auditDelete, "", "", oldText, "")
+	}
+	return err
+}
+
+// DeleteKeyspaceReplication is part of the topo.Impl interface.
+func (a *auditImpl) DeleteKeyspaceReplication(ctx context.Context, cell, keyspace string) error {
+	err := a.Impl.DeleteKeyspaceReplication(ctx, cell, keyspace)
+	if err == nil {
+		a.record(ctx, cell, path.Join("/keyspaces", keyspace, "shards"), auditDelete, "", "", "", "")
+	}
+	return err
+}
+
+//
+// Serving Graph management, per cell.
+//
+
+func srvKeyspaceAuditPath(keyspace string) string {
+	return path.Join("/keyspaces", keyspace, SrvKeyspaceFile)
+}
+
+// UpdateSrvKeyspace is part of the topo.Impl interface.
+func (a *auditImpl) UpdateSrvKeyspace(ctx context.Context, cell, keyspace string, srvKeyspace *topodatapb.SrvKeyspace) error {
+	old, oldErr := a.Impl.GetSrvKeyspace(ctx, cell, keyspace)
+	err := a.Impl.UpdateSrvKeyspace(ctx, cell, keyspace, srvKeyspace)
+	if err == nil {
+		a.record(ctx, cell, srvKeyspaceAuditPath(keyspace), updateAction(oldErr), "", "", auditText(old), auditText(srvKeyspace))
+	}
+	return err
+}
+
+// DeleteSrvKeyspace is part of the topo.Impl interface.
+func (a *auditImpl) DeleteSrvKeyspace(ctx context.Context, cell, keyspace string) error {
+	old, _ := a.Impl.GetSrvKeyspace(ctx, cell, keyspace)
+	err := a.Impl.DeleteSrvKeyspace(ctx, cell, keyspace)
+	if err == nil {
+		a.record(ctx, cell, srvKeyspaceAuditPath(keyspace), auditDelete, "", "", auditText(old), "")
+	}
+	return err
+}
+
+// UpdateSrvVSchema is part of the topo.Impl interface.
+func (a *auditImpl) UpdateSrvVSchema(ctx context.Context, cell string, srvVSchema *vschemapb.SrvVSchema) error {
+	old, oldErr := a.Impl.GetSrvVSchema(ctx, cell)
+	err := a.Impl.UpdateSrvVSchema(ctx, cell, srvVSchema)
+	if err == nil {
+		a.record(ctx, cell, "/"+SrvVSchemaFile, updateAction(oldErr), "", "", auditText(old), auditText(srvVSchema))
+	}
+	return err
+}
+
+//
+// V3 Schema management, global
+//
+
+// SaveVSchema is part of the topo.Impl interface.
+func (a *auditImpl) SaveVSchema(ctx context.Context, keyspace string, vschema *vschemapb.Keyspace) error {
+	old, oldErr := a.Impl.GetVSchema(ctx, keyspace)
+	err := a.Impl.SaveVSchema(ctx, keyspace, vschema)
+	if err == nil {
+		a.record(ctx, GlobalCell, path.Join("/keyspaces", keyspace, VSchemaFile), updateAction(oldErr), "", "", auditText(old), auditText(vschema))
+	}
+	return err
+}
@@ -0,0 +1,300 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topo
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/callerid"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+type fakeAuditVersion int
+
+func (v fakeAuditVersion) String() string {
+	return fmt.Sprintf("%v", int(v))
+}
+
+// fakeAuditBackend stores the files in memory, and implements the
+// keyspace methods of Impl on top of them.
+type fakeAuditBackend struct {
+	Impl
+	files    map[string][]byte
+	versions map[string]int
+}
+
+func newFakeAuditBackend() *fakeAuditBackend {
+	return &fakeAuditBackend{
+		files:    make(map[string][]byte),
+		versions: make(map[string]int),
+	}
+}
+
+func (f *fakeAuditBackend) ListDir(ctx context.Context, cell, dirPath string) ([]string, error) {
+	prefix := cell + dirPath + "/"
+	var result []string
+	for key := range f.files {
+		if strings.HasPrefix(key, prefix) {
+			result = append(result, strings.SplitN(key[len(prefix):], "/", 2)[0])
+		}
+	}
+	if len(result) == 0 {
+		return nil, ErrNoNode
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+func (f *fakeAuditBackend) Create(ctx context.Context, cell, filePath string, contents []byte) (Version, error) {
+	key := cell + filePath
+	if _, ok := f.files[key]; ok {
+		return nil, ErrNodeExists
+	}
+	return f.Update(ctx, cell, filePath, contents, nil)
+}
+
+func (f *fakeAuditBackend) Update(ctx context.Context, cell, filePath string, contents []byte, version Version) (Version, error) {
+	key := cell + filePath
+	if version != nil && int(version.(fakeAuditVersion)) != f.versions[key] {
+		return nil, ErrBadVersion
+	}
+	f.files[key] = contents
+	f.versions[key]++
+	return fakeAuditVersion(f.versions[key]), nil
+}
+
+func (f *fakeAuditBackend) Get(ctx context.Context, cell, filePath string) ([]byte, Version, error) {
+	key := cell + filePath
+	contents, ok := f.files[key]
+	if !ok {
+		return nil, nil, ErrNoNode
+	}
+	return contents, fakeAuditVersion(f.versions[key]), nil
+}
+
+func (f *fakeAuditBackend) Delete(ctx context.Context, cell, filePath string, version Version) error {
+	key := cell + filePath
+	if _, ok := f.files[key]; !ok {
+		return ErrNoNode
+	}
+	delete(f.files, key)
+	return nil
+}
+
+func (f *fakeAuditBackend) CreateKeyspace(ctx context.Context, keyspace string, value *topodatapb.Keyspace) error {
+	contents, err := proto.Marshal(value)
+	if err != nil {
+		return err
+	}
+	_, err = f.Create(ctx, GlobalCell, keyspaceAuditPath(keyspace), contents)
+	return err
+}
+
+func (f *fakeAuditBackend) UpdateKeyspace(ctx context.Context, keyspace string, value *topodatapb.Keyspace, existingVersion int64) (int64, error) {
+	contents, err := proto.Marshal(value)
+	if err != nil {
+		return 0, err
+	}
+	version, err := f.Update(ctx, GlobalCell, keyspaceAuditPath(keyspace), contents, fakeAuditVersion(existingVersion))
+	if err != nil {
+		return 0, err
+	}
+	return int64(version.(fakeAuditVersion)), nil
+}
+
+func (f *fakeAuditBackend) GetKeyspace(ctx context.Context, keyspace string) (*topodatapb.Keyspace, int64, error) {
+	contents, version, err := f.Get(ctx, GlobalCell, keyspaceAuditPath(keyspace))
+	if err != nil {
+		return nil, 0, err
+	}
+	value := &topodatapb.Keyspace{}
+	if err := proto.Unmarshal(contents, value); err != nil {
+		return nil, 0, err
+	}
+	return value, int64(version.(fakeAuditVersion)), nil
+}
+
+func (f *fakeAuditBackend) DeleteKeyspace(ctx context.Context, keyspace string) error {
+	return f.Delete(ctx, GlobalCell, keyspaceAuditPath(keyspace), nil)
+}
+
+// UpdateShardReplicationFields behaves like the real Impls: it
+// returns nil if update returns ErrNoUpdateNeeded.
+func (f *fakeAuditBackend) UpdateShardReplicationFields(ctx context.Context, cell, keyspace, shard string, update func(*topodatapb.ShardReplication) error) error {
+	sr := &topodatapb.ShardReplication{}
+	filePath := shardReplicationAuditPath(keyspace, shard)
+	contents, version, err := f.Get(ctx, cell, filePath)
+	switch err {
+	case nil:
+		if err := proto.Unmarshal(contents, sr); err != nil {
+			return err
+		}
+	case ErrNoNode:
+	default:
+		return err
+	}
+	if err := update(sr); err != nil {
+		if err == ErrNoUpdateNeeded {
+			return nil
+		}
+		return err
+	}
+	if contents, err = proto.Marshal(sr); err != nil {
+		return err
+	}
+	_, err = f.Update(ctx, cell, filePath, contents, version)
+	return err
+}
+
+func TestAuditImpl(t *testing.T) {
+	ctx := callerid.NewContext(context.Background(), callerid.NewEffectiveCallerID("alice", "vtctl", ""), nil)
+	ts := Server{Impl: NewAuditImpl(newFakeAuditBackend())}
+
+	if err := ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{ShardingColumnName: "id"}); err != nil {
+		t.Fatalf("CreateKeyspace failed: %v", err)
+	}
+	if _, err := ts.Impl.UpdateKeyspace(ctx, "ks", &topodatapb.Keyspace{ShardingColumnName: "user_id"}, 1); err != nil {
+		t.Fatalf("UpdateKeyspace failed: %v", err)
+	}
+	// A failed change is not recorded.
+	if _, err := ts.Impl.UpdateKeyspace(ctx, "ks", &topodatapb.Keyspace{}, 1); err != ErrBadVersion {
+		t.Fatalf("UpdateKeyspace with a bad version returned %v, want ErrBadVersion", err)
+	}
+	shard, err := proto.Marshal(&topodatapb.Shard{Cells: []string{"cell1"}})
+	if err != nil {
+		t.Fatalf("proto.Marshal failed: %v", err)
+	}
+	if _, err := ts.Update(ctx, "cell1", "/keyspaces/ks/shards/0/Shard", shard, nil); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if err := ts.DeleteKeyspace(ctx, "ks"); err != nil {
+		t.Fatalf("DeleteKeyspace failed: %v", err)
+	}
+
+	entries, err := ts.GetAuditEntries(ctx, GlobalCell, "", time.Time{}, 0)
+	if err != nil {
+		t.Fatalf("GetAuditEntries failed: %v", err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("got %v entries in the global cell, want 3: %v", len(entries), entries)
+	}
+	for i, want := range []struct {
+		action, oldVersion, newVersion string
+	}{
+		{"Create", "", ""},
+		{"Update", "1", "2"},
+		{"Delete", "2", ""},
+	} {
+		e := entries[i]
+		if e.Path != "/keyspaces/ks/Keyspace" || e.Action != want.action || e.OldVersion != want.oldVersion || e.NewVersion != want.newVersion {
+			t.Errorf("entry %v = %+v, want %+v on /keyspaces/ks/Keyspace", i, e, want)
+		}
+		if e.Caller != "effective caller alice/vtctl/" || e.Process != auditProcess {
+			t.Errorf("entry %v has caller %q and process %q", i, e.Caller, e.Process)
+		}
+	}
+	if want := "-sharding_column_name: \"id\"\n+sharding_column_name: \"user_id\"\n"; !strings.Contains(entries[1].Diff, want) {
+		t.Errorf("update diff is:\n%v\nwant it to contain:\n%v", entries[1].Diff, want)
+	}
+
+	// The Backend change is recorded in its cell, with its
+	// decoded contents.
+	entries, err = ts.GetAuditEntries(ctx, "cell1", "/keyspaces/ks/", time.Time{}, 0)
+	if err != nil {
+		t.Fatalf("GetAuditEntries failed: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Action != "Create" || !strings.Contains(entries[0].Diff, "+cells: \"cell1\"\n") {
+		t.Errorf("got entries %+v in cell1, want the Shard creation", entries)
+	}
+
+	// limit returns the latest entries, and pruning the audit log
+	// is not recorded.
+	entries, err = ts.GetAuditEntries(ctx, GlobalCell, "", time.Time{}, 1)
+	if err != nil {
+		t.Fatalf("GetAuditEntries failed: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Action != "Delete" {
+		t.Errorf("got entries %+v, want the Delete", entries)
+	}
+	if err := ts.DeleteAuditEntry(ctx, GlobalCell, entries[0].Name); err != nil {
+		t.Fatalf("DeleteAuditEntry failed: %v", err)
+	}
+	names, err := ts.GetAuditEntryNames(ctx, GlobalCell)
+	if err != nil || len(names) != 2 {
+		t.Fatalf("GetAuditEntryNames returned %v %v, want 2 names", names, err)
+	}
+
+	// Pruning keeps the latest and the recent entries.
+	if pruned, err := ts.PruneAuditEntries(ctx, GlobalCell, 1, time.Hour, false); err != nil || len(pruned) != 0 {
+		t.Errorf("PruneAuditEntries with keepDuration returned %v %v, want nothing pruned", pruned, err)
+	}
+	if pruned, err := ts.PruneAuditEntries(ctx, GlobalCell, 1, 0, true); err != nil || len(pruned) != 1 || pruned[0] != names[0] {
+		t.Errorf("PruneAuditEntries with dryRun returned %v %v, want %v", pruned, err, names[0])
+	}
+	if pruned, err := ts.PruneAuditEntries(ctx, GlobalCell, 1, 0, false); err != nil || len(pruned) != 1 || pruned[0] != names[0] {
+		t.Errorf("PruneAuditEntries returned %v %v, want %v", pruned, err, names[0])
+	}
+	if names, err := ts.GetAuditEntryNames(ctx, GlobalCell); err != nil || len(names) != 1 {
+		t.Errorf("GetAuditEntryNames after pruning returned %v %v, want 1 name", names, err)
+	}
+}
+
+func TestAuditUpdateShardReplicationFields(t *testing.T) {
+	ctx := context.Background()
+	ts := Server{Impl: NewAuditImpl(newFakeAuditBackend())}
+	node := &topodatapb.ShardReplication_Node{
+		TabletAlias: &topodatapb.TabletAlias{Cell: "cell1", Uid: 1},
+	}
+	if err := ts.UpdateShardReplicationFields(ctx, "cell1", "ks", "0", func(sr *topodatapb.ShardReplication) error {
+		sr.Nodes = append(sr.Nodes, node)
+		return nil
+	}); err != nil {
+		t.Fatalf("UpdateShardReplicationFields failed: %v", err)
+	}
+	// A no-op update is not recorded.
+	if err := ts.UpdateShardReplicationFields(ctx, "cell1", "ks", "0", func(sr *topodatapb.ShardReplication) error {
+		return ErrNoUpdateNeeded
+	}); err != nil {
+		t.Fatalf("UpdateShardReplicationFields with no update needed failed: %v", err)
+	}
+
+	entries, err := ts.GetAuditEntries(ctx, "cell1", "", time.Time{}, 0)
+	if err != nil {
+		t.Fatalf("GetAuditEntries failed: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Action != "Update" || !strings.Contains(entries[0].Diff, "+nodes: <") {
+		t.Errorf("got entries %+v in cell1, want the ShardReplication update only", entries)
+	}
+}
+
+func TestAuditFileText(t *testing.T) {
+	contents, err := proto.Marshal(&topodatapb.Keyspace{ShardingColumnName: "id"})
+	if err != nil {
+		t.Fatalf("proto.Marshal failed: %v", err)
+	}
+	for _, tc := range []struct {
+		filePath string
+		contents []byte
+		want     string
+	}{
+		{path.Join("/keyspaces/ks", KeyspaceFile), contents, "sharding_column_name: \"id\"\n"},
+		{"/other", []byte("text\n"), "text\n"},
+		{"/other", []byte{0xff, 0xfe}, "<2 bytes>\n"},
+		{"/other", nil, ""},
+	} {
+		if got := auditFileText(tc.filePath, tc.contents); got != tc.want {
+			t.Errorf("auditFileText(%v, %v) = %q, want %q", tc.filePath, tc.contents, got, tc.want)
+		}
+	}
+}
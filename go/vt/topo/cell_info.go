@@ -74,6 +74,56 @@ func (ts Server) CreateCellInfo(ctx context.Context, cell string, ci *topodatapb
 	return err
 }
 
+// UpdateCellInfoFields reads a CellInfo, calls the update method,
+// and writes it back, creating it if necessary. If the update method
+// returns ErrNoUpdateNeeded, nothing is written. The processes that
+// already use the cell keep using the previous CellInfo until they
+// are restarted.
+func (ts Server) UpdateCellInfoFields(ctx context.Context, cell string, update func(*topodatapb.CellInfo) error) error {
+	filePath := pathForCellInfo(cell)
+	for {
+		ci := &topodatapb.CellInfo{}
+
+		// Read the file, if any.
+		contents, version, err := ts.Get(ctx, GlobalCell, filePath)
+		switch err {
+		case nil:
+			if err := proto.Unmarshal(contents, ci); err != nil {
+				return err
+			}
+		case ErrNoNode:
+			// Empty file, version is nil.
+		default:
+			return err
+		}
+
+		// Call update method.
+		if err = update(ci); err != nil {
+			if err == ErrNoUpdateNeeded {
+				return nil
+			}
+			return err
+		}
+
+		// Pack and save the file. We retry on races.
+		contents, err = proto.Marshal(ci)
+		if err != nil {
+			return err
+		}
+		if version == nil {
+			_, err = ts.Create(ctx, GlobalCell, filePath, contents)
+			if err != ErrNodeExists {
+				return err
+			}
+		} else {
+			_, err = ts.Update(ctx, GlobalCell, filePath, contents, version)
+			if err != ErrBadVersion {
+				return err
+			}
+		}
+	}
+}
+
 // DeleteCellInfo deletes the specified CellInfo.
 // We first make sure no Shard record points to the cell.
 func (ts Server) DeleteCellInfo(ctx context.Context, cell string) error {
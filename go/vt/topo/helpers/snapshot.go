@@ -0,0 +1,417 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package helpers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pmezard/go-difflib/difflib"
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/topo"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+	vschemapb "github.com/youtube/vitess/go/vt/proto/vschema"
+	workflowpb "github.com/youtube/vitess/go/vt/proto/workflow"
+)
+
+// This file contains the topology snapshots: a point-in-time copy of
+// the global and cell topologies, that can be saved to a file and
+// restored into any topology implementation.
+//
+// The serving graph (SrvKeyspace and SrvVSchema) is not part of a
+// snapshot: it is rebuilt from the other objects with
+// RebuildKeyspaceGraph and RebuildVSchemaGraph.
+
+// SnapshotVersion is the version of the snapshot format written by
+// WriteSnapshot. ReadSnapshot rejects the other versions.
+const SnapshotVersion = 1
+
+// Snapshot is a point-in-time copy of a topology.
+type Snapshot struct {
+	Version int
+	Time    time.Time
+
+	Keyspaces []*KeyspaceSnapshot
+	Workflows []*workflowpb.Workflow
+	Cells     []*CellSnapshot
+}
+
+// KeyspaceSnapshot has the global objects of a keyspace. VSchema is
+// nil if the keyspace has none.
+type KeyspaceSnapshot struct {
+	Name     string
+	Keyspace *topodatapb.Keyspace
+	VSchema  *vschemapb.Keyspace
+	Shards   []*ShardSnapshot
+}
+
+// ShardSnapshot has a shard.
+type ShardSnapshot struct {
+	Name  string
+	Shard *topodatapb.Shard
+}
+
+// CellSnapshot has the objects of a cell. CellInfo is nil if the
+// topology has none for the cell.
+type CellSnapshot struct {
+	Name              string
+	CellInfo          *topodatapb.CellInfo
+	Tablets           []*topodatapb.Tablet
+	ShardReplications []*ShardReplicationSnapshot
+}
+
+// ShardReplicationSnapshot has the replication graph of a shard in a
+// cell.
+type ShardReplicationSnapshot struct {
+	Keyspace         string
+	Shard            string
+	ShardReplication *topodatapb.ShardReplication
+}
+
+// TakeSnapshot reads the topology of the global cell and the provided
+// cells (all the known cells if none are provided). The objects are
+// read one by one: the snapshot is only consistent if nothing changes
+// the topology while it is taken.
+func TakeSnapshot(ctx context.Context, ts topo.Impl, cells []string) (*Snapshot, error) {
+	s := &Snapshot{
+		Version: SnapshotVersion,
+		Time:    time.Now(),
+	}
+	if len(cells) == 0 {
+		var err error
+		if cells, err = ts.GetKnownCells(ctx); err != nil {
+			return nil, fmt.Errorf("GetKnownCells failed: %v", err)
+		}
+	}
+	server := topo.Server{Impl: ts}
+	for _, cell := range cells {
+		cs := &CellSnapshot{Name: cell}
+		var err error
+		cs.CellInfo, err = server.GetCellInfo(ctx, cell)
+		if err != nil && err != topo.ErrNoNode {
+			return nil, fmt.Errorf("GetCellInfo(%v) failed: %v", cell, err)
+		}
+		s.Cells = append(s.Cells, cs)
+	}
+
+	// Keyspaces, with their shards and replication graphs.
+	keyspaces, err := ts.GetKeyspaces(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetKeyspaces failed: %v", err)
+	}
+	for _, keyspace := range keyspaces {
+		ks := &KeyspaceSnapshot{Name: keyspace}
+		if ks.Keyspace, _, err = ts.GetKeyspace(ctx, keyspace); err != nil {
+			return nil, fmt.Errorf("GetKeyspace(%v) failed: %v", keyspace, err)
+		}
+		ks.VSchema, err = ts.GetVSchema(ctx, keyspace)
+		if err != nil && err != topo.ErrNoNode {
+			return nil, fmt.Errorf("GetVSchema(%v) failed: %v", keyspace, err)
+		}
+		shards, err := ts.GetShardNames(ctx, keyspace)
+		if err != nil {
+			return nil, fmt.Errorf("GetShardNames(%v) failed: %v", keyspace, err)
+		}
+		for _, shard := range shards {
+			value, _, err := ts.GetShard(ctx, keyspace, shard)
+			if err != nil {
+				return nil, fmt.Errorf("GetShard(%v, %v) failed: %v", keyspace, shard, err)
+			}
+			ks.Shards = append(ks.Shards, &ShardSnapshot{Name: shard, Shard: value})
+
+			// Shard.Cells may miss cells with tablets, so we
+			// look in all the cells.
+			for _, cs := range s.Cells {
+				sri, err := ts.GetShardReplication(ctx, cs.Name, keyspace, shard)
+				switch err {
+				case nil:
+					cs.ShardReplications = append(cs.ShardReplications, &ShardReplicationSnapshot{
+						Keyspace:         keyspace,
+						Shard:            shard,
+						ShardReplication: sri.ShardReplication,
+					})
+				case topo.ErrNoNode:
+					// Nothing to copy.
+				default:
+					return nil, fmt.Errorf("GetShardReplication(%v, %v, %v) failed: %v", cs.Name, keyspace, shard, err)
+				}
+			}
+		}
+		s.Keyspaces = append(s.Keyspaces, ks)
+	}
+
+	// Tablets.
+	for _, cs := range s.Cells {
+		aliases, err := ts.GetTabletsByCell(ctx, cs.Name)
+		switch err {
+		case nil:
+		case topo.ErrNoNode:
+			continue
+		default:
+			return nil, fmt.Errorf("GetTabletsByCell(%v) failed: %v", cs.Name, err)
+		}
+		for _, alias := range aliases {
+			tablet, _, err := ts.GetTablet(ctx, alias)
+			switch err {
+			case nil:
+				cs.Tablets = append(cs.Tablets, tablet)
+			case topo.ErrNoNode:
+				// The tablet was deleted since we listed them.
+			default:
+				return nil, fmt.Errorf("GetTablet(%v) failed: %v", topoproto.TabletAliasString(alias), err)
+			}
+		}
+	}
+
+	// Workflows.
+	uuids, err := server.GetWorkflowNames(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("GetWorkflowNames failed: %v", err)
+	}
+	for _, uuid := range uuids {
+		wi, err := server.GetWorkflow(ctx, uuid)
+		if err != nil {
+			return nil, fmt.Errorf("GetWorkflow(%v) failed: %v", uuid, err)
+		}
+		s.Workflows = append(s.Workflows, wi.Workflow)
+	}
+	return s, nil
+}
+
+// WriteSnapshot writes a snapshot as JSON.
+func WriteSnapshot(w io.Writer, s *Snapshot) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// ReadSnapshot reads a snapshot written by WriteSnapshot.
+func ReadSnapshot(r io.Reader) (*Snapshot, error) {
+	s := &Snapshot{}
+	if err := json.NewDecoder(r).Decode(s); err != nil {
+		return nil, fmt.Errorf("cannot decode snapshot: %v", err)
+	}
+	if s.Version != SnapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %v, want %v", s.Version, SnapshotVersion)
+	}
+	return s, nil
+}
+
+// SnapshotChange describes a change RestoreSnapshot makes to a
+// topology.
+type SnapshotChange struct {
+	// Object describes the changed object, e.g. "shard ks/0".
+	Object string
+
+	// Action is Create or Update.
+	Action string
+
+	// Diff is a unified diff of the text representations of the
+	// current and snapshot values.
+	Diff string
+
+	apply func(ctx context.Context) error
+}
+
+// RestoreSnapshot writes the objects of a snapshot into a topology:
+// the missing objects are created, and the different ones are
+// updated. The objects that are not in the snapshot are left alone.
+// It returns the changes it made, or the ones it would make with
+// dryRun. On error, the returned changes are the ones that were
+// made.
+//
+// The tablets should not be running while their records are restored.
+func RestoreSnapshot(ctx context.Context, ts topo.Impl, s *Snapshot, dryRun bool) ([]*SnapshotChange, error) {
+	changes, err := planSnapshotRestore(ctx, ts, s)
+	if err != nil || dryRun {
+		return changes, err
+	}
+	for i, c := range changes {
+		if err := c.apply(ctx); err != nil {
+			return changes[:i], fmt.Errorf("cannot %v %v: %v", strings.ToLower(c.Action), c.Object, err)
+		}
+	}
+	return changes, nil
+}
+
+// planSnapshotRestore compares the snapshot with the topology, and
+// returns the changes to make.
+func planSnapshotRestore(ctx context.Context, ts topo.Impl, s *Snapshot) ([]*SnapshotChange, error) {
+	var changes []*SnapshotChange
+	add := func(object string, oldValue, newValue proto.Message, apply func(ctx context.Context) error) {
+		action := "Update"
+		if reflect.ValueOf(oldValue).IsNil() {
+			action = "Create"
+		} else if proto.Equal(oldValue, newValue) {
+			return
+		}
+		changes = append(changes, &SnapshotChange{
+			Object: object,
+			Action: action,
+			Diff:   snapshotDiff(object, oldValue, newValue),
+			apply:  apply,
+		})
+	}
+
+	// The cells go first, as their cell objects can only be
+	// reached through them.
+	server := topo.Server{Impl: ts}
+	for _, cs := range s.Cells {
+		if cs.CellInfo == nil {
+			continue
+		}
+		cs := cs
+		old, err := server.GetCellInfo(ctx, cs.Name)
+		if err != nil && err != topo.ErrNoNode {
+			return nil, fmt.Errorf("GetCellInfo(%v) failed: %v", cs.Name, err)
+		}
+		add("cell "+cs.Name, old, cs.CellInfo, func(ctx context.Context) error {
+			return server.UpdateCellInfoFields(ctx, cs.Name, func(ci *topodatapb.CellInfo) error {
+				*ci = *cs.CellInfo
+				return nil
+			})
+		})
+	}
+
+	// Then the global objects, as the cell objects point to them.
+	for _, ks := range s.Keyspaces {
+		ks := ks
+		old, version, err := ts.GetKeyspace(ctx, ks.Name)
+		if err != nil && err != topo.ErrNoNode {
+			return nil, fmt.Errorf("GetKeyspace(%v) failed: %v", ks.Name, err)
+		}
+		add("keyspace "+ks.Name, old, ks.Keyspace, func(ctx context.Context) error {
+			if old == nil {
+				return ts.CreateKeyspace(ctx, ks.Name, ks.Keyspace)
+			}
+			_, err := ts.UpdateKeyspace(ctx, ks.Name, ks.Keyspace, version)
+			return err
+		})
+
+		if ks.VSchema != nil {
+			oldVSchema, err := ts.GetVSchema(ctx, ks.Name)
+			if err != nil && err != topo.ErrNoNode {
+				return nil, fmt.Errorf("GetVSchema(%v) failed: %v", ks.Name, err)
+			}
+			add("vschema "+ks.Name, oldVSchema, ks.VSchema, func(ctx context.Context) error {
+				return ts.SaveVSchema(ctx, ks.Name, ks.VSchema)
+			})
+		}
+
+		for _, ss := range ks.Shards {
+			ss := ss
+			old, version, err := ts.GetShard(ctx, ks.Name, ss.Name)
+			if err != nil && err != topo.ErrNoNode {
+				return nil, fmt.Errorf("GetShard(%v, %v) failed: %v", ks.Name, ss.Name, err)
+			}
+			add(fmt.Sprintf("shard %v/%v", ks.Name, ss.Name), old, ss.Shard, func(ctx context.Context) error {
+				if old == nil {
+					return ts.CreateShard(ctx, ks.Name, ss.Name, ss.Shard)
+				}
+				_, err := ts.UpdateShard(ctx, ks.Name, ss.Name, ss.Shard, version)
+				return err
+			})
+		}
+	}
+
+	for _, w := range s.Workflows {
+		w := w
+		wi, err := server.GetWorkflow(ctx, w.Uuid)
+		var old *workflowpb.Workflow
+		switch err {
+		case nil:
+			old = wi.Workflow
+		case topo.ErrNoNode:
+		default:
+			return nil, fmt.Errorf("GetWorkflow(%v) failed: %v", w.Uuid, err)
+		}
+		add("workflow "+w.Uuid, old, w, func(ctx context.Context) error {
+			if wi == nil {
+				_, err := server.CreateWorkflow(ctx, w)
+				return err
+			}
+			wi.Workflow = w
+			return server.SaveWorkflow(ctx, wi)
+		})
+	}
+
+	for _, cs := range s.Cells {
+		for _, tablet := range cs.Tablets {
+			tablet := tablet
+			old, version, err := ts.GetTablet(ctx, tablet.Alias)
+			if err != nil && err != topo.ErrNoNode {
+				return nil, fmt.Errorf("GetTablet(%v) failed: %v", topoproto.TabletAliasString(tablet.Alias), err)
+			}
+			add("tablet "+topoproto.TabletAliasString(tablet.Alias), old, tablet, func(ctx context.Context) error {
+				if old == nil {
+					return ts.CreateTablet(ctx, tablet)
+				}
+				_, err := ts.UpdateTablet(ctx, tablet, version)
+				return err
+			})
+		}
+
+		for _, srs := range cs.ShardReplications {
+			srs := srs
+			cell := cs.Name
+			var old *topodatapb.ShardReplication
+			sri, err := ts.GetShardReplication(ctx, cell, srs.Keyspace, srs.Shard)
+			switch err {
+			case nil:
+				old = sri.ShardReplication
+			case topo.ErrNoNode:
+			default:
+				return nil, fmt.Errorf("GetShardReplication(%v, %v, %v) failed: %v", cell, srs.Keyspace, srs.Shard, err)
+			}
+			add(fmt.Sprintf("shard replication %v/%v in cell %v", srs.Keyspace, srs.Shard, cell), old, srs.ShardReplication, func(ctx context.Context) error {
+				return ts.UpdateShardReplicationFields(ctx, cell, srs.Keyspace, srs.Shard, func(sr *topodatapb.ShardReplication) error {
+					*sr = *srs.ShardReplication
+					return nil
+				})
+			})
+		}
+	}
+	return changes, nil
+}
+
+// snapshotDiff returns a unified diff between the text
+// representations of two values.
+func snapshotDiff(object string, oldValue, newValue proto.Message) string {
+	var oldText string
+	if !reflect.ValueOf(oldValue).IsNil() {
+		oldText = proto.MarshalTextString(oldValue)
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        snapshotLines(oldText),
+		B:        snapshotLines(proto.MarshalTextString(newValue)),
+		FromFile: "current " + object,
+		ToFile:   "snapshot " + object,
+		Context:  3,
+	})
+	if err != nil {
+		return fmt.Sprintf("cannot diff: %v", err)
+	}
+	return diff
+}
+
+// snapshotLines splits a text representation in lines. Unlike
+// difflib.SplitLines, it returns no line for an empty text.
+func snapshotLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return difflib.SplitLines(strings.TrimSuffix(text, "\n"))
+}
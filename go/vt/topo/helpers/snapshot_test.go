@@ -0,0 +1,253 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package helpers
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/topo"
+	"github.com/youtube/vitess/go/vt/topo/zk2topo"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+	vschemapb "github.com/youtube/vitess/go/vt/proto/vschema"
+	workflowpb "github.com/youtube/vitess/go/vt/proto/workflow"
+)
+
+func TestSnapshot(t *testing.T) {
+	ctx := context.Background()
+	fromTS := zk2topo.NewFakeServer("cell1", "cell2")
+	toTS := zk2topo.NewFakeServer("cell1", "cell2")
+
+	// Fill in the source topology.
+	if err := fromTS.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{ShardingColumnName: "id"}); err != nil {
+		t.Fatalf("CreateKeyspace failed: %v", err)
+	}
+	if err := fromTS.SaveVSchema(ctx, "ks", &vschemapb.Keyspace{Sharded: true}); err != nil {
+		t.Fatalf("SaveVSchema failed: %v", err)
+	}
+	if err := fromTS.CreateShard(ctx, "ks", "0"); err != nil {
+		t.Fatalf("CreateShard failed: %v", err)
+	}
+	for _, alias := range []*topodatapb.TabletAlias{
+		{Cell: "cell1", Uid: 100},
+		{Cell: "cell2", Uid: 200},
+	} {
+		tablet := &topodatapb.Tablet{
+			Alias:    alias,
+			Hostname: "host",
+			Keyspace: "ks",
+			Shard:    "0",
+			Type:     topodatapb.TabletType_REPLICA,
+		}
+		if err := fromTS.CreateTablet(ctx, tablet); err != nil {
+			t.Fatalf("CreateTablet failed: %v", err)
+		}
+	}
+	if _, err := fromTS.CreateWorkflow(ctx, &workflowpb.Workflow{Uuid: "uuid1", Name: "wf"}); err != nil {
+		t.Fatalf("CreateWorkflow failed: %v", err)
+	}
+
+	// Take a snapshot of cell1, and round-trip it through a file.
+	s, err := TakeSnapshot(ctx, fromTS.Impl, []string{"cell1"})
+	if err != nil {
+		t.Fatalf("TakeSnapshot failed: %v", err)
+	}
+	buf := &bytes.Buffer{}
+	if err := WriteSnapshot(buf, s); err != nil {
+		t.Fatalf("WriteSnapshot failed: %v", err)
+	}
+	s, err = ReadSnapshot(buf)
+	if err != nil {
+		t.Fatalf("ReadSnapshot failed: %v", err)
+	}
+	if len(s.Cells) != 1 || len(s.Cells[0].Tablets) != 1 || len(s.Cells[0].ShardReplications) != 1 {
+		t.Fatalf("got cells %+v, want cell1 with its tablet and replication graph", s.Cells)
+	}
+
+	// A dry run doesn't change anything.
+	want := []string{
+		"Create keyspace ks",
+		"Create vschema ks",
+		"Create shard ks/0",
+		"Create workflow uuid1",
+		"Create tablet cell1-0000000100",
+		"Create shard replication ks/0 in cell cell1",
+	}
+	changes, err := RestoreSnapshot(ctx, toTS.Impl, s, true)
+	if err != nil {
+		t.Fatalf("RestoreSnapshot with dryRun failed: %v", err)
+	}
+	checkSnapshotChanges(t, changes, want)
+	if keyspaces, err := toTS.GetKeyspaces(ctx); err != nil || len(keyspaces) != 0 {
+		t.Fatalf("dry run created keyspaces %v %v", keyspaces, err)
+	}
+	if !strings.Contains(changes[0].Diff, "+sharding_column_name: \"id\"\n") {
+		t.Errorf("keyspace diff is:\n%v", changes[0].Diff)
+	}
+
+	// The restore creates the objects.
+	changes, err = RestoreSnapshot(ctx, toTS.Impl, s, false)
+	if err != nil {
+		t.Fatalf("RestoreSnapshot failed: %v", err)
+	}
+	checkSnapshotChanges(t, changes, want)
+	restored, err := TakeSnapshot(ctx, toTS.Impl, []string{"cell1"})
+	if err != nil {
+		t.Fatalf("TakeSnapshot failed: %v", err)
+	}
+	restored.Time = s.Time
+	if !snapshotsEqual(restored, s) {
+		t.Errorf("restored snapshot is %+v, want %+v", restored, s)
+	}
+	if _, err := toTS.GetTablet(ctx, &topodatapb.TabletAlias{Cell: "cell2", Uid: 200}); err != topo.ErrNoNode {
+		t.Errorf("GetTablet(cell2-0000000200) returned %v, want ErrNoNode", err)
+	}
+
+	// Restoring again only updates what changed.
+	keyspace, version, err := toTS.Impl.GetKeyspace(ctx, "ks")
+	if err != nil {
+		t.Fatalf("GetKeyspace failed: %v", err)
+	}
+	keyspace.ShardingColumnName = "user_id"
+	if _, err := toTS.Impl.UpdateKeyspace(ctx, "ks", keyspace, version); err != nil {
+		t.Fatalf("UpdateKeyspace failed: %v", err)
+	}
+	changes, err = RestoreSnapshot(ctx, toTS.Impl, s, true)
+	if err != nil {
+		t.Fatalf("RestoreSnapshot with dryRun failed: %v", err)
+	}
+	checkSnapshotChanges(t, changes, []string{"Update keyspace ks"})
+	if want := "-sharding_column_name: \"user_id\"\n+sharding_column_name: \"id\"\n"; !strings.Contains(changes[0].Diff, want) {
+		t.Errorf("keyspace diff is:\n%v\nwant it to contain:\n%v", changes[0].Diff, want)
+	}
+	if _, err := RestoreSnapshot(ctx, toTS.Impl, s, false); err != nil {
+		t.Fatalf("RestoreSnapshot failed: %v", err)
+	}
+	ki, err := toTS.GetKeyspace(ctx, "ks")
+	if err != nil || ki.ShardingColumnName != "id" {
+		t.Errorf("GetKeyspace returned %v %v, want the snapshot keyspace", ki, err)
+	}
+}
+
+func TestSnapshotRestoreCells(t *testing.T) {
+	ctx := context.Background()
+	fromTS := zk2topo.NewFakeServer("cell1")
+	toTS := zk2topo.NewFakeServer()
+
+	if err := fromTS.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}); err != nil {
+		t.Fatalf("CreateKeyspace failed: %v", err)
+	}
+	if err := fromTS.CreateShard(ctx, "ks", "0"); err != nil {
+		t.Fatalf("CreateShard failed: %v", err)
+	}
+	tablet := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 100},
+		Keyspace: "ks",
+		Shard:    "0",
+	}
+	if err := fromTS.CreateTablet(ctx, tablet); err != nil {
+		t.Fatalf("CreateTablet failed: %v", err)
+	}
+	s, err := TakeSnapshot(ctx, fromTS.Impl, nil)
+	if err != nil {
+		t.Fatalf("TakeSnapshot failed: %v", err)
+	}
+	if len(s.Cells) != 1 || s.Cells[0].CellInfo == nil || s.Cells[0].CellInfo.Root != "/" {
+		t.Fatalf("got cells %+v, want cell1 with its CellInfo", s.Cells)
+	}
+
+	// The destination has no cell: the CellInfo is restored
+	// before the objects of the cell.
+	changes, err := RestoreSnapshot(ctx, toTS.Impl, s, false)
+	if err != nil {
+		t.Fatalf("RestoreSnapshot failed: %v", err)
+	}
+	checkSnapshotChanges(t, changes, []string{
+		"Create cell cell1",
+		"Create keyspace ks",
+		"Create shard ks/0",
+		"Create tablet cell1-0000000100",
+		"Create shard replication ks/0 in cell cell1",
+	})
+	if cells, err := toTS.GetKnownCells(ctx); err != nil || len(cells) != 1 || cells[0] != "cell1" {
+		t.Errorf("GetKnownCells returned %v %v, want [cell1]", cells, err)
+	}
+	if ti, err := toTS.GetTablet(ctx, tablet.Alias); err != nil || !proto.Equal(ti.Tablet, tablet) {
+		t.Errorf("GetTablet returned %v %v, want %v", ti, err, tablet)
+	}
+
+	// A different CellInfo is updated.
+	s.Cells[0].CellInfo.Root = "/cell1"
+	changes, err = RestoreSnapshot(ctx, toTS.Impl, s, false)
+	if err != nil {
+		t.Fatalf("RestoreSnapshot failed: %v", err)
+	}
+	checkSnapshotChanges(t, changes, []string{"Update cell cell1"})
+	if ci, err := toTS.GetCellInfo(ctx, "cell1"); err != nil || ci.Root != "/cell1" {
+		t.Errorf("GetCellInfo returned %v %v, want root /cell1", ci, err)
+	}
+}
+
+func TestReadSnapshotVersion(t *testing.T) {
+	if _, err := ReadSnapshot(strings.NewReader(`{"Version": 2}`)); err == nil || !strings.Contains(err.Error(), "unsupported snapshot version 2") {
+		t.Errorf("ReadSnapshot of version 2 returned %v", err)
+	}
+}
+
+func checkSnapshotChanges(t *testing.T, changes []*SnapshotChange, want []string) {
+	var got []string
+	for _, c := range changes {
+		got = append(got, c.Action+" "+c.Object)
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got changes:\n%v\nwant:\n%v", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+// snapshotsEqual compares two snapshots through their protos, as the
+// workflow versions and times may differ.
+func snapshotsEqual(a, b *Snapshot) bool {
+	if len(a.Keyspaces) != len(b.Keyspaces) || len(a.Workflows) != len(b.Workflows) || len(a.Cells) != len(b.Cells) {
+		return false
+	}
+	for i, ks := range a.Keyspaces {
+		other := b.Keyspaces[i]
+		if ks.Name != other.Name || !proto.Equal(ks.Keyspace, other.Keyspace) || !proto.Equal(ks.VSchema, other.VSchema) || len(ks.Shards) != len(other.Shards) {
+			return false
+		}
+		for j, ss := range ks.Shards {
+			if ss.Name != other.Shards[j].Name || !proto.Equal(ss.Shard, other.Shards[j].Shard) {
+				return false
+			}
+		}
+	}
+	for i, w := range a.Workflows {
+		if !proto.Equal(w, b.Workflows[i]) {
+			return false
+		}
+	}
+	for i, cs := range a.Cells {
+		other := b.Cells[i]
+		if cs.Name != other.Name || !proto.Equal(cs.CellInfo, other.CellInfo) || len(cs.Tablets) != len(other.Tablets) || len(cs.ShardReplications) != len(other.ShardReplications) {
+			return false
+		}
+		for j, tablet := range cs.Tablets {
+			if !proto.Equal(tablet, other.Tablets[j]) {
+				return false
+			}
+		}
+		for j, srs := range cs.ShardReplications {
+			if !proto.Equal(srs.ShardReplication, other.ShardReplications[j].ShardReplication) {
+				return false
+			}
+		}
+	}
+	return true
+}
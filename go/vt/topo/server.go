@@ -404,10 +404,14 @@ func OpenServer(implementation, serverAddress, root string) (Server, error) {
 
 // Open returns a Server using the command line parameter flags
 // for implementation, address and root. It log.Fatals out if an error occurs.
+// The changes are recorded in the audit log if -topo_audit_log is set.
 func Open() Server {
 	ts, err := OpenServer(*topoImplementation, *topoGlobalServerAddress, *topoGlobalRoot)
 	if err != nil {
 		log.Fatalf("Failed to open topo server (%v,%v,%v): %v", *topoImplementation, *topoGlobalServerAddress, *topoGlobalRoot, err)
 	}
+	if *topoAuditLog {
+		ts.Impl = NewAuditImpl(ts.Impl)
+	}
 	return ts
 }
@@ -0,0 +1,115 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topotools
+
+import (
+	"fmt"
+
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+
+	replicationdatapb "github.com/youtube/vitess/go/vt/proto/replicationdata"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// The durability policies a keyspace can use. When a keyspace has
+// no durability policy, the tablets enable semi-sync according to
+// their -enable_semi_sync flag, and any tablet can be promoted.
+const (
+	// DurabilityNone disables semi-sync. Any REPLICA tablet can be
+	// promoted.
+	DurabilityNone = "none"
+
+	// DurabilitySemiSync makes the master wait for an ack from one
+	// REPLICA tablet, in any cell. A REPLICA tablet can be promoted
+	// if another REPLICA tablet can ack its transactions.
+	DurabilitySemiSync = "semi_sync"
+
+	// DurabilityCrossCell makes the master wait for an ack from one
+	// REPLICA tablet in another cell, so a transaction survives the
+	// loss of the master cell. A REPLICA tablet can be promoted if a
+	// REPLICA tablet in another cell can ack its transactions.
+	DurabilityCrossCell = "cross_cell"
+)
+
+// DurabilityPolicies is the list of the durability policies.
+var DurabilityPolicies = []string{DurabilityNone, DurabilitySemiSync, DurabilityCrossCell}
+
+// CheckDurabilityPolicy returns an error if policy is not a durability
+// policy, or empty.
+func CheckDurabilityPolicy(policy string) error {
+	if policy == "" {
+		return nil
+	}
+	for _, p := range DurabilityPolicies {
+		if policy == p {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown durability policy %q, must be one of %v", policy, DurabilityPolicies)
+}
+
+// IsMasterSemiSync returns true if the master of a keyspace with the
+// durability policy waits for semi-sync acks.
+func IsMasterSemiSync(policy string) bool {
+	return policy == DurabilitySemiSync || policy == DurabilityCrossCell
+}
+
+// IsSemiSyncAcker returns true if a tablet of the given type, in
+// tabletCell, acks the transactions of a master in masterCell with
+// the durability policy.
+func IsSemiSyncAcker(policy string, tabletType topodatapb.TabletType, tabletCell, masterCell string) bool {
+	if tabletType != topodatapb.TabletType_REPLICA {
+		return false
+	}
+	switch policy {
+	case DurabilitySemiSync:
+		return true
+	case DurabilityCrossCell:
+		return masterCell != "" && tabletCell != masterCell
+	}
+	return false
+}
+
+// CheckPromotable returns an error if the candidate tablet can't be
+// the master of its shard with the durability policy. tablets are
+// the tablets of the shard. The current master is counted as a future
+// REPLICA tablet. Delayed replicas are only known from their replication
+// status, callers that have it also use CheckNotDelayed.
+func CheckPromotable(policy string, candidate *topodatapb.Tablet, tablets []*topodatapb.Tablet) error {
+	if policy == "" {
+		return nil
+	}
+	if candidate.Type != topodatapb.TabletType_REPLICA && candidate.Type != topodatapb.TabletType_MASTER {
+		return fmt.Errorf("tablet %v is a %v tablet, only REPLICA tablets can be promoted with durability policy %v", topoproto.TabletAliasString(candidate.Alias), candidate.Type, policy)
+	}
+	if !IsMasterSemiSync(policy) {
+		return nil
+	}
+	for _, tablet := range tablets {
+		if topoproto.TabletAliasEqual(tablet.Alias, candidate.Alias) {
+			continue
+		}
+		tabletType := tablet.Type
+		if tabletType == topodatapb.TabletType_MASTER {
+			tabletType = topodatapb.TabletType_REPLICA
+		}
+		if IsSemiSyncAcker(policy, tabletType, tablet.Alias.Cell, candidate.Alias.Cell) {
+			return nil
+		}
+	}
+	return fmt.Errorf("no tablet can ack the transactions of tablet %v with durability policy %v", topoproto.TabletAliasString(candidate.Alias), policy)
+}
+
+// CheckNotDelayed returns an error if status, the replication status
+// of the candidate tablet, shows it is a delayed replica. Whatever the
+// durability policy, a delayed replica must never be promoted: it
+// applies the transactions of the master late on purpose. It still
+// counts as a tablet that can ack, its relay logs are not delayed.
+func CheckNotDelayed(candidate *topodatapb.Tablet, status *replicationdatapb.Status) error {
+	if status.SqlDelay > 0 {
+		return fmt.Errorf("tablet %v is a delayed replica (MASTER_DELAY %vs), it can't be promoted", topoproto.TabletAliasString(candidate.Alias), status.SqlDelay)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package topotools
+
+import (
+	"strings"
+	"testing"
+
+	replicationdatapb "github.com/youtube/vitess/go/vt/proto/replicationdata"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+func TestCheckDurabilityPolicy(t *testing.T) {
+	for _, policy := range []string{"", DurabilityNone, DurabilitySemiSync, DurabilityCrossCell} {
+		if err := CheckDurabilityPolicy(policy); err != nil {
+			t.Errorf("CheckDurabilityPolicy(%q) failed: %v", policy, err)
+		}
+	}
+	if err := CheckDurabilityPolicy("two_acks"); err == nil || !strings.Contains(err.Error(), "unknown durability policy") {
+		t.Errorf("CheckDurabilityPolicy(two_acks) returned %v", err)
+	}
+}
+
+func TestIsSemiSyncAcker(t *testing.T) {
+	table := []struct {
+		policy     string
+		tabletType topodatapb.TabletType
+		tabletCell string
+		want       bool
+	}{
+		{"", topodatapb.TabletType_REPLICA, "cell2", false},
+		{DurabilityNone, topodatapb.TabletType_REPLICA, "cell2", false},
+		{DurabilitySemiSync, topodatapb.TabletType_REPLICA, "cell1", true},
+		{DurabilitySemiSync, topodatapb.TabletType_RDONLY, "cell1", false},
+		{DurabilityCrossCell, topodatapb.TabletType_REPLICA, "cell1", false},
+		{DurabilityCrossCell, topodatapb.TabletType_REPLICA, "cell2", true},
+		{DurabilityCrossCell, topodatapb.TabletType_RDONLY, "cell2", false},
+	}
+	for _, tcase := range table {
+		if got := IsSemiSyncAcker(tcase.policy, tcase.tabletType, tcase.tabletCell, "cell1"); got != tcase.want {
+			t.Errorf("IsSemiSyncAcker(%q, %v, %v, cell1) = %v, want %v", tcase.policy, tcase.tabletType, tcase.tabletCell, got, tcase.want)
+		}
+	}
+}
+
+func TestCheckPromotable(t *testing.T) {
+	tablet := func(cell string, uid uint32, tabletType topodatapb.TabletType) *topodatapb.Tablet {
+		return &topodatapb.Tablet{
+			Alias: &topodatapb.TabletAlias{Cell: cell, Uid: uid},
+			Type:  tabletType,
+		}
+	}
+	master := tablet("cell1", 1, topodatapb.TabletType_MASTER)
+	replica1 := tablet("cell1", 2, topodatapb.TabletType_REPLICA)
+	rdonly2 := tablet("cell2", 3, topodatapb.TabletType_RDONLY)
+	replica2 := tablet("cell2", 4, topodatapb.TabletType_REPLICA)
+
+	table := []struct {
+		policy    string
+		candidate *topodatapb.Tablet
+		tablets   []*topodatapb.Tablet
+		wantErr   string
+	}{{
+		policy:    "",
+		candidate: rdonly2,
+		tablets:   []*topodatapb.Tablet{master, rdonly2},
+	}, {
+		policy:    DurabilityNone,
+		candidate: rdonly2,
+		tablets:   []*topodatapb.Tablet{master, rdonly2},
+		wantErr:   "only REPLICA tablets can be promoted",
+	}, {
+		policy:    DurabilityNone,
+		candidate: replica1,
+		tablets:   []*topodatapb.Tablet{replica1},
+	}, {
+		// The old master acks.
+		policy:    DurabilitySemiSync,
+		candidate: replica1,
+		tablets:   []*topodatapb.Tablet{master, replica1, rdonly2},
+	}, {
+		policy:    DurabilitySemiSync,
+		candidate: replica1,
+		tablets:   []*topodatapb.Tablet{replica1, rdonly2},
+		wantErr:   "no tablet can ack",
+	}, {
+		policy:    DurabilityCrossCell,
+		candidate: replica1,
+		tablets:   []*topodatapb.Tablet{master, replica1, rdonly2},
+		wantErr:   "no tablet can ack",
+	}, {
+		policy:    DurabilityCrossCell,
+		candidate: replica2,
+		tablets:   []*topodatapb.Tablet{master, replica1, rdonly2, replica2},
+	}, {
+		// The current master can stay the master.
+		policy:    DurabilityCrossCell,
+		candidate: master,
+		tablets:   []*topodatapb.Tablet{master, replica1, rdonly2, replica2},
+	}}
+	for i, tcase := range table {
+		err := CheckPromotable(tcase.policy, tcase.candidate, tcase.tablets)
+		if tcase.wantErr == "" {
+			if err != nil {
+				t.Errorf("case %v: CheckPromotable failed: %v", i, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tcase.wantErr) {
+			t.Errorf("case %v: CheckPromotable returned %v, want error containing %q", i, err, tcase.wantErr)
+		}
+	}
+}
+
+func TestCheckNotDelayed(t *testing.T) {
+	replica := &topodatapb.Tablet{
+		Alias: &topodatapb.TabletAlias{Cell: "cell1", Uid: 1},
+		Type:  topodatapb.TabletType_REPLICA,
+	}
+	if err := CheckNotDelayed(replica, &replicationdatapb.Status{SecondsBehindMaster: 10}); err != nil {
+		t.Errorf("CheckNotDelayed(lagging replica) failed: %v", err)
+	}
+	if err := CheckNotDelayed(replica, &replicationdatapb.Status{SqlDelay: 3600}); err == nil || !strings.Contains(err.Error(), "delayed replica") {
+		t.Errorf("CheckNotDelayed(delayed replica) returned %v", err)
+	}
+}
@@ -8,8 +8,10 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/mysqlctl"
 	"github.com/youtube/vitess/go/vt/mysqlctl/backupstorage"
 	"github.com/youtube/vitess/go/vt/topo/topoproto"
 	"github.com/youtube/vitess/go/vt/wrangler"
@@ -27,12 +29,22 @@ func init() {
 		commandRemoveBackup,
 		"<keyspace/shard> <backup name>",
 		"Removes a backup for the BackupStorage."})
+	addCommand("Shards", command{
+		"VerifyBackup",
+		commandVerifyBackup,
+		"[-concurrency <count>] <keyspace/shard> <backup name>",
+		"Reads all the files of a backup from the BackupStorage, and checks their size and hash, without restoring them."})
+	addCommand("Shards", command{
+		"PruneBackups",
+		commandPruneBackups,
+		"[-keep_count <count>] [-keep_duration <duration>] [-dry_run] <keyspace/shard>",
+		"Removes the backups of a shard that the retention policy doesn't keep. A backup is kept if it's one of the -keep_count most recent valid backups, or if it's newer than -keep_duration. The most recent valid backup is always kept. The archived binlogs that the remaining backups don't need are removed too."})
 
 	addCommand("Tablets", command{
 		"RestoreFromBackup",
 		commandRestoreFromBackup,
-		"<tablet alias>",
-		"Stops mysqld and restores the data from the latest backup."})
+		"[-restore_to_time <RFC3339 time>] [-restore_to_pos <position>] <tablet alias>",
+		"Stops mysqld and restores the data from the latest backup. With -restore_to_time or -restore_to_pos, restores the latest backup taken before that point, and replays the archived binlogs up to it. The tablet is then left DRAINED with replication stopped."})
 }
 
 func commandListBackups(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
@@ -87,13 +99,83 @@ func commandRemoveBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 	return bs.RemoveBackup(ctx, bucket, name)
 }
 
+func commandVerifyBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	concurrency := subFlags.Int("concurrency", 4, "how many files to verify concurrently")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("action VerifyBackup requires <keyspace/shard> <backup name>")
+	}
+
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	bucket := fmt.Sprintf("%v/%v", keyspace, shard)
+	name := subFlags.Arg(1)
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return err
+	}
+	defer bs.Close()
+	return mysqlctl.VerifyBackup(ctx, bs, wr.Logger(), bucket, name, *concurrency)
+}
+
+func commandPruneBackups(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	keepCount := subFlags.Int("keep_count", 0, "how many of the most recent valid backups to keep")
+	keepDuration := subFlags.Duration("keep_duration", 0, "keep the backups newer than this")
+	dryRun := subFlags.Bool("dry_run", false, "only lists the backups that would be removed")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("action PruneBackups requires <keyspace/shard>")
+	}
+	policy := mysqlctl.RetentionPolicy{
+		KeepCount:    *keepCount,
+		KeepDuration: *keepDuration,
+	}
+	if policy.IsZero() {
+		return fmt.Errorf("action PruneBackups requires -keep_count or -keep_duration")
+	}
+
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	bucket := fmt.Sprintf("%v/%v", keyspace, shard)
+
+	bs, err := backupstorage.GetBackupStorage()
+	if err != nil {
+		return err
+	}
+	defer bs.Close()
+	removed, err := mysqlctl.PruneBackups(ctx, bs, wr.Logger(), bucket, policy, *dryRun)
+	for _, name := range removed {
+		wr.Logger().Printf("%v\n", name)
+	}
+	return err
+}
+
 func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	restoreToTimeStr := subFlags.String("restore_to_time", "", "restores up to this time (RFC3339 format, e.g. 2006-01-02T15:04:05Z)")
+	restoreToPos := subFlags.String("restore_to_pos", "", "restores up to this replication position (e.g. MariaDB/0-1-100)")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
 	if subFlags.NArg() != 1 {
 		return fmt.Errorf("the RestoreFromBackup command requires the <tablet alias> argument")
 	}
+	var restoreToTime time.Time
+	if *restoreToTimeStr != "" {
+		var err error
+		restoreToTime, err = time.Parse(time.RFC3339, *restoreToTimeStr)
+		if err != nil {
+			return fmt.Errorf("cannot parse -restore_to_time: %v", err)
+		}
+	}
 
 	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
 	if err != nil {
@@ -103,7 +185,7 @@ func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFla
 	if err != nil {
 		return err
 	}
-	stream, err := wr.TabletManagerClient().RestoreFromBackup(ctx, tabletInfo.Tablet)
+	stream, err := wr.TabletManagerClient().RestoreFromBackup(ctx, tabletInfo.Tablet, restoreToTime, *restoreToPos)
 	if err != nil {
 		return err
 	}
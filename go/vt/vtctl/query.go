@@ -302,7 +302,7 @@ func commandVtGateSplitQuery(ctx context.Context, wr *wrangler.Wrangler, subFlag
 	numRowsPerQueryPart := subFlags.Int64(
 		"num_rows_per_query_part", 0, "The number of rows to return in each query part.")
 	algorithmStr := subFlags.String("algorithm", "EQUAL_SPLITS", "The algorithm to"+
-		" use for splitting the query. Either 'FULL_SCAN' or 'EQUAL_SPLITS'")
+		" use for splitting the query. One of 'FULL_SCAN', 'EQUAL_SPLITS' or 'SAMPLING'")
 	keyspace := subFlags.String("keyspace", "", "keyspace to send query to")
 
 	if err := subFlags.Parse(args); err != nil {
@@ -324,6 +324,8 @@ func commandVtGateSplitQuery(ctx context.Context, wr *wrangler.Wrangler, subFlag
 		algorithm = querypb.SplitQueryRequest_FULL_SCAN
 	case "EQUAL_SPLITS":
 		algorithm = querypb.SplitQueryRequest_EQUAL_SPLITS
+	case "SAMPLING":
+		algorithm = querypb.SplitQueryRequest_SAMPLING
 	default:
 		return fmt.Errorf("Unknown split-query algorithm: %v", algorithmStr)
 	}
@@ -462,11 +464,12 @@ func commandVtTabletCommit(ctx context.Context, wr *wrangler.Wrangler, subFlags
 	}
 	defer conn.Close(ctx)
 
-	return conn.Commit(ctx, &querypb.Target{
+	_, err = conn.Commit(ctx, &querypb.Target{
 		Keyspace:   tabletInfo.Tablet.Keyspace,
 		Shard:      tabletInfo.Tablet.Shard,
 		TabletType: tabletInfo.Tablet.Type,
 	}, transactionID)
+	return err
 }
 
 func commandVtTabletRollback(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
@@ -47,8 +47,8 @@ func init() {
 		addCommand("Shards", command{
 			"PlannedReparentShard",
 			commandPlannedReparentShard,
-			"-keyspace_shard=<keyspace/shard> [-new_master=<tablet alias>] [-avoid_master=<tablet alias>]",
-			"Reparents the shard to the new master, or away from old master. Both old and new master need to be up and running."})
+			"-keyspace_shard=<keyspace/shard> [-new_master=<tablet alias>] [-avoid_master=<tablet alias>] [-dry_run]",
+			"Reparents the shard to the new master, or away from old master. Both old and new master need to be up and running. With -dry_run, only checks the reparent can succeed and prints its plan."})
 		addCommand("Shards", command{
 			"EmergencyReparentShard",
 			commandEmergencyReparentShard,
@@ -96,6 +96,7 @@ func commandPlannedReparentShard(ctx context.Context, wr *wrangler.Wrangler, sub
 	keyspaceShard := subFlags.String("keyspace_shard", "", "keyspace/shard of the shard that needs to be reparented")
 	newMaster := subFlags.String("new_master", "", "alias of a tablet that should be the new master")
 	avoidMaster := subFlags.String("avoid_master", "", "alias of a tablet that should not be the master, i.e. reparent to any other tablet if this one is the master")
+	dryRun := subFlags.Bool("dry_run", false, "if set, only check the shard can be reparented and print the plan, without changing anything")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -127,6 +128,9 @@ func commandPlannedReparentShard(ctx context.Context, wr *wrangler.Wrangler, sub
 			return err
 		}
 	}
+	if *dryRun {
+		return wr.PlannedReparentShardDryRun(ctx, keyspace, shard, newMasterAlias, avoidMasterAlias, *waitSlaveTimeout)
+	}
 	return wr.PlannedReparentShard(ctx, keyspace, shard, newMasterAlias, avoidMasterAlias, *waitSlaveTimeout)
 }
 
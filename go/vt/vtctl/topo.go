@@ -0,0 +1,103 @@
+package vtctl
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/topo"
+	"github.com/youtube/vitess/go/vt/wrangler"
+)
+
+// This file contains the Topology command group for vtctl.
+
+const topoGroupName = "Topology"
+
+func init() {
+	addCommandGroup(topoGroupName)
+
+	addCommand(topoGroupName, command{
+		"GetTopoAuditLog",
+		commandGetTopoAuditLog,
+		"[-path_prefix=<path prefix>] [-since=<duration>] [-limit=<count>] [-diff] [<cell>]",
+		"Lists the latest changes of the topology of a cell (the global cell by default), as recorded by the processes running with -topo_audit_log. The paths are the ones of the topology Backend, e.g. /keyspaces/<keyspace>/shards/<shard>/Shard or /tablets/<tablet alias>/Tablet."})
+
+	addCommand(topoGroupName, command{
+		"PruneTopoAuditLog",
+		commandPruneTopoAuditLog,
+		"[-keep_count=<count>] [-keep_duration=<duration>] [-dry_run] <cell>",
+		"Removes the oldest entries of the topology audit log of a cell, but the -keep_count latest ones and the ones newer than -keep_duration."})
+}
+
+func commandGetTopoAuditLog(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	pathPrefix := subFlags.String("path_prefix", "", "only lists the changes of the paths that start with this prefix")
+	since := subFlags.Duration("since", 0, "only lists the changes newer than this")
+	limit := subFlags.Int("limit", 100, "the maximum number of changes to list, 0 for no limit")
+	showDiff := subFlags.Bool("diff", false, "also shows the diff of each change")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() > 1 {
+		return fmt.Errorf("action GetTopoAuditLog takes at most one <cell>")
+	}
+	cell := topo.GlobalCell
+	if subFlags.NArg() == 1 {
+		cell = subFlags.Arg(0)
+	}
+	var sinceTime time.Time
+	if *since != 0 {
+		sinceTime = time.Now().Add(-*since)
+	}
+
+	entries, err := wr.TopoServer().GetAuditEntries(ctx, cell, *pathPrefix, sinceTime, *limit)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		wr.Logger().Printf("%v %v %v%v by %v\n", e.Time.Format(time.RFC3339), e.Action, e.Path, auditVersions(e), auditAuthor(e))
+		if *showDiff && e.Diff != "" {
+			wr.Logger().Printf("%v\n", strings.TrimRight(e.Diff, "\n"))
+		}
+	}
+	return nil
+}
+
+// auditVersions describes the versions of an AuditEntry, if any.
+func auditVersions(e *topo.AuditEntry) string {
+	if e.OldVersion == "" && e.NewVersion == "" {
+		return ""
+	}
+	return fmt.Sprintf(" (version %q -> %q)", e.OldVersion, e.NewVersion)
+}
+
+// auditAuthor describes who made the change of an AuditEntry.
+func auditAuthor(e *topo.AuditEntry) string {
+	if e.Caller == "" {
+		return e.Process
+	}
+	return fmt.Sprintf("%v (%v)", e.Process, e.Caller)
+}
+
+func commandPruneTopoAuditLog(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	keepCount := subFlags.Int("keep_count", 0, "how many of the latest entries to keep")
+	keepDuration := subFlags.Duration("keep_duration", 0, "keep the entries newer than this")
+	dryRun := subFlags.Bool("dry_run", false, "only lists the entries that would be removed")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("action PruneTopoAuditLog requires <cell>")
+	}
+	if *keepCount == 0 && *keepDuration == 0 {
+		return fmt.Errorf("action PruneTopoAuditLog requires -keep_count or -keep_duration")
+	}
+
+	pruned, err := wr.TopoServer().PruneAuditEntries(ctx, subFlags.Arg(0), *keepCount, *keepDuration, *dryRun)
+	for _, name := range pruned {
+		wr.Logger().Printf("%v\n", name)
+	}
+	return err
+}
@@ -165,6 +165,12 @@ var commands = []commandGroup{
 			{"StopSlave", commandStopSlave,
 				"<tablet alias>",
 				"Stops replication on the specified slave."},
+			{"StopSlaveBeforeTime", commandStopSlaveBeforeTime,
+				"<tablet alias> <time>",
+				"Stops replication on the specified delayed replica right before the first transaction committed at or after the given time (in RFC 3339 format, for instance 2016-05-20T15:04:05Z), and prints the position it stops at. The tablet can then be used to recover data lost on the master at that time. Use StartSlave to resume replication."},
+			{"RepairErrantGTIDs", commandRepairErrantGTIDs,
+				"<tablet alias> <inject_empty|rebuild>",
+				"Repairs the errant GTIDs of the specified slave, i.e. the transactions it has but its master doesn't have. With inject_empty, an empty transaction is committed on the master for each errant GTID, so the other slaves get them too (MySQL 5.6 and above only). Use it only if the errant transactions didn't change data that matters. With rebuild, the slave is tagged with its errant GTIDs and changed to DRAINED, so it can be restored from a backup."},
 			{"ChangeSlaveType", commandChangeSlaveType,
 				"[-dry-run] <tablet alias> <tablet type>",
 				"Changes the db type for the specified tablet, if possible. This command is used primarily to arrange replicas, and it will not convert a master.\n" +
@@ -188,8 +194,8 @@ var commands = []commandGroup{
 				"<tablet alias> <duration>",
 				"Blocks the action queue on the specified tablet for the specified amount of time. This is typically used for testing."},
 			{"Backup", commandBackup,
-				"[-concurrency=4] <tablet alias>",
-				"Stops mysqld and uses the BackupStorage service to store a new backup. This function also remembers if the tablet was replicating so that it can restore the same state after the backup completes."},
+				"[-concurrency=4] [-incremental] <tablet alias>",
+				"Stops mysqld and uses the BackupStorage service to store a new backup. This function also remembers if the tablet was replicating so that it can restore the same state after the backup completes. With -incremental, mysqld keeps running, and only the binlogs since the latest backup of the shard are stored."},
 			{"ExecuteHook", commandExecuteHook,
 				"<tablet alias> <hook name> [<param1=value1> <param2=value2> ...]",
 				"Runs the specified hook on the given tablet. A hook is a script that resides in the $VTROOT/vthook directory. You can put any script into that directory and use this command to run that script.\n" +
@@ -217,6 +223,9 @@ var commands = []commandGroup{
 			{"ShardReplicationPositions", commandShardReplicationPositions,
 				"<keyspace/shard>",
 				"Shows the replication status of each slave machine in the shard graph. In this case, the status refers to the replication lag between the master vttablet and the slave vttablet. In Vitess, data is always written to the master vttablet first and then replicated to all slave vttablets. Output is sorted by tablet type, then replication position. Use ctrl-C to interrupt command and see partial result if needed."},
+			{"FindErrantGTIDs", commandFindErrantGTIDs,
+				"<keyspace/shard>",
+				"Compares the executed GTIDs of each slave of the shard with the ones of the master, and lists the errant GTIDs of each slave, i.e. the transactions the master doesn't have. Returns an error if a slave has errant GTIDs. Use RepairErrantGTIDs to repair them."},
 			{"ListShardTablets", commandListShardTablets,
 				"<keyspace/shard>",
 				"Lists all tablets in the specified shard."},
@@ -258,7 +267,7 @@ var commands = []commandGroup{
 	{
 		"Keyspaces", []command{
 			{"CreateKeyspace", commandCreateKeyspace,
-				"[-sharding_column_name=name] [-sharding_column_type=type] [-served_from=tablettype1:ks1,tablettype2,ks2,...] [-force] <keyspace name>",
+				"[-sharding_column_name=name] [-sharding_column_type=type] [-served_from=tablettype1:ks1,tablettype2,ks2,...] [-durability_policy=policy] [-force] <keyspace name>",
 				"Creates the specified keyspace."},
 			{"DeleteKeyspace", commandDeleteKeyspace,
 				"[-recursive] <keyspace>",
@@ -278,6 +287,12 @@ var commands = []commandGroup{
 			{"SetKeyspaceServedFrom", commandSetKeyspaceServedFrom,
 				"[-source=<source keyspace name>] [-remove] [-cells=c1,c2,...] <keyspace name> <tablet type>",
 				"Changes the ServedFromMap manually. This command is intended for emergency fixes. This field is automatically set when you call the *MigrateServedFrom* command. This command does not rebuild the serving graph."},
+			{"SetKeyspaceDurabilityPolicy", commandSetKeyspaceDurabilityPolicy,
+				"<keyspace name> <policy>",
+				"Sets the durability policy of a keyspace: none, semi_sync or cross_cell. Only the tablets started with -enable_semi_sync follow it, and an empty policy makes them use the default semi-sync settings. The tablets apply the policy the next time their replication is configured."},
+			{"ValidateDurabilityPolicy", commandValidateDurabilityPolicy,
+				"<keyspace name>",
+				"Validates that the master of every shard of the keyspace has tablets to ack its transactions, and can be replaced, as required by the durability policy of the keyspace."},
 			{"RebuildKeyspaceGraph", commandRebuildKeyspaceGraph,
 				"[-cells=c1,c2,...] <keyspace> ...",
 				"Rebuilds the serving data for the keyspace. This command may trigger an update to all connected clients."},
@@ -807,6 +822,49 @@ func commandStopSlave(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag
 	return wr.TabletManagerClient().StopSlave(ctx, ti.Tablet)
 }
 
+func commandStopSlaveBeforeTime(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("action StopSlaveBeforeTime requires <tablet alias> <time>")
+	}
+
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	stopTime, err := time.Parse(time.RFC3339, subFlags.Arg(1))
+	if err != nil {
+		return fmt.Errorf("invalid time %v: %v", subFlags.Arg(1), err)
+	}
+	ti, err := wr.TopoServer().GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return fmt.Errorf("failed reading tablet %v: %v", tabletAlias, err)
+	}
+	pos, err := wr.TabletManagerClient().StopSlaveBeforeTime(ctx, ti.Tablet, stopTime)
+	if err != nil {
+		return err
+	}
+	wr.Logger().Printf("%v\n", pos)
+	return nil
+}
+
+func commandRepairErrantGTIDs(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("action RepairErrantGTIDs requires <tablet alias> <inject_empty|rebuild>")
+	}
+
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	return wr.RepairErrantGTIDs(ctx, tabletAlias, subFlags.Arg(1))
+}
+
 func commandChangeSlaveType(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	dryRun := subFlags.Bool("dry-run", false, "Lists the proposed change without actually executing it")
 
@@ -995,6 +1053,7 @@ func commandSleep(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 
 func commandBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	concurrency := subFlags.Int("concurrency", 4, "Specifies the number of compression/checksum jobs to run simultaneously")
+	incremental := subFlags.Bool("incremental", false, "Backs up only the binlogs since the latest backup, without stopping mysqld")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -1010,7 +1069,7 @@ func commandBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fl
 	if err != nil {
 		return err
 	}
-	stream, err := wr.TabletManagerClient().Backup(ctx, tabletInfo.Tablet, *concurrency)
+	stream, err := wr.TabletManagerClient().Backup(ctx, tabletInfo.Tablet, *concurrency, *incremental)
 	if err != nil {
 		return err
 	}
@@ -1191,6 +1250,36 @@ func commandShardReplicationPositions(ctx context.Context, wr *wrangler.Wrangler
 	return nil
 }
 
+func commandFindErrantGTIDs(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace/shard> argument is required for the FindErrantGTIDs command")
+	}
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	errant, err := wr.FindErrantGTIDs(ctx, keyspace, shard)
+	if err != nil {
+		return err
+	}
+	if len(errant) == 0 {
+		return nil
+	}
+
+	lines := make([]string, 0, len(errant))
+	for alias, gtids := range errant {
+		lines = append(lines, fmt.Sprintf("%v %v", topoproto.TabletAliasString(&alias), gtids))
+	}
+	sort.Strings(lines)
+	for _, l := range lines {
+		wr.Logger().Printf("%v\n", l)
+	}
+	return fmt.Errorf("%v tablet(s) of shard %v/%v have errant GTIDs", len(errant), keyspace, shard)
+}
+
 func commandListShardTablets(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -1504,6 +1593,7 @@ func commandCreateKeyspace(ctx context.Context, wr *wrangler.Wrangler, subFlags
 	force := subFlags.Bool("force", false, "Proceeds even if the keyspace already exists")
 	var servedFrom flagutil.StringMapValue
 	subFlags.Var(&servedFrom, "served_from", "Specifies a comma-separated list of dbtype:keyspace pairs used to serve traffic")
+	durabilityPolicy := subFlags.String("durability_policy", "", "Specifies the durability policy: none, semi_sync or cross_cell")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -1532,6 +1622,10 @@ func commandCreateKeyspace(ctx context.Context, wr *wrangler.Wrangler, subFlags
 			})
 		}
 	}
+	if err := topotools.CheckDurabilityPolicy(*durabilityPolicy); err != nil {
+		return err
+	}
+	ki.DurabilityPolicy = *durabilityPolicy
 	err = wr.TopoServer().CreateKeyspace(ctx, keyspace, ki)
 	if *force && err == topo.ErrNodeExists {
 		wr.Logger().Infof("keyspace %v already exists (ignoring error with -force)", keyspace)
@@ -1671,6 +1765,28 @@ func commandRebuildKeyspaceGraph(ctx context.Context, wr *wrangler.Wrangler, sub
 	return nil
 }
 
+func commandSetKeyspaceDurabilityPolicy(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <keyspace name> and <policy> arguments are required for the SetKeyspaceDurabilityPolicy command")
+	}
+
+	return wr.SetKeyspaceDurabilityPolicy(ctx, subFlags.Arg(0), subFlags.Arg(1))
+}
+
+func commandValidateDurabilityPolicy(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace name> argument is required for the ValidateDurabilityPolicy command")
+	}
+
+	return wr.ValidateDurabilityPolicy(ctx, subFlags.Arg(0))
+}
+
 func commandValidateKeyspace(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	pingTablets := subFlags.Bool("ping-tablets", false, "Specifies whether all tablets will be pinged during the validation process")
 	if err := subFlags.Parse(args); err != nil {
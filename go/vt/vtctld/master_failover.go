@@ -0,0 +1,127 @@
+package vtctld
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	log "github.com/golang/glog"
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/acl"
+	"github.com/youtube/vitess/go/vt/discovery"
+	"github.com/youtube/vitess/go/vt/failover"
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/servenv"
+	"github.com/youtube/vitess/go/vt/tabletmanager/tmclient"
+	"github.com/youtube/vitess/go/vt/topo"
+	"github.com/youtube/vitess/go/vt/vtctl"
+	"github.com/youtube/vitess/go/vt/wrangler"
+)
+
+var (
+	enableMasterFailover           = flag.Bool("enable_master_failover", false, "If set, vtctld watches the health of the masters in all cells, and runs an emergency reparent when a master is dead, for the keyspaces in -master_failover_keyspaces.")
+	masterFailoverKeyspaces        = flag.String("master_failover_keyspaces", "", "comma separated list of keyspaces with automatic master failover enabled at startup. It can be changed with the EnableMasterFailover and DisableMasterFailover keyspace actions.")
+	masterFailoverThreshold        = flag.Duration("master_failover_threshold", 30*time.Second, "how long the health check of a master has to fail before its failure is confirmed with the other cells")
+	masterFailoverCheckInterval    = flag.Duration("master_failover_check_interval", 5*time.Second, "how often to check for failing masters")
+	masterFailoverWaitSlaveTimeout = flag.Duration("master_failover_wait_slave_timeout", 30*time.Second, "time to wait for the replicas during the failure confirmation and the emergency reparent")
+	masterFailoverAuditLog         = flag.String("master_failover_audit_log", "", "if set, every master failover decision is appended to this file, as JSON")
+)
+
+// masterFailover holds the objects of the automatic master failover.
+type masterFailover struct {
+	healthCheck  discovery.HealthCheck
+	detector     *failover.Detector
+	cellWatchers []*discovery.TopologyWatcher
+	auditFile    io.Closer
+}
+
+func newMasterFailover(ts topo.Server) (*masterFailover, error) {
+	var keyspaces []string
+	if *masterFailoverKeyspaces != "" {
+		keyspaces = strings.Split(*masterFailoverKeyspaces, ",")
+	}
+	m := &masterFailover{}
+	var auditWriter io.Writer
+	if *masterFailoverAuditLog != "" {
+		f, err := os.OpenFile(*masterFailoverAuditLog, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+		if err != nil {
+			return nil, fmt.Errorf("cannot open master failover audit log: %v", err)
+		}
+		auditWriter = f
+		m.auditFile = f
+	}
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+	m.detector = failover.NewDetector(wr, failover.NewAuditLog(1000, auditWriter), keyspaces, *masterFailoverThreshold, *masterFailoverWaitSlaveTimeout)
+
+	// The failover needs its own HealthCheck, as it is the listener.
+	m.healthCheck = discovery.NewHealthCheck(*vtctl.HealthCheckTimeout, *vtctl.HealthcheckRetryDelay, *vtctl.HealthCheckTimeout)
+	m.healthCheck.SetListener(m.detector, true)
+
+	// Watch the tablets of all cells.
+	cells, err := ts.GetKnownCells(context.Background())
+	if err != nil {
+		m.Stop()
+		return nil, fmt.Errorf("error when getting cells: %v", err)
+	}
+	for _, cell := range cells {
+		watcher := discovery.NewCellTabletsWatcher(ts, m.healthCheck, cell, *vtctl.HealthCheckTopologyRefresh, discovery.DefaultTopoReadConcurrency)
+		m.cellWatchers = append(m.cellWatchers, watcher)
+	}
+
+	m.detector.Start(*masterFailoverCheckInterval)
+	return m, nil
+}
+
+// Stop stops the automatic master failover.
+func (m *masterFailover) Stop() {
+	for _, w := range m.cellWatchers {
+		w.Stop()
+	}
+	if err := m.healthCheck.Close(); err != nil {
+		log.Warningf("healthCheck.Close() failed: %v", err)
+	}
+	m.detector.Close()
+	if m.auditFile != nil {
+		m.auditFile.Close()
+	}
+}
+
+// initMasterFailover starts the automatic master failover if it is
+// enabled, and registers its keyspace actions and status page part.
+func initMasterFailover(ts topo.Server, actionRepo *ActionRepository) {
+	if !*enableMasterFailover {
+		return
+	}
+	m, err := newMasterFailover(ts)
+	if err != nil {
+		log.Errorf("Failed to start the master failover: %v", err)
+		return
+	}
+	servenv.OnClose(m.Stop)
+
+	actionRepo.RegisterKeyspaceAction("EnableMasterFailover",
+		func(ctx context.Context, wr *wrangler.Wrangler, keyspace string, r *http.Request) (string, error) {
+			if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+				return "", err
+			}
+			m.detector.SetKeyspaceEnabled(keyspace, true)
+			return "", nil
+		})
+	actionRepo.RegisterKeyspaceAction("DisableMasterFailover",
+		func(ctx context.Context, wr *wrangler.Wrangler, keyspace string, r *http.Request) (string, error) {
+			if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+				return "", err
+			}
+			m.detector.SetKeyspaceEnabled(keyspace, false)
+			return "", nil
+		})
+
+	servenv.AddStatusPart("Master Failover", failover.StatusHTML, func() interface{} {
+		return m.detector.Status()
+	})
+}
@@ -0,0 +1,161 @@
+package vtctld
+
+import (
+	"flag"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/acl"
+	"github.com/youtube/vitess/go/vt/topo"
+)
+
+// This file serves the topology audit log, as an API collection and
+// as a page.
+
+var topoAuditTimeout = flag.Duration("topo_audit_timeout", 30*time.Second, "time to wait for the topology audit log entries of a request")
+
+const topoAuditHTML = `
+<html>
+<head>
+<title>Topology Audit Log</title>
+<style>
+  table { border-collapse: collapse; }
+  td, th { border: 1px solid #999; padding: 0.2rem; vertical-align: top; }
+  pre { margin: 0; }
+</style>
+</head>
+<body>
+<h1>Topology Audit Log</h1>
+<form>
+  Cell: <input type="text" name="cell" value="{{.Query.Cell}}">
+  Path prefix: <input type="text" name="path_prefix" value="{{.Query.PathPrefix}}">
+  Since: <input type="text" name="since" value="{{.Query.Since}}">
+  Limit: <input type="text" name="limit" value="{{.Query.Limit}}">
+  <input type="submit" value="Show">
+</form>
+{{if .Error}}
+  <p>Error: {{.Error}}</p>
+{{else}}
+<table>
+  <tr>
+    <th>Time</th>
+    <th>Action</th>
+    <th>Path</th>
+    <th>Versions</th>
+    <th>Process</th>
+    <th>Caller</th>
+    <th>Diff</th>
+  </tr>
+  {{range .Entries}}
+  <tr>
+    <td>{{.Time.Format "2006-01-02 15:04:05.000"}}</td>
+    <td>{{.Action}}</td>
+    <td>{{.Path}}</td>
+    <td>{{.OldVersion}} &rarr; {{.NewVersion}}</td>
+    <td>{{.Process}}</td>
+    <td>{{.Caller}}</td>
+    <td><pre>{{.Diff}}</pre></td>
+  </tr>
+  {{else}}
+  <tr><td colspan="7">No change was recorded.</td></tr>
+  {{end}}
+</table>
+{{end}}
+</body>
+</html>
+`
+
+// topoAuditQuery has the parameters of a topology audit log request.
+type topoAuditQuery struct {
+	Cell       string
+	PathPrefix string
+	Since      string
+	Limit      int
+}
+
+// parseTopoAuditQuery reads the parameters of a topology audit log
+// request: the cell (global by default), the path prefix, how old the
+// changes can be (e.g. 1h) and how many of them to return (100 by
+// default). It returns the query even on error, with the defaults.
+func parseTopoAuditQuery(r *http.Request) (*topoAuditQuery, error) {
+	q := &topoAuditQuery{
+		Cell:  topo.GlobalCell,
+		Limit: 100,
+	}
+	if err := r.ParseForm(); err != nil {
+		return q, err
+	}
+	if cell := r.FormValue("cell"); cell != "" {
+		q.Cell = cell
+	}
+	q.PathPrefix = r.FormValue("path_prefix")
+	q.Since = r.FormValue("since")
+	if limit := r.FormValue("limit"); limit != "" {
+		var err error
+		if q.Limit, err = strconv.Atoi(limit); err != nil {
+			return q, fmt.Errorf("bad limit %q: %v", limit, err)
+		}
+	}
+	return q, nil
+}
+
+// entries returns the entries of the audit log matching the query.
+func (q *topoAuditQuery) entries(ctx context.Context, ts topo.Server) ([]*topo.AuditEntry, error) {
+	var since time.Time
+	if q.Since != "" {
+		d, err := time.ParseDuration(q.Since)
+		if err != nil {
+			return nil, fmt.Errorf("bad since %q: %v", q.Since, err)
+		}
+		since = time.Now().Add(-d)
+	}
+	return ts.GetAuditEntries(ctx, q.Cell, q.PathPrefix, since, q.Limit)
+}
+
+// initTopoAudit serves the topology audit log.
+func initTopoAudit(ctx context.Context, ts topo.Server) {
+	// The API returns the entries as JSON.
+	handleCollection("topo_audit", func(r *http.Request) (interface{}, error) {
+		if err := acl.CheckAccessHTTP(r, acl.DEBUGGING); err != nil {
+			return nil, err
+		}
+		q, err := parseTopoAuditQuery(r)
+		if err != nil {
+			return nil, err
+		}
+		if cell := getItemPath(r.URL.Path); cell != "" {
+			q.Cell = cell
+		}
+		ctx, cancel := context.WithTimeout(ctx, *topoAuditTimeout)
+		defer cancel()
+		return q.entries(ctx, ts)
+	})
+
+	// The page shows them in a table.
+	topoAuditTemplate := template.Must(template.New("topo_audit").Parse(topoAuditHTML))
+	http.HandleFunc("/topo_audit", func(w http.ResponseWriter, r *http.Request) {
+		if err := acl.CheckAccessHTTP(r, acl.DEBUGGING); err != nil {
+			acl.SendError(w, err)
+			return
+		}
+		data := struct {
+			Query   *topoAuditQuery
+			Entries []*topo.AuditEntry
+			Error   error
+		}{}
+		data.Query, data.Error = parseTopoAuditQuery(r)
+		if data.Error == nil {
+			ctx, cancel := context.WithTimeout(ctx, *topoAuditTimeout)
+			defer cancel()
+			data.Entries, data.Error = data.Query.entries(ctx, ts)
+		}
+		if err := topoAuditTemplate.Execute(w, data); err != nil {
+			httpErrorf(w, r, "cannot execute template: %v", err)
+		}
+	})
+}
@@ -0,0 +1,77 @@
+package vtctld
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/topo"
+	"github.com/youtube/vitess/go/vt/topo/zk2topo"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+func TestTopoAudit(t *testing.T) {
+	ctx := context.Background()
+	ts := zk2topo.NewFakeServer("cell1")
+	ts.Impl = topo.NewAuditImpl(ts.Impl)
+	server := httptest.NewServer(nil)
+	defer server.Close()
+
+	if err := ts.CreateKeyspace(ctx, "ks1", &topodatapb.Keyspace{ShardingColumnName: "shardcol"}); err != nil {
+		t.Fatalf("CreateKeyspace failed: %v", err)
+	}
+	if err := ts.CreateTablet(ctx, &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 100},
+		Keyspace: "ks1",
+		Shard:    "0",
+	}); err != nil {
+		t.Fatalf("CreateTablet failed: %v", err)
+	}
+	initTopoAudit(ctx, ts)
+
+	get := func(url string) []byte {
+		resp, err := http.Get(server.URL + url)
+		if err != nil {
+			t.Fatalf("GET %v failed: %v", url, err)
+		}
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("cannot read %v: %v", url, err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("GET %v returned %v: %s", url, resp.Status, body)
+		}
+		return body
+	}
+
+	// The API returns the entries of the requested cell.
+	var entries []*topo.AuditEntry
+	if err := json.Unmarshal(get(apiPrefix+"topo_audit/"), &entries); err != nil {
+		t.Fatalf("bad JSON: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Path != "/keyspaces/ks1/Keyspace" || entries[0].Action != "Create" {
+		t.Errorf("got global entries %+v, want the keyspace creation", entries)
+	}
+	if err := json.Unmarshal(get(apiPrefix+"topo_audit/cell1?path_prefix=/tablets/"), &entries); err != nil {
+		t.Fatalf("bad JSON: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Path != "/tablets/cell1-0000000100/Tablet" {
+		t.Errorf("got cell1 entries %+v, want the tablet creation", entries)
+	}
+
+	// The page shows them.
+	page := string(get("/topo_audit?cell=cell1"))
+	if !strings.Contains(page, "/tablets/cell1-0000000100/Tablet") || !strings.Contains(page, "&#43;keyspace: &#34;ks1&#34;") {
+		t.Errorf("page doesn't show the tablet creation: %v", page)
+	}
+	if page := string(get("/topo_audit?limit=x")); !strings.Contains(page, "Error: bad limit") {
+		t.Errorf("page doesn't show the bad limit: %v", page)
+	}
+}
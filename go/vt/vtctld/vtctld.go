@@ -165,6 +165,9 @@ func InitVtctld(ts topo.Server) {
 		}
 	}
 
+	// Start the automatic master failover, if enabled.
+	initMasterFailover(ts, actionRepo)
+
 	// Serve the REST API for the vtctld web app.
 	initAPI(context.Background(), ts, actionRepo, realtimeStats)
 
@@ -173,4 +176,7 @@ func InitVtctld(ts topo.Server) {
 
 	// Init workflow manager.
 	initWorkflowManager(ts)
+
+	// Serve the topology audit log.
+	initTopoAudit(context.Background(), ts)
 }
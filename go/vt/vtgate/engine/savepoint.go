@@ -0,0 +1,37 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package engine
+
+import (
+	"errors"
+
+	"github.com/youtube/vitess/go/sqltypes"
+)
+
+// Savepoint represents the instructions to set, roll back to
+// or release a savepoint of the current transaction. It's
+// applied to all the shards that are part of the transaction,
+// and to the shards that join it later.
+type Savepoint struct {
+	// Action is one of sqlparser.SavepointStr, sqlparser.RollbackToStr
+	// or sqlparser.ReleaseStr.
+	Action string
+	Name   string
+}
+
+// Execute performs a non-streaming exec.
+func (sp *Savepoint) Execute(vcursor VCursor, joinvars map[string]interface{}, wantfields bool) (*sqltypes.Result, error) {
+	return vcursor.ExecuteSavepoint(sp)
+}
+
+// StreamExecute performs a streaming exec.
+func (sp *Savepoint) StreamExecute(vcursor VCursor, joinvars map[string]interface{}, wantfields bool, sendReply func(*sqltypes.Result) error) error {
+	return errors.New("savepoint statements not allowed for streaming")
+}
+
+// GetFields fetches the field info.
+func (sp *Savepoint) GetFields(vcursor VCursor, joinvars map[string]interface{}) (*sqltypes.Result, error) {
+	return nil, errors.New("savepoint statements have no fields")
+}
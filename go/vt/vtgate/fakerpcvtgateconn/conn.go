@@ -267,7 +267,7 @@ func (a *streamExecuteAdapter) Recv() (*sqltypes.Result, error) {
 }
 
 // StreamExecute please see vtgateconn.Impl.StreamExecute
-func (conn *FakeVTGateConn) StreamExecute(ctx context.Context, sql string, bindVars map[string]interface{}, keyspace string, tabletType topodatapb.TabletType, options *querypb.ExecuteOptions) (sqltypes.ResultStream, error) {
+func (conn *FakeVTGateConn) StreamExecute(ctx context.Context, sql string, bindVars map[string]interface{}, keyspace string, tabletType topodatapb.TabletType, session interface{}, options *querypb.ExecuteOptions) (sqltypes.ResultStream, error) {
 	response, ok := conn.execMap[sql]
 	if !ok {
 		return nil, fmt.Errorf("no match for: %s", sql)
@@ -320,19 +320,28 @@ func (conn *FakeVTGateConn) StreamExecuteKeyspaceIds(ctx context.Context, query
 }
 
 // Begin please see vtgateconn.Impl.Begin
-func (conn *FakeVTGateConn) Begin(ctx context.Context, singledb bool) (interface{}, error) {
-	return &vtgatepb.Session{
+func (conn *FakeVTGateConn) Begin(ctx context.Context, singledb bool, session interface{}) (interface{}, error) {
+	s := &vtgatepb.Session{
 		InTransaction: true,
 		SingleDb:      singledb,
-	}, nil
+	}
+	if session != nil {
+		s.ReadYourWrites = session.(*vtgatepb.Session).ReadYourWrites
+		s.Positions = session.(*vtgatepb.Session).Positions
+	}
+	return s, nil
 }
 
 // Commit please see vtgateconn.Impl.Commit
-func (conn *FakeVTGateConn) Commit(ctx context.Context, session interface{}, twopc bool) error {
+func (conn *FakeVTGateConn) Commit(ctx context.Context, session interface{}, twopc bool) (interface{}, error) {
 	if session == nil {
-		return errors.New("commit: not in transaction")
+		return nil, errors.New("commit: not in transaction")
 	}
-	return nil
+	s := session.(*vtgatepb.Session)
+	return &vtgatepb.Session{
+		ReadYourWrites: s.ReadYourWrites,
+		Positions:      s.Positions,
+	}, nil
 }
 
 // Rollback please see vtgateconn.Impl.Rollback
@@ -0,0 +1,377 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gateway
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/youtube/vitess/go/ewma"
+	"github.com/youtube/vitess/go/flagutil"
+	"github.com/youtube/vitess/go/vt/discovery"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// The balancer strategies the discovery gateway supports.
+const (
+	// balancerRandom picks a random tablet.
+	balancerRandom = "random"
+
+	// balancerPowerOfTwo picks two random tablets, and uses the one
+	// with the fewest requests in flight from this gateway.
+	balancerPowerOfTwo = "power_of_two"
+
+	// balancerLatency picks two random tablets, and uses the one with
+	// the lowest moving average of its request latency. Comparing two
+	// random tablets instead of all of them avoids sending all the
+	// requests to the fastest tablet until its average catches up.
+	balancerLatency = "latency"
+
+	// balancerCPU picks a random tablet, weighted by the CPU the
+	// tablet has left according to the cpu_usage of its health
+	// stream.
+	balancerCPU = "cpu"
+)
+
+// minCPUWeight is the weight of a tablet that reports it uses all its
+// CPU, so it still gets a few requests and we notice when it recovers.
+const minCPUWeight = 0.05
+
+// failureLatency is the latency recorded for a request that failed
+// because of the tablet, if it failed faster: a tablet that fails
+// fast must not look fast to the latency balancer.
+const failureLatency = time.Second
+
+var (
+	gatewayBalancer    = flag.String("gateway_balancer", balancerRandom, "strategy the discovery gateway uses to pick a tablet among the healthy ones: random, power_of_two (fewest requests in flight), latency (lowest latency moving average) or cpu (weighted by the cpu_usage of the tablets)")
+	tabletTypeBalancer flagutil.StringMapValue
+)
+
+func init() {
+	flag.Var(&tabletTypeBalancer, "gateway_balancer_per_tablet_type", "comma-separated list of tablet_type:strategy pairs, overriding -gateway_balancer for these tablet types, e.g. replica:latency,rdonly:cpu")
+}
+
+// balancer picks the tablet a request is sent to, among the healthy
+// tablets of a target.
+type balancer interface {
+	// pick returns the tablet to use, or nil if all the tablets
+	// are in skip.
+	pick(tablets []discovery.TabletStats, skip map[string]bool) *discovery.TabletStats
+}
+
+// newBalancer returns the balancer for a strategy.
+func newBalancer(strategy string, loads *tabletLoads) (balancer, error) {
+	switch strategy {
+	case balancerRandom:
+		return randomBalancer{}, nil
+	case balancerPowerOfTwo:
+		return powerOfTwoBalancer{cost: func(ts *discovery.TabletStats) float64 {
+			return float64(loads.get(ts.Key).inFlightCount())
+		}}, nil
+	case balancerLatency:
+		return powerOfTwoBalancer{cost: func(ts *discovery.TabletStats) float64 {
+			return loads.get(ts.Key).averageLatency()
+		}}, nil
+	case balancerCPU:
+		return cpuBalancer{}, nil
+	}
+	return nil, fmt.Errorf("unknown balancer strategy %q", strategy)
+}
+
+// balancerSet is the balancer of each tablet type, as configured by
+// the flags.
+type balancerSet struct {
+	defaultStrategy string
+	defaultBalancer balancer
+	strategies      map[topodatapb.TabletType]string
+	balancers       map[topodatapb.TabletType]balancer
+}
+
+// newBalancerSet returns a balancerSet using defaultStrategy, except
+// for the tablet types in perTabletType.
+func newBalancerSet(defaultStrategy string, perTabletType map[string]string, loads *tabletLoads) (*balancerSet, error) {
+	def, err := newBalancer(defaultStrategy, loads)
+	if err != nil {
+		return nil, err
+	}
+	bs := &balancerSet{
+		defaultStrategy: defaultStrategy,
+		defaultBalancer: def,
+		strategies:      make(map[topodatapb.TabletType]string),
+		balancers:       make(map[topodatapb.TabletType]balancer),
+	}
+	for tt, strategy := range perTabletType {
+		tabletType, err := topoproto.ParseTabletType(tt)
+		if err != nil {
+			return nil, err
+		}
+		b, err := newBalancer(strategy, loads)
+		if err != nil {
+			return nil, fmt.Errorf("tablet type %v: %v", tt, err)
+		}
+		bs.strategies[tabletType] = strategy
+		bs.balancers[tabletType] = b
+	}
+	return bs, nil
+}
+
+// get returns the balancer of a tablet type.
+func (bs *balancerSet) get(tabletType topodatapb.TabletType) balancer {
+	if b, ok := bs.balancers[tabletType]; ok {
+		return b
+	}
+	return bs.defaultBalancer
+}
+
+// strategy returns the strategy of the balancer of a tablet type.
+func (bs *balancerSet) strategy(tabletType topodatapb.TabletType) string {
+	if s, ok := bs.strategies[tabletType]; ok {
+		return s
+	}
+	return bs.defaultStrategy
+}
+
+// candidates returns the tablets that are not in skip.
+func candidates(tablets []discovery.TabletStats, skip map[string]bool) []*discovery.TabletStats {
+	result := make([]*discovery.TabletStats, 0, len(tablets))
+	for i := range tablets {
+		if !skip[tablets[i].Key] {
+			result = append(result, &tablets[i])
+		}
+	}
+	return result
+}
+
+// randomBalancer is the balancer for balancerRandom.
+type randomBalancer struct{}
+
+func (randomBalancer) pick(tablets []discovery.TabletStats, skip map[string]bool) *discovery.TabletStats {
+	shuffleTablets(tablets)
+	for i := range tablets {
+		if !skip[tablets[i].Key] {
+			return &tablets[i]
+		}
+	}
+	return nil
+}
+
+// powerOfTwoBalancer picks two random tablets, and returns the one
+// with the lowest cost.
+type powerOfTwoBalancer struct {
+	cost func(ts *discovery.TabletStats) float64
+}
+
+func (b powerOfTwoBalancer) pick(tablets []discovery.TabletStats, skip map[string]bool) *discovery.TabletStats {
+	c := candidates(tablets, skip)
+	switch len(c) {
+	case 0:
+		return nil
+	case 1:
+		return c[0]
+	}
+	i := rand.Intn(len(c))
+	j := rand.Intn(len(c) - 1)
+	if j >= i {
+		j++
+	}
+	if b.cost(c[j]) < b.cost(c[i]) {
+		return c[j]
+	}
+	return c[i]
+}
+
+// cpuBalancer is the balancer for balancerCPU.
+type cpuBalancer struct{}
+
+func (cpuBalancer) pick(tablets []discovery.TabletStats, skip map[string]bool) *discovery.TabletStats {
+	c := candidates(tablets, skip)
+	if len(c) == 0 {
+		return nil
+	}
+	weights := make([]float64, len(c))
+	var total float64
+	for i, ts := range c {
+		weights[i] = cpuWeight(ts)
+		total += weights[i]
+	}
+	r := rand.Float64() * total
+	for i, w := range weights {
+		if r < w {
+			return c[i]
+		}
+		r -= w
+	}
+	return c[len(c)-1]
+}
+
+// cpuWeight returns the share of its CPU a tablet has left. cpu_usage
+// is a fraction of the CPU, 0 if the tablet doesn't report it.
+func cpuWeight(ts *discovery.TabletStats) float64 {
+	if ts.Stats == nil {
+		return 1
+	}
+	w := 1 - ts.Stats.CpuUsage
+	if w < minCPUWeight {
+		return minCPUWeight
+	}
+	if w > 1 {
+		return 1
+	}
+	return w
+}
+
+// tabletLoad is what the gateway knows of the load it sends to a
+// tablet.
+type tabletLoad struct {
+	// inFlight and selections are accessed atomically.
+	inFlight   int64
+	selections int64
+
+	// mu protects latency.
+	mu      sync.Mutex
+	latency *ewma.EWMA
+}
+
+func (l *tabletLoad) inFlightCount() int64 {
+	return atomic.LoadInt64(&l.inFlight)
+}
+
+func (l *tabletLoad) selectionCount() int64 {
+	return atomic.LoadInt64(&l.selections)
+}
+
+// averageLatency returns the moving average of the request latency,
+// in nanoseconds. It is 0 until a request completes, so new tablets
+// are tried early.
+func (l *tabletLoad) averageLatency() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.latency.GetEWMA()
+}
+
+// begin records a request sent to the tablet.
+func (l *tabletLoad) begin() {
+	atomic.AddInt64(&l.selections, 1)
+	atomic.AddInt64(&l.inFlight, 1)
+}
+
+// end records the completion of a request started with begin, which
+// took elapsed. err is the error of the request, and tabletFailure
+// is true if the tablet caused it. The latency of a successful
+// request is recorded, and a tablet failure counts as at least
+// failureLatency. Other errors, like invalid queries, say nothing
+// of the tablet and are not recorded. The latency of a streaming
+// request is not recorded, as elapsed covers the whole stream.
+func (l *tabletLoad) end(elapsed time.Duration, streaming bool, err error, tabletFailure bool) {
+	atomic.AddInt64(&l.inFlight, -1)
+	switch {
+	case streaming:
+		return
+	case tabletFailure:
+		if elapsed < failureLatency {
+			elapsed = failureLatency
+		}
+	case err != nil:
+		return
+	}
+	l.mu.Lock()
+	l.latency.AddValue(float64(elapsed))
+	l.mu.Unlock()
+}
+
+// tabletLoads tracks the tabletLoad of each tablet, by tablet key.
+type tabletLoads struct {
+	mu    sync.RWMutex
+	loads map[string]*tabletLoad
+}
+
+func newTabletLoads() *tabletLoads {
+	return &tabletLoads{
+		loads: make(map[string]*tabletLoad),
+	}
+}
+
+// get returns the tabletLoad of a tablet, creating it if needed.
+func (tl *tabletLoads) get(key string) *tabletLoad {
+	tl.mu.RLock()
+	l, ok := tl.loads[key]
+	tl.mu.RUnlock()
+	if ok {
+		return l
+	}
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+	l, ok = tl.loads[key]
+	if ok {
+		return l
+	}
+	l = &tabletLoad{
+		latency: ewma.NewEWMA(ewma.DefaultWeightingFactor),
+	}
+	tl.loads[key] = l
+	return l
+}
+
+// remove forgets a tablet, when it goes away.
+func (tl *tabletLoads) remove(key string) {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+	delete(tl.loads, key)
+}
+
+// selectionCount returns the number of requests sent to a tablet,
+// without creating its tabletLoad.
+func (tl *tabletLoads) selectionCount(key string) int64 {
+	tl.mu.RLock()
+	defer tl.mu.RUnlock()
+	if l, ok := tl.loads[key]; ok {
+		return l.selectionCount()
+	}
+	return 0
+}
+
+// TabletSelection is the share of the requests of a target a tablet
+// received, for the status page.
+type TabletSelection struct {
+	Name       string
+	Selections int64
+	Share      float64 // in percent
+}
+
+// tabletSelectionList computes the TabletSelection of tablets, sorted
+// by name.
+func tabletSelectionList(tablets []discovery.TabletStats, loads *tabletLoads) []*TabletSelection {
+	result := make([]*TabletSelection, 0, len(tablets))
+	var total int64
+	for _, ts := range tablets {
+		s := &TabletSelection{
+			Name:       topoproto.TabletAliasString(ts.Tablet.Alias),
+			Selections: loads.selectionCount(ts.Key),
+		}
+		total += s.Selections
+		result = append(result, s)
+	}
+	if total > 0 {
+		for _, s := range result {
+			s.Share = float64(s.Selections) * 100 / float64(total)
+		}
+	}
+	sort.Sort(tabletSelectionsByName(result))
+	return result
+}
+
+// tabletSelectionsByName sorts TabletSelection by name.
+type tabletSelectionsByName []*TabletSelection
+
+func (s tabletSelectionsByName) Len() int           { return len(s) }
+func (s tabletSelectionsByName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s tabletSelectionsByName) Less(i, j int) bool { return s[i].Name < s[j].Name }
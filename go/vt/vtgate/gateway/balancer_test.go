@@ -0,0 +1,222 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gateway
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/discovery"
+	"github.com/youtube/vitess/go/vt/topo"
+
+	querypb "github.com/youtube/vitess/go/vt/proto/query"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+func TestBalancerSet(t *testing.T) {
+	loads := newTabletLoads()
+	bs, err := newBalancerSet(balancerRandom, map[string]string{"replica": balancerLatency, "rdonly": balancerCPU}, loads)
+	if err != nil {
+		t.Fatalf("newBalancerSet failed: %v", err)
+	}
+	for tabletType, want := range map[topodatapb.TabletType]string{
+		topodatapb.TabletType_MASTER:  balancerRandom,
+		topodatapb.TabletType_REPLICA: balancerLatency,
+		topodatapb.TabletType_RDONLY:  balancerCPU,
+	} {
+		if got := bs.strategy(tabletType); got != want {
+			t.Errorf("strategy(%v) = %v, want %v", tabletType, got, want)
+		}
+	}
+	if _, ok := bs.get(topodatapb.TabletType_RDONLY).(cpuBalancer); !ok {
+		t.Errorf("get(RDONLY) = %#v, want a cpuBalancer", bs.get(topodatapb.TabletType_RDONLY))
+	}
+
+	if _, err := newBalancerSet("fastest", nil, loads); err == nil {
+		t.Errorf("newBalancerSet with an unknown strategy worked")
+	}
+	if _, err := newBalancerSet(balancerRandom, map[string]string{"replica": "fastest"}, loads); err == nil {
+		t.Errorf("newBalancerSet with an unknown strategy for replica worked")
+	}
+	if _, err := newBalancerSet(balancerRandom, map[string]string{"slave": balancerCPU}, loads); err == nil {
+		t.Errorf("newBalancerSet with an unknown tablet type worked")
+	}
+}
+
+func testTabletStats(keys ...string) []discovery.TabletStats {
+	result := make([]discovery.TabletStats, len(keys))
+	for i, key := range keys {
+		result[i] = discovery.TabletStats{
+			Key:   key,
+			Stats: &querypb.RealtimeStats{},
+		}
+	}
+	return result
+}
+
+func TestBalancersSkip(t *testing.T) {
+	loads := newTabletLoads()
+	for _, strategy := range []string{balancerRandom, balancerPowerOfTwo, balancerLatency, balancerCPU} {
+		b, err := newBalancer(strategy, loads)
+		if err != nil {
+			t.Fatalf("newBalancer(%v) failed: %v", strategy, err)
+		}
+		for i := 0; i < 10; i++ {
+			if got := b.pick(testTabletStats("a", "b", "c"), map[string]bool{"a": true, "c": true}); got == nil || got.Key != "b" {
+				t.Errorf("%v: pick returned %v, want b", strategy, got)
+			}
+		}
+		if got := b.pick(testTabletStats("a", "b"), map[string]bool{"a": true, "b": true}); got != nil {
+			t.Errorf("%v: pick returned %v, want nil", strategy, got)
+		}
+	}
+}
+
+func TestPowerOfTwoBalancer(t *testing.T) {
+	loads := newTabletLoads()
+	b, err := newBalancer(balancerPowerOfTwo, loads)
+	if err != nil {
+		t.Fatalf("newBalancer failed: %v", err)
+	}
+
+	// With two tablets, both are compared every time.
+	loads.get("a").begin()
+	loads.get("a").begin()
+	loads.get("b").begin()
+	for i := 0; i < 10; i++ {
+		if got := b.pick(testTabletStats("a", "b"), nil); got.Key != "b" {
+			t.Fatalf("pick returned %v, want b", got.Key)
+		}
+	}
+	loads.get("a").end(time.Millisecond, false, nil, false)
+	loads.get("a").end(time.Millisecond, false, nil, false)
+	for i := 0; i < 10; i++ {
+		if got := b.pick(testTabletStats("a", "b"), nil); got.Key != "a" {
+			t.Fatalf("pick returned %v, want a", got.Key)
+		}
+	}
+}
+
+func TestLatencyBalancer(t *testing.T) {
+	loads := newTabletLoads()
+	b, err := newBalancer(balancerLatency, loads)
+	if err != nil {
+		t.Fatalf("newBalancer failed: %v", err)
+	}
+
+	for _, key := range []string{"a", "b"} {
+		loads.get(key).begin()
+	}
+	loads.get("a").end(50*time.Millisecond, false, nil, false)
+	loads.get("b").end(10*time.Millisecond, false, nil, false)
+	for i := 0; i < 10; i++ {
+		if got := b.pick(testTabletStats("a", "b"), nil); got.Key != "b" {
+			t.Fatalf("pick returned %v, want b", got.Key)
+		}
+	}
+
+	// A tablet without any request yet is tried first.
+	for i := 0; i < 10; i++ {
+		if got := b.pick(testTabletStats("b", "c"), nil); got.Key != "c" {
+			t.Fatalf("pick returned %v, want c", got.Key)
+		}
+	}
+}
+
+func TestTabletLoadLatency(t *testing.T) {
+	l := newTabletLoads().get("a")
+	end := func(elapsed time.Duration, streaming bool, err error, tabletFailure bool) {
+		l.begin()
+		l.end(elapsed, streaming, err, tabletFailure)
+	}
+
+	end(10*time.Millisecond, false, nil, false)
+	want := l.averageLatency()
+	if want != float64(10*time.Millisecond) {
+		t.Errorf("averageLatency = %v, want %v", want, float64(10*time.Millisecond))
+	}
+
+	// Streams and errors that don't come from the tablet don't
+	// change the average.
+	end(time.Hour, true, nil, false)
+	end(time.Microsecond, false, errors.New("syntax error"), false)
+	if got := l.averageLatency(); got != want {
+		t.Errorf("averageLatency = %v, want %v", got, want)
+	}
+
+	// A tablet failure counts as at least failureLatency.
+	end(time.Microsecond, false, errors.New("connection refused"), true)
+	if got := l.averageLatency(); got <= want {
+		t.Errorf("averageLatency after a fast failure = %v, want more than %v", got, want)
+	}
+	if got := l.inFlightCount(); got != 0 {
+		t.Errorf("inFlightCount = %v, want 0", got)
+	}
+}
+
+func TestCPUBalancer(t *testing.T) {
+	b, err := newBalancer(balancerCPU, newTabletLoads())
+	if err != nil {
+		t.Fatalf("newBalancer failed: %v", err)
+	}
+
+	// "busy" has a weight of minCPUWeight, so it gets about 5% of
+	// the requests.
+	picks := make(map[string]int)
+	for i := 0; i < 1000; i++ {
+		tablets := testTabletStats("busy", "idle")
+		tablets[0].Stats.CpuUsage = 1
+		picks[b.pick(tablets, nil).Key]++
+	}
+	if picks["busy"] == 0 || picks["busy"] > 150 {
+		t.Errorf("busy tablet got %v of the 1000 requests, want about 50", picks["busy"])
+	}
+}
+
+func TestDiscoveryGatewayTabletSelections(t *testing.T) {
+	keyspace := "ks"
+	shard := "0"
+	target := &querypb.Target{
+		Keyspace:   keyspace,
+		Shard:      shard,
+		TabletType: topodatapb.TabletType_REPLICA,
+	}
+	hc := discovery.NewFakeHealthCheck()
+	dg := createDiscoveryGateway(hc, topo.Server{}, nil, "cell", 2).(*discoveryGateway)
+	hc.Reset()
+	dg.tsc.ResetForTesting()
+	hc.AddTestTablet("cell", "1.1.1.1", 1001, keyspace, shard, topodatapb.TabletType_REPLICA, true, 10, nil)
+	hc.AddTestTablet("cell", "1.1.1.1", 1002, keyspace, shard, topodatapb.TabletType_REPLICA, true, 10, nil)
+
+	for i := 0; i < 10; i++ {
+		if _, err := dg.Execute(context.Background(), target, "query", nil, 0, nil); err != nil {
+			t.Fatalf("Execute failed: %v", err)
+		}
+	}
+
+	statuses := dg.CacheStatus()
+	if len(statuses) != 1 {
+		t.Fatalf("CacheStatus returned %v statuses, want 1", len(statuses))
+	}
+	status := statuses[0]
+	if status.Balancer != balancerRandom {
+		t.Errorf("Balancer = %v, want %v", status.Balancer, balancerRandom)
+	}
+	if len(status.TabletSelections) != 2 {
+		t.Fatalf("TabletSelections = %v, want 2 tablets", status.TabletSelections)
+	}
+	var selections int64
+	var share float64
+	for _, s := range status.TabletSelections {
+		selections += s.Selections
+		share += s.Share
+	}
+	if selections != 10 || share < 99.9 || share > 100.1 {
+		t.Errorf("TabletSelections add up to %v selections and %v%%, want 10 and 100%%", selections, share)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gateway
+
+import (
+	"flag"
+	"time"
+
+	"github.com/youtube/vitess/go/flagutil"
+	"github.com/youtube/vitess/go/stats"
+	"github.com/youtube/vitess/go/vt/discovery"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+var (
+	fallbackCells               flagutil.StringListValue
+	fallbackMaxReplicationLag   = flag.Duration("gateway_fallback_max_replication_lag", 0, "if not 0, the tablets of the fallback cells that lag more than this are not used")
+	fallbackDisabledTabletTypes flagutil.StringListValue
+
+	// crossCellRequests counts the requests sent to a fallback cell.
+	crossCellRequests = stats.NewMultiCounters("GatewayCrossCellRequests", []string{"Keyspace", "Shard", "TabletType", "Cell"})
+)
+
+func init() {
+	flag.Var(&fallbackCells, "gateway_fallback_cells", "comma-separated list of cells, in order of preference, the discovery gateway uses when no tablet of the local cell is healthy. The tablets of these cells must be watched, see -cells_to_watch")
+	flag.Var(&fallbackDisabledTabletTypes, "gateway_fallback_disabled_tablet_types", "comma-separated list of tablet types that never fall back to another cell")
+}
+
+// cellFallback finds healthy tablets in other cells, when the local
+// cell has none. Master tablets are not concerned: the gateway uses
+// the master of a shard in whichever cell it is.
+type cellFallback struct {
+	// cells are the fallback cells, in order of preference, and
+	// caches their TabletStatsCache.
+	cells  []string
+	caches []*discovery.TabletStatsCache
+
+	// maxLag is the replication lag ceiling of the fallback
+	// tablets, 0 if there is none.
+	maxLag time.Duration
+
+	// disabled has the tablet types that don't fall back.
+	disabled map[topodatapb.TabletType]bool
+}
+
+// newCellFallback returns a cellFallback for the provided cells. The
+// local cell and duplicates are ignored.
+func newCellFallback(localCell string, cells []string, maxLag time.Duration, disabledTabletTypes []string) (*cellFallback, error) {
+	cf := &cellFallback{
+		maxLag:   maxLag,
+		disabled: make(map[topodatapb.TabletType]bool),
+	}
+	seen := map[string]bool{localCell: true}
+	for _, cell := range cells {
+		if cell == "" || seen[cell] {
+			continue
+		}
+		seen[cell] = true
+		cf.cells = append(cf.cells, cell)
+		cf.caches = append(cf.caches, discovery.NewTabletStatsCacheDoNotSetListener(cell))
+	}
+	for _, tt := range disabledTabletTypes {
+		tabletType, err := topoproto.ParseTabletType(tt)
+		if err != nil {
+			return nil, err
+		}
+		cf.disabled[tabletType] = true
+	}
+	return cf, nil
+}
+
+// StatsUpdate forwards HealthCheck updates to the caches of the
+// fallback cells. Each cache only keeps the tablets of its cell.
+func (cf *cellFallback) StatsUpdate(ts *discovery.TabletStats) {
+	if ts.Target.TabletType == topodatapb.TabletType_MASTER {
+		return
+	}
+	for _, c := range cf.caches {
+		c.StatsUpdate(ts)
+	}
+}
+
+// healthyTablets returns the healthy tablets of the first fallback
+// cell that has some, or nil if there are none or the tablet type
+// doesn't fall back.
+func (cf *cellFallback) healthyTablets(keyspace, shard string, tabletType topodatapb.TabletType) []discovery.TabletStats {
+	if tabletType == topodatapb.TabletType_MASTER || cf.disabled[tabletType] {
+		return nil
+	}
+	for _, c := range cf.caches {
+		tablets := c.GetHealthyTabletStats(keyspace, shard, tabletType)
+		if cf.maxLag != 0 {
+			tablets = cf.filterByLag(tablets)
+		}
+		if len(tablets) > 0 {
+			return tablets
+		}
+	}
+	return nil
+}
+
+// filterByLag returns the tablets under the replication lag ceiling.
+func (cf *cellFallback) filterByLag(tablets []discovery.TabletStats) []discovery.TabletStats {
+	result := tablets[:0]
+	for _, ts := range tablets {
+		if ts.Stats != nil && time.Duration(ts.Stats.SecondsBehindMaster)*time.Second > cf.maxLag {
+			continue
+		}
+		result = append(result, ts)
+	}
+	return result
+}
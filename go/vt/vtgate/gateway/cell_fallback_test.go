@@ -0,0 +1,98 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/discovery"
+	"github.com/youtube/vitess/go/vt/topo"
+
+	querypb "github.com/youtube/vitess/go/vt/proto/query"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+func TestNewCellFallback(t *testing.T) {
+	cf, err := newCellFallback("local", []string{"remote1", "local", "", "remote2", "remote1"}, 0, []string{"rdonly"})
+	if err != nil {
+		t.Fatalf("newCellFallback failed: %v", err)
+	}
+	if len(cf.cells) != 2 || cf.cells[0] != "remote1" || cf.cells[1] != "remote2" {
+		t.Errorf("cells = %v, want [remote1 remote2]", cf.cells)
+	}
+	if !cf.disabled[topodatapb.TabletType_RDONLY] || cf.disabled[topodatapb.TabletType_REPLICA] {
+		t.Errorf("disabled = %v, want only RDONLY", cf.disabled)
+	}
+
+	if _, err := newCellFallback("local", nil, 0, []string{"slave"}); err == nil {
+		t.Errorf("newCellFallback with an unknown tablet type worked")
+	}
+}
+
+func TestDiscoveryGatewayCellFallback(t *testing.T) {
+	keyspace := "ks"
+	shard := "0"
+	target := &querypb.Target{
+		Keyspace:   keyspace,
+		Shard:      shard,
+		TabletType: topodatapb.TabletType_REPLICA,
+	}
+	rdonlyTarget := &querypb.Target{
+		Keyspace:   keyspace,
+		Shard:      shard,
+		TabletType: topodatapb.TabletType_RDONLY,
+	}
+	hc := discovery.NewFakeHealthCheck()
+	dg := createDiscoveryGateway(hc, topo.Server{}, nil, "local", 2).(*discoveryGateway)
+	var err error
+	dg.fallback, err = newCellFallback("local", []string{"remote1", "remote2"}, 30*time.Second, []string{"rdonly"})
+	if err != nil {
+		t.Fatalf("newCellFallback failed: %v", err)
+	}
+
+	// The local replica is used when it is healthy.
+	hc.AddTestTablet("remote1", "1.1.1.1", 1001, keyspace, shard, topodatapb.TabletType_REPLICA, true, 10, nil)
+	remote2 := hc.AddTestTablet("remote2", "2.2.2.2", 1001, keyspace, shard, topodatapb.TabletType_REPLICA, true, 10, nil)
+	local := hc.AddTestTablet("local", "3.3.3.3", 1001, keyspace, shard, topodatapb.TabletType_REPLICA, true, 10, nil)
+	if _, err := dg.Execute(context.Background(), target, "query", nil, 0, nil); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if local.ExecCount.Get() != 1 {
+		t.Errorf("local tablet got %v queries, want 1", local.ExecCount.Get())
+	}
+
+	// Without a healthy local replica, the first fallback cell is
+	// used.
+	hc.AddTestTablet("local", "3.3.3.3", 1001, keyspace, shard, topodatapb.TabletType_REPLICA, false, 10, nil)
+	if _, err := dg.Execute(context.Background(), target, "query", nil, 0, nil); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if got := crossCellRequests.Counts()["ks.0.replica.remote1"]; got != 1 {
+		t.Errorf("cross-cell requests to remote1 = %v, want 1", got)
+	}
+
+	// The replica of remote1 lags too much, remote2 is used.
+	lagging := dg.fallback.caches[0].GetTabletStats(keyspace, shard, topodatapb.TabletType_REPLICA)[0]
+	lagging.Stats = &querypb.RealtimeStats{SecondsBehindMaster: 60}
+	dg.StatsUpdate(&lagging)
+	if _, err := dg.Execute(context.Background(), target, "query", nil, 0, nil); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if remote2.ExecCount.Get() != 1 {
+		t.Errorf("remote2 tablet got %v queries, want 1", remote2.ExecCount.Get())
+	}
+	if got := crossCellRequests.Counts()["ks.0.replica.remote2"]; got != 1 {
+		t.Errorf("cross-cell requests to remote2 = %v, want 1", got)
+	}
+
+	// RDONLY doesn't fall back.
+	hc.AddTestTablet("remote1", "1.1.1.2", 1001, keyspace, shard, topodatapb.TabletType_RDONLY, true, 10, nil)
+	if _, err := dg.Execute(context.Background(), rdonlyTarget, "query", nil, 0, nil); err == nil {
+		t.Errorf("Execute on rdonly worked, want no valid tablet")
+	}
+}
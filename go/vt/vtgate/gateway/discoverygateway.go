@@ -22,6 +22,7 @@ import (
 	"github.com/youtube/vitess/go/vt/tabletserver/querytypes"
 	"github.com/youtube/vitess/go/vt/tabletserver/tabletconn"
 	"github.com/youtube/vitess/go/vt/topo"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
 	"github.com/youtube/vitess/go/vt/vterrors"
 	"github.com/youtube/vitess/go/vt/vtgate/buffer"
 	"github.com/youtube/vitess/go/vt/vtgate/masterbuffer"
@@ -67,6 +68,18 @@ type discoveryGateway struct {
 
 	// buffer, if enabled, buffers requests during a detected MASTER failover.
 	buffer *buffer.Buffer
+
+	// loads tracks the requests we send to each tablet, and
+	// balancers uses them to pick the tablet of each request.
+	loads     *tabletLoads
+	balancers *balancerSet
+
+	// fallback finds tablets in other cells when the local cell
+	// has no healthy tablet.
+	fallback *cellFallback
+
+	// outliers ejects the tablets that fail or are slow.
+	outliers *outlierDetector
 }
 
 func createDiscoveryGateway(hc discovery.HealthCheck, topoServer topo.Server, serv topo.SrvTopoServer, cell string, retryCount int) Gateway {
@@ -80,6 +93,17 @@ func createDiscoveryGateway(hc discovery.HealthCheck, topoServer topo.Server, se
 		tabletsWatchers:   make([]*discovery.TopologyWatcher, 0, 1),
 		statusAggregators: make(map[string]*TabletStatusAggregator),
 		buffer:            buffer.New(),
+		loads:             newTabletLoads(),
+		outliers:          newOutlierDetector(outlierConfigFromFlags()),
+	}
+	var err error
+	dg.balancers, err = newBalancerSet(*gatewayBalancer, tabletTypeBalancer, dg.loads)
+	if err != nil {
+		log.Fatalf("Cannot parse gateway_balancer parameters: %v", err)
+	}
+	dg.fallback, err = newCellFallback(cell, fallbackCells, *fallbackMaxReplicationLag, fallbackDisabledTabletTypes)
+	if err != nil {
+		log.Fatalf("Cannot parse gateway_fallback_disabled_tablet_types parameter: %v", err)
 	}
 
 	// Set listener which will update TabletStatsCache and MasterBuffer.
@@ -106,10 +130,16 @@ func createDiscoveryGateway(hc discovery.HealthCheck, topoServer topo.Server, se
 	return dg
 }
 
-// StatsUpdate forwards HealthCheck updates to TabletStatsCache and MasterBuffer.
+// StatsUpdate forwards HealthCheck updates to TabletStatsCache, the
+// caches of the fallback cells and MasterBuffer.
 // It is part of the discovery.HealthCheckStatsListener interface.
 func (dg *discoveryGateway) StatsUpdate(ts *discovery.TabletStats) {
 	dg.tsc.StatsUpdate(ts)
+	dg.fallback.StatsUpdate(ts)
+	if !ts.Up {
+		dg.loads.remove(ts.Key)
+		dg.outliers.remove(ts.Key)
+	}
 
 	if ts.Target.TabletType == topodatapb.TabletType_MASTER {
 		dg.buffer.StatsUpdate(ts)
@@ -178,13 +208,16 @@ func (dg *discoveryGateway) Begin(ctx context.Context, target *querypb.Target) (
 }
 
 // Commit commits the current transaction for the specified keyspace, shard, and tablet type.
-func (dg *discoveryGateway) Commit(ctx context.Context, target *querypb.Target, transactionID int64) error {
-	return dg.withRetry(ctx, target, func(conn tabletconn.TabletConn, target *querypb.Target) error {
+func (dg *discoveryGateway) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (string, error) {
+	var position string
+	err := dg.withRetry(ctx, target, func(conn tabletconn.TabletConn, target *querypb.Target) error {
+		var innerErr error
 		startTime := time.Now()
-		innerErr := conn.Commit(ctx, target, transactionID)
+		position, innerErr = conn.Commit(ctx, target, transactionID)
 		dg.updateStats(target, startTime, innerErr)
 		return innerErr
 	}, true, false)
+	return position, err
 }
 
 // Rollback rolls back the current transaction for the specified keyspace, shard, and tablet type.
@@ -208,13 +241,16 @@ func (dg *discoveryGateway) Prepare(ctx context.Context, target *querypb.Target,
 }
 
 // CommitPrepared rolls back the current transaction for the specified keyspace, shard, and tablet type.
-func (dg *discoveryGateway) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) (err error) {
-	return dg.withRetry(ctx, target, func(conn tabletconn.TabletConn, target *querypb.Target) error {
+func (dg *discoveryGateway) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) (string, error) {
+	var position string
+	err := dg.withRetry(ctx, target, func(conn tabletconn.TabletConn, target *querypb.Target) error {
+		var innerErr error
 		startTime := time.Now()
-		innerErr := conn.CommitPrepared(ctx, target, dtid)
+		position, innerErr = conn.CommitPrepared(ctx, target, dtid)
 		dg.updateStats(target, startTime, innerErr)
 		return innerErr
 	}, true, false)
+	return position, err
 }
 
 // RollbackPrepared rolls back the current transaction for the specified keyspace, shard, and tablet type.
@@ -238,13 +274,16 @@ func (dg *discoveryGateway) CreateTransaction(ctx context.Context, target *query
 }
 
 // StartCommit rolls back the current transaction for the specified keyspace, shard, and tablet type.
-func (dg *discoveryGateway) StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (err error) {
-	return dg.withRetry(ctx, target, func(conn tabletconn.TabletConn, target *querypb.Target) error {
+func (dg *discoveryGateway) StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (string, error) {
+	var position string
+	err := dg.withRetry(ctx, target, func(conn tabletconn.TabletConn, target *querypb.Target) error {
+		var innerErr error
 		startTime := time.Now()
-		innerErr := conn.StartCommit(ctx, target, transactionID, dtid)
+		position, innerErr = conn.StartCommit(ctx, target, transactionID, dtid)
 		dg.updateStats(target, startTime, innerErr)
 		return innerErr
 	}, true, false)
+	return position, err
 }
 
 // SetRollback rolls back the current transaction for the specified keyspace, shard, and tablet type.
@@ -354,7 +393,11 @@ func (dg *discoveryGateway) CacheStatus() TabletCacheStatusList {
 	dg.mu.RLock()
 	res := make(TabletCacheStatusList, 0, len(dg.statusAggregators))
 	for _, aggr := range dg.statusAggregators {
-		res = append(res, aggr.GetCacheStatus())
+		status := aggr.GetCacheStatus()
+		status.Balancer = dg.balancers.strategy(status.TabletType)
+		status.TabletSelections = tabletSelectionList(dg.tsc.GetTabletStats(status.Keyspace, status.Shard, status.TabletType), dg.loads)
+		status.EjectedTablets = dg.outliers.ejectedTablets(&querypb.Target{Keyspace: status.Keyspace, Shard: status.Shard, TabletType: status.TabletType})
+		res = append(res, status)
 	}
 	dg.mu.RUnlock()
 	sort.Sort(res)
@@ -405,21 +448,20 @@ func (dg *discoveryGateway) withRetry(ctx context.Context, target *querypb.Targe
 		}
 
 		tablets := dg.tsc.GetHealthyTabletStats(target.Keyspace, target.Shard, target.TabletType)
+		if len(tablets) == 0 {
+			// no healthy tablet in the local cell, try the
+			// fallback cells
+			tablets = dg.fallback.healthyTablets(target.Keyspace, target.Shard, target.TabletType)
+		}
+		tablets = dg.outliers.filter(tablets)
 		if len(tablets) == 0 {
 			// fail fast if there is no tablet
 			err = vterrors.FromError(vtrpcpb.ErrorCode_INTERNAL_ERROR, fmt.Errorf("no valid tablet"))
 			break
 		}
-		shuffleTablets(tablets)
 
 		// skip tablets we tried before
-		var ts *discovery.TabletStats
-		for _, t := range tablets {
-			if _, ok := invalidTablets[t.Key]; !ok {
-				ts = &t
-				break
-			}
-		}
+		ts := dg.balancers.get(target.TabletType).pick(tablets, invalidTablets)
 		if ts == nil {
 			if err == nil {
 				// do not override error from last attempt.
@@ -442,7 +484,17 @@ func (dg *discoveryGateway) withRetry(ctx context.Context, target *querypb.Targe
 			return bufferErr
 		}
 
+		load := dg.loads.get(ts.Key)
+		load.begin()
+		if ts.Tablet.Alias.Cell != dg.localCell && target.TabletType != topodatapb.TabletType_MASTER {
+			crossCellRequests.Add([]string{target.Keyspace, target.Shard, topoproto.TabletTypeLString(target.TabletType), ts.Tablet.Alias.Cell}, 1)
+		}
+		startTime := time.Now()
 		err = action(conn, ts.Target)
+		elapsed := time.Now().Sub(startTime)
+		tabletFailure := isTabletFailure(ctx, err)
+		load.end(elapsed, isStreaming, err, tabletFailure)
+		dg.outliers.record(ts, elapsed, tabletFailure)
 		if dg.canRetry(ctx, err, inTransaction, isStreaming) {
 			invalidTablets[ts.Key] = true
 			continue
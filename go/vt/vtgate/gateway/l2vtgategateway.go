@@ -191,13 +191,16 @@ func (lg *l2VTGateGateway) Begin(ctx context.Context, target *querypb.Target) (t
 }
 
 // Commit commits the current transaction for the specified keyspace, shard, and tablet type.
-func (lg *l2VTGateGateway) Commit(ctx context.Context, target *querypb.Target, transactionID int64) error {
-	return lg.withRetry(ctx, target, func(conn *l2VTGateConn) error {
+func (lg *l2VTGateGateway) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (string, error) {
+	var position string
+	err := lg.withRetry(ctx, target, func(conn *l2VTGateConn) error {
+		var innerErr error
 		startTime := time.Now()
-		innerErr := conn.conn.Commit(ctx, target, transactionID)
+		position, innerErr = conn.conn.Commit(ctx, target, transactionID)
 		lg.updateStats(conn, target.TabletType, startTime, innerErr)
 		return innerErr
 	}, true, false)
+	return position, err
 }
 
 // Rollback rolls back the current transaction for the specified keyspace, shard, and tablet type.
@@ -221,13 +224,16 @@ func (lg *l2VTGateGateway) Prepare(ctx context.Context, target *querypb.Target,
 }
 
 // CommitPrepared rolls back the current transaction for the specified keyspace, shard, and tablet type.
-func (lg *l2VTGateGateway) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) (err error) {
-	return lg.withRetry(ctx, target, func(conn *l2VTGateConn) error {
+func (lg *l2VTGateGateway) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) (string, error) {
+	var position string
+	err := lg.withRetry(ctx, target, func(conn *l2VTGateConn) error {
+		var innerErr error
 		startTime := time.Now()
-		innerErr := conn.conn.CommitPrepared(ctx, target, dtid)
+		position, innerErr = conn.conn.CommitPrepared(ctx, target, dtid)
 		lg.updateStats(conn, target.TabletType, startTime, innerErr)
 		return innerErr
 	}, true, false)
+	return position, err
 }
 
 // RollbackPrepared rolls back the current transaction for the specified keyspace, shard, and tablet type.
@@ -251,13 +257,16 @@ func (lg *l2VTGateGateway) CreateTransaction(ctx context.Context, target *queryp
 }
 
 // StartCommit rolls back the current transaction for the specified keyspace, shard, and tablet type.
-func (lg *l2VTGateGateway) StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (err error) {
-	return lg.withRetry(ctx, target, func(conn *l2VTGateConn) error {
+func (lg *l2VTGateGateway) StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (string, error) {
+	var position string
+	err := lg.withRetry(ctx, target, func(conn *l2VTGateConn) error {
+		var innerErr error
 		startTime := time.Now()
-		innerErr := conn.conn.StartCommit(ctx, target, transactionID, dtid)
+		position, innerErr = conn.conn.StartCommit(ctx, target, transactionID, dtid)
 		lg.updateStats(conn, target.TabletType, startTime, innerErr)
 		return innerErr
 	}, true, false)
+	return position, err
 }
 
 // SetRollback rolls back the current transaction for the specified keyspace, shard, and tablet type.
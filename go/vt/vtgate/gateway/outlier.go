@@ -0,0 +1,352 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gateway
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/stats"
+	"github.com/youtube/vitess/go/vt/discovery"
+	"github.com/youtube/vitess/go/vt/tabletserver/tabletconn"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+
+	querypb "github.com/youtube/vitess/go/vt/proto/query"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+	vtrpcpb "github.com/youtube/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	outlierErrorRate          = flag.Float64("gateway_outlier_error_rate", 0, "if not 0, the discovery gateway ejects the tablets whose share of failed queries over the last minute is above this, e.g. 0.5")
+	outlierLatencyFactor      = flag.Float64("gateway_outlier_latency_factor", 0, "if not 0, the discovery gateway ejects the tablets whose average latency over the last minute is more than this many times the median of their keyspace/shard/tablet type, e.g. 3")
+	outlierMinQueries         = flag.Int("gateway_outlier_min_queries", 20, "number of queries over the last minute a tablet needs before it can be ejected")
+	outlierBaseEjectionTime   = flag.Duration("gateway_outlier_base_ejection_time", 30*time.Second, "how long a tablet is ejected the first time, doubled each time it is ejected again")
+	outlierMaxEjectionTime    = flag.Duration("gateway_outlier_max_ejection_time", 5*time.Minute, "maximum time a tablet is ejected")
+	outlierMaxEjectionPercent = flag.Int("gateway_outlier_max_ejection_percent", 50, "maximum percentage of the healthy tablets of a keyspace/shard/tablet type that can be ejected at the same time")
+
+	// outlierEjections counts the ejections of each tablet, and
+	// ejectedTablets is 1 while a tablet is ejected.
+	outlierEjections = stats.NewMultiCounters("GatewayOutlierEjections", []string{"Keyspace", "Shard", "TabletType", "Tablet"})
+	ejectedTablets   = stats.NewMultiCounters("GatewayEjectedTablets", []string{"Keyspace", "Shard", "TabletType", "Tablet"})
+)
+
+// outlierCheckInterval is how often the last minute stats of a tablet
+// are recomputed.
+const outlierCheckInterval = time.Second
+
+// outlierConfig are the parameters of an outlierDetector.
+type outlierConfig struct {
+	errorRate          float64
+	latencyFactor      float64
+	minQueries         int
+	baseEjectionTime   time.Duration
+	maxEjectionTime    time.Duration
+	maxEjectionPercent int
+}
+
+// outlierConfigFromFlags returns the outlierConfig set by the flags.
+func outlierConfigFromFlags() outlierConfig {
+	return outlierConfig{
+		errorRate:          *outlierErrorRate,
+		latencyFactor:      *outlierLatencyFactor,
+		minQueries:         *outlierMinQueries,
+		baseEjectionTime:   *outlierBaseEjectionTime,
+		maxEjectionTime:    *outlierMaxEjectionTime,
+		maxEjectionPercent: *outlierMaxEjectionPercent,
+	}
+}
+
+// outlierDetector tracks the failures and the latency of each tablet
+// with a TabletStatusAggregator, and temporarily ejects the tablets
+// that fail or are slow much more than the others, even if their
+// health stream says they are fine. The ejection time doubles each
+// time a tablet is ejected again, up to maxEjectionTime.
+type outlierDetector struct {
+	config outlierConfig
+	// now is time.Now, except in tests.
+	now func() time.Time
+
+	// mu protects tablets.
+	mu sync.Mutex
+	// tablets is indexed by tablet key.
+	tablets map[string]*tabletOutlier
+}
+
+// tabletOutlier is the state of a tablet in the outlierDetector.
+type tabletOutlier struct {
+	keyspace   string
+	shard      string
+	tabletType topodatapb.TabletType
+	name       string
+	aggr       *TabletStatusAggregator
+
+	// The stats of the last minute, recomputed every
+	// outlierCheckInterval.
+	checkedAt  time.Time
+	queryCount uint64
+	errorRate  float64
+	avgLatency time.Duration
+
+	// ejections is the number of consecutive ejections,
+	// ejectedUntil is set while the tablet is ejected, and
+	// readmittedAt is when it was last readmitted.
+	ejections    int
+	ejectedUntil time.Time
+	readmittedAt time.Time
+	reason       string
+}
+
+func newOutlierDetector(config outlierConfig) *outlierDetector {
+	return &outlierDetector{
+		config:  config,
+		now:     time.Now,
+		tablets: make(map[string]*tabletOutlier),
+	}
+}
+
+// enabled returns true if the detector can eject tablets.
+func (od *outlierDetector) enabled() bool {
+	return od.config.errorRate > 0 || od.config.latencyFactor > 0
+}
+
+// isTabletFailure returns true if err is a failure of the tablet, as
+// opposed to an error of the query, or the caller giving up.
+func isTabletFailure(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() == context.Canceled {
+		return false
+	}
+	if serverError, ok := err.(*tabletconn.ServerError); ok {
+		switch serverError.ServerCode {
+		case vtrpcpb.ErrorCode_INTERNAL_ERROR, vtrpcpb.ErrorCode_DEADLINE_EXCEEDED, vtrpcpb.ErrorCode_UNKNOWN_ERROR:
+			return true
+		}
+		return false
+	}
+	// Operational errors: the tablet couldn't be reached, or didn't
+	// answer in time.
+	return true
+}
+
+// record adds the outcome of a query to the stats of a tablet.
+func (od *outlierDetector) record(ts *discovery.TabletStats, elapsed time.Duration, failed bool) {
+	if !od.enabled() {
+		return
+	}
+	od.get(ts).aggr.UpdateQueryInfo("", ts.Target.TabletType, elapsed, failed)
+}
+
+// get returns the tabletOutlier of a tablet, creating it if needed.
+func (od *outlierDetector) get(ts *discovery.TabletStats) *tabletOutlier {
+	od.mu.Lock()
+	defer od.mu.Unlock()
+	to, ok := od.tablets[ts.Key]
+	if !ok {
+		name := topoproto.TabletAliasString(ts.Tablet.Alias)
+		to = &tabletOutlier{
+			keyspace:   ts.Target.Keyspace,
+			shard:      ts.Target.Shard,
+			tabletType: ts.Target.TabletType,
+			name:       name,
+			aggr:       NewTabletStatusAggregator(ts.Target.Keyspace, ts.Target.Shard, ts.Target.TabletType, name),
+		}
+		od.tablets[ts.Key] = to
+	}
+	return to
+}
+
+// remove forgets a tablet, when it goes away.
+func (od *outlierDetector) remove(key string) {
+	od.mu.Lock()
+	defer od.mu.Unlock()
+	to, ok := od.tablets[key]
+	if !ok {
+		return
+	}
+	unregisterAggregator(to.aggr)
+	if !to.ejectedUntil.IsZero() {
+		ejectedTablets.Set(to.statsNames(), 0)
+	}
+	delete(od.tablets, key)
+}
+
+func (to *tabletOutlier) statsNames() []string {
+	return []string{to.keyspace, to.shard, topoproto.TabletTypeLString(to.tabletType), to.name}
+}
+
+// refresh recomputes the stats of the last minute, at most every
+// outlierCheckInterval.
+func (to *tabletOutlier) refresh(now time.Time) {
+	if now.Sub(to.checkedAt) < outlierCheckInterval {
+		return
+	}
+	to.checkedAt = now
+	queryCount, queryError, latency := to.aggr.lastMinute()
+	to.queryCount = queryCount
+	to.errorRate = 0
+	to.avgLatency = 0
+	if queryCount > 0 {
+		to.errorRate = float64(queryError) / float64(queryCount)
+		to.avgLatency = latency / time.Duration(queryCount)
+	}
+}
+
+// filter returns the tablets that are not ejected, after ejecting the
+// new outliers. If all the tablets are ejected, it returns them all:
+// a degraded tablet is better than none.
+func (od *outlierDetector) filter(tablets []discovery.TabletStats) []discovery.TabletStats {
+	if !od.enabled() || len(tablets) == 0 {
+		return tablets
+	}
+	now := od.now()
+	od.mu.Lock()
+	defer od.mu.Unlock()
+
+	// Readmit the tablets whose ejection is over, and compute the
+	// median latency of the others.
+	ejected := 0
+	var latencies []time.Duration
+	for _, ts := range tablets {
+		to, ok := od.tablets[ts.Key]
+		if !ok {
+			continue
+		}
+		if !to.ejectedUntil.IsZero() {
+			if now.Before(to.ejectedUntil) {
+				ejected++
+				continue
+			}
+			od.readmit(to, now)
+		}
+		to.refresh(now)
+		if to.queryCount >= uint64(od.config.minQueries) {
+			latencies = append(latencies, to.avgLatency)
+		}
+	}
+	var median time.Duration
+	if len(latencies) > 0 {
+		sort.Sort(durations(latencies))
+		median = latencies[(len(latencies)-1)/2]
+	}
+
+	result := make([]discovery.TabletStats, 0, len(tablets))
+	for _, ts := range tablets {
+		to, ok := od.tablets[ts.Key]
+		if !ok {
+			result = append(result, ts)
+			continue
+		}
+		if !to.ejectedUntil.IsZero() {
+			continue
+		}
+		if reason := od.outlierReason(to, median); reason != "" && (ejected+1)*100 <= od.config.maxEjectionPercent*len(tablets) {
+			od.eject(to, reason, now)
+			ejected++
+			continue
+		}
+		result = append(result, ts)
+	}
+	if len(result) == 0 {
+		return tablets
+	}
+	return result
+}
+
+// outlierReason returns why a tablet is an outlier, or "" if it is not.
+func (od *outlierDetector) outlierReason(to *tabletOutlier, median time.Duration) string {
+	if to.queryCount < uint64(od.config.minQueries) {
+		return ""
+	}
+	if od.config.errorRate > 0 && to.errorRate > od.config.errorRate {
+		return fmt.Sprintf("%.0f%% of the queries failed", to.errorRate*100)
+	}
+	if od.config.latencyFactor > 0 && median > 0 && float64(to.avgLatency) > od.config.latencyFactor*float64(median) {
+		return fmt.Sprintf("average latency %v, median %v", to.avgLatency, median)
+	}
+	return ""
+}
+
+// eject ejects a tablet. The caller holds mu.
+func (od *outlierDetector) eject(to *tabletOutlier, reason string, now time.Time) {
+	// A tablet that behaved for maxEjectionTime since it was
+	// readmitted starts over.
+	if !to.readmittedAt.IsZero() && now.Sub(to.readmittedAt) > od.config.maxEjectionTime {
+		to.ejections = 0
+	}
+	to.ejections++
+	d := od.config.baseEjectionTime
+	for i := 1; i < to.ejections && d < od.config.maxEjectionTime; i++ {
+		d *= 2
+	}
+	if d > od.config.maxEjectionTime {
+		d = od.config.maxEjectionTime
+	}
+	to.ejectedUntil = now.Add(d)
+	to.reason = reason
+	log.Warningf("ejecting tablet %v for %v: %v", to.name, d, reason)
+	outlierEjections.Add(to.statsNames(), 1)
+	ejectedTablets.Set(to.statsNames(), 1)
+}
+
+// readmit readmits an ejected tablet, with a clean slate of stats.
+// The caller holds mu.
+func (od *outlierDetector) readmit(to *tabletOutlier, now time.Time) {
+	log.Infof("readmitting tablet %v after %v ejection(s)", to.name, to.ejections)
+	to.ejectedUntil = time.Time{}
+	to.readmittedAt = now
+	to.reason = ""
+	to.checkedAt = time.Time{}
+	to.aggr.resetMinute()
+	ejectedTablets.Set(to.statsNames(), 0)
+}
+
+// EjectedTablet is an ejected tablet, for the status page.
+type EjectedTablet struct {
+	Name      string
+	Until     time.Time
+	Ejections int
+	Reason    string
+}
+
+// ejectedTablets returns the tablets of a target that are currently
+// ejected, sorted by name.
+func (od *outlierDetector) ejectedTablets(target *querypb.Target) []*EjectedTablet {
+	now := od.now()
+	od.mu.Lock()
+	defer od.mu.Unlock()
+	var result []*EjectedTablet
+	for _, to := range od.tablets {
+		if to.keyspace != target.Keyspace || to.shard != target.Shard || to.tabletType != target.TabletType || !now.Before(to.ejectedUntil) {
+			continue
+		}
+		result = append(result, &EjectedTablet{
+			Name:      to.name,
+			Until:     to.ejectedUntil,
+			Ejections: to.ejections,
+			Reason:    to.reason,
+		})
+	}
+	sort.Sort(ejectedTabletsByName(result))
+	return result
+}
+
+// ejectedTabletsByName sorts EjectedTablet by name.
+type ejectedTabletsByName []*EjectedTablet
+
+func (s ejectedTabletsByName) Len() int           { return len(s) }
+func (s ejectedTabletsByName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s ejectedTabletsByName) Less(i, j int) bool { return s[i].Name < s[j].Name }
+
+// durations sorts time.Duration.
+type durations []time.Duration
+
+func (d durations) Len() int           { return len(d) }
+func (d durations) Swap(i, j int)      { d[i], d[j] = d[j], d[i] }
+func (d durations) Less(i, j int) bool { return d[i] < d[j] }
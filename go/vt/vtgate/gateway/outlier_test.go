@@ -0,0 +1,200 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gateway
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html/template"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/discovery"
+	"github.com/youtube/vitess/go/vt/tabletserver/tabletconn"
+	"github.com/youtube/vitess/go/vt/topo"
+
+	querypb "github.com/youtube/vitess/go/vt/proto/query"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+	vtrpcpb "github.com/youtube/vitess/go/vt/proto/vtrpc"
+)
+
+func TestIsTabletFailure(t *testing.T) {
+	ctx := context.Background()
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	testcases := []struct {
+		ctx  context.Context
+		err  error
+		want bool
+	}{
+		{ctx, nil, false},
+		{ctx, tabletconn.OperationalError("vttablet: deadline exceeded"), true},
+		{ctx, errors.New("conn"), true},
+		{ctx, &tabletconn.ServerError{ServerCode: vtrpcpb.ErrorCode_INTERNAL_ERROR}, true},
+		{ctx, &tabletconn.ServerError{ServerCode: vtrpcpb.ErrorCode_BAD_INPUT}, false},
+		{ctx, &tabletconn.ServerError{ServerCode: vtrpcpb.ErrorCode_QUERY_NOT_SERVED}, false},
+		{canceledCtx, tabletconn.OperationalError("vttablet: context canceled"), false},
+	}
+	for _, tc := range testcases {
+		if got := isTabletFailure(tc.ctx, tc.err); got != tc.want {
+			t.Errorf("isTabletFailure(%v) = %v, want %v", tc.err, got, tc.want)
+		}
+	}
+}
+
+func outlierTabletStats(uid uint32) discovery.TabletStats {
+	tablet := topo.NewTablet(uid, "cell", fmt.Sprintf("host%v", uid))
+	return discovery.TabletStats{
+		Key:    discovery.TabletToMapKey(tablet),
+		Tablet: tablet,
+		Target: &querypb.Target{
+			Keyspace:   "ks",
+			Shard:      "0",
+			TabletType: topodatapb.TabletType_REPLICA,
+		},
+	}
+}
+
+// addQueries synchronously adds queries to the stats of a tablet.
+func addQueries(od *outlierDetector, ts discovery.TabletStats, count, failures int, latency time.Duration) {
+	to := od.get(&ts)
+	for i := 0; i < count; i++ {
+		to.aggr.processQueryInfo(&queryInfo{
+			aggr:       to.aggr,
+			tabletType: ts.Target.TabletType,
+			elapsed:    latency,
+			hasError:   i < failures,
+		})
+	}
+}
+
+func tabletKeys(tablets []discovery.TabletStats) string {
+	var keys []string
+	for _, ts := range tablets {
+		keys = append(keys, ts.Key)
+	}
+	return strings.Join(keys, " ")
+}
+
+func TestOutlierDetector(t *testing.T) {
+	od := newOutlierDetector(outlierConfig{
+		errorRate:          0.5,
+		latencyFactor:      3,
+		minQueries:         10,
+		baseEjectionTime:   30 * time.Second,
+		maxEjectionTime:    time.Minute,
+		maxEjectionPercent: 50,
+	})
+	now := time.Now()
+	od.now = func() time.Time { return now }
+	failing := outlierTabletStats(1)
+	slow := outlierTabletStats(2)
+	good1 := outlierTabletStats(3)
+	good2 := outlierTabletStats(4)
+	tablets := []discovery.TabletStats{failing, slow, good1, good2}
+	defer func() {
+		for _, ts := range tablets {
+			od.remove(ts.Key)
+		}
+	}()
+
+	// The failing and slow tablets are ejected.
+	addQueries(od, failing, 10, 8, 10*time.Millisecond)
+	addQueries(od, slow, 10, 0, 100*time.Millisecond)
+	addQueries(od, good1, 10, 1, 10*time.Millisecond)
+	addQueries(od, good2, 9, 9, 10*time.Millisecond)
+	want := tabletKeys([]discovery.TabletStats{good1, good2})
+	if got := tabletKeys(od.filter(tablets)); got != want {
+		t.Errorf("filter returned %v, want %v", got, want)
+	}
+	ejected := od.ejectedTablets(failing.Target)
+	if len(ejected) != 2 || ejected[0].Name != "cell-0000000001" || ejected[0].Reason != "80% of the queries failed" || !ejected[0].Until.Equal(now.Add(30*time.Second)) || ejected[1].Name != "cell-0000000002" || !strings.HasPrefix(ejected[1].Reason, "average latency 100ms") {
+		t.Errorf("ejectedTablets returned %+v", ejected)
+	}
+	if got := ejectedTablets.Counts()["ks.0.replica.cell-0000000001"]; got != 1 {
+		t.Errorf("GatewayEjectedTablets = %v, want 1", got)
+	}
+
+	// With 2 tablets out of 4 ejected, good2 can't be ejected,
+	// even though all its queries failed.
+	now = now.Add(time.Second)
+	addQueries(od, good2, 1, 1, 10*time.Millisecond)
+	if got := tabletKeys(od.filter(tablets)); got != want {
+		t.Errorf("filter returned %v, want %v", got, want)
+	}
+
+	// After the ejection time, the tablets are readmitted with
+	// clean stats, and good2 is ejected.
+	now = now.Add(30 * time.Second)
+	want = tabletKeys([]discovery.TabletStats{failing, slow, good1})
+	if got := tabletKeys(od.filter(tablets)); got != want {
+		t.Errorf("filter returned %v, want %v", got, want)
+	}
+	if got := ejectedTablets.Counts()["ks.0.replica.cell-0000000001"]; got != 0 {
+		t.Errorf("GatewayEjectedTablets = %v, want 0", got)
+	}
+
+	// The failing tablet fails again, its ejection time doubles.
+	now = now.Add(time.Second)
+	addQueries(od, failing, 10, 10, 10*time.Millisecond)
+	want = tabletKeys([]discovery.TabletStats{slow, good1})
+	if got := tabletKeys(od.filter(tablets)); got != want {
+		t.Errorf("filter returned %v, want %v", got, want)
+	}
+	ejected = od.ejectedTablets(failing.Target)
+	if len(ejected) != 2 || ejected[0].Name != "cell-0000000001" || ejected[0].Ejections != 2 || !ejected[0].Until.Equal(now.Add(time.Minute)) {
+		t.Errorf("ejectedTablets returned %+v", ejected)
+	}
+	if got := outlierEjections.Counts()["ks.0.replica.cell-0000000001"]; got != 2 {
+		t.Errorf("GatewayOutlierEjections = %v, want 2", got)
+	}
+
+	// Make sure the HTML rendering of the ejected tablets works.
+	templ, err := template.New("").Parse(StatusTemplate)
+	if err != nil {
+		t.Fatalf("error parsing template: %v", err)
+	}
+	wr := &bytes.Buffer{}
+	if err := templ.Execute(wr, []*TabletCacheStatus{{EjectedTablets: ejected}}); err != nil {
+		t.Fatalf("error executing template: %v", err)
+	}
+	if !strings.Contains(wr.String(), "cell-0000000001 until") {
+		t.Errorf("status doesn't show the ejected tablet: %v", wr.String())
+	}
+}
+
+func TestOutlierDetectorKeepsOneTablet(t *testing.T) {
+	od := newOutlierDetector(outlierConfig{
+		errorRate:          0.5,
+		minQueries:         10,
+		baseEjectionTime:   30 * time.Second,
+		maxEjectionTime:    time.Minute,
+		maxEjectionPercent: 100,
+	})
+	ts := outlierTabletStats(5)
+	defer od.remove(ts.Key)
+
+	// The only tablet is ejected, but still used.
+	addQueries(od, ts, 10, 10, 10*time.Millisecond)
+	if got := od.filter([]discovery.TabletStats{ts}); len(got) != 1 {
+		t.Errorf("filter returned %v, want the tablet", got)
+	}
+	if got := od.ejectedTablets(ts.Target); len(got) != 1 {
+		t.Errorf("ejectedTablets returned %v, want the tablet", got)
+	}
+}
+
+func TestOutlierDetectorDisabled(t *testing.T) {
+	od := newOutlierDetector(outlierConfig{})
+	ts := outlierTabletStats(6)
+	od.record(&ts, time.Second, true)
+	if len(od.tablets) != 0 {
+		t.Errorf("disabled outlierDetector tracks tablets: %v", od.tablets)
+	}
+}
@@ -45,6 +45,9 @@ const (
     <th>Query Error</th>
     <th>QPS (avg 1m)</th>
     <th>Latency (ms) (avg 1m)</th>
+    <th>Balancer</th>
+    <th>Tablet Selection Share</th>
+    <th>Ejected Tablets</th>
   </tr>
   {{range $i, $status := .}}
   <tr>
@@ -56,6 +59,9 @@ const (
     <td>{{$status.QueryError}}</td>
     <td>{{$status.QPS}}</td>
     <td>{{$status.AvgLatency}}</td>
+    <td>{{$status.Balancer}}</td>
+    <td>{{range $status.TabletSelections}}{{.Name}}: {{printf "%.1f" .Share}}% ({{.Selections}})<br>{{end}}</td>
+    <td>{{range $status.EjectedTablets}}{{.Name}} until {{.Until.Format "15:04:05"}} ({{.Ejections}} ejection(s): {{.Reason}})<br>{{end}}</td>
   </tr>
   {{end}}
 </table>
@@ -89,6 +95,18 @@ func registerAggregator(a *TabletStatusAggregator) {
 	aggregators = append(aggregators, a)
 }
 
+// unregisterAggregator removes an aggregator from the global list.
+func unregisterAggregator(a *TabletStatusAggregator) {
+	muAggr.Lock()
+	defer muAggr.Unlock()
+	for i, aggr := range aggregators {
+		if aggr == a {
+			aggregators = append(aggregators[:i], aggregators[i+1:]...)
+			return
+		}
+	}
+}
+
 // resetAggregators resets the next stats slot for all aggregators every second.
 func resetAggregators() {
 	ticker := time.NewTicker(time.Second)
@@ -124,6 +142,15 @@ type TabletCacheStatus struct {
 	QueryError uint64
 	QPS        uint64
 	AvgLatency float64 // in milliseconds
+
+	// Balancer is the strategy used to pick the tablets,
+	// TabletSelections the share of the queries each tablet
+	// received, and EjectedTablets the tablets currently ejected
+	// for failing or being slow. Only the discovery gateway sets
+	// them.
+	Balancer         string
+	TabletSelections []*TabletSelection
+	EjectedTablets   []*EjectedTablet
 }
 
 //
@@ -142,9 +169,10 @@ type TabletStatusAggregator struct {
 	mu         sync.RWMutex
 	QueryCount uint64
 	QueryError uint64
-	// for QPS and latency (avg value over a minute)
+	// for QPS, errors and latency (avg value over a minute)
 	tick               uint32
 	queryCountInMinute [60]uint64
+	queryErrorInMinute [60]uint64
 	latencyInMinute    [60]time.Duration
 }
 
@@ -193,12 +221,7 @@ func (tsa *TabletStatusAggregator) processQueryInfo(qi *queryInfo) {
 		// reset counters
 		tsa.QueryCount = 0
 		tsa.QueryError = 0
-		for i := 0; i < len(tsa.queryCountInMinute); i++ {
-			tsa.queryCountInMinute[i] = 0
-		}
-		for i := 0; i < len(tsa.latencyInMinute); i++ {
-			tsa.latencyInMinute[i] = 0
-		}
+		tsa.resetMinuteLocked()
 	}
 	if qi.addr != "" {
 		tsa.Addr = qi.addr
@@ -208,6 +231,39 @@ func (tsa *TabletStatusAggregator) processQueryInfo(qi *queryInfo) {
 	tsa.latencyInMinute[tsa.tick] += qi.elapsed
 	if qi.hasError {
 		tsa.QueryError++
+		tsa.queryErrorInMinute[tsa.tick]++
+	}
+}
+
+// lastMinute returns the number of queries and errors, and the total
+// latency of the queries, over the last minute.
+func (tsa *TabletStatusAggregator) lastMinute() (queryCount, queryError uint64, latency time.Duration) {
+	tsa.mu.RLock()
+	defer tsa.mu.RUnlock()
+	for i := range tsa.queryCountInMinute {
+		queryCount += tsa.queryCountInMinute[i]
+		queryError += tsa.queryErrorInMinute[i]
+		latency += tsa.latencyInMinute[i]
+	}
+	return queryCount, queryError, latency
+}
+
+// resetMinute forgets the queries of the last minute.
+func (tsa *TabletStatusAggregator) resetMinute() {
+	tsa.mu.Lock()
+	defer tsa.mu.Unlock()
+	tsa.resetMinuteLocked()
+}
+
+func (tsa *TabletStatusAggregator) resetMinuteLocked() {
+	for i := 0; i < len(tsa.queryCountInMinute); i++ {
+		tsa.queryCountInMinute[i] = 0
+	}
+	for i := 0; i < len(tsa.queryErrorInMinute); i++ {
+		tsa.queryErrorInMinute[i] = 0
+	}
+	for i := 0; i < len(tsa.latencyInMinute); i++ {
+		tsa.latencyInMinute[i] = 0
 	}
 }
 
@@ -245,6 +301,7 @@ func (tsa *TabletStatusAggregator) resetNextSlot() {
 	defer tsa.mu.Unlock()
 	tsa.tick = (tsa.tick + 1) % 60
 	tsa.queryCountInMinute[tsa.tick] = 0
+	tsa.queryErrorInMinute[tsa.tick] = 0
 	tsa.latencyInMinute[tsa.tick] = time.Duration(0)
 }
 
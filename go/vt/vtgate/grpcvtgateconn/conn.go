@@ -291,7 +291,11 @@ func (a *streamExecuteAdapter) Recv() (*sqltypes.Result, error) {
 	return sqltypes.CustomProto3ToResult(a.fields, qr), nil
 }
 
-func (conn *vtgateConn) StreamExecute(ctx context.Context, query string, bindVars map[string]interface{}, keyspace string, tabletType topodatapb.TabletType, options *querypb.ExecuteOptions) (sqltypes.ResultStream, error) {
+func (conn *vtgateConn) StreamExecute(ctx context.Context, query string, bindVars map[string]interface{}, keyspace string, tabletType topodatapb.TabletType, session interface{}, options *querypb.ExecuteOptions) (sqltypes.ResultStream, error) {
+	var s *vtgatepb.Session
+	if session != nil {
+		s = session.(*vtgatepb.Session)
+	}
 	q, err := querytypes.BoundQueryToProto3(query, bindVars)
 	if err != nil {
 		return nil, err
@@ -301,6 +305,7 @@ func (conn *vtgateConn) StreamExecute(ctx context.Context, query string, bindVar
 		Query:      q,
 		Keyspace:   keyspace,
 		TabletType: tabletType,
+		Session:    s,
 		Options:    options,
 	}
 	stream, err := conn.c.StreamExecute(ctx, req)
@@ -402,10 +407,15 @@ func (conn *vtgateConn) StreamExecuteKeyspaceIds(ctx context.Context, query stri
 	}, nil
 }
 
-func (conn *vtgateConn) Begin(ctx context.Context, singledb bool) (interface{}, error) {
+func (conn *vtgateConn) Begin(ctx context.Context, singledb bool, session interface{}) (interface{}, error) {
+	var s *vtgatepb.Session
+	if session != nil {
+		s = session.(*vtgatepb.Session)
+	}
 	request := &vtgatepb.BeginRequest{
 		CallerId: callerid.EffectiveCallerIDFromContext(ctx),
 		SingleDb: singledb,
+		Session:  s,
 	}
 	response, err := conn.c.Begin(ctx, request)
 	if err != nil {
@@ -414,14 +424,17 @@ func (conn *vtgateConn) Begin(ctx context.Context, singledb bool) (interface{},
 	return response.Session, nil
 }
 
-func (conn *vtgateConn) Commit(ctx context.Context, session interface{}, twopc bool) error {
+func (conn *vtgateConn) Commit(ctx context.Context, session interface{}, twopc bool) (interface{}, error) {
 	request := &vtgatepb.CommitRequest{
 		CallerId: callerid.EffectiveCallerIDFromContext(ctx),
 		Session:  session.(*vtgatepb.Session),
 		Atomic:   twopc,
 	}
-	_, err := conn.c.Commit(ctx, request)
-	return vterrors.FromGRPCError(err)
+	response, err := conn.c.Commit(ctx, request)
+	if err != nil {
+		return nil, vterrors.FromGRPCError(err)
+	}
+	return response.Session, nil
 }
 
 func (conn *vtgateConn) Rollback(ctx context.Context, session interface{}) error {
@@ -267,6 +267,7 @@ func (vtg *VTGate) StreamExecute(request *vtgatepb.StreamExecuteRequest, stream
 		bv,
 		request.Keyspace,
 		request.TabletType,
+		request.Session,
 		request.Options,
 		func(value *sqltypes.Result) error {
 			return stream.Send(&vtgatepb.StreamExecuteResponse{
@@ -351,7 +352,7 @@ func (vtg *VTGate) StreamExecuteKeyRanges(request *vtgatepb.StreamExecuteKeyRang
 func (vtg *VTGate) Begin(ctx context.Context, request *vtgatepb.BeginRequest) (response *vtgatepb.BeginResponse, err error) {
 	defer vtg.server.HandlePanic(&err)
 	ctx = withCallerIDContext(ctx, request.CallerId)
-	session, vtgErr := vtg.server.Begin(ctx, request.SingleDb)
+	session, vtgErr := vtg.server.Begin(ctx, request.SingleDb, request.Session)
 	if vtgErr == nil {
 		return &vtgatepb.BeginResponse{
 			Session: session,
@@ -365,7 +366,9 @@ func (vtg *VTGate) Commit(ctx context.Context, request *vtgatepb.CommitRequest)
 	defer vtg.server.HandlePanic(&err)
 	ctx = withCallerIDContext(ctx, request.CallerId)
 	vtgErr := vtg.server.Commit(ctx, request.Atomic, request.Session)
-	response = &vtgatepb.CommitResponse{}
+	response = &vtgatepb.CommitResponse{
+		Session: request.Session,
+	}
 	if vtgErr == nil {
 		return response, nil
 	}
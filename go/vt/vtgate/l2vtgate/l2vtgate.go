@@ -104,7 +104,7 @@ func (l *L2VTGate) Begin(ctx context.Context, target *querypb.Target) (int64, er
 }
 
 // Commit is part of the queryservice.QueryService interface
-func (l *L2VTGate) Commit(ctx context.Context, target *querypb.Target, transactionID int64) error {
+func (l *L2VTGate) Commit(ctx context.Context, target *querypb.Target, transactionID int64) (string, error) {
 	return l.gateway.Commit(ctx, target, transactionID)
 }
 
@@ -119,7 +119,7 @@ func (l *L2VTGate) Prepare(ctx context.Context, target *querypb.Target, transact
 }
 
 // CommitPrepared is part of the queryservice.QueryService interface
-func (l *L2VTGate) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) (err error) {
+func (l *L2VTGate) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) (string, error) {
 	return l.gateway.CommitPrepared(ctx, target, dtid)
 }
 
@@ -134,7 +134,7 @@ func (l *L2VTGate) CreateTransaction(ctx context.Context, target *querypb.Target
 }
 
 // StartCommit is part of the queryservice.QueryService interface
-func (l *L2VTGate) StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (err error) {
+func (l *L2VTGate) StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (string, error) {
 	return l.gateway.StartCommit(ctx, target, transactionID, dtid)
 }
 
@@ -97,6 +97,8 @@ func Build(query string, vschema VSchema) (*engine.Plan, error) {
 		plan.Instructions, err = buildUpdatePlan(statement, vschema)
 	case *sqlparser.Delete:
 		plan.Instructions, err = buildDeletePlan(statement, vschema)
+	case *sqlparser.Savepoint:
+		plan.Instructions = &engine.Savepoint{Action: statement.Action, Name: statement.Name.String()}
 	case *sqlparser.Union, *sqlparser.Set, *sqlparser.DDL, *sqlparser.Other:
 		return nil, errors.New("unsupported construct")
 	default:
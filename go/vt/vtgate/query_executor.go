@@ -61,3 +61,7 @@ func (vc *queryExecutor) StreamExecuteRoute(route *engine.Route, joinvars map[st
 func (vc *queryExecutor) GetRouteFields(route *engine.Route, joinvars map[string]interface{}) (*sqltypes.Result, error) {
 	return vc.router.GetRouteFields(vc, route, joinvars)
 }
+
+func (vc *queryExecutor) ExecuteSavepoint(savepoint *engine.Savepoint) (*sqltypes.Result, error) {
+	return vc.router.ExecuteSavepoint(vc, savepoint)
+}
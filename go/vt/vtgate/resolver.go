@@ -345,6 +345,7 @@ func (res *Resolver) streamExecute(
 	if err != nil {
 		return err
 	}
+	// The streaming queries of the V2 API have no session.
 	err = res.scatterConn.StreamExecute(
 		ctx,
 		sql,
@@ -352,6 +353,7 @@ func (res *Resolver) streamExecute(
 		keyspace,
 		shards,
 		tabletType,
+		nil, /* session */
 		options,
 		sendReply)
 	return err
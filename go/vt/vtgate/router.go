@@ -72,12 +72,13 @@ func (rtr *Router) Execute(ctx context.Context, sql string, bindVars map[string]
 	return plan.Instructions.Execute(vcursor, make(map[string]interface{}), true)
 }
 
-// StreamExecute executes a streaming query.
-func (rtr *Router) StreamExecute(ctx context.Context, sql string, bindVars map[string]interface{}, keyspace string, tabletType topodatapb.TabletType, options *querypb.ExecuteOptions, sendReply func(*sqltypes.Result) error) error {
+// StreamExecute executes a streaming query. The session is only used to
+// read the writes of its previous commits.
+func (rtr *Router) StreamExecute(ctx context.Context, sql string, bindVars map[string]interface{}, keyspace string, tabletType topodatapb.TabletType, session *vtgatepb.Session, options *querypb.ExecuteOptions, sendReply func(*sqltypes.Result) error) error {
 	if bindVars == nil {
 		bindVars = make(map[string]interface{})
 	}
-	vcursor := newQueryExecutor(ctx, sql, bindVars, keyspace, tabletType, nil, false, options, rtr)
+	vcursor := newQueryExecutor(ctx, sql, bindVars, keyspace, tabletType, session, false, options, rtr)
 	plan, err := rtr.planner.GetPlan(sql, keyspace)
 	if err != nil {
 		return err
@@ -192,6 +193,14 @@ func (rtr *Router) GetRouteFields(vcursor *queryExecutor, route *engine.Route, j
 	)
 }
 
+// ExecuteSavepoint applies the savepoint to the current transaction.
+func (rtr *Router) ExecuteSavepoint(vcursor *queryExecutor, savepoint *engine.Savepoint) (*sqltypes.Result, error) {
+	if err := rtr.scatterConn.txConn.Savepoint(vcursor.ctx, NewSafeSession(vcursor.session), savepoint.Action, savepoint.Name); err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{}, nil
+}
+
 // StreamExecuteRoute performs a streaming route. Only selects are allowed.
 func (rtr *Router) StreamExecuteRoute(vcursor *queryExecutor, route *engine.Route, joinvars map[string]interface{}, sendReply func(*sqltypes.Result) error) error {
 	saved := copyBindVars(vcursor.bindVars)
@@ -223,6 +232,7 @@ func (rtr *Router) StreamExecuteRoute(vcursor *queryExecutor, route *engine.Rout
 		params.ks,
 		params.shardVars,
 		vcursor.tabletType,
+		NewSafeSession(vcursor.session),
 		vcursor.options,
 		sendReply,
 	)
@@ -196,7 +196,7 @@ func routerExec(router *Router, sql string, bv map[string]interface{}) (*sqltype
 
 func routerStream(router *Router, sql string) (qr *sqltypes.Result, err error) {
 	results := make(chan *sqltypes.Result, 10)
-	err = router.StreamExecute(context.Background(), sql, nil, "", topodatapb.TabletType_MASTER, nil, func(qr *sqltypes.Result) error {
+	err = router.StreamExecute(context.Background(), sql, nil, "", topodatapb.TabletType_MASTER, nil, nil, func(qr *sqltypes.Result) error {
 		results <- qr
 		return nil
 	})
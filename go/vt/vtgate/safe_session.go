@@ -6,6 +6,7 @@ package vtgate
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/youtube/vitess/go/vt/vterrors"
@@ -89,7 +90,60 @@ func (session *SafeSession) MustRollback() bool {
 	return session.mustRollback
 }
 
-// Reset clears the session
+// ReadYourWrites returns true if the reads of the session must see
+// its previous commits.
+func (session *SafeSession) ReadYourWrites() bool {
+	if session == nil || session.Session == nil {
+		return false
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.Session.ReadYourWrites
+}
+
+// Position returns the replication position of the last commit of
+// the session on a shard, or "" if there is none.
+func (session *SafeSession) Position(keyspace, shard string) string {
+	if session == nil || session.Session == nil {
+		return ""
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	for _, p := range session.Positions {
+		if p.Keyspace == keyspace && p.Shard == shard {
+			return p.Position
+		}
+	}
+	return ""
+}
+
+// SetPosition records the replication position of the last commit of
+// the session on a shard. The call is a no-op if the session doesn't
+// read its own writes.
+func (session *SafeSession) SetPosition(keyspace, shard, position string) {
+	if session == nil || session.Session == nil || position == "" {
+		return
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if !session.Session.ReadYourWrites {
+		return
+	}
+	for _, p := range session.Positions {
+		if p.Keyspace == keyspace && p.Shard == shard {
+			p.Position = position
+			return
+		}
+	}
+	session.Positions = append(session.Positions, &vtgatepb.Session_ShardPosition{
+		Keyspace: keyspace,
+		Shard:    shard,
+		Position: position,
+	})
+}
+
+// Reset clears the session. ReadYourWrites and Positions are kept,
+// they outlive transactions.
 func (session *SafeSession) Reset() {
 	if session == nil || session.Session == nil {
 		return
@@ -98,4 +152,69 @@ func (session *SafeSession) Reset() {
 	defer session.mu.Unlock()
 	session.Session.InTransaction = false
 	session.ShardSessions = nil
+	session.Savepoints = nil
+}
+
+// HasSavepoint returns true if the named savepoint is active.
+// Savepoint names are case-insensitive.
+func (session *SafeSession) HasSavepoint(name string) bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.findSavepoint(name) != -1
+}
+
+// GetSavepoints returns a copy of the active savepoint names,
+// in the order they were set.
+func (session *SafeSession) GetSavepoints() []string {
+	if session == nil || session.Session == nil {
+		return nil
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if len(session.Savepoints) == 0 {
+		return nil
+	}
+	return append([]string(nil), session.Savepoints...)
+}
+
+// SetSavepoint adds the named savepoint. Like in MySQL, an
+// existing savepoint with the same name is replaced.
+func (session *SafeSession) SetSavepoint(name string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if i := session.findSavepoint(name); i != -1 {
+		session.Savepoints = append(session.Savepoints[:i], session.Savepoints[i+1:]...)
+	}
+	session.Savepoints = append(session.Savepoints, name)
+}
+
+// RollbackToSavepoint removes all the savepoints that were set
+// after the named one. The named savepoint remains active.
+func (session *SafeSession) RollbackToSavepoint(name string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if i := session.findSavepoint(name); i != -1 {
+		session.Savepoints = session.Savepoints[:i+1]
+	}
+}
+
+// ReleaseSavepoint removes the named savepoint and all the
+// savepoints that were set after it.
+func (session *SafeSession) ReleaseSavepoint(name string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if i := session.findSavepoint(name); i != -1 {
+		session.Savepoints = session.Savepoints[:i]
+	}
+}
+
+// findSavepoint returns the index of the named savepoint, or -1.
+// The caller must hold the lock.
+func (session *SafeSession) findSavepoint(name string) int {
+	for i, savepoint := range session.Savepoints {
+		if strings.EqualFold(savepoint, name) {
+			return i
+		}
+	}
+	return -1
 }
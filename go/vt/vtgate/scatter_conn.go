@@ -5,9 +5,11 @@
 package vtgate
 
 import (
+	"flag"
 	"fmt"
 	"io"
 	"math/rand"
+	"strings"
 	"sync"
 	"time"
 
@@ -27,6 +29,8 @@ import (
 	vtrpcpb "github.com/youtube/vitess/go/vt/proto/vtrpc"
 )
 
+var streamOverLimitResults = flag.Bool("stream_over_limit_results", false, "if set, vtgate retries the non-transactional queries that vttablet rejects because their results are too large as streaming queries, and returns the whole result")
+
 // ScatterConn is used for executing queries across
 // multiple shard level connections.
 type ScatterConn struct {
@@ -116,21 +120,24 @@ func (stc *ScatterConn) Execute(
 		session,
 		notInTransaction,
 		func(target *querypb.Target, shouldBegin bool, transactionID int64) (int64, error) {
+			opts := readYourWritesOptions(session, target, options)
 			var innerqr *sqltypes.Result
 			if shouldBegin {
 				var err error
-				innerqr, transactionID, err = stc.gateway.BeginExecute(ctx, target, query, bindVars, options)
+				innerqr, transactionID, err = stc.gateway.BeginExecute(ctx, target, query, bindVars, opts)
 				if err != nil {
 					return transactionID, err
 				}
 			} else {
 				var err error
-				innerqr, err = stc.gateway.Execute(ctx, target, query, bindVars, transactionID, options)
+				innerqr, err = stc.execute(ctx, target, query, bindVars, transactionID, opts)
 				if err != nil {
 					return transactionID, err
 				}
 			}
 
+			recordPosition(session, target, innerqr)
+
 			mu.Lock()
 			defer mu.Unlock()
 			qr.AppendResult(innerqr)
@@ -139,6 +146,43 @@ func (stc *ScatterConn) Execute(
 	return qr, err
 }
 
+// execute runs a non-streaming query on one shard. If the tablet
+// rejects it because its result is too large, and the query is not
+// part of a transaction, it's retried as a streaming query if
+// -stream_over_limit_results is set.
+func (stc *ScatterConn) execute(ctx context.Context, target *querypb.Target, query string, bindVars map[string]interface{}, transactionID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, error) {
+	qr, err := stc.gateway.Execute(ctx, target, query, bindVars, transactionID, options)
+	if err == nil || !*streamOverLimitResults || transactionID != 0 || !isOverLimitResult(err) {
+		return qr, err
+	}
+	stream, err := stc.gateway.StreamExecute(ctx, target, query, bindVars, options)
+	if err != nil {
+		return nil, err
+	}
+	qr = &sqltypes.Result{}
+	for {
+		r, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if r.Fields != nil {
+			qr.Fields = r.Fields
+		}
+		qr.Rows = append(qr.Rows, r.Rows...)
+	}
+	qr.RowsAffected = uint64(len(qr.Rows))
+	return qr, nil
+}
+
+// isOverLimitResult returns true if vttablet rejected a query because
+// its result was too large to be returned by a non-streaming query.
+func isOverLimitResult(err error) bool {
+	return vterrors.RecoverVtErrorCode(err) == vtrpcpb.ErrorCode_RESOURCE_EXHAUSTED && strings.Contains(err.Error(), "use a streaming query instead")
+}
+
 // ExecuteMultiShard is like Execute,
 // but each shard gets its own Sql Queries and BindVariables.
 func (stc *ScatterConn) ExecuteMultiShard(
@@ -168,21 +212,24 @@ func (stc *ScatterConn) ExecuteMultiShard(
 		session,
 		notInTransaction,
 		func(target *querypb.Target, shouldBegin bool, transactionID int64) (int64, error) {
+			opts := readYourWritesOptions(session, target, options)
 			var innerqr *sqltypes.Result
 			if shouldBegin {
 				var err error
-				innerqr, transactionID, err = stc.gateway.BeginExecute(ctx, target, shardQueries[target.Shard].Sql, shardQueries[target.Shard].BindVariables, options)
+				innerqr, transactionID, err = stc.gateway.BeginExecute(ctx, target, shardQueries[target.Shard].Sql, shardQueries[target.Shard].BindVariables, opts)
 				if err != nil {
 					return transactionID, err
 				}
 			} else {
 				var err error
-				innerqr, err = stc.gateway.Execute(ctx, target, shardQueries[target.Shard].Sql, shardQueries[target.Shard].BindVariables, transactionID, options)
+				innerqr, err = stc.execute(ctx, target, shardQueries[target.Shard].Sql, shardQueries[target.Shard].BindVariables, transactionID, opts)
 				if err != nil {
 					return transactionID, err
 				}
 			}
 
+			recordPosition(session, target, innerqr)
+
 			mu.Lock()
 			defer mu.Unlock()
 			qr.AppendResult(innerqr)
@@ -219,22 +266,25 @@ func (stc *ScatterConn) ExecuteEntityIds(
 		func(target *querypb.Target, shouldBegin bool, transactionID int64) (int64, error) {
 			sql := sqls[target.Shard]
 			bindVar := bindVars[target.Shard]
+			opts := readYourWritesOptions(session, target, options)
 			var innerqr *sqltypes.Result
 
 			if shouldBegin {
 				var err error
-				innerqr, transactionID, err = stc.gateway.BeginExecute(ctx, target, sql, bindVar, options)
+				innerqr, transactionID, err = stc.gateway.BeginExecute(ctx, target, sql, bindVar, opts)
 				if err != nil {
 					return transactionID, err
 				}
 			} else {
 				var err error
-				innerqr, err = stc.gateway.Execute(ctx, target, sql, bindVar, transactionID, options)
+				innerqr, err = stc.execute(ctx, target, sql, bindVar, transactionID, opts)
 				if err != nil {
 					return transactionID, err
 				}
 			}
 
+			recordPosition(session, target, innerqr)
+
 			mu.Lock()
 			defer mu.Unlock()
 			qr.AppendResult(innerqr)
@@ -289,9 +339,16 @@ func (stc *ScatterConn) ExecuteBatch(
 			defer stc.endAction(startTime, allErrors, statsKey, &err, session)
 
 			shouldBegin, transactionID := transactionInfo(target, session, false)
+			if shouldBegin && len(session.GetSavepoints()) != 0 {
+				if transactionID, err = stc.txConn.beginWithSavepoints(ctx, target, session); err != nil {
+					return
+				}
+				shouldBegin = false
+			}
+			opts := readYourWritesOptions(session, target, options)
 			var innerqrs []sqltypes.Result
 			if shouldBegin {
-				innerqrs, transactionID, err = stc.gateway.BeginExecuteBatch(ctx, target, req.Queries, asTransaction, options)
+				innerqrs, transactionID, err = stc.gateway.BeginExecuteBatch(ctx, target, req.Queries, asTransaction, opts)
 				if transactionID != 0 {
 					if appendErr := session.Append(&vtgatepb.Session_ShardSession{
 						Target:        target,
@@ -304,12 +361,16 @@ func (stc *ScatterConn) ExecuteBatch(
 					return
 				}
 			} else {
-				innerqrs, err = stc.gateway.ExecuteBatch(ctx, target, req.Queries, asTransaction, transactionID, options)
+				innerqrs, err = stc.gateway.ExecuteBatch(ctx, target, req.Queries, asTransaction, transactionID, opts)
 				if err != nil {
 					return
 				}
 			}
 
+			for i := range innerqrs {
+				recordPosition(session, target, &innerqrs[i])
+			}
+
 			resMutex.Lock()
 			defer resMutex.Unlock()
 			for i, result := range innerqrs {
@@ -328,6 +389,42 @@ func (stc *ScatterConn) ExecuteBatch(
 	return results, nil
 }
 
+// readYourWritesOptions returns the options to use for a query sent to
+// target, for a session that reads its own writes: the master returns
+// the replication position of the writes, and the other tablets wait
+// until they have replicated the last commit of the session on the
+// shard. The options are returned unchanged otherwise.
+func readYourWritesOptions(session *SafeSession, target *querypb.Target, options *querypb.ExecuteOptions) *querypb.ExecuteOptions {
+	if !session.ReadYourWrites() {
+		return options
+	}
+	var position string
+	if target.TabletType != topodatapb.TabletType_MASTER {
+		position = session.Position(target.Keyspace, target.Shard)
+		if position == "" {
+			return options
+		}
+	}
+	result := &querypb.ExecuteOptions{}
+	if options != nil {
+		*result = *options
+	}
+	if target.TabletType == topodatapb.TabletType_MASTER {
+		result.IncludePosition = true
+	} else {
+		result.WaitForPosition = position
+	}
+	return result
+}
+
+// recordPosition records in the session the replication position
+// returned with the result of an autocommitted write.
+func recordPosition(session *SafeSession, target *querypb.Target, qr *sqltypes.Result) {
+	if qr.Extras != nil && qr.Extras.Position != "" {
+		session.SetPosition(target.Keyspace, target.Shard, qr.Extras.Position)
+	}
+}
+
 func (stc *ScatterConn) processOneStreamingResult(mu *sync.Mutex, stream sqltypes.ResultStream, err error, replyErr *error, fieldSent *bool, sendReply func(reply *sqltypes.Result) error) error {
 	if err != nil {
 		return err
@@ -367,6 +464,7 @@ func (stc *ScatterConn) processOneStreamingResult(mu *sync.Mutex, stream sqltype
 }
 
 // StreamExecute executes a streaming query on vttablet. The retry rules are the same.
+// The session is only used to read the writes of its previous commits.
 func (stc *ScatterConn) StreamExecute(
 	ctx context.Context,
 	query string,
@@ -374,6 +472,7 @@ func (stc *ScatterConn) StreamExecute(
 	keyspace string,
 	shards []string,
 	tabletType topodatapb.TabletType,
+	session *SafeSession,
 	options *querypb.ExecuteOptions,
 	sendReply func(reply *sqltypes.Result) error,
 ) error {
@@ -390,7 +489,7 @@ func (stc *ScatterConn) StreamExecute(
 		shards,
 		tabletType,
 		func(target *querypb.Target) error {
-			stream, err := stc.gateway.StreamExecute(ctx, target, query, bindVars, options)
+			stream, err := stc.gateway.StreamExecute(ctx, target, query, bindVars, readYourWritesOptions(session, target, options))
 			return stc.processOneStreamingResult(&mu, stream, err, &replyErr, &fieldSent, sendReply)
 		})
 	if replyErr != nil {
@@ -408,6 +507,7 @@ func (stc *ScatterConn) StreamExecuteMulti(
 	keyspace string,
 	shardVars map[string]map[string]interface{},
 	tabletType topodatapb.TabletType,
+	session *SafeSession,
 	options *querypb.ExecuteOptions,
 	sendReply func(reply *sqltypes.Result) error,
 ) error {
@@ -423,7 +523,7 @@ func (stc *ScatterConn) StreamExecuteMulti(
 		getShards(shardVars),
 		tabletType,
 		func(target *querypb.Target) error {
-			stream, err := stc.gateway.StreamExecute(ctx, target, query, shardVars[target.Shard], options)
+			stream, err := stc.gateway.StreamExecute(ctx, target, query, shardVars[target.Shard], readYourWritesOptions(session, target, options))
 			return stc.processOneStreamingResult(&mu, stream, err, &replyErr, &fieldSent, sendReply)
 		})
 	if replyErr != nil {
@@ -690,6 +790,14 @@ func (stc *ScatterConn) multiGoTransaction(
 		defer stc.endAction(startTime, allErrors, statsKey, &err, session)
 
 		shouldBegin, transactionID := transactionInfo(target, session, notInTransaction)
+		if shouldBegin && len(session.GetSavepoints()) != 0 {
+			// The savepoints of the session must be set before the action
+			// runs, so the transaction can't be started by the action.
+			if transactionID, err = stc.txConn.beginWithSavepoints(ctx, target, session); err != nil {
+				return
+			}
+			shouldBegin = false
+		}
 		transactionID, err = action(target, shouldBegin, transactionID)
 		if shouldBegin && transactionID != 0 {
 			if appendErr := session.Append(&vtgatepb.Session_ShardSession{
@@ -12,6 +12,7 @@ import (
 
 	"github.com/youtube/vitess/go/sqltypes"
 	"github.com/youtube/vitess/go/vt/discovery"
+	"github.com/youtube/vitess/go/vt/tabletserver/sandboxconn"
 	"github.com/youtube/vitess/go/vt/tabletserver/tabletconn"
 	"github.com/youtube/vitess/go/vt/topo"
 	"github.com/youtube/vitess/go/vt/vterrors"
@@ -73,7 +74,7 @@ func TestScatterConnExecuteBatch(t *testing.T) {
 func TestScatterConnStreamExecute(t *testing.T) {
 	testScatterConnGeneric(t, "TestScatterConnStreamExecute", func(sc *ScatterConn, shards []string) (*sqltypes.Result, error) {
 		qr := new(sqltypes.Result)
-		err := sc.StreamExecute(context.Background(), "query", nil, "TestScatterConnStreamExecute", shards, topodatapb.TabletType_REPLICA, nil, func(r *sqltypes.Result) error {
+		err := sc.StreamExecute(context.Background(), "query", nil, "TestScatterConnStreamExecute", shards, topodatapb.TabletType_REPLICA, nil, nil, func(r *sqltypes.Result) error {
 			qr.AppendResult(r)
 			return nil
 		})
@@ -88,7 +89,7 @@ func TestScatterConnStreamExecuteMulti(t *testing.T) {
 		for _, shard := range shards {
 			shardVars[shard] = nil
 		}
-		err := sc.StreamExecuteMulti(context.Background(), "query", "TestScatterConnStreamExecuteMulti", shardVars, topodatapb.TabletType_REPLICA, nil, func(r *sqltypes.Result) error {
+		err := sc.StreamExecuteMulti(context.Background(), "query", "TestScatterConnStreamExecuteMulti", shardVars, topodatapb.TabletType_REPLICA, nil, nil, func(r *sqltypes.Result) error {
 			qr.AppendResult(r)
 			return nil
 		})
@@ -252,7 +253,7 @@ func TestMultiExecs(t *testing.T) {
 	}
 	sbc0.Queries = nil
 	sbc1.Queries = nil
-	_ = sc.StreamExecuteMulti(context.Background(), "query", "TestMultiExecs", shardVars, topodatapb.TabletType_REPLICA, nil, func(*sqltypes.Result) error {
+	_ = sc.StreamExecuteMulti(context.Background(), "query", "TestMultiExecs", shardVars, topodatapb.TabletType_REPLICA, nil, nil, func(*sqltypes.Result) error {
 		return nil
 	})
 	if !reflect.DeepEqual(sbc0.Queries[0].BindVariables, shardVars["0"]) {
@@ -268,7 +269,7 @@ func TestScatterConnStreamExecuteSendError(t *testing.T) {
 	hc := discovery.NewFakeHealthCheck()
 	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
 	hc.AddTestTablet("aa", "0", 1, "TestScatterConnStreamExecuteSendError", "0", topodatapb.TabletType_REPLICA, true, 1, nil)
-	err := sc.StreamExecute(context.Background(), "query", nil, "TestScatterConnStreamExecuteSendError", []string{"0"}, topodatapb.TabletType_REPLICA, nil, func(*sqltypes.Result) error {
+	err := sc.StreamExecute(context.Background(), "query", nil, "TestScatterConnStreamExecuteSendError", []string{"0"}, topodatapb.TabletType_REPLICA, nil, nil, func(*sqltypes.Result) error {
 		return fmt.Errorf("send error")
 	})
 	want := "send error"
@@ -278,6 +279,51 @@ func TestScatterConnStreamExecuteSendError(t *testing.T) {
 	}
 }
 
+func TestScatterConnStreamOverLimitResults(t *testing.T) {
+	createSandbox("TestScatterConnStreamOverLimitResults")
+	hc := discovery.NewFakeHealthCheck()
+	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
+	sbc := hc.AddTestTablet("aa", "0", 1, "TestScatterConnStreamOverLimitResults", "0", topodatapb.TabletType_REPLICA, true, 1, nil)
+	execute := func() (*sqltypes.Result, error) {
+		return sc.Execute(context.Background(), "query", nil, "TestScatterConnStreamOverLimitResults", []string{"0"}, topodatapb.TabletType_REPLICA, NewSafeSession(nil), false, nil)
+	}
+
+	// Without the flag, the error is returned.
+	sbc.MustFailResultSize = 1
+	if _, err := execute(); err == nil || !strings.Contains(err.Error(), "use a streaming query instead") {
+		t.Errorf("Execute returned %v, want the result size error", err)
+	}
+
+	*streamOverLimitResults = true
+	defer func() { *streamOverLimitResults = false }()
+	sbc.MustFailResultSize = 1
+	sbc.ExecCount.Set(0)
+	qr, err := execute()
+	if err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if !reflect.DeepEqual(qr, sandboxconn.SingleRowResult) {
+		t.Errorf("Execute returned %v, want %v", qr, sandboxconn.SingleRowResult)
+	}
+	if execCount := sbc.ExecCount.Get(); execCount != 2 {
+		t.Errorf("want 2, got %v", execCount)
+	}
+
+	// Queries in a transaction are not retried.
+	session := NewSafeSession(&vtgatepb.Session{InTransaction: true})
+	if _, err := sc.Execute(context.Background(), "query", nil, "TestScatterConnStreamOverLimitResults", []string{"0"}, topodatapb.TabletType_REPLICA, session, false, nil); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	sbc.MustFailResultSize = 1
+	sbc.ExecCount.Set(0)
+	if _, err := sc.Execute(context.Background(), "query", nil, "TestScatterConnStreamOverLimitResults", []string{"0"}, topodatapb.TabletType_REPLICA, session, false, nil); err == nil {
+		t.Errorf("Execute in a transaction succeeded, want the result size error")
+	}
+	if execCount := sbc.ExecCount.Get(); execCount != 1 {
+		t.Errorf("want 1, got %v", execCount)
+	}
+}
+
 func TestScatterConnError(t *testing.T) {
 	err := &ScatterConnError{
 		Retryable: false,
@@ -15,6 +15,7 @@ import (
 
 	"github.com/youtube/vitess/go/vt/concurrency"
 	"github.com/youtube/vitess/go/vt/dtids"
+	"github.com/youtube/vitess/go/vt/sqlparser"
 	"github.com/youtube/vitess/go/vt/vterrors"
 	"github.com/youtube/vitess/go/vt/vtgate/gateway"
 
@@ -56,9 +57,12 @@ func (txc *TxConn) commitNormal(ctx context.Context, session *SafeSession) error
 			txc.gateway.Rollback(ctx, shardSession.Target, shardSession.TransactionId)
 			continue
 		}
-		if err = txc.gateway.Commit(ctx, shardSession.Target, shardSession.TransactionId); err != nil {
+		var position string
+		if position, err = txc.gateway.Commit(ctx, shardSession.Target, shardSession.TransactionId); err != nil {
 			committing = false
+			continue
 		}
+		session.SetPosition(shardSession.Target.Keyspace, shardSession.Target.Shard, position)
 	}
 	session.Reset()
 	return err
@@ -70,6 +74,10 @@ func (txc *TxConn) commit2PC(ctx context.Context, session *SafeSession) error {
 		return txc.commitNormal(ctx, session)
 	}
 
+	// The session is done with the transaction whatever the outcome:
+	// a failed 2PC commit is resolved by the watchdog.
+	defer session.Reset()
+
 	participants := make([]*querypb.Target, 0, len(session.ShardSessions)-1)
 	for _, s := range session.ShardSessions[1:] {
 		participants = append(participants, s.Target)
@@ -96,13 +104,19 @@ func (txc *TxConn) commit2PC(ctx context.Context, session *SafeSession) error {
 		return err
 	}
 
-	err = txc.gateway.StartCommit(ctx, mmShard.Target, mmShard.TransactionId, dtid)
+	position, err := txc.gateway.StartCommit(ctx, mmShard.Target, mmShard.TransactionId, dtid)
 	if err != nil {
 		return err
 	}
+	session.SetPosition(mmShard.Target.Keyspace, mmShard.Target.Shard, position)
 
 	err = txc.runSessions(session.ShardSessions[1:], func(s *vtgatepb.Session_ShardSession) error {
-		return txc.gateway.CommitPrepared(ctx, s.Target, dtid)
+		position, err := txc.gateway.CommitPrepared(ctx, s.Target, dtid)
+		if err != nil {
+			return err
+		}
+		session.SetPosition(s.Target.Keyspace, s.Target.Shard, position)
+		return nil
 	})
 	if err != nil {
 		return err
@@ -122,6 +136,63 @@ func (txc *TxConn) Rollback(ctx context.Context, session *SafeSession) error {
 	})
 }
 
+// Savepoint sets, rolls back to or releases the named savepoint, based on action,
+// on all the shards that are part of the transaction. The savepoints are recorded
+// in the session so that they can be replayed on shards that join the transaction
+// later. Rolling back to or releasing an unknown savepoint is an error.
+func (txc *TxConn) Savepoint(ctx context.Context, session *SafeSession, action, name string) error {
+	if !session.InTransaction() {
+		return vterrors.FromError(vtrpcpb.ErrorCode_NOT_IN_TX, fmt.Errorf("cannot %s: not in transaction", action))
+	}
+	if action != sqlparser.SavepointStr && !session.HasSavepoint(name) {
+		return vterrors.FromError(vtrpcpb.ErrorCode_BAD_INPUT, fmt.Errorf("savepoint %s does not exist", name))
+	}
+	query := sqlparser.String(&sqlparser.Savepoint{Action: action, Name: sqlparser.NewColIdent(name)})
+	err := txc.runSessions(session.ShardSessions, func(s *vtgatepb.Session_ShardSession) error {
+		_, err := txc.gateway.Execute(ctx, s.Target, query, nil, s.TransactionId, nil)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	switch action {
+	case sqlparser.SavepointStr:
+		session.SetSavepoint(name)
+	case sqlparser.RollbackToStr:
+		session.RollbackToSavepoint(name)
+	case sqlparser.ReleaseStr:
+		session.ReleaseSavepoint(name)
+	}
+	return nil
+}
+
+// beginWithSavepoints begins a transaction on the target and sets all the
+// savepoints of the session on it, so that the shard can be rolled back to
+// any of them just like the shards that were already part of the transaction.
+// The new shard session is appended even if the savepoints could not be set,
+// so that it gets rolled back with the rest of the transaction.
+// The transaction is started with a plain Begin: the priority comment of the
+// query, if any, doesn't select its transaction pool partition on the tablet.
+func (txc *TxConn) beginWithSavepoints(ctx context.Context, target *querypb.Target, session *SafeSession) (int64, error) {
+	transactionID, err := txc.gateway.Begin(ctx, target)
+	if err != nil {
+		return 0, err
+	}
+	if err := session.Append(&vtgatepb.Session_ShardSession{
+		Target:        target,
+		TransactionId: transactionID,
+	}); err != nil {
+		return transactionID, err
+	}
+	for _, name := range session.GetSavepoints() {
+		query := sqlparser.String(&sqlparser.Savepoint{Action: sqlparser.SavepointStr, Name: sqlparser.NewColIdent(name)})
+		if _, err := txc.gateway.Execute(ctx, target, query, nil, transactionID, nil); err != nil {
+			return transactionID, err
+		}
+	}
+	return transactionID, nil
+}
+
 // Resolve resolves the specified 2PC transaction.
 func (txc *TxConn) Resolve(ctx context.Context, dtid string) error {
 	mmShard, err := dtids.ShardSession(dtid)
@@ -172,7 +243,8 @@ func (txc *TxConn) resumeRollback(ctx context.Context, target *querypb.Target, t
 
 func (txc *TxConn) resumeCommit(ctx context.Context, target *querypb.Target, transaction *querypb.TransactionMetadata) error {
 	err := txc.runTargets(transaction.Participants, func(t *querypb.Target) error {
-		return txc.gateway.CommitPrepared(ctx, t, transaction.Dtid)
+		_, err := txc.gateway.CommitPrepared(ctx, t, transaction.Dtid)
+		return err
 	})
 	if err != nil {
 		return err
@@ -12,6 +12,7 @@ import (
 	"testing"
 
 	"github.com/youtube/vitess/go/vt/discovery"
+	"github.com/youtube/vitess/go/vt/sqlparser"
 	"github.com/youtube/vitess/go/vt/tabletserver/sandboxconn"
 	"github.com/youtube/vitess/go/vt/vterrors"
 
@@ -318,6 +319,62 @@ func TestTxConnRollback(t *testing.T) {
 	}
 }
 
+func TestTxConnSavepoint(t *testing.T) {
+	sc, sbc0, sbc1 := newTestTxConnEnv("TestTxConn")
+
+	// not in transaction
+	session := NewSafeSession(&vtgatepb.Session{})
+	err := sc.txConn.Savepoint(context.Background(), session, sqlparser.SavepointStr, "a")
+	if got := vterrors.RecoverVtErrorCode(err); got != vtrpcpb.ErrorCode_NOT_IN_TX {
+		t.Errorf("Savepoint: %v, want %v", got, vtrpcpb.ErrorCode_NOT_IN_TX)
+	}
+
+	session = NewSafeSession(&vtgatepb.Session{InTransaction: true})
+	sc.Execute(context.Background(), "query1", nil, "TestTxConn", []string{"0"}, topodatapb.TabletType_MASTER, session, false, nil)
+	if err := sc.txConn.Savepoint(context.Background(), session, sqlparser.SavepointStr, "a"); err != nil {
+		t.Error(err)
+	}
+	if err := sc.txConn.Savepoint(context.Background(), session, sqlparser.SavepointStr, "b"); err != nil {
+		t.Error(err)
+	}
+	// Shard 1 joins the transaction after the savepoints were set.
+	sc.Execute(context.Background(), "query2", nil, "TestTxConn", []string{"0", "1"}, topodatapb.TabletType_MASTER, session, false, nil)
+	if err := sc.txConn.Savepoint(context.Background(), session, sqlparser.RollbackToStr, "A"); err != nil {
+		t.Error(err)
+	}
+	if want := []string{"a"}; !reflect.DeepEqual(session.Savepoints, want) {
+		t.Errorf("Savepoints: %v, want %v", session.Savepoints, want)
+	}
+	wantQueries := []string{"query1", "savepoint a", "savepoint b", "query2", "rollback to A"}
+	if got := sandboxQueries(sbc0); !reflect.DeepEqual(got, wantQueries) {
+		t.Errorf("sbc0.Queries: %v, want %v", got, wantQueries)
+	}
+	wantQueries = []string{"savepoint a", "savepoint b", "query2", "rollback to A"}
+	if got := sandboxQueries(sbc1); !reflect.DeepEqual(got, wantQueries) {
+		t.Errorf("sbc1.Queries: %v, want %v", got, wantQueries)
+	}
+
+	// unknown savepoint
+	err = sc.txConn.Savepoint(context.Background(), session, sqlparser.ReleaseStr, "b")
+	if got := vterrors.RecoverVtErrorCode(err); got != vtrpcpb.ErrorCode_BAD_INPUT {
+		t.Errorf("Savepoint: %v, want %v", got, vtrpcpb.ErrorCode_BAD_INPUT)
+	}
+	if err := sc.txConn.Savepoint(context.Background(), session, sqlparser.ReleaseStr, "a"); err != nil {
+		t.Error(err)
+	}
+	if len(session.Savepoints) != 0 {
+		t.Errorf("Savepoints: %v, want none", session.Savepoints)
+	}
+
+	if err := sc.txConn.Rollback(context.Background(), session); err != nil {
+		t.Error(err)
+	}
+	wantSession := vtgatepb.Session{}
+	if !reflect.DeepEqual(*session.Session, wantSession) {
+		t.Errorf("Session:\n%+v, want\n%+v", *session.Session, wantSession)
+	}
+}
+
 func TestTxConnResolveOnPrepare(t *testing.T) {
 	sc, sbc0, sbc1 := newTestTxConnEnv("TestTxConn")
 
@@ -668,6 +725,14 @@ func TestTxConnMultiGoTargets(t *testing.T) {
 	}
 }
 
+func sandboxQueries(sbc *sandboxconn.SandboxConn) []string {
+	queries := make([]string, 0, len(sbc.Queries))
+	for _, q := range sbc.Queries {
+		queries = append(queries, q.Sql)
+	}
+	return queries
+}
+
 func newTestTxConnEnv(name string) (sc *ScatterConn, sbc0, sbc1 *sandboxconn.SandboxConn) {
 	createSandbox(name)
 	hc := discovery.NewFakeHealthCheck()
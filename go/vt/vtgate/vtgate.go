@@ -25,6 +25,7 @@ import (
 	"github.com/youtube/vitess/go/vt/logutil"
 	"github.com/youtube/vitess/go/vt/servenv"
 	"github.com/youtube/vitess/go/vt/sqlannotation"
+	"github.com/youtube/vitess/go/vt/sqlparser"
 	"github.com/youtube/vitess/go/vt/tabletserver/querytypes"
 	"github.com/youtube/vitess/go/vt/tabletserver/tabletconn"
 	"github.com/youtube/vitess/go/vt/topo"
@@ -231,6 +232,10 @@ func (vtg *VTGate) ExecuteShards(ctx context.Context, sql string, bindVariables
 	statsKey := []string{"ExecuteShards", keyspace, ltt}
 	defer vtg.timings.Record(statsKey, startTime)
 
+	if err := checkNoSavepoint(sql); err != nil {
+		return nil, err
+	}
+
 	sql = sqlannotation.AnnotateIfDML(sql, nil)
 
 	qr, err := vtg.resolver.Execute(
@@ -272,6 +277,10 @@ func (vtg *VTGate) ExecuteKeyspaceIds(ctx context.Context, sql string, bindVaria
 	statsKey := []string{"ExecuteKeyspaceIds", keyspace, ltt}
 	defer vtg.timings.Record(statsKey, startTime)
 
+	if err := checkNoSavepoint(sql); err != nil {
+		return nil, err
+	}
+
 	sql = sqlannotation.AnnotateIfDML(sql, keyspaceIds)
 
 	qr, err := vtg.resolver.ExecuteKeyspaceIds(ctx, sql, bindVariables, keyspace, keyspaceIds, tabletType, session, notInTransaction, options)
@@ -301,6 +310,10 @@ func (vtg *VTGate) ExecuteKeyRanges(ctx context.Context, sql string, bindVariabl
 	statsKey := []string{"ExecuteKeyRanges", keyspace, ltt}
 	defer vtg.timings.Record(statsKey, startTime)
 
+	if err := checkNoSavepoint(sql); err != nil {
+		return nil, err
+	}
+
 	sql = sqlannotation.AnnotateIfDML(sql, nil)
 
 	qr, err := vtg.resolver.ExecuteKeyRanges(ctx, sql, bindVariables, keyspace, keyRanges, tabletType, session, notInTransaction, options)
@@ -330,6 +343,10 @@ func (vtg *VTGate) ExecuteEntityIds(ctx context.Context, sql string, bindVariabl
 	statsKey := []string{"ExecuteEntityIds", keyspace, ltt}
 	defer vtg.timings.Record(statsKey, startTime)
 
+	if err := checkNoSavepoint(sql); err != nil {
+		return nil, err
+	}
+
 	sql = sqlannotation.AnnotateIfDML(sql, nil)
 
 	qr, err := vtg.resolver.ExecuteEntityIds(ctx, sql, bindVariables, keyspace, entityColumnName, entityKeyspaceIDs, tabletType, session, notInTransaction, options)
@@ -388,6 +405,12 @@ func (vtg *VTGate) ExecuteBatchShards(ctx context.Context, queries []*vtgatepb.B
 	statsKey := []string{"ExecuteBatchShards", "", ltt}
 	defer vtg.timings.Record(statsKey, startTime)
 
+	for _, q := range queries {
+		if err := checkNoSavepoint(q.Query.Sql); err != nil {
+			return nil, err
+		}
+	}
+
 	annotateBoundShardQueriesAsUnfriendly(queries)
 
 	qrs, err := vtg.resolver.ExecuteBatch(
@@ -426,6 +449,12 @@ func (vtg *VTGate) ExecuteBatchKeyspaceIds(ctx context.Context, queries []*vtgat
 	statsKey := []string{"ExecuteBatchKeyspaceIds", "", ltt}
 	defer vtg.timings.Record(statsKey, startTime)
 
+	for _, q := range queries {
+		if err := checkNoSavepoint(q.Query.Sql); err != nil {
+			return nil, err
+		}
+	}
+
 	annotateBoundKeyspaceIDQueries(queries)
 
 	qrs, err := vtg.resolver.ExecuteBatchKeyspaceIds(
@@ -456,7 +485,8 @@ func (vtg *VTGate) ExecuteBatchKeyspaceIds(ctx context.Context, queries []*vtgat
 }
 
 // StreamExecute executes a streaming query by routing based on the values in the query.
-func (vtg *VTGate) StreamExecute(ctx context.Context, sql string, bindVariables map[string]interface{}, keyspace string, tabletType topodatapb.TabletType, options *querypb.ExecuteOptions, sendReply func(*sqltypes.Result) error) error {
+// The session is only used to read the writes of its previous commits.
+func (vtg *VTGate) StreamExecute(ctx context.Context, sql string, bindVariables map[string]interface{}, keyspace string, tabletType topodatapb.TabletType, session *vtgatepb.Session, options *querypb.ExecuteOptions, sendReply func(*sqltypes.Result) error) error {
 	startTime := time.Now()
 	ltt := topoproto.TabletTypeLString(tabletType)
 	statsKey := []string{"StreamExecute", "Any", ltt}
@@ -468,6 +498,7 @@ func (vtg *VTGate) StreamExecute(ctx context.Context, sql string, bindVariables
 		bindVariables,
 		keyspace,
 		tabletType,
+		session,
 		options,
 		func(reply *sqltypes.Result) error {
 			vtg.rowsReturned.Add(statsKey, int64(len(reply.Rows)))
@@ -606,14 +637,20 @@ func (vtg *VTGate) StreamExecuteShards(ctx context.Context, sql string, bindVari
 }
 
 // Begin begins a transaction. It has to be concluded by a Commit or Rollback.
-func (vtg *VTGate) Begin(ctx context.Context, singledb bool) (*vtgatepb.Session, error) {
+// The read-your-writes state of the previous session, if any, is carried over.
+func (vtg *VTGate) Begin(ctx context.Context, singledb bool, session *vtgatepb.Session) (*vtgatepb.Session, error) {
 	if !singledb && vtg.transactionMode == TxSingle {
 		return nil, vterrors.FromError(vtrpcpb.ErrorCode_BAD_INPUT, errors.New("multi-db transaction disallowed"))
 	}
-	return &vtgatepb.Session{
+	newSession := &vtgatepb.Session{
 		InTransaction: true,
 		SingleDb:      singledb,
-	}, nil
+	}
+	if session != nil {
+		newSession.ReadYourWrites = session.ReadYourWrites
+		newSession.Positions = session.Positions
+	}
+	return newSession, nil
 }
 
 // Commit commits a transaction.
@@ -886,6 +923,17 @@ func isErrorCausedByVTGate(err error) bool {
 	return false
 }
 
+// checkNoSavepoint returns an error if the query is a savepoint
+// statement. Savepoints are only supported by Execute, which records
+// them in the session so they can be replayed on the shards that join
+// the transaction later.
+func checkNoSavepoint(sql string) error {
+	if sqlparser.IsSavepoint(sql) {
+		return vterrors.FromError(vtrpcpb.ErrorCode_BAD_INPUT, errors.New("savepoints are only supported by Execute"))
+	}
+	return nil
+}
+
 func handleExecuteError(err error, statsKey []string, query map[string]interface{}, logger *logutil.ThrottledLogger) error {
 	// First we log in the right category.
 	ec := vterrors.RecoverVtErrorCode(err)
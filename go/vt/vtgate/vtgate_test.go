@@ -7,10 +7,12 @@ package vtgate
 import (
 	"encoding/hex"
 	"fmt"
+	"io"
 	"math"
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/youtube/vitess/go/sqltypes"
@@ -22,6 +24,7 @@ import (
 	"github.com/youtube/vitess/go/vt/topo"
 	"github.com/youtube/vitess/go/vt/vterrors"
 	"github.com/youtube/vitess/go/vt/vtgate/gateway"
+	"github.com/youtube/vitess/go/vt/vtgate/vtgateconn"
 	"golang.org/x/net/context"
 
 	querypb "github.com/youtube/vitess/go/vt/proto/query"
@@ -58,7 +61,7 @@ func TestVTGateBegin(t *testing.T) {
 	}()
 
 	rpcVTGate.transactionMode = TxSingle
-	got, err := rpcVTGate.Begin(context.Background(), true)
+	got, err := rpcVTGate.Begin(context.Background(), true, nil)
 	if err != nil {
 		t.Error(err)
 	}
@@ -70,14 +73,14 @@ func TestVTGateBegin(t *testing.T) {
 		t.Errorf("Begin(single): %v, want %v", got, wantSession)
 	}
 
-	_, err = rpcVTGate.Begin(context.Background(), false)
+	_, err = rpcVTGate.Begin(context.Background(), false, nil)
 	wantErr := "multi-db transaction disallowed"
 	if err == nil || err.Error() != wantErr {
 		t.Errorf("Begin(multi): %v, want %s", err, wantErr)
 	}
 
 	rpcVTGate.transactionMode = TxMulti
-	got, err = rpcVTGate.Begin(context.Background(), true)
+	got, err = rpcVTGate.Begin(context.Background(), true, nil)
 	if err != nil {
 		t.Error(err)
 	}
@@ -89,7 +92,7 @@ func TestVTGateBegin(t *testing.T) {
 		t.Errorf("Begin(single): %v, want %v", got, wantSession)
 	}
 
-	got, err = rpcVTGate.Begin(context.Background(), false)
+	got, err = rpcVTGate.Begin(context.Background(), false, nil)
 	if err != nil {
 		t.Error(err)
 	}
@@ -101,7 +104,7 @@ func TestVTGateBegin(t *testing.T) {
 	}
 
 	rpcVTGate.transactionMode = TxTwoPC
-	got, err = rpcVTGate.Begin(context.Background(), true)
+	got, err = rpcVTGate.Begin(context.Background(), true, nil)
 	if err != nil {
 		t.Error(err)
 	}
@@ -113,7 +116,7 @@ func TestVTGateBegin(t *testing.T) {
 		t.Errorf("Begin(single): %v, want %v", got, wantSession)
 	}
 
-	got, err = rpcVTGate.Begin(context.Background(), false)
+	got, err = rpcVTGate.Begin(context.Background(), false, nil)
 	if err != nil {
 		t.Error(err)
 	}
@@ -214,7 +217,7 @@ func TestVTGateExecute(t *testing.T) {
 		t.Errorf("got ExecuteOptions \n%+v, want \n%+v", sbc.Options[0], executeOptions)
 	}
 
-	session, err := rpcVTGate.Begin(context.Background(), false)
+	session, err := rpcVTGate.Begin(context.Background(), false, nil)
 	if !session.InTransaction {
 		t.Errorf("want true, got false")
 	}
@@ -246,7 +249,7 @@ func TestVTGateExecute(t *testing.T) {
 		t.Errorf("want 1, got %d", commitCount)
 	}
 
-	session, err = rpcVTGate.Begin(context.Background(), false)
+	session, err = rpcVTGate.Begin(context.Background(), false, nil)
 	rpcVTGate.Execute(context.Background(),
 		"select id from t1",
 		nil,
@@ -293,6 +296,33 @@ func TestVTGateExecuteWithKeyspace(t *testing.T) {
 	}
 }
 
+func TestVTGateRejectsSavepoints(t *testing.T) {
+	ks := "TestVTGateRejectsSavepoints"
+	createSandbox(ks)
+	hcVTGateTest.Reset()
+	sbc := hcVTGateTest.AddTestTablet("aa", "1.1.1.1", 1001, ks, "0", topodatapb.TabletType_MASTER, true, 1, nil)
+	session, err := rpcVTGate.Begin(context.Background(), false, nil)
+	if err != nil {
+		t.Fatalf("Begin failed: %v", err)
+	}
+	want := "savepoints are only supported by Execute"
+	_, err = rpcVTGate.ExecuteShards(context.Background(), "savepoint a", nil, ks, []string{"0"}, topodatapb.TabletType_MASTER, session, false, nil)
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("ExecuteShards returned %v, want %v", err, want)
+	}
+	_, err = rpcVTGate.ExecuteBatchShards(context.Background(), []*vtgatepb.BoundShardQuery{{
+		Query:    &querypb.BoundQuery{Sql: "rollback to a"},
+		Keyspace: ks,
+		Shards:   []string{"0"},
+	}}, topodatapb.TabletType_MASTER, false, session, nil)
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("ExecuteBatchShards returned %v, want %v", err, want)
+	}
+	if execCount := sbc.ExecCount.Get(); execCount != 0 {
+		t.Errorf("want 0, got %v", execCount)
+	}
+}
+
 func TestVTGateExecuteShards(t *testing.T) {
 	ks := "TestVTGateExecuteShards"
 	shard := "0"
@@ -318,7 +348,7 @@ func TestVTGateExecuteShards(t *testing.T) {
 		t.Errorf("got ExecuteOptions \n%+v, want \n%+v", sbc.Options[0], executeOptions)
 	}
 
-	session, err := rpcVTGate.Begin(context.Background(), false)
+	session, err := rpcVTGate.Begin(context.Background(), false, nil)
 	if !session.InTransaction {
 		t.Errorf("want true, got false")
 	}
@@ -351,7 +381,7 @@ func TestVTGateExecuteShards(t *testing.T) {
 		t.Errorf("want 1, got %d", commitCount)
 	}
 
-	session, err = rpcVTGate.Begin(context.Background(), false)
+	session, err = rpcVTGate.Begin(context.Background(), false, nil)
 	rpcVTGate.ExecuteShards(context.Background(),
 		"query",
 		nil,
@@ -367,6 +397,213 @@ func TestVTGateExecuteShards(t *testing.T) {
 	}
 }
 
+func TestVTGateReadYourWrites(t *testing.T) {
+	ks := "TestVTGateReadYourWrites"
+	shard := "0"
+	createSandbox(ks)
+	hcVTGateTest.Reset()
+	master := hcVTGateTest.AddTestTablet("aa", "1.1.1.1", 1001, ks, shard, topodatapb.TabletType_MASTER, true, 1, nil)
+	replica := hcVTGateTest.AddTestTablet("aa", "1.1.1.2", 1001, ks, shard, topodatapb.TabletType_REPLICA, true, 1, nil)
+	wantPositions := func(position string) []*vtgatepb.Session_ShardPosition {
+		return []*vtgatepb.Session_ShardPosition{{
+			Keyspace: ks,
+			Shard:    shard,
+			Position: position,
+		}}
+	}
+
+	// An autocommitted write records the position it returns.
+	session := &vtgatepb.Session{ReadYourWrites: true}
+	master.SetResults([]*sqltypes.Result{{
+		RowsAffected: 1,
+		Extras:       &querypb.ResultExtras{Position: "MariaDB/0-1-5"},
+	}})
+	if _, err := rpcVTGate.ExecuteShards(context.Background(), "update", nil, ks, []string{shard}, topodatapb.TabletType_MASTER, session, false, executeOptions); err != nil {
+		t.Fatalf("ExecuteShards failed: %v", err)
+	}
+	if !master.Options[0].IncludePosition || !master.Options[0].ExcludeFieldNames {
+		t.Errorf("master got ExecuteOptions %v, want include_position and exclude_field_names", master.Options[0])
+	}
+	if !reflect.DeepEqual(session.Positions, wantPositions("MariaDB/0-1-5")) {
+		t.Errorf("Positions = %v, want MariaDB/0-1-5", session.Positions)
+	}
+
+	// A replica read waits for it.
+	if _, err := rpcVTGate.ExecuteShards(context.Background(), "select", nil, ks, []string{shard}, topodatapb.TabletType_REPLICA, session, false, executeOptions); err != nil {
+		t.Fatalf("ExecuteShards failed: %v", err)
+	}
+	if replica.Options[0].WaitForPosition != "MariaDB/0-1-5" || !replica.Options[0].ExcludeFieldNames {
+		t.Errorf("replica got ExecuteOptions %v, want wait_for_position and exclude_field_names", replica.Options[0])
+	}
+	if executeOptions.WaitForPosition != "" || executeOptions.IncludePosition {
+		t.Errorf("the options of the caller were modified: %v", executeOptions)
+	}
+
+	// A transaction records the position of its commit.
+	session.InTransaction = true
+	master.CommitPosition = "MariaDB/0-1-6"
+	if _, err := rpcVTGate.ExecuteShards(context.Background(), "update", nil, ks, []string{shard}, topodatapb.TabletType_MASTER, session, false, nil); err != nil {
+		t.Fatalf("ExecuteShards failed: %v", err)
+	}
+	if !master.Options[1].IncludePosition {
+		t.Errorf("master got ExecuteOptions %v, want include_position", master.Options[1])
+	}
+	if err := rpcVTGate.Commit(context.Background(), false, session); err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+	if !reflect.DeepEqual(session.Positions, wantPositions("MariaDB/0-1-6")) || !session.ReadYourWrites || session.InTransaction {
+		t.Errorf("session after Commit = %v, want position MariaDB/0-1-6 and read_your_writes", session)
+	}
+
+	// Other sessions are unaffected.
+	if _, err := rpcVTGate.ExecuteShards(context.Background(), "select", nil, ks, []string{shard}, topodatapb.TabletType_REPLICA, &vtgatepb.Session{}, false, nil); err != nil {
+		t.Fatalf("ExecuteShards failed: %v", err)
+	}
+	if replica.Options[1] != nil {
+		t.Errorf("replica got ExecuteOptions %v, want nil", replica.Options[1])
+	}
+}
+
+// localVTGateConn is a vtgateconn.Impl that calls rpcVTGate
+// directly. It only implements the v3 calls. Like an RPC, it
+// copies the sessions it sends and receives.
+type localVTGateConn struct {
+	vtgateconn.Impl
+}
+
+func copySession(session interface{}) *vtgatepb.Session {
+	if session == nil {
+		return nil
+	}
+	return proto.Clone(session.(*vtgatepb.Session)).(*vtgatepb.Session)
+}
+
+func (conn *localVTGateConn) Execute(ctx context.Context, query string, bindVars map[string]interface{}, keyspace string, tabletType topodatapb.TabletType, session interface{}, options *querypb.ExecuteOptions) (*sqltypes.Result, interface{}, error) {
+	s := copySession(session)
+	qr, err := rpcVTGate.Execute(ctx, query, bindVars, keyspace, tabletType, s, false, options)
+	return qr, copySession(s), err
+}
+
+func (conn *localVTGateConn) StreamExecute(ctx context.Context, query string, bindVars map[string]interface{}, keyspace string, tabletType topodatapb.TabletType, session interface{}, options *querypb.ExecuteOptions) (sqltypes.ResultStream, error) {
+	stream := &localResultStream{}
+	if err := rpcVTGate.StreamExecute(ctx, query, bindVars, keyspace, tabletType, copySession(session), options, func(qr *sqltypes.Result) error {
+		stream.results = append(stream.results, qr)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+func (conn *localVTGateConn) Begin(ctx context.Context, singledb bool, session interface{}) (interface{}, error) {
+	s, err := rpcVTGate.Begin(ctx, singledb, copySession(session))
+	return copySession(s), err
+}
+
+func (conn *localVTGateConn) Commit(ctx context.Context, session interface{}, twopc bool) (interface{}, error) {
+	s := copySession(session)
+	err := rpcVTGate.Commit(ctx, twopc, s)
+	return copySession(s), err
+}
+
+func (conn *localVTGateConn) Close() {
+}
+
+// localResultStream is the sqltypes.ResultStream of localVTGateConn.
+type localResultStream struct {
+	results []*sqltypes.Result
+}
+
+func (s *localResultStream) Recv() (*sqltypes.Result, error) {
+	if len(s.results) == 0 {
+		return nil, io.EOF
+	}
+	qr := s.results[0]
+	s.results = s.results[1:]
+	return qr, nil
+}
+
+func init() {
+	vtgateconn.RegisterDialer("local", func(ctx context.Context, address string, timeout time.Duration) (vtgateconn.Impl, error) {
+		return &localVTGateConn{}, nil
+	})
+}
+
+func TestVTGateConnReadYourWrites(t *testing.T) {
+	createSandbox(KsTestUnsharded)
+	hcVTGateTest.Reset()
+	master := hcVTGateTest.AddTestTablet("aa", "1.1.1.1", 1001, KsTestUnsharded, "0", topodatapb.TabletType_MASTER, true, 1, nil)
+	replica := hcVTGateTest.AddTestTablet("aa", "1.1.1.2", 1001, KsTestUnsharded, "0", topodatapb.TabletType_REPLICA, true, 1, nil)
+	ctx := context.Background()
+	conn, err := vtgateconn.DialProtocol(ctx, "local", "", 0, "")
+	if err != nil {
+		t.Fatalf("DialProtocol failed: %v", err)
+	}
+	defer conn.Close()
+	// checkReplicaWaits checks that the replica reads of session
+	// wait for the given position, streaming or not.
+	checkReplicaWaits := func(session *vtgateconn.VTGateSession, position string) {
+		replica.Options = nil
+		if _, err := session.Execute(ctx, "select id from t1", nil, topodatapb.TabletType_REPLICA, nil); err != nil {
+			t.Fatalf("Execute failed: %v", err)
+		}
+		stream, err := session.StreamExecute(ctx, "select id from t1", nil, topodatapb.TabletType_REPLICA, nil)
+		if err != nil {
+			t.Fatalf("StreamExecute failed: %v", err)
+		}
+		for {
+			if _, err := stream.Recv(); err != nil {
+				if err != io.EOF {
+					t.Fatalf("Recv failed: %v", err)
+				}
+				break
+			}
+		}
+		if len(replica.Options) != 2 {
+			t.Fatalf("replica got %v queries, want 2", len(replica.Options))
+		}
+		for _, options := range replica.Options {
+			if options == nil || options.WaitForPosition != position {
+				t.Errorf("replica got ExecuteOptions %v, want wait_for_position %v", options, position)
+			}
+		}
+	}
+
+	// An autocommitted write.
+	session := conn.ReadYourWritesSession()
+	master.SetResults([]*sqltypes.Result{{
+		RowsAffected: 1,
+		Extras:       &querypb.ResultExtras{Position: "MariaDB/0-1-5"},
+	}})
+	if _, err := session.Execute(ctx, "update t1 set id = 1", nil, topodatapb.TabletType_MASTER, nil); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	checkReplicaWaits(session, "MariaDB/0-1-5")
+
+	// A committed transaction.
+	master.CommitPosition = "MariaDB/0-1-6"
+	tx, err := session.Begin(ctx)
+	if err != nil {
+		t.Fatalf("Begin failed: %v", err)
+	}
+	if _, err := tx.Execute(ctx, "update t1 set id = 2", nil, topodatapb.TabletType_MASTER, nil); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		t.Fatalf("Commit failed: %v", err)
+	}
+	checkReplicaWaits(session, "MariaDB/0-1-6")
+
+	// The reads of the connection itself don't wait.
+	replica.Options = nil
+	if _, err := conn.Execute(ctx, "select id from t1", nil, topodatapb.TabletType_REPLICA, nil); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if replica.Options[0] != nil {
+		t.Errorf("replica got ExecuteOptions %v, want nil", replica.Options[0])
+	}
+}
+
 func TestVTGateExecuteKeyspaceIds(t *testing.T) {
 	ks := "TestVTGateExecuteKeyspaceIds"
 	shard1 := "-20"
@@ -398,7 +635,7 @@ func TestVTGateExecuteKeyspaceIds(t *testing.T) {
 		t.Errorf("got ExecuteOptions \n%+v, want \n%+v", sbc1.Options[0], executeOptions)
 	}
 	// Test for successful execution in transaction
-	session, err := rpcVTGate.Begin(context.Background(), false)
+	session, err := rpcVTGate.Begin(context.Background(), false, nil)
 	if !session.InTransaction {
 		t.Errorf("want true, got false")
 	}
@@ -478,7 +715,7 @@ func TestVTGateExecuteKeyRanges(t *testing.T) {
 		t.Errorf("got ExecuteOptions \n%+v, want \n%+v", sbc1.Options[0], executeOptions)
 	}
 	// Test for successful execution in transaction
-	session, err := rpcVTGate.Begin(context.Background(), false)
+	session, err := rpcVTGate.Begin(context.Background(), false, nil)
 	if !session.InTransaction {
 		t.Errorf("want true, got false")
 	}
@@ -567,7 +804,7 @@ func TestVTGateExecuteEntityIds(t *testing.T) {
 		t.Errorf("got ExecuteOptions \n%+v, want \n%+v", sbc1.Options[0], executeOptions)
 	}
 	// Test for successful execution in transaction
-	session, err := rpcVTGate.Begin(context.Background(), false)
+	session, err := rpcVTGate.Begin(context.Background(), false, nil)
 	if !session.InTransaction {
 		t.Errorf("want true, got false")
 	}
@@ -676,7 +913,7 @@ func TestVTGateExecuteBatchShards(t *testing.T) {
 		t.Errorf("got ExecuteOptions \n%+v, want \n%+v", sbc1.Options[0], executeOptions)
 	}
 
-	session, err := rpcVTGate.Begin(context.Background(), false)
+	session, err := rpcVTGate.Begin(context.Background(), false, nil)
 	rpcVTGate.ExecuteBatchShards(context.Background(),
 		[]*vtgatepb.BoundShardQuery{{
 			Query: &querypb.BoundQuery{
@@ -745,7 +982,7 @@ func TestVTGateExecuteBatchKeyspaceIds(t *testing.T) {
 		t.Errorf("got ExecuteOptions \n%+v, want \n%+v", sbc1.Options[0], executeOptions)
 	}
 
-	session, err := rpcVTGate.Begin(context.Background(), false)
+	session, err := rpcVTGate.Begin(context.Background(), false, nil)
 	rpcVTGate.ExecuteBatchKeyspaceIds(context.Background(),
 		[]*vtgatepb.BoundKeyspaceIdQuery{{
 			Query: &querypb.BoundQuery{
@@ -783,6 +1020,7 @@ func TestVTGateStreamExecute(t *testing.T) {
 		nil,
 		"",
 		topodatapb.TabletType_MASTER,
+		nil,
 		executeOptions,
 		func(r *sqltypes.Result) error {
 			qrs = append(qrs, r)
@@ -1728,6 +1966,7 @@ func testErrorPropagation(t *testing.T, sbcs []*sandboxconn.SandboxConn, before
 		nil,
 		"",
 		topodatapb.TabletType_MASTER,
+		nil,
 		executeOptions,
 		func(r *sqltypes.Result) error {
 			return nil
@@ -1988,7 +2227,7 @@ func TestErrorIssuesRollback(t *testing.T) {
 	// Start a transaction, send one statement.
 	// Simulate an error that should trigger a rollback:
 	// vtrpcpb.ErrorCode_NOT_IN_TX case.
-	session, err := rpcVTGate.Begin(context.Background(), false)
+	session, err := rpcVTGate.Begin(context.Background(), false, nil)
 	if err != nil {
 		t.Fatalf("cannot start a transaction: %v", err)
 	}
@@ -2027,7 +2266,7 @@ func TestErrorIssuesRollback(t *testing.T) {
 	// Start a transaction, send one statement.
 	// Simulate an error that should trigger a rollback:
 	// vtrpcpb.ErrorCode_RESOURCE_EXHAUSTED case.
-	session, err = rpcVTGate.Begin(context.Background(), false)
+	session, err = rpcVTGate.Begin(context.Background(), false, nil)
 	if err != nil {
 		t.Fatalf("cannot start a transaction: %v", err)
 	}
@@ -2066,7 +2305,7 @@ func TestErrorIssuesRollback(t *testing.T) {
 	// Start a transaction, send one statement.
 	// Simulate an error that should *not* trigger a rollback:
 	// vtrpcpb.ErrorCode_INTEGRITY_ERROR case.
-	session, err = rpcVTGate.Begin(context.Background(), false)
+	session, err = rpcVTGate.Begin(context.Background(), false, nil)
 	if err != nil {
 		t.Fatalf("cannot start a transaction: %v", err)
 	}
@@ -116,7 +116,7 @@ func (conn *VTGateConn) ExecuteBatchKeyspaceIds(ctx context.Context, queries []*
 // ResultStream and an error. First check the error. Then you can
 // pull values from the ResultStream until io.EOF, or another error.
 func (conn *VTGateConn) StreamExecute(ctx context.Context, query string, bindVars map[string]interface{}, tabletType topodatapb.TabletType, options *querypb.ExecuteOptions) (sqltypes.ResultStream, error) {
-	return conn.impl.StreamExecute(ctx, query, bindVars, conn.keyspace, tabletType, options)
+	return conn.impl.StreamExecute(ctx, query, bindVars, conn.keyspace, tabletType, nil, options)
 }
 
 // StreamExecuteShards executes a streaming query on vtgate, on a set
@@ -150,8 +150,19 @@ func (conn *VTGateConn) ResolveTransaction(ctx context.Context, dtid string) err
 
 // Begin starts a transaction and returns a VTGateTX.
 func (conn *VTGateConn) Begin(ctx context.Context) (*VTGateTx, error) {
+	return conn.begin(ctx, nil)
+}
+
+// begin starts a transaction. If parent is set, the transaction
+// carries over its session, and gives it back the session
+// returned by Commit.
+func (conn *VTGateConn) begin(ctx context.Context, parent *VTGateSession) (*VTGateTx, error) {
+	var parentSession interface{}
+	if parent != nil {
+		parentSession = parent.session
+	}
 	atomicity := AtomicityFromContext(ctx)
-	session, err := conn.impl.Begin(ctx, atomicity == AtomicitySingle)
+	session, err := conn.impl.Begin(ctx, atomicity == AtomicitySingle, parentSession)
 	if err != nil {
 		return nil, err
 	}
@@ -160,9 +171,20 @@ func (conn *VTGateConn) Begin(ctx context.Context) (*VTGateTx, error) {
 		conn:      conn,
 		session:   session,
 		atomicity: atomicity,
+		parent:    parent,
 	}, nil
 }
 
+// ReadYourWritesSession returns a VTGateSession whose reads see its
+// own writes: after a write, its reads on replica and rdonly tablets
+// wait for the replication position of the write.
+func (conn *VTGateConn) ReadYourWritesSession() *VTGateSession {
+	return &VTGateSession{
+		conn:    conn,
+		session: &vtgatepb.Session{ReadYourWrites: true},
+	}
+}
+
 // Close must be called for releasing resources.
 func (conn *VTGateConn) Close() {
 	conn.impl.Close()
@@ -213,6 +235,9 @@ type VTGateTx struct {
 	conn      *VTGateConn
 	session   interface{}
 	atomicity Atomicity
+	// parent is the VTGateSession the transaction was started
+	// from, if any.
+	parent *VTGateSession
 }
 
 // Execute executes a query on vtgate within the current transaction.
@@ -300,7 +325,10 @@ func (tx *VTGateTx) Commit(ctx context.Context) error {
 	if tx.session == nil {
 		return fmt.Errorf("commit: not in transaction")
 	}
-	err := tx.conn.impl.Commit(ctx, tx.session, tx.atomicity == Atomicity2PC)
+	session, err := tx.conn.impl.Commit(ctx, tx.session, tx.atomicity == Atomicity2PC)
+	if tx.parent != nil && session != nil {
+		tx.parent.session = session
+	}
 	tx.session = nil
 	return err
 }
@@ -315,6 +343,37 @@ func (tx *VTGateTx) Rollback(ctx context.Context) error {
 	return err
 }
 
+// VTGateSession is a sequence of v3 queries and transactions that
+// read their own writes. It is created with ReadYourWritesSession.
+// It should not be concurrently used across goroutines.
+type VTGateSession struct {
+	conn    *VTGateConn
+	session interface{}
+}
+
+// Execute executes a non-streaming query on vtgate, outside of any
+// transaction.
+func (s *VTGateSession) Execute(ctx context.Context, query string, bindVars map[string]interface{}, tabletType topodatapb.TabletType, options *querypb.ExecuteOptions) (*sqltypes.Result, error) {
+	res, session, err := s.conn.impl.Execute(ctx, query, bindVars, s.conn.keyspace, tabletType, s.session, options)
+	if session != nil {
+		s.session = session
+	}
+	return res, err
+}
+
+// StreamExecute executes a streaming query on vtgate. It returns a
+// ResultStream and an error. First check the error. Then you can
+// pull values from the ResultStream until io.EOF, or another error.
+func (s *VTGateSession) StreamExecute(ctx context.Context, query string, bindVars map[string]interface{}, tabletType topodatapb.TabletType, options *querypb.ExecuteOptions) (sqltypes.ResultStream, error) {
+	return s.conn.impl.StreamExecute(ctx, query, bindVars, s.conn.keyspace, tabletType, s.session, options)
+}
+
+// Begin starts a transaction and returns a VTGateTX. The writes of
+// the transaction are visible to the session once it is committed.
+func (s *VTGateSession) Begin(ctx context.Context) (*VTGateTx, error) {
+	return s.conn.begin(ctx, s)
+}
+
 //
 // The rest of this file is for the protocol implementations.
 //
@@ -347,7 +406,7 @@ type Impl interface {
 	ExecuteBatchKeyspaceIds(ctx context.Context, queries []*vtgatepb.BoundKeyspaceIdQuery, tabletType topodatapb.TabletType, asTransaction bool, session interface{}, options *querypb.ExecuteOptions) ([]sqltypes.Result, interface{}, error)
 
 	// StreamExecute executes a streaming query on vtgate.
-	StreamExecute(ctx context.Context, query string, bindVars map[string]interface{}, keyspace string, tabletType topodatapb.TabletType, options *querypb.ExecuteOptions) (sqltypes.ResultStream, error)
+	StreamExecute(ctx context.Context, query string, bindVars map[string]interface{}, keyspace string, tabletType topodatapb.TabletType, session interface{}, options *querypb.ExecuteOptions) (sqltypes.ResultStream, error)
 
 	// StreamExecuteShards executes a streaming query on vtgate, on a set of shards.
 	StreamExecuteShards(ctx context.Context, query string, keyspace string, shards []string, bindVars map[string]interface{}, tabletType topodatapb.TabletType, options *querypb.ExecuteOptions) (sqltypes.ResultStream, error)
@@ -358,10 +417,12 @@ type Impl interface {
 	// StreamExecuteKeyspaceIds executes a streaming query on vtgate, for the given keyspaceIds.
 	StreamExecuteKeyspaceIds(ctx context.Context, query string, keyspace string, keyspaceIds [][]byte, bindVars map[string]interface{}, tabletType topodatapb.TabletType, options *querypb.ExecuteOptions) (sqltypes.ResultStream, error)
 
-	// Begin starts a transaction and returns a VTGateTX.
-	Begin(ctx context.Context, singledb bool) (interface{}, error)
-	// Commit commits the current transaction.
-	Commit(ctx context.Context, session interface{}, twopc bool) error
+	// Begin starts a transaction and returns a VTGateTX. The
+	// previous session, if any, is carried over.
+	Begin(ctx context.Context, singledb bool, session interface{}) (interface{}, error)
+	// Commit commits the current transaction, and returns the
+	// session after the transaction.
+	Commit(ctx context.Context, session interface{}, twopc bool) (interface{}, error)
 	// Rollback rolls back the current transaction.
 	Rollback(ctx context.Context, session interface{}) error
 	// ResolveTransaction resolves the specified 2pc transaction.
@@ -0,0 +1,139 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wrangler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/topo"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+	"github.com/youtube/vitess/go/vt/topotools"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// SetKeyspaceDurabilityPolicy changes the durability policy of a
+// keyspace. The tablets apply it the next time their replication is
+// configured, for instance during a reparent.
+func (wr *Wrangler) SetKeyspaceDurabilityPolicy(ctx context.Context, keyspace, policy string) (err error) {
+	if err := topotools.CheckDurabilityPolicy(policy); err != nil {
+		return err
+	}
+
+	// Lock the keyspace
+	ctx, unlock, lockErr := wr.ts.LockKeyspace(ctx, keyspace, "SetKeyspaceDurabilityPolicy")
+	if lockErr != nil {
+		return lockErr
+	}
+	defer unlock(&err)
+
+	// and change it
+	ki, err := wr.ts.GetKeyspace(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	ki.DurabilityPolicy = policy
+	return wr.ts.UpdateKeyspace(ctx, ki)
+}
+
+// ValidateDurabilityPolicy checks every shard of the keyspace follows
+// the durability policy of the keyspace: its master has tablets to ack
+// its transactions, and another tablet can be promoted if it fails.
+// It returns an error listing the violations.
+func (wr *Wrangler) ValidateDurabilityPolicy(ctx context.Context, keyspace string) error {
+	ki, err := wr.ts.GetKeyspace(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	if ki.DurabilityPolicy == "" {
+		wr.logger.Infof("keyspace %v has no durability policy, nothing to validate", keyspace)
+		return nil
+	}
+	shards, err := wr.ts.GetShardNames(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	sort.Strings(shards)
+
+	var violations []string
+	for _, shard := range shards {
+		shardViolations, err := wr.validateShardDurabilityPolicy(ctx, ki.DurabilityPolicy, keyspace, shard)
+		if err != nil {
+			return err
+		}
+		for _, v := range shardViolations {
+			wr.logger.Warningf("%v/%v: %v", keyspace, shard, v)
+			violations = append(violations, fmt.Sprintf("%v/%v: %v", keyspace, shard, v))
+		}
+	}
+	if len(violations) > 0 {
+		return fmt.Errorf("durability policy %v of keyspace %v is violated:\n%v", ki.DurabilityPolicy, keyspace, strings.Join(violations, "\n"))
+	}
+	return nil
+}
+
+func (wr *Wrangler) validateShardDurabilityPolicy(ctx context.Context, policy, keyspace, shard string) ([]string, error) {
+	si, err := wr.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, err
+	}
+	tabletMap, err := wr.ts.GetTabletMapForShard(ctx, keyspace, shard)
+	if err != nil && err != topo.ErrPartialResult {
+		return nil, err
+	}
+	tablets := shardTablets(tabletMap, nil)
+
+	if !si.HasMaster() {
+		return []string{"the shard has no master"}, nil
+	}
+	masterInfo, ok := tabletMap[*si.MasterAlias]
+	if !ok {
+		return []string{fmt.Sprintf("master %v is not in the shard", topoproto.TabletAliasString(si.MasterAlias))}, nil
+	}
+
+	var violations []string
+	if err := topotools.CheckPromotable(policy, masterInfo.Tablet, tablets); err != nil {
+		violations = append(violations, fmt.Sprintf("master: %v", err))
+	}
+
+	// If the master fails, one of the others has to be promoted.
+	otherTablets := shardTablets(tabletMap, si.MasterAlias)
+	promotable := false
+	for _, tablet := range otherTablets {
+		if tablet.Type == topodatapb.TabletType_REPLICA && topotools.CheckPromotable(policy, tablet, otherTablets) == nil {
+			promotable = true
+			break
+		}
+	}
+	if !promotable {
+		violations = append(violations, fmt.Sprintf("no tablet can replace master %v", topoproto.TabletAliasString(si.MasterAlias)))
+	}
+	return violations, nil
+}
+
+// keyspaceDurabilityPolicy returns the durability policy of a keyspace.
+func (wr *Wrangler) keyspaceDurabilityPolicy(ctx context.Context, keyspace string) (string, error) {
+	ki, err := wr.ts.GetKeyspace(ctx, keyspace)
+	if err != nil {
+		return "", err
+	}
+	return ki.DurabilityPolicy, nil
+}
+
+// shardTablets returns the tablets of tabletMap, except exclude.
+func shardTablets(tabletMap map[topodatapb.TabletAlias]*topo.TabletInfo, exclude *topodatapb.TabletAlias) []*topodatapb.Tablet {
+	result := make([]*topodatapb.Tablet, 0, len(tabletMap))
+	for alias, tabletInfo := range tabletMap {
+		if topoproto.TabletAliasEqual(&alias, exclude) {
+			continue
+		}
+		result = append(result, tabletInfo.Tablet)
+	}
+	return result
+}
@@ -0,0 +1,187 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wrangler
+
+import (
+	"fmt"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/mysqlctl/replication"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// The ways RepairErrantGTIDs can repair the errant GTIDs of a tablet.
+const (
+	// ErrantGTIDsInjectEmpty commits an empty transaction with each
+	// errant GTID on the master. The other tablets replicate them,
+	// and the tablet with the errant GTIDs skips them. Its data
+	// still differs from the master, so this is only right if the
+	// errant transactions didn't change anything that matters.
+	ErrantGTIDsInjectEmpty = "inject_empty"
+
+	// ErrantGTIDsRebuild marks the tablet for a rebuild from a
+	// backup: it is tagged with its errant GTIDs, and changed to
+	// DRAINED so it doesn't serve anymore.
+	ErrantGTIDsRebuild = "rebuild"
+)
+
+// ErrantGTIDsTag is the tag of the tablets marked for a rebuild by
+// RepairErrantGTIDs. Its value is the errant GTIDs.
+const ErrantGTIDsTag = "errant_gtids"
+
+// FindErrantGTIDs compares the executed GTIDs of the slaves of a shard
+// with the ones of the master, and returns the errant GTIDs of each
+// slave, i.e. the transactions that are not on the master. The slaves
+// without errant GTIDs are not in the result. Unreachable slaves are
+// skipped with a warning.
+func (wr *Wrangler) FindErrantGTIDs(ctx context.Context, keyspace, shard string) (map[topodatapb.TabletAlias]replication.GTIDSet, error) {
+	si, err := wr.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, err
+	}
+	if !si.HasMaster() {
+		return nil, fmt.Errorf("no master tablet for shard %v/%v", keyspace, shard)
+	}
+
+	// Unreachable tablets have a nil status, we check them below.
+	tabletMap, err := wr.ts.GetTabletMapForShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, err
+	}
+	tablets, statuses, _ := wr.replicationStatuses(ctx, tabletMap, true /*masterLast*/)
+	var masterPos replication.Position
+	foundMaster := false
+	for i, ti := range tablets {
+		if !topoproto.TabletAliasEqual(ti.Alias, si.MasterAlias) || statuses[i] == nil {
+			continue
+		}
+		masterPos, err = replication.DecodePosition(statuses[i].Position)
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode position of master %v: %v", ti.AliasString(), err)
+		}
+		foundMaster = true
+	}
+	if !foundMaster {
+		return nil, fmt.Errorf("cannot get the position of master %v", topoproto.TabletAliasString(si.MasterAlias))
+	}
+
+	result := make(map[topodatapb.TabletAlias]replication.GTIDSet)
+	for i, ti := range tablets {
+		if topoproto.TabletAliasEqual(ti.Alias, si.MasterAlias) || !ti.IsSlaveType() {
+			continue
+		}
+		if statuses[i] == nil {
+			wr.logger.Warningf("cannot get the position of tablet %v, skipping it", ti.AliasString())
+			continue
+		}
+		pos, err := replication.DecodePosition(statuses[i].Position)
+		if err != nil {
+			wr.logger.Warningf("cannot decode position of tablet %v, skipping it: %v", ti.AliasString(), err)
+			continue
+		}
+		if errant := errantGTIDs(pos, masterPos); errant != nil {
+			result[*ti.Alias] = errant
+		}
+	}
+	return result, nil
+}
+
+// RepairErrantGTIDs repairs the errant GTIDs of a slave with the
+// provided method, ErrantGTIDsInjectEmpty or ErrantGTIDsRebuild. It
+// does nothing if the slave has no errant GTIDs.
+func (wr *Wrangler) RepairErrantGTIDs(ctx context.Context, tabletAlias *topodatapb.TabletAlias, method string) error {
+	if method != ErrantGTIDsInjectEmpty && method != ErrantGTIDsRebuild {
+		return fmt.Errorf("unknown errant GTIDs repair method %q, must be %v or %v", method, ErrantGTIDsInjectEmpty, ErrantGTIDsRebuild)
+	}
+
+	ti, err := wr.ts.GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return err
+	}
+	si, err := wr.ts.GetShard(ctx, ti.Keyspace, ti.Shard)
+	if err != nil {
+		return err
+	}
+	if !si.HasMaster() {
+		return fmt.Errorf("no master tablet for shard %v/%v", ti.Keyspace, ti.Shard)
+	}
+	if topoproto.TabletAliasEqual(si.MasterAlias, tabletAlias) {
+		return fmt.Errorf("tablet %v is the master of its shard, it can't have errant GTIDs", ti.AliasString())
+	}
+	masterTi, err := wr.ts.GetTablet(ctx, si.MasterAlias)
+	if err != nil {
+		return err
+	}
+
+	// Get the positions, and compute the errant GTIDs.
+	status, err := wr.tmc.SlaveStatus(ctx, ti.Tablet)
+	if err != nil {
+		return fmt.Errorf("SlaveStatus(%v) failed: %v", ti.AliasString(), err)
+	}
+	pos, err := replication.DecodePosition(status.Position)
+	if err != nil {
+		return fmt.Errorf("cannot decode position of tablet %v: %v", ti.AliasString(), err)
+	}
+	masterPosStr, err := wr.tmc.MasterPosition(ctx, masterTi.Tablet)
+	if err != nil {
+		return fmt.Errorf("MasterPosition(%v) failed: %v", masterTi.AliasString(), err)
+	}
+	masterPos, err := replication.DecodePosition(masterPosStr)
+	if err != nil {
+		return fmt.Errorf("cannot decode position of master %v: %v", masterTi.AliasString(), err)
+	}
+	errant := errantGTIDs(pos, masterPos)
+	if errant == nil {
+		wr.logger.Infof("tablet %v has no errant GTIDs, nothing to do", ti.AliasString())
+		return nil
+	}
+
+	switch method {
+	case ErrantGTIDsInjectEmpty:
+		wr.logger.Infof("injecting empty transactions for the errant GTIDs %v of tablet %v on master %v", errant, ti.AliasString(), masterTi.AliasString())
+		if err := wr.tmc.InjectEmptyTransactions(ctx, masterTi.Tablet, replication.EncodePosition(replication.Position{GTIDSet: errant})); err != nil {
+			return fmt.Errorf("InjectEmptyTransactions(%v) failed: %v", masterTi.AliasString(), err)
+		}
+	case ErrantGTIDsRebuild:
+		wr.logger.Infof("marking tablet %v with errant GTIDs %v for a rebuild from backup", ti.AliasString(), errant)
+		if _, err := wr.ts.UpdateTabletFields(ctx, tabletAlias, func(tablet *topodatapb.Tablet) error {
+			if tablet.Tags == nil {
+				tablet.Tags = make(map[string]string)
+			}
+			tablet.Tags[ErrantGTIDsTag] = errant.String()
+			return nil
+		}); err != nil {
+			return err
+		}
+		if ti.Type != topodatapb.TabletType_DRAINED {
+			if err := wr.ChangeSlaveType(ctx, tabletAlias, topodatapb.TabletType_DRAINED); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// errantGTIDs returns the transactions of a slave position that are
+// not in the master position, or nil if there are none. Only MySQL 5.6
+// GTID sets can be subtracted: for the other flavors, the whole slave
+// position is returned.
+func errantGTIDs(slavePos, masterPos replication.Position) replication.GTIDSet {
+	if slavePos.IsZero() || masterPos.AtLeast(slavePos) {
+		return nil
+	}
+	slaveSet, ok := slavePos.GTIDSet.(replication.Mysql56GTIDSet)
+	if !ok {
+		return slavePos.GTIDSet
+	}
+	masterSet, ok := masterPos.GTIDSet.(replication.Mysql56GTIDSet)
+	if !ok {
+		return slavePos.GTIDSet
+	}
+	return slaveSet.Difference(masterSet)
+}
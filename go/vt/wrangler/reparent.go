@@ -39,6 +39,14 @@ func (wr *Wrangler) ShardReplicationStatuses(ctx context.Context, keyspace, shar
 	if err != nil {
 		return nil, nil, err
 	}
+	return wr.replicationStatuses(ctx, tabletMap, false /*masterLast*/)
+}
+
+// replicationStatuses returns the ReplicationStatus for each tablet of
+// tabletMap. If masterLast is set, the master position is read once
+// all the slave statuses are in, so a slave can't have transactions
+// the master position misses: that's required to compare them.
+func (wr *Wrangler) replicationStatuses(ctx context.Context, tabletMap map[topodatapb.TabletAlias]*topo.TabletInfo, masterLast bool) ([]*topo.TabletInfo, []*replicationdatapb.Status, error) {
 	tablets := topotools.CopyMapValues(tabletMap, []*topo.TabletInfo{}).([]*topo.TabletInfo)
 
 	wr.logger.Infof("Gathering tablet replication status for: %v", tablets)
@@ -50,17 +58,13 @@ func (wr *Wrangler) ShardReplicationStatuses(ctx context.Context, keyspace, shar
 		// Don't scan tablets that won't return something
 		// useful. Otherwise, you'll end up waiting for a timeout.
 		if ti.Type == topodatapb.TabletType_MASTER {
+			if masterLast {
+				continue
+			}
 			wg.Add(1)
 			go func(i int, ti *topo.TabletInfo) {
 				defer wg.Done()
-				pos, err := wr.tmc.MasterPosition(ctx, ti.Tablet)
-				if err != nil {
-					rec.RecordError(fmt.Errorf("MasterPosition(%v) failed: %v", ti.AliasString(), err))
-					return
-				}
-				result[i] = &replicationdatapb.Status{
-					Position: pos,
-				}
+				result[i] = wr.masterStatus(ctx, ti, &rec)
 			}(i, ti)
 		} else if ti.IsSlaveType() {
 			wg.Add(1)
@@ -76,9 +80,30 @@ func (wr *Wrangler) ShardReplicationStatuses(ctx context.Context, keyspace, shar
 		}
 	}
 	wg.Wait()
+
+	if masterLast {
+		for i, ti := range tablets {
+			if ti.Type == topodatapb.TabletType_MASTER {
+				result[i] = wr.masterStatus(ctx, ti, &rec)
+			}
+		}
+	}
 	return tablets, result, rec.Error()
 }
 
+// masterStatus returns the ReplicationStatus of a master, with only
+// its position, or records the error and returns nil.
+func (wr *Wrangler) masterStatus(ctx context.Context, ti *topo.TabletInfo, rec concurrency.ErrorRecorder) *replicationdatapb.Status {
+	pos, err := wr.tmc.MasterPosition(ctx, ti.Tablet)
+	if err != nil {
+		rec.RecordError(fmt.Errorf("MasterPosition(%v) failed: %v", ti.AliasString(), err))
+		return nil
+	}
+	return &replicationdatapb.Status{
+		Position: pos,
+	}
+}
+
 // ReparentTablet tells a tablet to reparent this tablet to the current
 // master, based on the current replication position. If there is no
 // match, it will fail.
@@ -336,41 +361,16 @@ func (wr *Wrangler) plannedReparentShardLocked(ctx context.Context, ev *events.R
 		return err
 	}
 
-	// Check corner cases we're going to depend on
-	if topoproto.TabletAliasEqual(masterElectTabletAlias, avoidMasterTabletAlias) {
-		return fmt.Errorf("master-elect tablet %v is the same as the tablet to avoid", topoproto.TabletAliasString(masterElectTabletAlias))
-	}
-	if masterElectTabletAlias == nil {
-		if !topoproto.TabletAliasEqual(avoidMasterTabletAlias, shardInfo.MasterAlias) {
-			event.DispatchUpdate(ev, "current master is different than -avoid_master, nothing to do")
-			return nil
-		}
-		event.DispatchUpdate(ev, "searching for master candidate")
-		masterElectTabletAlias, err = wr.chooseNewMaster(ctx, shardInfo, tabletMap, avoidMasterTabletAlias, waitSlaveTimeout)
-		if err != nil {
-			return err
-		}
-		if masterElectTabletAlias == nil {
-			return fmt.Errorf("cannot find a tablet to reparent to")
-		}
-		wr.logger.Infof("elected new master candidate %v", topoproto.TabletAliasString(masterElectTabletAlias))
-		event.DispatchUpdate(ev, "elected new master candidate")
+	masterElectTabletInfo, oldMasterTabletInfo, err := wr.plannedReparentTablets(ctx, ev, shardInfo, tabletMap, masterElectTabletAlias, avoidMasterTabletAlias, waitSlaveTimeout)
+	if err != nil {
+		return err
 	}
-	masterElectTabletInfo, ok := tabletMap[*masterElectTabletAlias]
-	if !ok {
-		return fmt.Errorf("master-elect tablet %v is not in the shard", topoproto.TabletAliasString(masterElectTabletAlias))
+	if masterElectTabletInfo == nil {
+		event.DispatchUpdate(ev, "current master is different than -avoid_master, nothing to do")
+		return nil
 	}
+	masterElectTabletAlias = masterElectTabletInfo.Alias
 	ev.NewMaster = *masterElectTabletInfo.Tablet
-	if topoproto.TabletAliasEqual(shardInfo.MasterAlias, masterElectTabletAlias) {
-		return fmt.Errorf("master-elect tablet %v is already the master", topoproto.TabletAliasString(masterElectTabletAlias))
-	}
-	if topoproto.TabletAliasIsZero(shardInfo.MasterAlias) {
-		return fmt.Errorf("the shard has no master, use EmergencyReparentShard")
-	}
-	oldMasterTabletInfo, ok := tabletMap[*shardInfo.MasterAlias]
-	if !ok {
-		return fmt.Errorf("old master tablet %v is not in the shard", topoproto.TabletAliasString(shardInfo.MasterAlias))
-	}
 	ev.OldMaster = *oldMasterTabletInfo.Tablet
 
 	// Demote the current master, get its replication position
@@ -457,6 +457,61 @@ func (wr *Wrangler) plannedReparentShardLocked(ctx context.Context, ev *events.R
 	return nil
 }
 
+// plannedReparentTablets checks the parameters of a
+// PlannedReparentShard, chooses the master-elect if it is not
+// provided, and returns the master-elect and old master tablets. It
+// returns nil tablets if the current master doesn't have to be
+// avoided, so there is nothing to do. The progress is dispatched as
+// updates of ev, unless it is nil.
+func (wr *Wrangler) plannedReparentTablets(ctx context.Context, ev *events.Reparent, shardInfo *topo.ShardInfo, tabletMap map[topodatapb.TabletAlias]*topo.TabletInfo, masterElectTabletAlias, avoidMasterTabletAlias *topodatapb.TabletAlias, waitSlaveTimeout time.Duration) (masterElectTabletInfo, oldMasterTabletInfo *topo.TabletInfo, err error) {
+	policy, err := wr.keyspaceDurabilityPolicy(ctx, shardInfo.Keyspace())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Check corner cases we're going to depend on
+	if topoproto.TabletAliasEqual(masterElectTabletAlias, avoidMasterTabletAlias) {
+		return nil, nil, fmt.Errorf("master-elect tablet %v is the same as the tablet to avoid", topoproto.TabletAliasString(masterElectTabletAlias))
+	}
+	if masterElectTabletAlias == nil {
+		if !topoproto.TabletAliasEqual(avoidMasterTabletAlias, shardInfo.MasterAlias) {
+			return nil, nil, nil
+		}
+		if ev != nil {
+			event.DispatchUpdate(ev, "searching for master candidate")
+		}
+		masterElectTabletAlias, err = wr.chooseNewMaster(ctx, shardInfo, tabletMap, avoidMasterTabletAlias, policy, waitSlaveTimeout)
+		if err != nil {
+			return nil, nil, err
+		}
+		if masterElectTabletAlias == nil {
+			return nil, nil, fmt.Errorf("cannot find a tablet to reparent to")
+		}
+		wr.logger.Infof("elected new master candidate %v", topoproto.TabletAliasString(masterElectTabletAlias))
+		if ev != nil {
+			event.DispatchUpdate(ev, "elected new master candidate")
+		}
+	}
+	masterElectTabletInfo, ok := tabletMap[*masterElectTabletAlias]
+	if !ok {
+		return nil, nil, fmt.Errorf("master-elect tablet %v is not in the shard", topoproto.TabletAliasString(masterElectTabletAlias))
+	}
+	if topoproto.TabletAliasEqual(shardInfo.MasterAlias, masterElectTabletAlias) {
+		return nil, nil, fmt.Errorf("master-elect tablet %v is already the master", topoproto.TabletAliasString(masterElectTabletAlias))
+	}
+	if topoproto.TabletAliasIsZero(shardInfo.MasterAlias) {
+		return nil, nil, fmt.Errorf("the shard has no master, use EmergencyReparentShard")
+	}
+	if err := topotools.CheckPromotable(policy, masterElectTabletInfo.Tablet, shardTablets(tabletMap, nil)); err != nil {
+		return nil, nil, fmt.Errorf("master-elect tablet %v can't be promoted: %v", topoproto.TabletAliasString(masterElectTabletAlias), err)
+	}
+	oldMasterTabletInfo, ok = tabletMap[*shardInfo.MasterAlias]
+	if !ok {
+		return nil, nil, fmt.Errorf("old master tablet %v is not in the shard", topoproto.TabletAliasString(shardInfo.MasterAlias))
+	}
+	return masterElectTabletInfo, oldMasterTabletInfo, nil
+}
+
 // maxReplPosSearch is a struct helping to search for a tablet with the largest replication
 // position querying status from all tablets in parallel.
 type maxReplPosSearch struct {
@@ -481,6 +536,10 @@ func (maxPosSearch *maxReplPosSearch) processTablet(tablet *topodatapb.Tablet) {
 		maxPosSearch.wrangler.logger.Warningf("failed to get replication status from %v, ignoring tablet: %v", topoproto.TabletAliasString(tablet.Alias), err)
 		return
 	}
+	if err := topotools.CheckNotDelayed(tablet, status); err != nil {
+		maxPosSearch.wrangler.logger.Infof("ignoring tablet: %v", err)
+		return
+	}
 	replPos, err := replication.DecodePosition(status.Position)
 	if err != nil {
 		maxPosSearch.wrangler.logger.Warningf("cannot decode slave %v position %v: %v", topoproto.TabletAliasString(tablet.Alias), status.Position, err)
@@ -496,8 +555,9 @@ func (maxPosSearch *maxReplPosSearch) processTablet(tablet *topodatapb.Tablet) {
 }
 
 // chooseNewMaster finds a tablet that is going to become master after reparent. The criterias
-// for the new master-elect are (preferably) to be in the same cell as the current master, and
-// to be different from avoidMasterTabletAlias. The tablet with the largest replication
+// for the new master-elect are (preferably) to be in the same cell as the current master, to
+// be different from avoidMasterTabletAlias, not to be a delayed replica, and to be promotable
+// with the durability policy of the keyspace. The tablet with the largest replication
 // position is chosen to minimize the time of catching up with the master. Note that the search
 // for largest replication position will race with transactions being executed on the master at
 // the same time, so when all tablets are roughly at the same position then the choice of the
@@ -507,6 +567,7 @@ func (wr *Wrangler) chooseNewMaster(
 	shardInfo *topo.ShardInfo,
 	tabletMap map[topodatapb.TabletAlias]*topo.TabletInfo,
 	avoidMasterTabletAlias *topodatapb.TabletAlias,
+	policy string,
 	waitSlaveTimeout time.Duration) (*topodatapb.TabletAlias, error) {
 
 	if avoidMasterTabletAlias == nil {
@@ -524,10 +585,12 @@ func (wr *Wrangler) chooseNewMaster(
 		waitGroup:        sync.WaitGroup{},
 		maxPosLock:       sync.Mutex{},
 	}
+	tablets := shardTablets(tabletMap, nil)
 	for tabletAlias, tabletInfo := range tabletMap {
 		if (masterCell != "" && tabletAlias.Cell != masterCell) ||
 			topoproto.TabletAliasEqual(&tabletAlias, avoidMasterTabletAlias) ||
-			tabletInfo.Tablet.Type != topodatapb.TabletType_REPLICA {
+			tabletInfo.Tablet.Type != topodatapb.TabletType_REPLICA ||
+			topotools.CheckPromotable(policy, tabletInfo.Tablet, tablets) != nil {
 			continue
 		}
 		maxPosSearch.waitGroup.Add(1)
@@ -564,6 +627,83 @@ func (wr *Wrangler) EmergencyReparentShard(ctx context.Context, keyspace, shard
 	return err
 }
 
+// AutomaticEmergencyReparentShard is the EmergencyReparentShard used
+// by automatic master failover: under the shard lock, it checks that
+// deadMasterAlias is still the shard master, and promotes the most
+// advanced REPLICA tablet. It returns the alias of the new master.
+func (wr *Wrangler) AutomaticEmergencyReparentShard(ctx context.Context, keyspace, shard string, deadMasterAlias *topodatapb.TabletAlias, waitSlaveTimeout time.Duration) (newMasterAlias *topodatapb.TabletAlias, err error) {
+	// lock the shard
+	ctx, unlock, lockErr := wr.ts.LockShard(ctx, keyspace, shard, fmt.Sprintf("AutomaticEmergencyReparentShard(%v)", topoproto.TabletAliasString(deadMasterAlias)))
+	if lockErr != nil {
+		return nil, lockErr
+	}
+	defer unlock(&err)
+
+	// The master may have been changed while we were deciding.
+	shardInfo, err := wr.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, err
+	}
+	if !topoproto.TabletAliasEqual(shardInfo.MasterAlias, deadMasterAlias) {
+		return nil, fmt.Errorf("shard master is now %v, not %v anymore", topoproto.TabletAliasString(shardInfo.MasterAlias), topoproto.TabletAliasString(deadMasterAlias))
+	}
+
+	// Create reusable Reparent event with available info
+	ev := &events.Reparent{}
+
+	// do the work
+	err = wr.emergencyReparentShardLocked(ctx, ev, keyspace, shard, nil, waitSlaveTimeout)
+	if err != nil {
+		event.DispatchUpdate(ev, "failed AutomaticEmergencyReparentShard: "+err.Error())
+		return nil, err
+	}
+	event.DispatchUpdate(ev, "finished AutomaticEmergencyReparentShard")
+	return ev.NewMaster.Alias, nil
+}
+
+// chooseMostAdvancedSlave returns the REPLICA tablet with the most
+// advanced replication position in statusMap, among the ones that are
+// not delayed replicas and can be promoted with the durability policy.
+// Among the tablets with the
+// same position, the ones in the cell of the dead oldMasterAlias are
+// preferred.
+func chooseMostAdvancedSlave(tabletMap map[topodatapb.TabletAlias]*topo.TabletInfo, statusMap map[topodatapb.TabletAlias]*replicationdatapb.Status, oldMasterAlias *topodatapb.TabletAlias, policy string) (*topodatapb.TabletAlias, error) {
+	var masterCell string
+	if oldMasterAlias != nil {
+		masterCell = oldMasterAlias.Cell
+	}
+	var bestAlias *topodatapb.TabletAlias
+	var bestPos replication.Position
+	// The old master is dead, it can't ack.
+	tablets := shardTablets(tabletMap, oldMasterAlias)
+	for alias, status := range statusMap {
+		tabletInfo, ok := tabletMap[alias]
+		if !ok || tabletInfo.Type != topodatapb.TabletType_REPLICA ||
+			topotools.CheckNotDelayed(tabletInfo.Tablet, status) != nil ||
+			topotools.CheckPromotable(policy, tabletInfo.Tablet, tablets) != nil {
+			continue
+		}
+		pos, err := replication.DecodePosition(status.Position)
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode slave %v position %v: %v", topoproto.TabletAliasString(&alias), status.Position, err)
+		}
+		if bestAlias != nil {
+			if !pos.AtLeast(bestPos) {
+				continue
+			}
+			if pos.Equal(bestPos) && (bestAlias.Cell == masterCell || alias.Cell != masterCell) {
+				continue
+			}
+		}
+		bestAlias = tabletInfo.Alias
+		bestPos = pos
+	}
+	if bestAlias == nil {
+		return nil, fmt.Errorf("no REPLICA tablet that can be promoted is reachable")
+	}
+	return bestAlias, nil
+}
+
 func (wr *Wrangler) emergencyReparentShardLocked(ctx context.Context, ev *events.Reparent, keyspace, shard string, masterElectTabletAlias *topodatapb.TabletAlias, waitSlaveTimeout time.Duration) error {
 	shardInfo, err := wr.ts.GetShard(ctx, keyspace, shard)
 	if err != nil {
@@ -577,14 +717,29 @@ func (wr *Wrangler) emergencyReparentShardLocked(ctx context.Context, ev *events
 		return err
 	}
 
-	// Check corner cases we're going to depend on
-	masterElectTabletInfo, ok := tabletMap[*masterElectTabletAlias]
-	if !ok {
-		return fmt.Errorf("master-elect tablet %v is not in the shard", topoproto.TabletAliasString(masterElectTabletAlias))
+	policy, err := wr.keyspaceDurabilityPolicy(ctx, keyspace)
+	if err != nil {
+		return err
 	}
-	ev.NewMaster = *masterElectTabletInfo.Tablet
-	if topoproto.TabletAliasEqual(shardInfo.MasterAlias, masterElectTabletAlias) {
-		return fmt.Errorf("master-elect tablet %v is already the master", topoproto.TabletAliasString(masterElectTabletAlias))
+
+	// Check corner cases we're going to depend on. If no
+	// master-elect is provided, it is chosen once we know the
+	// replication positions.
+	var masterElectTabletInfo *topo.TabletInfo
+	if masterElectTabletAlias != nil {
+		var ok bool
+		masterElectTabletInfo, ok = tabletMap[*masterElectTabletAlias]
+		if !ok {
+			return fmt.Errorf("master-elect tablet %v is not in the shard", topoproto.TabletAliasString(masterElectTabletAlias))
+		}
+		ev.NewMaster = *masterElectTabletInfo.Tablet
+		if topoproto.TabletAliasEqual(shardInfo.MasterAlias, masterElectTabletAlias) {
+			return fmt.Errorf("master-elect tablet %v is already the master", topoproto.TabletAliasString(masterElectTabletAlias))
+		}
+		// The old master is dead, it can't ack.
+		if err := topotools.CheckPromotable(policy, masterElectTabletInfo.Tablet, shardTablets(tabletMap, shardInfo.MasterAlias)); err != nil {
+			return fmt.Errorf("master-elect tablet %v can't be promoted: %v", topoproto.TabletAliasString(masterElectTabletAlias), err)
+		}
 	}
 
 	// Deal with the old master: try to remote-scrap it, if it's
@@ -640,11 +795,24 @@ func (wr *Wrangler) emergencyReparentShardLocked(ctx context.Context, ev *events
 	}
 	wg.Wait()
 
+	if masterElectTabletAlias == nil {
+		masterElectTabletAlias, err = chooseMostAdvancedSlave(tabletMap, statusMap, shardInfo.MasterAlias, policy)
+		if err != nil {
+			return err
+		}
+		masterElectTabletInfo = tabletMap[*masterElectTabletAlias]
+		ev.NewMaster = *masterElectTabletInfo.Tablet
+		wr.logger.Infof("elected new master candidate %v", topoproto.TabletAliasString(masterElectTabletAlias))
+	}
+
 	// Verify masterElect is alive and has the most advanced position
 	masterElectStatus, ok := statusMap[*masterElectTabletAlias]
 	if !ok {
 		return fmt.Errorf("couldn't get master elect %v replication position", topoproto.TabletAliasString(masterElectTabletAlias))
 	}
+	if err := topotools.CheckNotDelayed(masterElectTabletInfo.Tablet, masterElectStatus); err != nil {
+		return fmt.Errorf("master-elect tablet %v can't be promoted: %v", topoproto.TabletAliasString(masterElectTabletAlias), err)
+	}
 	masterElectPos, err := replication.DecodePosition(masterElectStatus.Position)
 	if err != nil {
 		return fmt.Errorf("cannot decode master elect position %v: %v", masterElectStatus.Position, err)
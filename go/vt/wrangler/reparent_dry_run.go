@@ -0,0 +1,199 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wrangler
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/mysqlctl/replication"
+	"github.com/youtube/vitess/go/vt/topo"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+
+	replicationdatapb "github.com/youtube/vitess/go/vt/proto/replicationdata"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+// PlannedReparentShardDryRun runs the checks of PlannedReparentShard
+// without changing anything: it locks the shard, gathers the
+// replication status of its tablets, looks for errant GTIDs, and
+// prints the RPCs PlannedReparentShard would issue. It returns an
+// error if one of the checks would make the reparent fail.
+func (wr *Wrangler) PlannedReparentShardDryRun(ctx context.Context, keyspace, shard string, masterElectTabletAlias, avoidMasterAlias *topodatapb.TabletAlias, waitSlaveTimeout time.Duration) (err error) {
+	// lock the shard, so the plan doesn't change under us
+	ctx, unlock, lockErr := wr.ts.LockShard(ctx, keyspace, shard, "PlannedReparentShard(dry run)")
+	if lockErr != nil {
+		return lockErr
+	}
+	defer unlock(&err)
+
+	shardInfo, err := wr.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return err
+	}
+	tabletMap, err := wr.ts.GetTabletMapForShard(ctx, keyspace, shard)
+	if err != nil {
+		return err
+	}
+	// A dry run dispatches no Reparent event.
+	masterElectTabletInfo, oldMasterTabletInfo, err := wr.plannedReparentTablets(ctx, nil /*ev*/, shardInfo, tabletMap, masterElectTabletAlias, avoidMasterAlias, waitSlaveTimeout)
+	if err != nil {
+		return err
+	}
+	if masterElectTabletInfo == nil {
+		wr.logger.Printf("current master %v is different than -avoid_master, nothing to do\n", topoproto.TabletAliasString(shardInfo.MasterAlias))
+		return nil
+	}
+
+	// Unreachable tablets are reported by the checks below. The
+	// master position is read last, to check for errant GTIDs.
+	tablets, statuses, _ := wr.replicationStatuses(ctx, tabletMap, true /*masterLast*/)
+	statusMap := make(map[topodatapb.TabletAlias]*replicationdatapb.Status, len(tablets))
+	for i, ti := range tablets {
+		if statuses[i] != nil {
+			statusMap[*ti.Alias] = statuses[i]
+		}
+	}
+
+	problems, warnings := checkPlannedReparent(masterElectTabletInfo, oldMasterTabletInfo, tabletMap, statusMap, waitSlaveTimeout)
+
+	wr.logger.Printf("Replication status of shard %v/%v:\n", keyspace, shard)
+	aliases := sortedTabletAliases(tabletMap)
+	for _, alias := range aliases {
+		ti := tabletMap[alias]
+		status, ok := statusMap[alias]
+		switch {
+		case !ok:
+			wr.logger.Printf("  %v %v <unreachable>\n", ti.AliasString(), ti.Type)
+		case ti.Type == topodatapb.TabletType_MASTER:
+			wr.logger.Printf("  %v %v %v\n", ti.AliasString(), ti.Type, status.Position)
+		default:
+			wr.logger.Printf("  %v %v %v io_running=%v sql_running=%v seconds_behind_master=%v\n", ti.AliasString(), ti.Type, status.Position, status.SlaveIoRunning, status.SlaveSqlRunning, status.SecondsBehindMaster)
+		}
+	}
+	if status, ok := statusMap[*masterElectTabletInfo.Alias]; ok {
+		wr.logger.Printf("Master-elect %v should catch up with the demoted master in about %v, -wait_slave_timeout is %v.\n", masterElectTabletInfo.AliasString(), time.Duration(status.SecondsBehindMaster)*time.Second, waitSlaveTimeout)
+	}
+
+	wr.logger.Printf("Plan:\n")
+	step := 0
+	printStep := func(format string, args ...interface{}) {
+		step++
+		wr.logger.Printf("  %v. %v\n", step, fmt.Sprintf(format, args...))
+	}
+	printStep("DemoteMaster on old master %v", oldMasterTabletInfo.AliasString())
+	printStep("PromoteSlaveWhenCaughtUp on master-elect %v, waiting at most %v", masterElectTabletInfo.AliasString(), waitSlaveTimeout)
+	printStep("PopulateReparentJournal on new master %v", masterElectTabletInfo.AliasString())
+	for _, alias := range aliases {
+		if topoproto.TabletAliasEqual(&alias, masterElectTabletInfo.Alias) {
+			continue
+		}
+		forceStartSlave := topoproto.TabletAliasEqual(&alias, oldMasterTabletInfo.Alias)
+		printStep("SetMaster(%v, forceStartSlave=%v) on %v", masterElectTabletInfo.AliasString(), forceStartSlave, topoproto.TabletAliasString(&alias))
+	}
+	printStep("update the shard record with new master %v", masterElectTabletInfo.AliasString())
+
+	for _, w := range warnings {
+		wr.logger.Printf("WARNING: %v\n", w)
+	}
+	if len(problems) > 0 {
+		for _, p := range problems {
+			wr.logger.Printf("ERROR: %v\n", p)
+		}
+		return fmt.Errorf("PlannedReparentShard to %v would fail:\n%v", masterElectTabletInfo.AliasString(), strings.Join(problems, "\n"))
+	}
+	wr.logger.Printf("All checks passed.\n")
+	return nil
+}
+
+// checkPlannedReparent returns the problems that would make a
+// PlannedReparentShard from oldMaster to masterElect fail, and the
+// warnings about the other tablets. statusMap contains the status of
+// the reachable tablets.
+func checkPlannedReparent(masterElect, oldMaster *topo.TabletInfo, tabletMap map[topodatapb.TabletAlias]*topo.TabletInfo, statusMap map[topodatapb.TabletAlias]*replicationdatapb.Status, waitSlaveTimeout time.Duration) (problems, warnings []string) {
+	masterStatus, ok := statusMap[*oldMaster.Alias]
+	if !ok {
+		problems = append(problems, fmt.Sprintf("old master %v is unreachable, use EmergencyReparentShard", oldMaster.AliasString()))
+	}
+	var masterPos replication.Position
+	if ok {
+		var err error
+		masterPos, err = replication.DecodePosition(masterStatus.Position)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("cannot decode position of old master %v: %v", oldMaster.AliasString(), err))
+			ok = false
+		}
+	}
+
+	if masterElect.Type != topodatapb.TabletType_REPLICA {
+		warnings = append(warnings, fmt.Sprintf("master-elect %v is a %v tablet, not a REPLICA tablet", masterElect.AliasString(), masterElect.Type))
+	}
+
+	for _, alias := range sortedTabletAliases(tabletMap) {
+		if topoproto.TabletAliasEqual(&alias, oldMaster.Alias) {
+			continue
+		}
+		ti := tabletMap[alias]
+		isElect := topoproto.TabletAliasEqual(&alias, masterElect.Alias)
+		report := func(format string, args ...interface{}) {
+			msg := fmt.Sprintf(format, args...)
+			if isElect {
+				problems = append(problems, "master-elect "+msg)
+			} else {
+				warnings = append(warnings, msg)
+			}
+		}
+
+		status, found := statusMap[alias]
+		if !found {
+			if isElect || ti.IsSlaveType() {
+				report("%v is unreachable", ti.AliasString())
+			}
+			continue
+		}
+		if isElect {
+			if !status.SlaveIoRunning || !status.SlaveSqlRunning {
+				report("%v is not replicating (io_running=%v sql_running=%v)", ti.AliasString(), status.SlaveIoRunning, status.SlaveSqlRunning)
+			}
+			if lag := time.Duration(status.SecondsBehindMaster) * time.Second; lag > waitSlaveTimeout {
+				report("%v is %v behind the master, more than -wait_slave_timeout %v", ti.AliasString(), lag, waitSlaveTimeout)
+			}
+		}
+		if !ok {
+			continue
+		}
+		pos, err := replication.DecodePosition(status.Position)
+		if err != nil {
+			report("%v has a position that cannot be decoded: %v", ti.AliasString(), err)
+			continue
+		}
+		if errant := errantGTIDs(pos, masterPos); errant != nil {
+			report("%v has errant GTIDs that are not on the master: %v", ti.AliasString(), errant)
+		}
+	}
+	return problems, warnings
+}
+
+// sortedTabletAliases returns the aliases of tabletMap, sorted by
+// their string representation.
+func sortedTabletAliases(tabletMap map[topodatapb.TabletAlias]*topo.TabletInfo) []topodatapb.TabletAlias {
+	names := make([]string, 0, len(tabletMap))
+	byName := make(map[string]topodatapb.TabletAlias, len(tabletMap))
+	for alias := range tabletMap {
+		name := topoproto.TabletAliasString(&alias)
+		names = append(names, name)
+		byName[name] = alias
+	}
+	sort.Strings(names)
+	result := make([]topodatapb.TabletAlias, len(names))
+	for i, name := range names {
+		result[i] = byName[name]
+	}
+	return result
+}
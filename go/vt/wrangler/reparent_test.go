@@ -0,0 +1,129 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package wrangler
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/tabletmanager/tmclient"
+	"github.com/youtube/vitess/go/vt/topo"
+	"github.com/youtube/vitess/go/vt/topotools"
+
+	replicationdatapb "github.com/youtube/vitess/go/vt/proto/replicationdata"
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+func TestChooseMostAdvancedSlave(t *testing.T) {
+	tabletMap := make(map[topodatapb.TabletAlias]*topo.TabletInfo)
+	addTablet := func(cell string, uid uint32, tabletType topodatapb.TabletType) *topodatapb.TabletAlias {
+		alias := &topodatapb.TabletAlias{Cell: cell, Uid: uid}
+		tabletMap[*alias] = &topo.TabletInfo{Tablet: &topodatapb.Tablet{Alias: alias, Type: tabletType}}
+		return alias
+	}
+	oldMaster := addTablet("cell1", 1, topodatapb.TabletType_MASTER)
+	replica1 := addTablet("cell1", 2, topodatapb.TabletType_REPLICA)
+	replica2 := addTablet("cell2", 3, topodatapb.TabletType_REPLICA)
+	statusMap := map[topodatapb.TabletAlias]*replicationdatapb.Status{
+		*replica1: {Position: "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-10"},
+		*replica2: {Position: "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-10"},
+	}
+
+	// The tablet in the cell of the old master is preferred.
+	got, err := chooseMostAdvancedSlave(tabletMap, statusMap, oldMaster, topotools.DurabilitySemiSync)
+	if err != nil || *got != *replica1 {
+		t.Errorf("chooseMostAdvancedSlave(semi_sync) = %v, %v, want %v", got, err, replica1)
+	}
+
+	// A more advanced tablet wins.
+	statusMap[*replica2] = &replicationdatapb.Status{Position: "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-11"}
+	got, err = chooseMostAdvancedSlave(tabletMap, statusMap, oldMaster, topotools.DurabilitySemiSync)
+	if err != nil || *got != *replica2 {
+		t.Errorf("chooseMostAdvancedSlave(semi_sync) = %v, %v, want %v", got, err, replica2)
+	}
+
+	// A delayed replica is never chosen, even the most advanced one.
+	delayed := addTablet("cell1", 4, topodatapb.TabletType_REPLICA)
+	statusMap[*delayed] = &replicationdatapb.Status{Position: "MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-12", SqlDelay: 3600}
+	got, err = chooseMostAdvancedSlave(tabletMap, statusMap, oldMaster, topotools.DurabilitySemiSync)
+	if err != nil || *got != *replica2 {
+		t.Errorf("chooseMostAdvancedSlave(semi_sync) with a delayed replica = %v, %v, want %v", got, err, replica2)
+	}
+
+	// But it can still ack the transactions of replica1.
+	delete(tabletMap, *replica2)
+	delete(statusMap, *replica2)
+	got, err = chooseMostAdvancedSlave(tabletMap, statusMap, oldMaster, topotools.DurabilitySemiSync)
+	if err != nil || *got != *replica1 {
+		t.Errorf("chooseMostAdvancedSlave(semi_sync) with a delayed replica = %v, %v, want %v", got, err, replica1)
+	}
+
+	// Without another replica, replica1 would need the dead old
+	// master to ack its transactions.
+	delete(tabletMap, *delayed)
+	delete(statusMap, *delayed)
+	if got, err := chooseMostAdvancedSlave(tabletMap, statusMap, oldMaster, topotools.DurabilitySemiSync); err == nil {
+		t.Errorf("chooseMostAdvancedSlave(semi_sync) without another replica = %v, want error", got)
+	}
+	if got, err := chooseMostAdvancedSlave(tabletMap, statusMap, oldMaster, ""); err != nil || *got != *replica1 {
+		t.Errorf("chooseMostAdvancedSlave without a policy = %v, %v, want %v", got, err, replica1)
+	}
+}
+
+// orderCheckingTMClient checks the master position is read after the
+// slave statuses. It only implements SlaveStatus and MasterPosition.
+type orderCheckingTMClient struct {
+	tmclient.TabletManagerClient
+
+	mu           sync.Mutex
+	slaveReads   int
+	masterReadAt int
+}
+
+func (c *orderCheckingTMClient) SlaveStatus(ctx context.Context, tablet *topodatapb.Tablet) (*replicationdatapb.Status, error) {
+	time.Sleep(10 * time.Millisecond)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.slaveReads++
+	return &replicationdatapb.Status{Position: "slave"}, nil
+}
+
+func (c *orderCheckingTMClient) MasterPosition(ctx context.Context, tablet *topodatapb.Tablet) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.masterReadAt = c.slaveReads
+	return "master", nil
+}
+
+func TestReplicationStatusesMasterLast(t *testing.T) {
+	tabletMap := make(map[topodatapb.TabletAlias]*topo.TabletInfo)
+	for uid, tabletType := range []topodatapb.TabletType{topodatapb.TabletType_MASTER, topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY} {
+		alias := &topodatapb.TabletAlias{Cell: "cell1", Uid: uint32(uid)}
+		tabletMap[*alias] = &topo.TabletInfo{Tablet: &topodatapb.Tablet{Alias: alias, Type: tabletType}}
+	}
+	tmc := &orderCheckingTMClient{}
+	wr := New(logutil.NewMemoryLogger(), topo.Server{}, tmc)
+
+	tablets, statuses, err := wr.replicationStatuses(context.Background(), tabletMap, true /*masterLast*/)
+	if err != nil {
+		t.Fatalf("replicationStatuses failed: %v", err)
+	}
+	if tmc.masterReadAt != 2 {
+		t.Errorf("master position read after %v slave statuses, want 2", tmc.masterReadAt)
+	}
+	for i, ti := range tablets {
+		want := "slave"
+		if ti.Type == topodatapb.TabletType_MASTER {
+			want = "master"
+		}
+		if statuses[i] == nil || statuses[i].Position != want {
+			t.Errorf("replicationStatuses for %v = %v, want position %v", ti.AliasString(), statuses[i], want)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlib
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/tabletmanager/tmclient"
+	"github.com/youtube/vitess/go/vt/topo/zk2topo"
+	"github.com/youtube/vitess/go/vt/topotools"
+	"github.com/youtube/vitess/go/vt/vttest/fakesqldb"
+	"github.com/youtube/vitess/go/vt/wrangler"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+func TestValidateDurabilityPolicy(t *testing.T) {
+	ctx := context.Background()
+	db := fakesqldb.Register()
+	ts := zk2topo.NewFakeServer("cell1", "cell2")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+	vp := NewVtctlPipe(t, ts)
+	defer vp.Close()
+
+	// A master and a replica in cell1, a rdonly in cell2.
+	master := NewFakeTablet(t, wr, "cell1", 0, topodatapb.TabletType_MASTER, db)
+	NewFakeTablet(t, wr, "cell1", 1, topodatapb.TabletType_REPLICA, db)
+	rdonly := NewFakeTablet(t, wr, "cell2", 2, topodatapb.TabletType_RDONLY, db)
+	keyspace := master.Tablet.Keyspace
+
+	// Without a policy, there is nothing to validate.
+	if err := vp.Run([]string{"ValidateDurabilityPolicy", keyspace}); err != nil {
+		t.Fatalf("ValidateDurabilityPolicy without a policy failed: %v", err)
+	}
+
+	if err := vp.Run([]string{"SetKeyspaceDurabilityPolicy", keyspace, "two_acks"}); err == nil || !strings.Contains(err.Error(), "unknown durability policy") {
+		t.Fatalf("SetKeyspaceDurabilityPolicy with an unknown policy returned %v", err)
+	}
+	if err := vp.Run([]string{"SetKeyspaceDurabilityPolicy", keyspace, topotools.DurabilityCrossCell}); err != nil {
+		t.Fatalf("SetKeyspaceDurabilityPolicy failed: %v", err)
+	}
+	ki, err := ts.GetKeyspace(ctx, keyspace)
+	if err != nil {
+		t.Fatalf("GetKeyspace failed: %v", err)
+	}
+	if ki.DurabilityPolicy != topotools.DurabilityCrossCell {
+		t.Errorf("DurabilityPolicy = %q, want %q", ki.DurabilityPolicy, topotools.DurabilityCrossCell)
+	}
+
+	// No tablet in cell2 can ack, or replace the master.
+	err = vp.Run([]string{"ValidateDurabilityPolicy", keyspace})
+	if err == nil || !strings.Contains(err.Error(), "no tablet can ack the transactions of tablet cell1-0000000000") || !strings.Contains(err.Error(), "no tablet can replace master cell1-0000000000") {
+		t.Errorf("ValidateDurabilityPolicy returned %v", err)
+	}
+
+	// A rdonly tablet can't be promoted.
+	if err := wr.PlannedReparentShard(ctx, keyspace, master.Tablet.Shard, rdonly.Tablet.Alias, nil, 10*time.Second); err == nil || !strings.Contains(err.Error(), "only REPLICA tablets can be promoted") {
+		t.Errorf("PlannedReparentShard to a rdonly tablet returned %v", err)
+	}
+	if err := wr.EmergencyReparentShard(ctx, keyspace, master.Tablet.Shard, rdonly.Tablet.Alias, 10*time.Second); err == nil || !strings.Contains(err.Error(), "only REPLICA tablets can be promoted") {
+		t.Errorf("EmergencyReparentShard to a rdonly tablet returned %v", err)
+	}
+
+	// With a replica in cell2, the policy is followed.
+	NewFakeTablet(t, wr, "cell2", 3, topodatapb.TabletType_REPLICA, db)
+	if err := vp.Run([]string{"ValidateDurabilityPolicy", keyspace}); err != nil {
+		t.Errorf("ValidateDurabilityPolicy failed: %v", err)
+	}
+}
+
+func TestDurabilityPolicySemiSync(t *testing.T) {
+	ctx := context.Background()
+	db := fakesqldb.Register()
+	ts := zk2topo.NewFakeServer("cell1", "cell2")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+
+	master := NewFakeTablet(t, wr, "cell1", 0, topodatapb.TabletType_MASTER, db)
+	replica1 := NewFakeTablet(t, wr, "cell1", 1, topodatapb.TabletType_REPLICA, db)
+	replica2 := NewFakeTablet(t, wr, "cell2", 2, topodatapb.TabletType_REPLICA, db)
+	rdonly2 := NewFakeTablet(t, wr, "cell2", 3, topodatapb.TabletType_RDONLY, db)
+	if err := wr.SetKeyspaceDurabilityPolicy(ctx, master.Tablet.Keyspace, topotools.DurabilityCrossCell); err != nil {
+		t.Fatalf("SetKeyspaceDurabilityPolicy failed: %v", err)
+	}
+
+	// The replica in the master cell was acking.
+	replica1.FakeMysqlDaemon.SemiSyncSlaveEnabled = true
+	for _, tablet := range []*FakeTablet{replica1, replica2, rdonly2} {
+		tablet.StartActionLoop(t, wr)
+		defer tablet.StopActionLoop(t)
+	}
+
+	// Changing the tablet type fixes semi-sync: only the replica
+	// in the other cell acks.
+	for _, tablet := range []*FakeTablet{replica1, replica2, rdonly2} {
+		if err := wr.TabletManagerClient().ChangeType(ctx, tablet.Tablet, tablet.Tablet.Type); err != nil {
+			t.Fatalf("ChangeType(%v) failed: %v", tablet.Tablet.Alias, err)
+		}
+	}
+	checkSemiSyncEnabled(t, false, false, replica1, rdonly2)
+	checkSemiSyncEnabled(t, false, true, replica2)
+}
@@ -205,3 +205,94 @@ func TestEmergencyReparentShardMasterElectNotBest(t *testing.T) {
 		t.Fatalf("moreAdvancedSlave.FakeMysqlDaemon.CheckSuperQueryList failed: %v", err)
 	}
 }
+
+// TestAutomaticEmergencyReparentShard checks the most advanced
+// REPLICA is chosen as the new master.
+func TestAutomaticEmergencyReparentShard(t *testing.T) {
+	ctx := context.Background()
+	db := fakesqldb.Register()
+	ts := zk2topo.NewFakeServer("cell1", "cell2")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+
+	// Create a master, a replica in each cell, and a rdonly
+	oldMaster := NewFakeTablet(t, wr, "cell1", 0, topodatapb.TabletType_MASTER, db)
+	newMaster := NewFakeTablet(t, wr, "cell2", 1, topodatapb.TabletType_REPLICA, db)
+	goodSlave := NewFakeTablet(t, wr, "cell1", 2, topodatapb.TabletType_REPLICA, db)
+	goodRdonly := NewFakeTablet(t, wr, "cell1", 3, topodatapb.TabletType_RDONLY, db)
+
+	// the replica in the other cell is the most advanced
+	newMaster.FakeMysqlDaemon.ReadOnly = true
+	newMaster.FakeMysqlDaemon.Replicating = true
+	newMaster.FakeMysqlDaemon.CurrentMasterPosition = replication.Position{
+		GTIDSet: replication.MariadbGTID{
+			Domain:   2,
+			Server:   123,
+			Sequence: 456,
+		},
+	}
+	newMaster.FakeMysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+		"STOP SLAVE",
+		"CREATE DATABASE IF NOT EXISTS _vt",
+		"SUBCREATE TABLE IF NOT EXISTS _vt.reparent_journal",
+		"SUBINSERT INTO _vt.reparent_journal (time_created_ns, action_name, master_alias, replication_position) VALUES",
+	}
+	newMaster.FakeMysqlDaemon.PromoteSlaveResult = newMaster.FakeMysqlDaemon.CurrentMasterPosition
+	newMaster.StartActionLoop(t, wr)
+	defer newMaster.StopActionLoop(t)
+
+	// old master, will be scrapped
+	oldMaster.StartActionLoop(t, wr)
+	defer oldMaster.StopActionLoop(t)
+
+	for _, slave := range []*FakeTablet{goodSlave, goodRdonly} {
+		slave.FakeMysqlDaemon.ReadOnly = true
+		slave.FakeMysqlDaemon.Replicating = true
+		slave.FakeMysqlDaemon.CurrentMasterPosition = replication.Position{
+			GTIDSet: replication.MariadbGTID{
+				Domain:   2,
+				Server:   123,
+				Sequence: 455,
+			},
+		}
+		slave.FakeMysqlDaemon.SetMasterCommandsInput = fmt.Sprintf("%v:%v", newMaster.Tablet.Hostname, newMaster.Tablet.PortMap["mysql"])
+		slave.FakeMysqlDaemon.SetMasterCommandsResult = []string{"set master cmd 1"}
+		slave.FakeMysqlDaemon.ExpectedExecuteSuperQueryList = []string{
+			"STOP SLAVE",
+			"set master cmd 1",
+			"START SLAVE",
+		}
+		slave.StartActionLoop(t, wr)
+		defer slave.StopActionLoop(t)
+	}
+
+	// a stale dead master is refused
+	if _, err := wr.AutomaticEmergencyReparentShard(ctx, newMaster.Tablet.Keyspace, newMaster.Tablet.Shard, goodSlave.Tablet.Alias, 10*time.Second); err == nil || !strings.Contains(err.Error(), "not "+topoproto.TabletAliasString(goodSlave.Tablet.Alias)+" anymore") {
+		t.Fatalf("AutomaticEmergencyReparentShard with the wrong master returned the wrong error: %v", err)
+	}
+
+	// run AutomaticEmergencyReparentShard
+	alias, err := wr.AutomaticEmergencyReparentShard(ctx, newMaster.Tablet.Keyspace, newMaster.Tablet.Shard, oldMaster.Tablet.Alias, 10*time.Second)
+	if err != nil {
+		t.Fatalf("AutomaticEmergencyReparentShard failed: %v", err)
+	}
+	if !topoproto.TabletAliasEqual(alias, newMaster.Tablet.Alias) {
+		t.Errorf("AutomaticEmergencyReparentShard chose %v, want %v", topoproto.TabletAliasString(alias), topoproto.TabletAliasString(newMaster.Tablet.Alias))
+	}
+	si, err := ts.GetShard(ctx, newMaster.Tablet.Keyspace, newMaster.Tablet.Shard)
+	if err != nil {
+		t.Fatalf("GetShard failed: %v", err)
+	}
+	if !topoproto.TabletAliasEqual(si.MasterAlias, newMaster.Tablet.Alias) {
+		t.Errorf("shard master is %v, want %v", topoproto.TabletAliasString(si.MasterAlias), topoproto.TabletAliasString(newMaster.Tablet.Alias))
+	}
+
+	// check what was run
+	for _, tablet := range []*FakeTablet{newMaster, oldMaster, goodSlave, goodRdonly} {
+		if err := tablet.FakeMysqlDaemon.CheckSuperQueryList(); err != nil {
+			t.Errorf("%v: CheckSuperQueryList failed: %v", topoproto.TabletAliasString(tablet.Tablet.Alias), err)
+		}
+	}
+	if newMaster.FakeMysqlDaemon.ReadOnly {
+		t.Errorf("newMaster.FakeMysqlDaemon.ReadOnly set")
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlib
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/mysqlctl/replication"
+	"github.com/youtube/vitess/go/vt/tabletmanager/tmclient"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+	"github.com/youtube/vitess/go/vt/topo/zk2topo"
+	"github.com/youtube/vitess/go/vt/vttest/fakesqldb"
+	"github.com/youtube/vitess/go/vt/wrangler"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+func TestErrantGTIDs(t *testing.T) {
+	ctx := context.Background()
+	db := fakesqldb.Register()
+	ts := zk2topo.NewFakeServer("cell1", "cell2")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+	vp := NewVtctlPipe(t, ts)
+	defer vp.Close()
+
+	master := NewFakeTablet(t, wr, "cell1", 0, topodatapb.TabletType_MASTER, db)
+	goodSlave := NewFakeTablet(t, wr, "cell1", 1, topodatapb.TabletType_REPLICA, db)
+	errantSlave1 := NewFakeTablet(t, wr, "cell1", 2, topodatapb.TabletType_REPLICA, db)
+	errantSlave2 := NewFakeTablet(t, wr, "cell2", 3, topodatapb.TabletType_RDONLY, db)
+
+	master.FakeMysqlDaemon.CurrentMasterPosition = replication.MustParsePosition("MySQL56", "00010203-0405-0607-0809-0a0b0c0d0e0f:1-100")
+	goodSlave.FakeMysqlDaemon.CurrentMasterPosition = replication.MustParsePosition("MySQL56", "00010203-0405-0607-0809-0a0b0c0d0e0f:1-90")
+	errantSlave1.FakeMysqlDaemon.CurrentMasterPosition = replication.MustParsePosition("MySQL56", "00010203-0405-0607-0809-0a0b0c0d0e0f:1-100,00010203-0405-0607-0809-0a0b0c0d0eff:1-2")
+	errantSlave2.FakeMysqlDaemon.CurrentMasterPosition = replication.MustParsePosition("MySQL56", "00010203-0405-0607-0809-0a0b0c0d0e0f:1-90,00010203-0405-0607-0809-0a0b0c0d0eee:5")
+	for _, tablet := range []*FakeTablet{master, goodSlave, errantSlave1, errantSlave2} {
+		tablet.FakeMysqlDaemon.Replicating = tablet != master
+		tablet.StartActionLoop(t, wr)
+		defer tablet.StopActionLoop(t)
+	}
+
+	keyspaceShard := master.Tablet.Keyspace + "/" + master.Tablet.Shard
+	output, err := vp.RunAndOutput([]string{"FindErrantGTIDs", keyspaceShard})
+	if err == nil || !strings.Contains(err.Error(), "2 tablet(s) of shard test_keyspace/0 have errant GTIDs") {
+		t.Errorf("FindErrantGTIDs returned %v", err)
+	}
+	for _, want := range []string{
+		"cell1-0000000002 00010203-0405-0607-0809-0a0b0c0d0eff:1-2\n",
+		"cell2-0000000003 00010203-0405-0607-0809-0a0b0c0d0eee:5\n",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("FindErrantGTIDs output doesn't contain %q:\n%v", want, output)
+		}
+	}
+	if strings.Contains(output, "cell1-0000000001 ") {
+		t.Errorf("FindErrantGTIDs output contains the good slave:\n%v", output)
+	}
+
+	// The errant GTIDs of the first slave are injected on the master.
+	master.FakeMysqlDaemon.InjectEmptyTransactionsCommandsInput = "00010203-0405-0607-0809-0a0b0c0d0eff:1-2"
+	master.FakeMysqlDaemon.InjectEmptyTransactionsCommandsResult = []string{"inject cmd 1"}
+	master.FakeMysqlDaemon.ExpectedExecuteSuperQueryList = []string{"inject cmd 1"}
+	if err := vp.Run([]string{"RepairErrantGTIDs", topoproto.TabletAliasString(errantSlave1.Tablet.Alias), "inject_empty"}); err != nil {
+		t.Fatalf("RepairErrantGTIDs inject_empty failed: %v", err)
+	}
+	if err := master.FakeMysqlDaemon.CheckSuperQueryList(); err != nil {
+		t.Errorf("master.FakeMysqlDaemon.CheckSuperQueryList failed: %v", err)
+	}
+
+	// The second slave is marked for a rebuild.
+	if err := vp.Run([]string{"RepairErrantGTIDs", topoproto.TabletAliasString(errantSlave2.Tablet.Alias), "rebuild"}); err != nil {
+		t.Fatalf("RepairErrantGTIDs rebuild failed: %v", err)
+	}
+	ti, err := ts.GetTablet(ctx, errantSlave2.Tablet.Alias)
+	if err != nil {
+		t.Fatalf("GetTablet failed: %v", err)
+	}
+	if ti.Type != topodatapb.TabletType_DRAINED {
+		t.Errorf("tablet type = %v, want DRAINED", ti.Type)
+	}
+	if got, want := ti.Tags[wrangler.ErrantGTIDsTag], "00010203-0405-0607-0809-0a0b0c0d0eee:5"; got != want {
+		t.Errorf("tag %v = %q, want %q", wrangler.ErrantGTIDsTag, got, want)
+	}
+
+	// Nothing to repair on a good slave, and the master can't be
+	// repaired.
+	if err := vp.Run([]string{"RepairErrantGTIDs", topoproto.TabletAliasString(goodSlave.Tablet.Alias), "rebuild"}); err != nil {
+		t.Errorf("RepairErrantGTIDs on a good slave failed: %v", err)
+	}
+	if ti, err := ts.GetTablet(ctx, goodSlave.Tablet.Alias); err != nil || ti.Type != topodatapb.TabletType_REPLICA {
+		t.Errorf("good slave was changed: %v %v", ti, err)
+	}
+	if err := vp.Run([]string{"RepairErrantGTIDs", topoproto.TabletAliasString(master.Tablet.Alias), "rebuild"}); err == nil || !strings.Contains(err.Error(), "is the master") {
+		t.Errorf("RepairErrantGTIDs on the master returned %v", err)
+	}
+	if err := vp.Run([]string{"RepairErrantGTIDs", topoproto.TabletAliasString(errantSlave1.Tablet.Alias), "skip"}); err == nil || !strings.Contains(err.Error(), "unknown errant GTIDs repair method") {
+		t.Errorf("RepairErrantGTIDs with an unknown method returned %v", err)
+	}
+}
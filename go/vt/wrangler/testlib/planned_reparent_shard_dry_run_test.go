@@ -0,0 +1,96 @@
+// Copyright 2016, Google Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testlib
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/logutil"
+	"github.com/youtube/vitess/go/vt/mysqlctl/replication"
+	"github.com/youtube/vitess/go/vt/tabletmanager/tmclient"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+	"github.com/youtube/vitess/go/vt/topo/zk2topo"
+	"github.com/youtube/vitess/go/vt/vttest/fakesqldb"
+	"github.com/youtube/vitess/go/vt/wrangler"
+
+	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+)
+
+func TestPlannedReparentShardDryRun(t *testing.T) {
+	ctx := context.Background()
+	db := fakesqldb.Register()
+	ts := zk2topo.NewFakeServer("cell1", "cell2")
+	wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmclient.NewTabletManagerClient())
+	vp := NewVtctlPipe(t, ts)
+	defer vp.Close()
+
+	oldMaster := NewFakeTablet(t, wr, "cell1", 0, topodatapb.TabletType_MASTER, db)
+	newMaster := NewFakeTablet(t, wr, "cell1", 1, topodatapb.TabletType_REPLICA, db)
+	goodSlave := NewFakeTablet(t, wr, "cell2", 2, topodatapb.TabletType_REPLICA, db)
+
+	// The dry run doesn't execute anything, so the tablets don't
+	// expect any query.
+	oldMaster.FakeMysqlDaemon.CurrentMasterPosition = replication.MustParsePosition("MySQL56", "00010203-0405-0607-0809-0a0b0c0d0e0f:1-100")
+	for _, tablet := range []*FakeTablet{newMaster, goodSlave} {
+		tablet.FakeMysqlDaemon.ReadOnly = true
+		tablet.FakeMysqlDaemon.Replicating = true
+		tablet.FakeMysqlDaemon.CurrentMasterPosition = replication.MustParsePosition("MySQL56", "00010203-0405-0607-0809-0a0b0c0d0e0f:1-90")
+	}
+	for _, tablet := range []*FakeTablet{oldMaster, newMaster, goodSlave} {
+		tablet.StartActionLoop(t, wr)
+		defer tablet.StopActionLoop(t)
+	}
+
+	args := []string{"PlannedReparentShard", "-dry_run", "-wait_slave_timeout", "10s", "-keyspace_shard", newMaster.Tablet.Keyspace + "/" + newMaster.Tablet.Shard, "-new_master", topoproto.TabletAliasString(newMaster.Tablet.Alias)}
+	output, err := vp.RunAndOutput(args)
+	if err != nil {
+		t.Fatalf("PlannedReparentShard -dry_run failed: %v", err)
+	}
+	for _, want := range []string{
+		"1. DemoteMaster on old master cell1-0000000000",
+		"2. PromoteSlaveWhenCaughtUp on master-elect cell1-0000000001",
+		"3. PopulateReparentJournal on new master cell1-0000000001",
+		"4. SetMaster(cell1-0000000001, forceStartSlave=true) on cell1-0000000000",
+		"5. SetMaster(cell1-0000000001, forceStartSlave=false) on cell2-0000000002",
+		"All checks passed.",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("PlannedReparentShard -dry_run output doesn't contain %q:\n%v", want, output)
+		}
+	}
+
+	// Nothing changed.
+	si, err := ts.GetShard(ctx, newMaster.Tablet.Keyspace, newMaster.Tablet.Shard)
+	if err != nil {
+		t.Fatalf("GetShard failed: %v", err)
+	}
+	if !topoproto.TabletAliasEqual(si.MasterAlias, oldMaster.Tablet.Alias) {
+		t.Errorf("shard master changed to %v", topoproto.TabletAliasString(si.MasterAlias))
+	}
+	if oldMaster.FakeMysqlDaemon.ReadOnly {
+		t.Errorf("old master is read-only")
+	}
+
+	// Errant GTIDs on another slave are a warning.
+	goodSlave.FakeMysqlDaemon.CurrentMasterPosition = replication.MustParsePosition("MySQL56", "00010203-0405-0607-0809-0a0b0c0d0e0f:1-90,00010203-0405-0607-0809-0a0b0c0d0eff:1-2")
+	output, err = vp.RunAndOutput(args)
+	if err != nil {
+		t.Fatalf("PlannedReparentShard -dry_run failed: %v", err)
+	}
+	if want := "WARNING: cell2-0000000002 has errant GTIDs that are not on the master: 00010203-0405-0607-0809-0a0b0c0d0eff:1-2"; !strings.Contains(output, want) {
+		t.Errorf("PlannedReparentShard -dry_run output doesn't contain %q:\n%v", want, output)
+	}
+
+	// Errant GTIDs and lag on the master-elect make the reparent fail.
+	newMaster.FakeMysqlDaemon.CurrentMasterPosition = replication.MustParsePosition("MySQL56", "00010203-0405-0607-0809-0a0b0c0d0e0f:1-101")
+	newMaster.FakeMysqlDaemon.SecondsBehindMaster = 60
+	_, err = vp.RunAndOutput(args)
+	if err == nil || !strings.Contains(err.Error(), "master-elect cell1-0000000001 has errant GTIDs that are not on the master: 00010203-0405-0607-0809-0a0b0c0d0e0f:101") || !strings.Contains(err.Error(), "master-elect cell1-0000000001 is 1m0s behind the master") {
+		t.Errorf("PlannedReparentShard -dry_run returned %v", err)
+	}
+}
@@ -20,7 +20,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   name='tabletmanagerservice.proto',
   package='tabletmanagerservice',
   syntax='proto3',
-  serialized_pb=_b('\n\x1atabletmanagerservice.proto\x12\x14tabletmanagerservice\x1a\x17tabletmanagerdata.proto2\x9c\"\n\rTabletManager\x12I\n\x04Ping\x12\x1e.tabletmanagerdata.PingRequest\x1a\x1f.tabletmanagerdata.PingResponse\"\x00\x12L\n\x05Sleep\x12\x1f.tabletmanagerdata.SleepRequest\x1a .tabletmanagerdata.SleepResponse\"\x00\x12^\n\x0b\x45xecuteHook\x12%.tabletmanagerdata.ExecuteHookRequest\x1a&.tabletmanagerdata.ExecuteHookResponse\"\x00\x12X\n\tGetSchema\x12#.tabletmanagerdata.GetSchemaRequest\x1a$.tabletmanagerdata.GetSchemaResponse\"\x00\x12g\n\x0eGetPermissions\x12(.tabletmanagerdata.GetPermissionsRequest\x1a).tabletmanagerdata.GetPermissionsResponse\"\x00\x12^\n\x0bSetReadOnly\x12%.tabletmanagerdata.SetReadOnlyRequest\x1a&.tabletmanagerdata.SetReadOnlyResponse\"\x00\x12\x61\n\x0cSetReadWrite\x12&.tabletmanagerdata.SetReadWriteRequest\x1a\'.tabletmanagerdata.SetReadWriteResponse\"\x00\x12[\n\nChangeType\x12$.tabletmanagerdata.ChangeTypeRequest\x1a%.tabletmanagerdata.ChangeTypeResponse\"\x00\x12\x61\n\x0cRefreshState\x12&.tabletmanagerdata.RefreshStateRequest\x1a\'.tabletmanagerdata.RefreshStateResponse\"\x00\x12g\n\x0eRunHealthCheck\x12(.tabletmanagerdata.RunHealthCheckRequest\x1a).tabletmanagerdata.RunHealthCheckResponse\"\x00\x12p\n\x11IgnoreHealthError\x12+.tabletmanagerdata.IgnoreHealthErrorRequest\x1a,.tabletmanagerdata.IgnoreHealthErrorResponse\"\x00\x12\x61\n\x0cReloadSchema\x12&.tabletmanagerdata.ReloadSchemaRequest\x1a\'.tabletmanagerdata.ReloadSchemaResponse\"\x00\x12j\n\x0fPreflightSchema\x12).tabletmanagerdata.PreflightSchemaRequest\x1a*.tabletmanagerdata.PreflightSchemaResponse\"\x00\x12^\n\x0b\x41pplySchema\x12%.tabletmanagerdata.ApplySchemaRequest\x1a&.tabletmanagerdata.ApplySchemaResponse\"\x00\x12p\n\x11\x45xecuteFetchAsDba\x12+.tabletmanagerdata.ExecuteFetchAsDbaRequest\x1a,.tabletmanagerdata.ExecuteFetchAsDbaResponse\"\x00\x12\x7f\n\x16\x45xecuteFetchAsAllPrivs\x12\x30.tabletmanagerdata.ExecuteFetchAsAllPrivsRequest\x1a\x31.tabletmanagerdata.ExecuteFetchAsAllPrivsResponse\"\x00\x12p\n\x11\x45xecuteFetchAsApp\x12+.tabletmanagerdata.ExecuteFetchAsAppRequest\x1a,.tabletmanagerdata.ExecuteFetchAsAppResponse\"\x00\x12^\n\x0bSlaveStatus\x12%.tabletmanagerdata.SlaveStatusRequest\x1a&.tabletmanagerdata.SlaveStatusResponse\"\x00\x12g\n\x0eMasterPosition\x12(.tabletmanagerdata.MasterPositionRequest\x1a).tabletmanagerdata.MasterPositionResponse\"\x00\x12X\n\tStopSlave\x12#.tabletmanagerdata.StopSlaveRequest\x1a$.tabletmanagerdata.StopSlaveResponse\"\x00\x12m\n\x10StopSlaveMinimum\x12*.tabletmanagerdata.StopSlaveMinimumRequest\x1a+.tabletmanagerdata.StopSlaveMinimumResponse\"\x00\x12[\n\nStartSlave\x12$.tabletmanagerdata.StartSlaveRequest\x1a%.tabletmanagerdata.StartSlaveResponse\"\x00\x12\x8b\x01\n\x1aTabletExternallyReparented\x12\x34.tabletmanagerdata.TabletExternallyReparentedRequest\x1a\x35.tabletmanagerdata.TabletExternallyReparentedResponse\"\x00\x12\x82\x01\n\x17TabletExternallyElected\x12\x31.tabletmanagerdata.TabletExternallyElectedRequest\x1a\x32.tabletmanagerdata.TabletExternallyElectedResponse\"\x00\x12X\n\tGetSlaves\x12#.tabletmanagerdata.GetSlavesRequest\x1a$.tabletmanagerdata.GetSlavesResponse\"\x00\x12j\n\x0fWaitBlpPosition\x12).tabletmanagerdata.WaitBlpPositionRequest\x1a*.tabletmanagerdata.WaitBlpPositionResponse\"\x00\x12R\n\x07StopBlp\x12!.tabletmanagerdata.StopBlpRequest\x1a\".tabletmanagerdata.StopBlpResponse\"\x00\x12U\n\x08StartBlp\x12\".tabletmanagerdata.StartBlpRequest\x1a#.tabletmanagerdata.StartBlpResponse\"\x00\x12^\n\x0bRunBlpUntil\x12%.tabletmanagerdata.RunBlpUntilRequest\x1a&.tabletmanagerdata.RunBlpUntilResponse\"\x00\x12m\n\x10ResetReplication\x12*.tabletmanagerdata.ResetReplicationRequest\x1a+.tabletmanagerdata.ResetReplicationResponse\"\x00\x12[\n\nInitMaster\x12$.tabletmanagerdata.InitMasterRequest\x1a%.tabletmanagerdata.InitMasterResponse\"\x00\x12\x82\x01\n\x17PopulateReparentJournal\x12\x31.tabletmanagerdata.PopulateReparentJournalRequest\x1a\x32.tabletmanagerdata.PopulateReparentJournalResponse\"\x00\x12X\n\tInitSlave\x12#.tabletmanagerdata.InitSlaveRequest\x1a$.tabletmanagerdata.InitSlaveResponse\"\x00\x12\x61\n\x0c\x44\x65moteMaster\x12&.tabletmanagerdata.DemoteMasterRequest\x1a\'.tabletmanagerdata.DemoteMasterResponse\"\x00\x12\x85\x01\n\x18PromoteSlaveWhenCaughtUp\x12\x32.tabletmanagerdata.PromoteSlaveWhenCaughtUpRequest\x1a\x33.tabletmanagerdata.PromoteSlaveWhenCaughtUpResponse\"\x00\x12m\n\x10SlaveWasPromoted\x12*.tabletmanagerdata.SlaveWasPromotedRequest\x1a+.tabletmanagerdata.SlaveWasPromotedResponse\"\x00\x12X\n\tSetMaster\x12#.tabletmanagerdata.SetMasterRequest\x1a$.tabletmanagerdata.SetMasterResponse\"\x00\x12p\n\x11SlaveWasRestarted\x12+.tabletmanagerdata.SlaveWasRestartedRequest\x1a,.tabletmanagerdata.SlaveWasRestartedResponse\"\x00\x12\x8e\x01\n\x1bStopReplicationAndGetStatus\x12\x35.tabletmanagerdata.StopReplicationAndGetStatusRequest\x1a\x36.tabletmanagerdata.StopReplicationAndGetStatusResponse\"\x00\x12\x61\n\x0cPromoteSlave\x12&.tabletmanagerdata.PromoteSlaveRequest\x1a\'.tabletmanagerdata.PromoteSlaveResponse\"\x00\x12Q\n\x06\x42\x61\x63kup\x12 .tabletmanagerdata.BackupRequest\x1a!.tabletmanagerdata.BackupResponse\"\x00\x30\x01\x12r\n\x11RestoreFromBackup\x12+.tabletmanagerdata.RestoreFromBackupRequest\x1a,.tabletmanagerdata.RestoreFromBackupResponse\"\x00\x30\x01\x62\x06proto3')
+  serialized_pb=_b('\n\x1atabletmanagerservice.proto\x12\x14tabletmanagerservice\x1a\x17tabletmanagerdata.proto2\x99$\n\rTabletManager\x12I\n\x04Ping\x12\x1e.tabletmanagerdata.PingRequest\x1a\x1f.tabletmanagerdata.PingResponse\"\x00\x12L\n\x05Sleep\x12\x1f.tabletmanagerdata.SleepRequest\x1a .tabletmanagerdata.SleepResponse\"\x00\x12^\n\x0b\x45xecuteHook\x12%.tabletmanagerdata.ExecuteHookRequest\x1a&.tabletmanagerdata.ExecuteHookResponse\"\x00\x12X\n\tGetSchema\x12#.tabletmanagerdata.GetSchemaRequest\x1a$.tabletmanagerdata.GetSchemaResponse\"\x00\x12g\n\x0eGetPermissions\x12(.tabletmanagerdata.GetPermissionsRequest\x1a).tabletmanagerdata.GetPermissionsResponse\"\x00\x12^\n\x0bSetReadOnly\x12%.tabletmanagerdata.SetReadOnlyRequest\x1a&.tabletmanagerdata.SetReadOnlyResponse\"\x00\x12\x61\n\x0cSetReadWrite\x12&.tabletmanagerdata.SetReadWriteRequest\x1a\'.tabletmanagerdata.SetReadWriteResponse\"\x00\x12[\n\nChangeType\x12$.tabletmanagerdata.ChangeTypeRequest\x1a%.tabletmanagerdata.ChangeTypeResponse\"\x00\x12\x61\n\x0cRefreshState\x12&.tabletmanagerdata.RefreshStateRequest\x1a\'.tabletmanagerdata.RefreshStateResponse\"\x00\x12g\n\x0eRunHealthCheck\x12(.tabletmanagerdata.RunHealthCheckRequest\x1a).tabletmanagerdata.RunHealthCheckResponse\"\x00\x12p\n\x11IgnoreHealthError\x12+.tabletmanagerdata.IgnoreHealthErrorRequest\x1a,.tabletmanagerdata.IgnoreHealthErrorResponse\"\x00\x12\x61\n\x0cReloadSchema\x12&.tabletmanagerdata.ReloadSchemaRequest\x1a\'.tabletmanagerdata.ReloadSchemaResponse\"\x00\x12j\n\x0fPreflightSchema\x12).tabletmanagerdata.PreflightSchemaRequest\x1a*.tabletmanagerdata.PreflightSchemaResponse\"\x00\x12^\n\x0b\x41pplySchema\x12%.tabletmanagerdata.ApplySchemaRequest\x1a&.tabletmanagerdata.ApplySchemaResponse\"\x00\x12p\n\x11\x45xecuteFetchAsDba\x12+.tabletmanagerdata.ExecuteFetchAsDbaRequest\x1a,.tabletmanagerdata.ExecuteFetchAsDbaResponse\"\x00\x12\x7f\n\x16\x45xecuteFetchAsAllPrivs\x12\x30.tabletmanagerdata.ExecuteFetchAsAllPrivsRequest\x1a\x31.tabletmanagerdata.ExecuteFetchAsAllPrivsResponse\"\x00\x12p\n\x11\x45xecuteFetchAsApp\x12+.tabletmanagerdata.ExecuteFetchAsAppRequest\x1a,.tabletmanagerdata.ExecuteFetchAsAppResponse\"\x00\x12^\n\x0bSlaveStatus\x12%.tabletmanagerdata.SlaveStatusRequest\x1a&.tabletmanagerdata.SlaveStatusResponse\"\x00\x12g\n\x0eMasterPosition\x12(.tabletmanagerdata.MasterPositionRequest\x1a).tabletmanagerdata.MasterPositionResponse\"\x00\x12X\n\tStopSlave\x12#.tabletmanagerdata.StopSlaveRequest\x1a$.tabletmanagerdata.StopSlaveResponse\"\x00\x12m\n\x10StopSlaveMinimum\x12*.tabletmanagerdata.StopSlaveMinimumRequest\x1a+.tabletmanagerdata.StopSlaveMinimumResponse\"\x00\x12v\n\x13StopSlaveBeforeTime\x12-.tabletmanagerdata.StopSlaveBeforeTimeRequest\x1a..tabletmanagerdata.StopSlaveBeforeTimeResponse\"\x00\x12[\n\nStartSlave\x12$.tabletmanagerdata.StartSlaveRequest\x1a%.tabletmanagerdata.StartSlaveResponse\"\x00\x12\x82\x01\n\x17InjectEmptyTransactions\x12\x31.tabletmanagerdata.InjectEmptyTransactionsRequest\x1a\x32.tabletmanagerdata.InjectEmptyTransactionsResponse\"\x00\x12\x8b\x01\n\x1aTabletExternallyReparented\x12\x34.tabletmanagerdata.TabletExternallyReparentedRequest\x1a\x35.tabletmanagerdata.TabletExternallyReparentedResponse\"\x00\x12\x82\x01\n\x17TabletExternallyElected\x12\x31.tabletmanagerdata.TabletExternallyElectedRequest\x1a\x32.tabletmanagerdata.TabletExternallyElectedResponse\"\x00\x12X\n\tGetSlaves\x12#.tabletmanagerdata.GetSlavesRequest\x1a$.tabletmanagerdata.GetSlavesResponse\"\x00\x12j\n\x0fWaitBlpPosition\x12).tabletmanagerdata.WaitBlpPositionRequest\x1a*.tabletmanagerdata.WaitBlpPositionResponse\"\x00\x12R\n\x07StopBlp\x12!.tabletmanagerdata.StopBlpRequest\x1a\".tabletmanagerdata.StopBlpResponse\"\x00\x12U\n\x08StartBlp\x12\".tabletmanagerdata.StartBlpRequest\x1a#.tabletmanagerdata.StartBlpResponse\"\x00\x12^\n\x0bRunBlpUntil\x12%.tabletmanagerdata.RunBlpUntilRequest\x1a&.tabletmanagerdata.RunBlpUntilResponse\"\x00\x12m\n\x10ResetReplication\x12*.tabletmanagerdata.ResetReplicationRequest\x1a+.tabletmanagerdata.ResetReplicationResponse\"\x00\x12[\n\nInitMaster\x12$.tabletmanagerdata.InitMasterRequest\x1a%.tabletmanagerdata.InitMasterResponse\"\x00\x12\x82\x01\n\x17PopulateReparentJournal\x12\x31.tabletmanagerdata.PopulateReparentJournalRequest\x1a\x32.tabletmanagerdata.PopulateReparentJournalResponse\"\x00\x12X\n\tInitSlave\x12#.tabletmanagerdata.InitSlaveRequest\x1a$.tabletmanagerdata.InitSlaveResponse\"\x00\x12\x61\n\x0c\x44\x65moteMaster\x12&.tabletmanagerdata.DemoteMasterRequest\x1a\'.tabletmanagerdata.DemoteMasterResponse\"\x00\x12\x85\x01\n\x18PromoteSlaveWhenCaughtUp\x12\x32.tabletmanagerdata.PromoteSlaveWhenCaughtUpRequest\x1a\x33.tabletmanagerdata.PromoteSlaveWhenCaughtUpResponse\"\x00\x12m\n\x10SlaveWasPromoted\x12*.tabletmanagerdata.SlaveWasPromotedRequest\x1a+.tabletmanagerdata.SlaveWasPromotedResponse\"\x00\x12X\n\tSetMaster\x12#.tabletmanagerdata.SetMasterRequest\x1a$.tabletmanagerdata.SetMasterResponse\"\x00\x12p\n\x11SlaveWasRestarted\x12+.tabletmanagerdata.SlaveWasRestartedRequest\x1a,.tabletmanagerdata.SlaveWasRestartedResponse\"\x00\x12\x8e\x01\n\x1bStopReplicationAndGetStatus\x12\x35.tabletmanagerdata.StopReplicationAndGetStatusRequest\x1a\x36.tabletmanagerdata.StopReplicationAndGetStatusResponse\"\x00\x12\x61\n\x0cPromoteSlave\x12&.tabletmanagerdata.PromoteSlaveRequest\x1a\'.tabletmanagerdata.PromoteSlaveResponse\"\x00\x12Q\n\x06\x42\x61\x63kup\x12 .tabletmanagerdata.BackupRequest\x1a!.tabletmanagerdata.BackupResponse\"\x00\x30\x01\x12r\n\x11RestoreFromBackup\x12+.tabletmanagerdata.RestoreFromBackupRequest\x1a,.tabletmanagerdata.RestoreFromBackupResponse\"\x00\x30\x01\x62\x06proto3')
   ,
   dependencies=[tabletmanagerdata__pb2.DESCRIPTOR,])
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
@@ -154,11 +154,21 @@ class TabletManagerStub(object):
         request_serializer=tabletmanagerdata__pb2.StopSlaveMinimumRequest.SerializeToString,
         response_deserializer=tabletmanagerdata__pb2.StopSlaveMinimumResponse.FromString,
         )
+    self.StopSlaveBeforeTime = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/StopSlaveBeforeTime',
+        request_serializer=tabletmanagerdata__pb2.StopSlaveBeforeTimeRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.StopSlaveBeforeTimeResponse.FromString,
+        )
     self.StartSlave = channel.unary_unary(
         '/tabletmanagerservice.TabletManager/StartSlave',
         request_serializer=tabletmanagerdata__pb2.StartSlaveRequest.SerializeToString,
         response_deserializer=tabletmanagerdata__pb2.StartSlaveResponse.FromString,
         )
+    self.InjectEmptyTransactions = channel.unary_unary(
+        '/tabletmanagerservice.TabletManager/InjectEmptyTransactions',
+        request_serializer=tabletmanagerdata__pb2.InjectEmptyTransactionsRequest.SerializeToString,
+        response_deserializer=tabletmanagerdata__pb2.InjectEmptyTransactionsResponse.FromString,
+        )
     self.TabletExternallyReparented = channel.unary_unary(
         '/tabletmanagerservice.TabletManager/TabletExternallyReparented',
         request_serializer=tabletmanagerdata__pb2.TabletExternallyReparentedRequest.SerializeToString,
@@ -403,6 +413,15 @@ class TabletManagerServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def StopSlaveBeforeTime(self, request, context):
+    """StopSlaveBeforeTime stops the mysql replication of a delayed
+    replica right before the first transaction committed at or
+    after the provided time
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
   def StartSlave(self, request, context):
     """StartSlave starts the mysql replication
     """
@@ -410,6 +429,14 @@ class TabletManagerServicer(object):
     context.set_details('Method not implemented!')
     raise NotImplementedError('Method not implemented!')
 
+  def InjectEmptyTransactions(self, request, context):
+    """InjectEmptyTransactions commits an empty transaction for each
+    of the provided GTIDs
+    """
+    context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+    context.set_details('Method not implemented!')
+    raise NotImplementedError('Method not implemented!')
+
   def TabletExternallyReparented(self, request, context):
     """TabletExternallyReparented tells a tablet that its underlying MySQL is
     currently the master. It is only used in environments (tabletmanagerdata.such as Vitess+MoB)
@@ -692,11 +719,21 @@ def add_TabletManagerServicer_to_server(servicer, server):
           request_deserializer=tabletmanagerdata__pb2.StopSlaveMinimumRequest.FromString,
           response_serializer=tabletmanagerdata__pb2.StopSlaveMinimumResponse.SerializeToString,
       ),
+      'StopSlaveBeforeTime': grpc.unary_unary_rpc_method_handler(
+          servicer.StopSlaveBeforeTime,
+          request_deserializer=tabletmanagerdata__pb2.StopSlaveBeforeTimeRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.StopSlaveBeforeTimeResponse.SerializeToString,
+      ),
       'StartSlave': grpc.unary_unary_rpc_method_handler(
           servicer.StartSlave,
           request_deserializer=tabletmanagerdata__pb2.StartSlaveRequest.FromString,
           response_serializer=tabletmanagerdata__pb2.StartSlaveResponse.SerializeToString,
       ),
+      'InjectEmptyTransactions': grpc.unary_unary_rpc_method_handler(
+          servicer.InjectEmptyTransactions,
+          request_deserializer=tabletmanagerdata__pb2.InjectEmptyTransactionsRequest.FromString,
+          response_serializer=tabletmanagerdata__pb2.InjectEmptyTransactionsResponse.SerializeToString,
+      ),
       'TabletExternallyReparented': grpc.unary_unary_rpc_method_handler(
           servicer.TabletExternallyReparented,
           request_deserializer=tabletmanagerdata__pb2.TabletExternallyReparentedRequest.FromString,
@@ -881,10 +918,21 @@ class BetaTabletManagerServicer(object):
     the provided minimum point
     """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def StopSlaveBeforeTime(self, request, context):
+    """StopSlaveBeforeTime stops the mysql replication of a delayed
+    replica right before the first transaction committed at or
+    after the provided time
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
   def StartSlave(self, request, context):
     """StartSlave starts the mysql replication
     """
     context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
+  def InjectEmptyTransactions(self, request, context):
+    """InjectEmptyTransactions commits an empty transaction for each
+    of the provided GTIDs
+    """
+    context.code(beta_interfaces.StatusCode.UNIMPLEMENTED)
   def TabletExternallyReparented(self, request, context):
     """TabletExternallyReparented tells a tablet that its underlying MySQL is
     currently the master. It is only used in environments (tabletmanagerdata.such as Vitess+MoB)
@@ -1100,11 +1148,24 @@ class BetaTabletManagerStub(object):
     """
     raise NotImplementedError()
   StopSlaveMinimum.future = None
+  def StopSlaveBeforeTime(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """StopSlaveBeforeTime stops the mysql replication of a delayed
+    replica right before the first transaction committed at or
+    after the provided time
+    """
+    raise NotImplementedError()
+  StopSlaveBeforeTime.future = None
   def StartSlave(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
     """StartSlave starts the mysql replication
     """
     raise NotImplementedError()
   StartSlave.future = None
+  def InjectEmptyTransactions(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
+    """InjectEmptyTransactions commits an empty transaction for each
+    of the provided GTIDs
+    """
+    raise NotImplementedError()
+  InjectEmptyTransactions.future = None
   def TabletExternallyReparented(self, request, timeout, metadata=None, with_call=False, protocol_options=None):
     """TabletExternallyReparented tells a tablet that its underlying MySQL is
     currently the master. It is only used in environments (tabletmanagerdata.such as Vitess+MoB)
@@ -1276,10 +1337,12 @@ def beta_create_TabletManager_server(servicer, pool=None, pool_size=None, defaul
     ('tabletmanagerservice.TabletManager', 'Sleep'): tabletmanagerdata__pb2.SleepRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'StartBlp'): tabletmanagerdata__pb2.StartBlpRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'StartSlave'): tabletmanagerdata__pb2.StartSlaveRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'InjectEmptyTransactions'): tabletmanagerdata__pb2.InjectEmptyTransactionsRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'StopBlp'): tabletmanagerdata__pb2.StopBlpRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'StopReplicationAndGetStatus'): tabletmanagerdata__pb2.StopReplicationAndGetStatusRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'StopSlave'): tabletmanagerdata__pb2.StopSlaveRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'StopSlaveMinimum'): tabletmanagerdata__pb2.StopSlaveMinimumRequest.FromString,
+    ('tabletmanagerservice.TabletManager', 'StopSlaveBeforeTime'): tabletmanagerdata__pb2.StopSlaveBeforeTimeRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'TabletExternallyElected'): tabletmanagerdata__pb2.TabletExternallyElectedRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'TabletExternallyReparented'): tabletmanagerdata__pb2.TabletExternallyReparentedRequest.FromString,
     ('tabletmanagerservice.TabletManager', 'WaitBlpPosition'): tabletmanagerdata__pb2.WaitBlpPositionRequest.FromString,
@@ -1320,10 +1383,12 @@ def beta_create_TabletManager_server(servicer, pool=None, pool_size=None, defaul
     ('tabletmanagerservice.TabletManager', 'Sleep'): tabletmanagerdata__pb2.SleepResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'StartBlp'): tabletmanagerdata__pb2.StartBlpResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'StartSlave'): tabletmanagerdata__pb2.StartSlaveResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'InjectEmptyTransactions'): tabletmanagerdata__pb2.InjectEmptyTransactionsResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'StopBlp'): tabletmanagerdata__pb2.StopBlpResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'StopReplicationAndGetStatus'): tabletmanagerdata__pb2.StopReplicationAndGetStatusResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'StopSlave'): tabletmanagerdata__pb2.StopSlaveResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'StopSlaveMinimum'): tabletmanagerdata__pb2.StopSlaveMinimumResponse.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'StopSlaveBeforeTime'): tabletmanagerdata__pb2.StopSlaveBeforeTimeResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'TabletExternallyElected'): tabletmanagerdata__pb2.TabletExternallyElectedResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'TabletExternallyReparented'): tabletmanagerdata__pb2.TabletExternallyReparentedResponse.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'WaitBlpPosition'): tabletmanagerdata__pb2.WaitBlpPositionResponse.SerializeToString,
@@ -1364,10 +1429,12 @@ def beta_create_TabletManager_server(servicer, pool=None, pool_size=None, defaul
     ('tabletmanagerservice.TabletManager', 'Sleep'): face_utilities.unary_unary_inline(servicer.Sleep),
     ('tabletmanagerservice.TabletManager', 'StartBlp'): face_utilities.unary_unary_inline(servicer.StartBlp),
     ('tabletmanagerservice.TabletManager', 'StartSlave'): face_utilities.unary_unary_inline(servicer.StartSlave),
+    ('tabletmanagerservice.TabletManager', 'InjectEmptyTransactions'): face_utilities.unary_unary_inline(servicer.InjectEmptyTransactions),
     ('tabletmanagerservice.TabletManager', 'StopBlp'): face_utilities.unary_unary_inline(servicer.StopBlp),
     ('tabletmanagerservice.TabletManager', 'StopReplicationAndGetStatus'): face_utilities.unary_unary_inline(servicer.StopReplicationAndGetStatus),
     ('tabletmanagerservice.TabletManager', 'StopSlave'): face_utilities.unary_unary_inline(servicer.StopSlave),
     ('tabletmanagerservice.TabletManager', 'StopSlaveMinimum'): face_utilities.unary_unary_inline(servicer.StopSlaveMinimum),
+    ('tabletmanagerservice.TabletManager', 'StopSlaveBeforeTime'): face_utilities.unary_unary_inline(servicer.StopSlaveBeforeTime),
     ('tabletmanagerservice.TabletManager', 'TabletExternallyElected'): face_utilities.unary_unary_inline(servicer.TabletExternallyElected),
     ('tabletmanagerservice.TabletManager', 'TabletExternallyReparented'): face_utilities.unary_unary_inline(servicer.TabletExternallyReparented),
     ('tabletmanagerservice.TabletManager', 'WaitBlpPosition'): face_utilities.unary_unary_inline(servicer.WaitBlpPosition),
@@ -1413,10 +1480,12 @@ def beta_create_TabletManager_stub(channel, host=None, metadata_transformer=None
     ('tabletmanagerservice.TabletManager', 'Sleep'): tabletmanagerdata__pb2.SleepRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'StartBlp'): tabletmanagerdata__pb2.StartBlpRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'StartSlave'): tabletmanagerdata__pb2.StartSlaveRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'InjectEmptyTransactions'): tabletmanagerdata__pb2.InjectEmptyTransactionsRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'StopBlp'): tabletmanagerdata__pb2.StopBlpRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'StopReplicationAndGetStatus'): tabletmanagerdata__pb2.StopReplicationAndGetStatusRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'StopSlave'): tabletmanagerdata__pb2.StopSlaveRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'StopSlaveMinimum'): tabletmanagerdata__pb2.StopSlaveMinimumRequest.SerializeToString,
+    ('tabletmanagerservice.TabletManager', 'StopSlaveBeforeTime'): tabletmanagerdata__pb2.StopSlaveBeforeTimeRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'TabletExternallyElected'): tabletmanagerdata__pb2.TabletExternallyElectedRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'TabletExternallyReparented'): tabletmanagerdata__pb2.TabletExternallyReparentedRequest.SerializeToString,
     ('tabletmanagerservice.TabletManager', 'WaitBlpPosition'): tabletmanagerdata__pb2.WaitBlpPositionRequest.SerializeToString,
@@ -1457,10 +1526,12 @@ def beta_create_TabletManager_stub(channel, host=None, metadata_transformer=None
     ('tabletmanagerservice.TabletManager', 'Sleep'): tabletmanagerdata__pb2.SleepResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'StartBlp'): tabletmanagerdata__pb2.StartBlpResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'StartSlave'): tabletmanagerdata__pb2.StartSlaveResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'InjectEmptyTransactions'): tabletmanagerdata__pb2.InjectEmptyTransactionsResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'StopBlp'): tabletmanagerdata__pb2.StopBlpResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'StopReplicationAndGetStatus'): tabletmanagerdata__pb2.StopReplicationAndGetStatusResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'StopSlave'): tabletmanagerdata__pb2.StopSlaveResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'StopSlaveMinimum'): tabletmanagerdata__pb2.StopSlaveMinimumResponse.FromString,
+    ('tabletmanagerservice.TabletManager', 'StopSlaveBeforeTime'): tabletmanagerdata__pb2.StopSlaveBeforeTimeResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'TabletExternallyElected'): tabletmanagerdata__pb2.TabletExternallyElectedResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'TabletExternallyReparented'): tabletmanagerdata__pb2.TabletExternallyReparentedResponse.FromString,
     ('tabletmanagerservice.TabletManager', 'WaitBlpPosition'): tabletmanagerdata__pb2.WaitBlpPositionResponse.FromString,
@@ -1501,10 +1572,12 @@ def beta_create_TabletManager_stub(channel, host=None, metadata_transformer=None
     'Sleep': cardinality.Cardinality.UNARY_UNARY,
     'StartBlp': cardinality.Cardinality.UNARY_UNARY,
     'StartSlave': cardinality.Cardinality.UNARY_UNARY,
+    'InjectEmptyTransactions': cardinality.Cardinality.UNARY_UNARY,
     'StopBlp': cardinality.Cardinality.UNARY_UNARY,
     'StopReplicationAndGetStatus': cardinality.Cardinality.UNARY_UNARY,
     'StopSlave': cardinality.Cardinality.UNARY_UNARY,
     'StopSlaveMinimum': cardinality.Cardinality.UNARY_UNARY,
+    'StopSlaveBeforeTime': cardinality.Cardinality.UNARY_UNARY,
     'TabletExternallyElected': cardinality.Cardinality.UNARY_UNARY,
     'TabletExternallyReparented': cardinality.Cardinality.UNARY_UNARY,
     'WaitBlpPosition': cardinality.Cardinality.UNARY_UNARY,
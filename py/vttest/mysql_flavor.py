@@ -52,6 +52,28 @@ class MySQL56(MysqlFlavor):
     return ":".join(files)
 
 
+class MySQL57(MysqlFlavor):
+  """Overrides specific to MySQL 5.7, and 8.0 before 8.0.23."""
+
+  def my_cnf(self):
+    files = [
+        os.path.join(vttop, "config/mycnf/default-fast.cnf"),
+        os.path.join(vttop, "config/mycnf/master_mysql57.cnf"),
+    ]
+    return ":".join(files)
+
+
+class MySQL80(MysqlFlavor):
+  """Overrides specific to MySQL 8.0.23+."""
+
+  def my_cnf(self):
+    files = [
+        os.path.join(vttop, "config/mycnf/default-fast.cnf"),
+        os.path.join(vttop, "config/mycnf/master_mysql80.cnf"),
+    ]
+    return ":".join(files)
+
+
 __mysql_flavor = None
 
 
@@ -78,6 +100,10 @@ def set_mysql_flavor(flavor):
     __mysql_flavor = MariaDB()
   elif flavor == "MySQL56":
     __mysql_flavor = MySQL56()
+  elif flavor == "MySQL57":
+    __mysql_flavor = MySQL57()
+  elif flavor == "MySQL80":
+    __mysql_flavor = MySQL80()
   else:
     logging.error("Unknown MYSQL_FLAVOR '%s'", flavor)
     exit(1)
@@ -139,6 +139,43 @@ class MySQL56(MysqlFlavor):
         (host, port)]
 
 
+class MySQL57(MySQL56):
+  """Overrides specific to MySQL 5.7, and 8.0 before 8.0.23."""
+
+  def extra_my_cnf(self):
+    return environment.vttop + "/config/mycnf/master_mysql57.cnf"
+
+
+class MySQL80(MySQL57):
+  """Overrides specific to MySQL 8.0.23+, with the replica vocabulary."""
+
+  def promote_slave_commands(self):
+    return [
+        "STOP REPLICA",
+        "RESET REPLICA ALL",
+        "RESET MASTER",
+    ]
+
+  def reset_replication_commands(self):
+    return [
+        "STOP REPLICA",
+        "RESET REPLICA ALL",
+        "RESET MASTER",
+    ]
+
+  def extra_my_cnf(self):
+    return environment.vttop + "/config/mycnf/master_mysql80.cnf"
+
+  def change_master_commands(self, host, port, pos):
+    gtid = pos.split("/")[1]
+    return [
+        "RESET MASTER",
+        "SET GLOBAL gtid_purged = '%s'" % gtid,
+        "CHANGE REPLICATION SOURCE TO SOURCE_HOST='%s', SOURCE_PORT=%d, "
+        "SOURCE_USER='vt_repl', SOURCE_AUTO_POSITION = 1" %
+        (host, port)]
+
+
 __mysql_flavor = None
 
 
@@ -173,6 +210,10 @@ def set_mysql_flavor(flavor):
     __mysql_flavor = MariaDB()
   elif flavor == "MySQL56":
     __mysql_flavor = MySQL56()
+  elif flavor == "MySQL57":
+    __mysql_flavor = MySQL57()
+  elif flavor == "MySQL80":
+    __mysql_flavor = MySQL80()
   else:
     logging.error("Unknown MYSQL_FLAVOR '%s'", flavor)
     exit(1)